@@ -2,12 +2,15 @@ package main
 
 import (
 	"context"
+	"crypto/rand"
 	"fmt"
+	"io"
 	"net"
 	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"buf.build/go/protovalidate"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -19,14 +22,30 @@ import (
 	"google.golang.org/grpc/reflection"
 
 	pb "github.com/homindolenern/goapps-costing-v1/gen/go/costing/v1"
+	appaudit "github.com/homindolenern/goapps-costing-v1/internal/application/audit"
+	appcosting "github.com/homindolenern/goapps-costing-v1/internal/application/costing"
+	appmachine "github.com/homindolenern/goapps-costing-v1/internal/application/machine"
+	appmaterial "github.com/homindolenern/goapps-costing-v1/internal/application/material"
+	"github.com/homindolenern/goapps-costing-v1/internal/application/outbox"
 	appparam "github.com/homindolenern/goapps-costing-v1/internal/application/parameter"
+	appvalue "github.com/homindolenern/goapps-costing-v1/internal/application/parametervalue"
 	appuom "github.com/homindolenern/goapps-costing-v1/internal/application/uom"
+	"github.com/homindolenern/goapps-costing-v1/internal/application/watch"
+	appwebhook "github.com/homindolenern/goapps-costing-v1/internal/application/webhook"
 	"github.com/homindolenern/goapps-costing-v1/internal/config"
 	grpcdelivery "github.com/homindolenern/goapps-costing-v1/internal/delivery/grpc"
 	"github.com/homindolenern/goapps-costing-v1/internal/delivery/grpc/interceptors"
 	httpdelivery "github.com/homindolenern/goapps-costing-v1/internal/delivery/http"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/changerequest"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/webhook"
+	infraauth "github.com/homindolenern/goapps-costing-v1/internal/infrastructure/auth"
+	"github.com/homindolenern/goapps-costing-v1/internal/infrastructure/cache"
 	"github.com/homindolenern/goapps-costing-v1/internal/infrastructure/postgres"
+	"github.com/homindolenern/goapps-costing-v1/internal/infrastructure/publisher"
 	"github.com/homindolenern/goapps-costing-v1/internal/infrastructure/redis"
+	pkgauth "github.com/homindolenern/goapps-costing-v1/pkg/auth"
+	"github.com/homindolenern/goapps-costing-v1/pkg/pagetoken"
+	"github.com/homindolenern/goapps-costing-v1/pkg/ratelimit"
 )
 
 // swaggerHTML is the Swagger UI HTML template.
@@ -104,23 +123,184 @@ func run(cfg *config.Config) error {
 		defer redisClient.Close()
 	}
 
+	// Initialize authentication (optional - requests run as "system" without it)
+	var verifier pkgauth.Verifier
+	if cfg.Auth.Enabled {
+		jwtVerifier, err := infraauth.NewJWTVerifier(cfg.Auth)
+		if err != nil {
+			return fmt.Errorf("failed to create token verifier: %w", err)
+		}
+		verifier = jwtVerifier
+	} else {
+		log.Warn().Msg("Authentication disabled - audit fields will record \"system\"")
+	}
+
+	// Initialize role-based authorization (requires authentication)
+	var policy *pkgauth.Policy
+	if cfg.RBAC.Enabled && verifier != nil {
+		roles := make([]pkgauth.Role, len(cfg.RBAC.Roles))
+		for i, role := range cfg.RBAC.Roles {
+			roles[i] = pkgauth.Role{Name: role.Name, Inherits: role.Inherits, Permissions: role.Permissions}
+		}
+		policy, err = pkgauth.NewPolicy(roles)
+		if err != nil {
+			return fmt.Errorf("failed to load RBAC policy: %w", err)
+		}
+	} else if cfg.RBAC.Enabled {
+		log.Warn().Msg("RBAC requires authentication - authorization disabled")
+	}
+
+	// Initialize rate limiter (distributed via Redis when available)
+	var limiter ratelimit.Limiter
+	limitPolicy := ratelimit.Policy{
+		Default:        ratelimit.Limit{Rate: cfg.RateLimit.RequestsPerSecond, Burst: float64(cfg.RateLimit.Burst)},
+		Methods:        make(map[string]ratelimit.Limit, len(cfg.RateLimit.Methods)),
+		TrustedProxies: cfg.RateLimit.TrustedProxies,
+	}
+	limitPolicy.Gateways, err = ratelimit.ParseNetworks(cfg.RateLimit.GatewayNetworks)
+	if err != nil {
+		return fmt.Errorf("invalid rate limit gateway networks: %w", err)
+	}
+	for _, m := range cfg.RateLimit.Methods {
+		limitPolicy.Methods[m.Method] = ratelimit.Limit{Rate: m.RequestsPerSecond, Burst: float64(m.Burst)}
+	}
+	if cfg.RateLimit.Enabled {
+		if cfg.RateLimit.Distributed && redisClient != nil {
+			limiter = redis.NewRateLimiter(redisClient)
+		} else {
+			memoryLimiter := ratelimit.NewRateLimiter(limitPolicy.Default.Burst, limitPolicy.Default.Rate)
+			memoryLimiter.StartCleanup(ctx, time.Minute, 10*time.Minute)
+			limiter = memoryLimiter
+		}
+	}
+
+	// Initialize read cache (degrades to a no-op cache without Redis)
+	var readCache cache.Cache = cache.NewNoOpCache()
+	if redisClient != nil {
+		readCache = cache.NewRedisCache(redisClient, "costing:")
+	}
+
+	// Initialize page token signing, shared by replicas through configuration
+	tokenSecret := []byte(cfg.Pagination.TokenSecret)
+	if len(tokenSecret) == 0 {
+		tokenSecret = make([]byte, 32)
+		if _, err := rand.Read(tokenSecret); err != nil {
+			return fmt.Errorf("failed to generate page token secret: %w", err)
+		}
+		log.Warn().Msg("Pagination token secret not set - page tokens only work on this instance until it restarts")
+	}
+	pageTokens := pagetoken.NewCodec(tokenSecret)
+
 	// Initialize repositories
-	uomRepo := postgres.NewUOMRepository(db)
-	paramRepo := postgres.NewParameterRepository(db)
+	uomRepo := cache.NewUOMRepository(
+		postgres.NewUOMRepository(db),
+		cache.NewInstrumented(readCache, "uom"),
+		cfg.Redis.CacheTTL,
+	)
+	paramRepo := cache.NewParameterRepository(
+		postgres.NewParameterRepository(db),
+		cache.NewInstrumented(readCache, "parameter"),
+		cfg.Redis.CacheTTL,
+	)
+	valueRepo := postgres.NewParameterValueRepository(db)
+	materialRepo := postgres.NewMaterialRepository(db)
+	machineTypeRepo := postgres.NewMachineTypeRepository(db)
+	machineRepo := postgres.NewMachineRepository(db)
+	auditRepo := postgres.NewAuditRepository(db)
+	outboxRepo := postgres.NewOutboxRepository(db)
+	webhookRepo := postgres.NewWebhookRepository(db)
+	changeRepo := postgres.NewChangeRequestRepository(db)
+
+	// Initialize change feed followers, woken by outbox notifications
+	outboxListener := postgres.NewListener(cfg.Database.DSN())
+	watchFollower := watch.NewFollower(outboxListener, cfg.Watch.PollInterval)
+
+	// Initialize the four-eyes review policy of parameter changes (requires authentication)
+	approval := cfg.EffectiveApproval()
+	if !cfg.Auth.Enabled && (len(cfg.Approval.Categories) > 0 || cfg.Approval.Mandatory) {
+		log.Warn().Msg("Change approval requires authentication - approval disabled")
+	}
+	approvalPolicy, err := changerequest.NewPolicy(approval.Categories, approval.Mandatory)
+	if err != nil {
+		return fmt.Errorf("invalid approval categories: %w", err)
+	}
+
+	// Initialize audit recorder shared by all command handlers; it writes
+	// each change and its audit event in one transaction
+	auditRecorder := appaudit.NewRecorder(auditRepo, db)
 
 	// Initialize UOM application handlers
-	uomCreateHandler := appuom.NewCreateHandler(uomRepo)
-	uomUpdateHandler := appuom.NewUpdateHandler(uomRepo)
-	uomDeleteHandler := appuom.NewDeleteHandler(uomRepo)
+	uomCreateHandler := appuom.NewCreateHandler(uomRepo, auditRecorder, policy)
+	uomUpdateHandler := appuom.NewUpdateHandler(uomRepo, auditRecorder, policy)
+	uomDeleteHandler := appuom.NewDeleteHandler(uomRepo, auditRecorder)
+	uomRestoreHandler := appuom.NewRestoreHandler(uomRepo, auditRecorder)
+	uomBatchUpsertHandler := appuom.NewBatchUpsertHandler(uomRepo, auditRecorder, policy)
 	uomGetHandler := appuom.NewGetHandler(uomRepo)
-	uomListHandler := appuom.NewListHandler(uomRepo)
+	uomListHandler := appuom.NewListHandler(uomRepo, pageTokens)
+	uomExportHandler := appuom.NewExportHandler(uomRepo)
+	uomWatchHandler := appuom.NewWatchHandler(outboxRepo, watchFollower)
+	uomConvertHandler := appuom.NewConvertHandler(uomRepo)
+	uomListConversionsHandler := appuom.NewListConversionsHandler(uomRepo)
+	uomCreateConversionHandler := appuom.NewCreateConversionHandler(uomRepo, auditRecorder)
+	uomDeleteConversionHandler := appuom.NewDeleteConversionHandler(uomRepo, auditRecorder)
 
 	// Initialize Parameter application handlers
-	paramCreateHandler := appparam.NewCreateHandler(paramRepo)
-	paramUpdateHandler := appparam.NewUpdateHandler(paramRepo)
-	paramDeleteHandler := appparam.NewDeleteHandler(paramRepo)
+	paramCreateHandler := appparam.NewCreateHandler(paramRepo, uomRepo, auditRecorder)
+	paramUpdateHandler := appparam.NewUpdateHandler(paramRepo, uomRepo, auditRecorder)
+	paramDeleteHandler := appparam.NewDeleteHandler(paramRepo, auditRecorder)
+	paramRestoreHandler := appparam.NewRestoreHandler(paramRepo, auditRecorder)
+	paramEditHandler := appparam.NewEditHandler(
+		paramRepo, changeRepo, paramUpdateHandler, paramDeleteHandler, paramRestoreHandler, approvalPolicy)
+	paramBatchUpsertHandler := appparam.NewBatchUpsertHandler(paramRepo, uomRepo, auditRecorder, approvalPolicy)
 	paramGetHandler := appparam.NewGetHandler(paramRepo)
-	paramListHandler := appparam.NewListHandler(paramRepo)
+	paramListHandler := appparam.NewListHandler(paramRepo, pageTokens)
+	paramListVersionsHandler := appparam.NewListVersionsHandler(paramRepo)
+	paramExportHandler := appparam.NewExportHandler(paramRepo)
+	paramWatchHandler := appparam.NewWatchHandler(outboxRepo, watchFollower)
+	paramApproveChangeHandler := appparam.NewApproveChangeHandler(
+		changeRepo, paramUpdateHandler, paramDeleteHandler, paramRestoreHandler)
+	paramRejectChangeHandler := appparam.NewRejectChangeHandler(changeRepo)
+	paramGetChangeHandler := appparam.NewGetChangeHandler(changeRepo)
+	paramListChangesHandler := appparam.NewListChangesHandler(changeRepo)
+
+	// Initialize Parameter Value application handlers
+	valueCreateHandler := appvalue.NewCreateHandler(valueRepo, paramRepo, auditRecorder)
+	valueUpdateHandler := appvalue.NewUpdateHandler(valueRepo, paramRepo, auditRecorder)
+	valueDeleteHandler := appvalue.NewDeleteHandler(valueRepo, auditRecorder)
+	valueGetHandler := appvalue.NewGetHandler(valueRepo)
+	valueListHandler := appvalue.NewListHandler(valueRepo)
+
+	// Initialize Material application handlers
+	materialCreateHandler := appmaterial.NewCreateHandler(materialRepo, uomRepo, auditRecorder)
+	materialUpdateHandler := appmaterial.NewUpdateHandler(materialRepo, uomRepo, auditRecorder)
+	materialDeleteHandler := appmaterial.NewDeleteHandler(materialRepo, auditRecorder)
+	materialGetHandler := appmaterial.NewGetHandler(materialRepo)
+	materialListHandler := appmaterial.NewListHandler(materialRepo)
+
+	// Initialize Machine application handlers
+	machineCreateTypeHandler := appmachine.NewCreateTypeHandler(machineTypeRepo, paramRepo, auditRecorder)
+	machineUpdateTypeHandler := appmachine.NewUpdateTypeHandler(machineTypeRepo, paramRepo, auditRecorder)
+	machineDeleteTypeHandler := appmachine.NewDeleteTypeHandler(machineTypeRepo, auditRecorder)
+	machineGetTypeHandler := appmachine.NewGetTypeHandler(machineTypeRepo)
+	machineListTypesHandler := appmachine.NewListTypesHandler(machineTypeRepo)
+	machineCreateHandler := appmachine.NewCreateHandler(machineRepo, machineTypeRepo, paramRepo, auditRecorder)
+	machineUpdateHandler := appmachine.NewUpdateHandler(machineRepo, machineTypeRepo, paramRepo, auditRecorder)
+	machineDeleteHandler := appmachine.NewDeleteHandler(machineRepo, auditRecorder)
+	machineGetHandler := appmachine.NewGetHandler(machineRepo)
+	machineListHandler := appmachine.NewListHandler(machineRepo)
+
+	// Initialize Audit application handlers
+	auditListHandler := appaudit.NewListHandler(auditRepo)
+
+	// Initialize Webhook application handlers
+	webhookCreateHandler := appwebhook.NewCreateHandler(webhookRepo)
+	webhookDeleteHandler := appwebhook.NewDeleteHandler(webhookRepo)
+	webhookReplayHandler := appwebhook.NewReplayHandler(webhookRepo)
+	webhookListHandler := appwebhook.NewListHandler(webhookRepo)
+	webhookListDeliveriesHandler := appwebhook.NewListDeliveriesHandler(webhookRepo)
+
+	// Initialize Costing application handlers
+	costingCalculateHandler := appcosting.NewCalculateHandler(uomRepo, paramRepo)
 
 	// Create protovalidate validator
 	validator, err := protovalidate.New()
@@ -136,18 +316,75 @@ func run(cfg *config.Config) error {
 		uomCreateHandler,
 		uomUpdateHandler,
 		uomDeleteHandler,
+		uomRestoreHandler,
+		uomBatchUpsertHandler,
 		uomGetHandler,
 		uomListHandler,
+		uomExportHandler,
+		uomWatchHandler,
+		uomConvertHandler,
+		uomListConversionsHandler,
+		uomCreateConversionHandler,
+		uomDeleteConversionHandler,
 		validationHelper,
 	)
 	paramHandler := grpcdelivery.NewParameterHandler(
 		paramCreateHandler,
-		paramUpdateHandler,
-		paramDeleteHandler,
+		paramEditHandler,
+		paramBatchUpsertHandler,
 		paramGetHandler,
 		paramListHandler,
+		paramListVersionsHandler,
+		paramExportHandler,
+		paramWatchHandler,
+		validationHelper,
+	)
+	valueHandler := grpcdelivery.NewParameterValueHandler(
+		valueCreateHandler,
+		valueUpdateHandler,
+		valueDeleteHandler,
+		valueGetHandler,
+		valueListHandler,
 		validationHelper,
 	)
+	materialHandler := grpcdelivery.NewMaterialHandler(
+		materialCreateHandler,
+		materialUpdateHandler,
+		materialDeleteHandler,
+		materialGetHandler,
+		materialListHandler,
+		validationHelper,
+	)
+	machineHandler := grpcdelivery.NewMachineHandler(
+		machineCreateTypeHandler,
+		machineUpdateTypeHandler,
+		machineDeleteTypeHandler,
+		machineGetTypeHandler,
+		machineListTypesHandler,
+		machineCreateHandler,
+		machineUpdateHandler,
+		machineDeleteHandler,
+		machineGetHandler,
+		machineListHandler,
+		validationHelper,
+	)
+	paramChangeHandler := grpcdelivery.NewParameterChangeHandler(
+		paramApproveChangeHandler,
+		paramRejectChangeHandler,
+		paramGetChangeHandler,
+		paramListChangesHandler,
+		validationHelper,
+	)
+	auditHandler := grpcdelivery.NewAuditHandler(auditListHandler, validationHelper)
+	webhookHandler := grpcdelivery.NewWebhookHandler(
+		webhookCreateHandler,
+		webhookDeleteHandler,
+		webhookReplayHandler,
+		webhookListHandler,
+		webhookListDeliveriesHandler,
+		validationHelper,
+	)
+	costingHandler := grpcdelivery.NewCostingHandler(costingCalculateHandler, validationHelper)
 	healthHandler := grpcdelivery.NewHealthHandlerWithRedis(db, redisClient)
 
 	// Handle graceful shutdown
@@ -158,7 +395,7 @@ func run(cfg *config.Config) error {
 
 	// Start gRPC server
 	g.Go(func() error {
-		return runGRPCServer(ctx, cfg, uomHandler, paramHandler, healthHandler)
+		return runGRPCServer(ctx, cfg, verifier, policy, limiter, limitPolicy, uomHandler, paramHandler, paramChangeHandler, valueHandler, materialHandler, machineHandler, auditHandler, webhookHandler, costingHandler, healthHandler)
 	})
 
 	// Start HTTP gateway server
@@ -166,6 +403,64 @@ func run(cfg *config.Config) error {
 		return runHTTPServer(ctx, cfg)
 	})
 
+	// Start change feed listener; watches end on shutdown so servers can stop
+	g.Go(func() error {
+		return outboxListener.Run(ctx)
+	})
+	g.Go(func() error {
+		<-ctx.Done()
+		watchFollower.Stop()
+		return nil
+	})
+
+	// Start outbox relay; webhook deliveries are recorded first, as that is
+	// idempotent when a later publisher fails
+	var eventPublishers []outbox.Publisher
+	if cfg.Webhook.Enabled {
+		eventPublishers = append(eventPublishers, appwebhook.NewFanout(webhookRepo))
+	}
+	if cfg.Outbox.Enabled {
+		eventPublisher, err := newEventPublisher(cfg.Outbox)
+		if err != nil {
+			return fmt.Errorf("failed to create event publisher: %w", err)
+		}
+		if closer, ok := eventPublisher.(io.Closer); ok {
+			defer closer.Close()
+		}
+		eventPublishers = append(eventPublishers, eventPublisher)
+	}
+	if len(eventPublishers) > 0 {
+		relay := outbox.NewRelay(outboxRepo, outbox.Multi(eventPublishers...), outbox.RelayConfig{
+			Interval:  cfg.Outbox.Interval,
+			BatchSize: cfg.Outbox.BatchSize,
+			Lease:     cfg.Outbox.Lease,
+		})
+		log.Info().Str("publisher", cfg.Outbox.Publisher).Bool("webhooks", cfg.Webhook.Enabled).Msg("Starting outbox relay")
+		g.Go(func() error {
+			return relay.Run(ctx)
+		})
+	}
+
+	// Start webhook dispatcher
+	if cfg.Webhook.Enabled {
+		dispatcher := appwebhook.NewDispatcher(webhookRepo,
+			publisher.NewSubscriptionSender(&http.Client{Timeout: cfg.Webhook.Timeout}),
+			appwebhook.DispatcherConfig{
+				Interval:  cfg.Webhook.Interval,
+				BatchSize: cfg.Webhook.BatchSize,
+				Lease:     cfg.Webhook.Lease,
+				Retry: webhook.RetryPolicy{
+					MaxAttempts:    cfg.Webhook.MaxAttempts,
+					InitialBackoff: cfg.Webhook.InitialBackoff,
+					MaxBackoff:     cfg.Webhook.MaxBackoff,
+				},
+			})
+		log.Info().Msg("Starting webhook dispatcher")
+		g.Go(func() error {
+			return dispatcher.Run(ctx)
+		})
+	}
+
 	// Wait for shutdown signal
 	g.Go(func() error {
 		select {
@@ -180,11 +475,39 @@ func run(cfg *config.Config) error {
 	return g.Wait()
 }
 
+// newEventPublisher creates the outbox publisher named by the configuration.
+func newEventPublisher(cfg config.OutboxConfig) (outbox.Publisher, error) {
+	switch cfg.Publisher {
+	case "", "log":
+		return publisher.Log{}, nil
+	case "webhook":
+		if cfg.Webhook.URL == "" {
+			return nil, fmt.Errorf("outbox.webhook.url is required")
+		}
+		return publisher.NewWebhook(cfg.Webhook.URL, cfg.Timeout), nil
+	case "nats":
+		return publisher.NewNATS(cfg.NATS.URL, cfg.NATS.SubjectPrefix, cfg.Timeout)
+	default:
+		return nil, fmt.Errorf("unknown outbox publisher %q", cfg.Publisher)
+	}
+}
+
 func runGRPCServer(
 	ctx context.Context,
 	cfg *config.Config,
+	verifier pkgauth.Verifier,
+	policy *pkgauth.Policy,
+	limiter ratelimit.Limiter,
+	limitPolicy ratelimit.Policy,
 	uomHandler *grpcdelivery.UOMHandler,
 	paramHandler *grpcdelivery.ParameterHandler,
+	paramChangeHandler *grpcdelivery.ParameterChangeHandler,
+	valueHandler *grpcdelivery.ParameterValueHandler,
+	materialHandler *grpcdelivery.MaterialHandler,
+	machineHandler *grpcdelivery.MachineHandler,
+	auditHandler *grpcdelivery.AuditHandler,
+	webhookHandler *grpcdelivery.WebhookHandler,
+	costingHandler *grpcdelivery.CostingHandler,
 	healthHandler *grpcdelivery.HealthHandler,
 ) error {
 	addr := fmt.Sprintf(":%d", cfg.Server.GRPCPort)
@@ -195,11 +518,32 @@ func runGRPCServer(
 
 	// Create gRPC server with interceptors
 	// Note: Validation is now done in handlers to return proper BaseResponse format
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
+		interceptors.Recovery(),
+		interceptors.Logging(),
+	}
+	streamInterceptors := []grpc.StreamServerInterceptor{
+		interceptors.StreamRecovery(),
+		interceptors.StreamLogging(),
+	}
+	// Health probes stay public for Kubernetes
+	publicMethods := []string{"/costing.v1.HealthService/"}
+	if verifier != nil {
+		unaryInterceptors = append(unaryInterceptors, interceptors.Auth(verifier, publicMethods...))
+		streamInterceptors = append(streamInterceptors, interceptors.StreamAuth(verifier, publicMethods...))
+	}
+	if limiter != nil {
+		// After Auth so callers are limited by principal rather than IP
+		unaryInterceptors = append(unaryInterceptors, ratelimit.UnaryInterceptor(limiter, limitPolicy, publicMethods...))
+		streamInterceptors = append(streamInterceptors, ratelimit.StreamInterceptor(limiter, limitPolicy, publicMethods...))
+	}
+	if policy != nil {
+		unaryInterceptors = append(unaryInterceptors, interceptors.Authorization(policy, publicMethods...))
+		streamInterceptors = append(streamInterceptors, interceptors.StreamAuthorization(policy, publicMethods...))
+	}
 	grpcServer := grpc.NewServer(
-		grpc.ChainUnaryInterceptor(
-			interceptors.Recovery(),
-			interceptors.Logging(),
-		),
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
 	)
 
 	// Register reflection for debugging
@@ -208,6 +552,13 @@ func runGRPCServer(
 	// Register service implementations
 	pb.RegisterUOMServiceServer(grpcServer, uomHandler)
 	pb.RegisterParameterServiceServer(grpcServer, paramHandler)
+	pb.RegisterParameterChangeServiceServer(grpcServer, paramChangeHandler)
+	pb.RegisterParameterValueServiceServer(grpcServer, valueHandler)
+	pb.RegisterMaterialServiceServer(grpcServer, materialHandler)
+	pb.RegisterMachineServiceServer(grpcServer, machineHandler)
+	pb.RegisterAuditServiceServer(grpcServer, auditHandler)
+	pb.RegisterWebhookServiceServer(grpcServer, webhookHandler)
+	pb.RegisterCostingServiceServer(grpcServer, costingHandler)
 	pb.RegisterHealthServiceServer(grpcServer, healthHandler)
 
 	log.Info().Str("addr", addr).Msg("gRPC server starting")
@@ -240,10 +591,50 @@ func runHTTPServer(ctx context.Context, cfg *config.Config) error {
 	if err := pb.RegisterParameterServiceHandlerFromEndpoint(ctx, mux, grpcAddr, opts); err != nil {
 		return fmt.Errorf("failed to register Parameter gateway: %w", err)
 	}
+	if err := pb.RegisterParameterChangeServiceHandlerFromEndpoint(ctx, mux, grpcAddr, opts); err != nil {
+		return fmt.Errorf("failed to register Parameter Change gateway: %w", err)
+	}
+	if err := pb.RegisterParameterValueServiceHandlerFromEndpoint(ctx, mux, grpcAddr, opts); err != nil {
+		return fmt.Errorf("failed to register Parameter Value gateway: %w", err)
+	}
+	if err := pb.RegisterMaterialServiceHandlerFromEndpoint(ctx, mux, grpcAddr, opts); err != nil {
+		return fmt.Errorf("failed to register Material gateway: %w", err)
+	}
+	if err := pb.RegisterMachineServiceHandlerFromEndpoint(ctx, mux, grpcAddr, opts); err != nil {
+		return fmt.Errorf("failed to register Machine gateway: %w", err)
+	}
+	if err := pb.RegisterAuditServiceHandlerFromEndpoint(ctx, mux, grpcAddr, opts); err != nil {
+		return fmt.Errorf("failed to register Audit gateway: %w", err)
+	}
+	if err := pb.RegisterWebhookServiceHandlerFromEndpoint(ctx, mux, grpcAddr, opts); err != nil {
+		return fmt.Errorf("failed to register Webhook gateway: %w", err)
+	}
+	if err := pb.RegisterCostingServiceHandlerFromEndpoint(ctx, mux, grpcAddr, opts); err != nil {
+		return fmt.Errorf("failed to register Costing gateway: %w", err)
+	}
 	if err := pb.RegisterHealthServiceHandlerFromEndpoint(ctx, mux, grpcAddr, opts); err != nil {
 		return fmt.Errorf("failed to register Health gateway: %w", err)
 	}
 
+	// File uploads and downloads are streamed to the import and export RPCs
+	// over their own connection
+	fileConn, err := grpc.NewClient(grpcAddr, opts...)
+	if err != nil {
+		return fmt.Errorf("failed to connect file gateway: %w", err)
+	}
+	defer fileConn.Close()
+	if err := httpdelivery.RegisterImportHandlers(mux, fileConn); err != nil {
+		return fmt.Errorf("failed to register import gateway: %w", err)
+	}
+	if err := httpdelivery.RegisterExportHandlers(mux, fileConn); err != nil {
+		return fmt.Errorf("failed to register export gateway: %w", err)
+	}
+
+	// Watch streams are bridged to Server-Sent Events
+	if err := httpdelivery.RegisterWatchHandlers(mux, fileConn); err != nil {
+		return fmt.Errorf("failed to register watch gateway: %w", err)
+	}
+
 	// Create HTTP server with additional endpoints
 	httpMux := http.NewServeMux()
 
@@ -0,0 +1,608 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: costing/v1/audit.proto
+
+package costingv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AuditEntityType represents the kind of master data changed
+type AuditEntityType int32
+
+const (
+	AuditEntityType_AUDIT_ENTITY_TYPE_UNSPECIFIED     AuditEntityType = 0
+	AuditEntityType_AUDIT_ENTITY_TYPE_UOM             AuditEntityType = 1
+	AuditEntityType_AUDIT_ENTITY_TYPE_UOM_CONVERSION  AuditEntityType = 2
+	AuditEntityType_AUDIT_ENTITY_TYPE_PARAMETER       AuditEntityType = 3
+	AuditEntityType_AUDIT_ENTITY_TYPE_PARAMETER_VALUE AuditEntityType = 4
+	AuditEntityType_AUDIT_ENTITY_TYPE_MATERIAL        AuditEntityType = 5
+	AuditEntityType_AUDIT_ENTITY_TYPE_MACHINE_TYPE    AuditEntityType = 6
+	AuditEntityType_AUDIT_ENTITY_TYPE_MACHINE         AuditEntityType = 7
+)
+
+// Enum value maps for AuditEntityType.
+var (
+	AuditEntityType_name = map[int32]string{
+		0: "AUDIT_ENTITY_TYPE_UNSPECIFIED",
+		1: "AUDIT_ENTITY_TYPE_UOM",
+		2: "AUDIT_ENTITY_TYPE_UOM_CONVERSION",
+		3: "AUDIT_ENTITY_TYPE_PARAMETER",
+		4: "AUDIT_ENTITY_TYPE_PARAMETER_VALUE",
+		5: "AUDIT_ENTITY_TYPE_MATERIAL",
+		6: "AUDIT_ENTITY_TYPE_MACHINE_TYPE",
+		7: "AUDIT_ENTITY_TYPE_MACHINE",
+	}
+	AuditEntityType_value = map[string]int32{
+		"AUDIT_ENTITY_TYPE_UNSPECIFIED":     0,
+		"AUDIT_ENTITY_TYPE_UOM":             1,
+		"AUDIT_ENTITY_TYPE_UOM_CONVERSION":  2,
+		"AUDIT_ENTITY_TYPE_PARAMETER":       3,
+		"AUDIT_ENTITY_TYPE_PARAMETER_VALUE": 4,
+		"AUDIT_ENTITY_TYPE_MATERIAL":        5,
+		"AUDIT_ENTITY_TYPE_MACHINE_TYPE":    6,
+		"AUDIT_ENTITY_TYPE_MACHINE":         7,
+	}
+)
+
+func (x AuditEntityType) Enum() *AuditEntityType {
+	p := new(AuditEntityType)
+	*p = x
+	return p
+}
+
+func (x AuditEntityType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AuditEntityType) Descriptor() protoreflect.EnumDescriptor {
+	return file_costing_v1_audit_proto_enumTypes[0].Descriptor()
+}
+
+func (AuditEntityType) Type() protoreflect.EnumType {
+	return &file_costing_v1_audit_proto_enumTypes[0]
+}
+
+func (x AuditEntityType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AuditEntityType.Descriptor instead.
+func (AuditEntityType) EnumDescriptor() ([]byte, []int) {
+	return file_costing_v1_audit_proto_rawDescGZIP(), []int{0}
+}
+
+// AuditAction represents the kind of change
+type AuditAction int32
+
+const (
+	AuditAction_AUDIT_ACTION_UNSPECIFIED AuditAction = 0
+	AuditAction_AUDIT_ACTION_CREATE      AuditAction = 1
+	AuditAction_AUDIT_ACTION_UPDATE      AuditAction = 2
+	AuditAction_AUDIT_ACTION_DELETE      AuditAction = 3
+)
+
+// Enum value maps for AuditAction.
+var (
+	AuditAction_name = map[int32]string{
+		0: "AUDIT_ACTION_UNSPECIFIED",
+		1: "AUDIT_ACTION_CREATE",
+		2: "AUDIT_ACTION_UPDATE",
+		3: "AUDIT_ACTION_DELETE",
+	}
+	AuditAction_value = map[string]int32{
+		"AUDIT_ACTION_UNSPECIFIED": 0,
+		"AUDIT_ACTION_CREATE":      1,
+		"AUDIT_ACTION_UPDATE":      2,
+		"AUDIT_ACTION_DELETE":      3,
+	}
+)
+
+func (x AuditAction) Enum() *AuditAction {
+	p := new(AuditAction)
+	*p = x
+	return p
+}
+
+func (x AuditAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AuditAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_costing_v1_audit_proto_enumTypes[1].Descriptor()
+}
+
+func (AuditAction) Type() protoreflect.EnumType {
+	return &file_costing_v1_audit_proto_enumTypes[1]
+}
+
+func (x AuditAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AuditAction.Descriptor instead.
+func (AuditAction) EnumDescriptor() ([]byte, []int) {
+	return file_costing_v1_audit_proto_rawDescGZIP(), []int{1}
+}
+
+// FieldChange is a field whose value differs between the snapshots
+type FieldChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Before        *structpb.Value        `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	After         *structpb.Value        `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FieldChange) Reset() {
+	*x = FieldChange{}
+	mi := &file_costing_v1_audit_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FieldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldChange) ProtoMessage() {}
+
+func (x *FieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_audit_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldChange.ProtoReflect.Descriptor instead.
+func (*FieldChange) Descriptor() ([]byte, []int) {
+	return file_costing_v1_audit_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FieldChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *FieldChange) GetBefore() *structpb.Value {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *FieldChange) GetAfter() *structpb.Value {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+// AuditEvent is a recorded change of a master-data entity
+type AuditEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	EntityType    AuditEntityType        `protobuf:"varint,2,opt,name=entity_type,json=entityType,proto3,enum=costing.v1.AuditEntityType" json:"entity_type,omitempty"`
+	EntityCode    string                 `protobuf:"bytes,3,opt,name=entity_code,json=entityCode,proto3" json:"entity_code,omitempty"` // ID for parameter values, FROM:TO for conversions
+	Action        AuditAction            `protobuf:"varint,4,opt,name=action,proto3,enum=costing.v1.AuditAction" json:"action,omitempty"`
+	Before        *structpb.Struct       `protobuf:"bytes,5,opt,name=before,proto3" json:"before,omitempty"` // Absent for CREATE
+	After         *structpb.Struct       `protobuf:"bytes,6,opt,name=after,proto3" json:"after,omitempty"`   // Absent for DELETE
+	Changes       []*FieldChange         `protobuf:"bytes,7,rep,name=changes,proto3" json:"changes,omitempty"`
+	ChangedBy     string                 `protobuf:"bytes,8,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`
+	OccurredAt    string                 `protobuf:"bytes,9,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
+	mi := &file_costing_v1_audit_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEvent) ProtoMessage() {}
+
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_audit_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_costing_v1_audit_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AuditEvent) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AuditEvent) GetEntityType() AuditEntityType {
+	if x != nil {
+		return x.EntityType
+	}
+	return AuditEntityType_AUDIT_ENTITY_TYPE_UNSPECIFIED
+}
+
+func (x *AuditEvent) GetEntityCode() string {
+	if x != nil {
+		return x.EntityCode
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetAction() AuditAction {
+	if x != nil {
+		return x.Action
+	}
+	return AuditAction_AUDIT_ACTION_UNSPECIFIED
+}
+
+func (x *AuditEvent) GetBefore() *structpb.Struct {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetAfter() *structpb.Struct {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetChanges() []*FieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetChangedBy() string {
+	if x != nil {
+		return x.ChangedBy
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetOccurredAt() string {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return ""
+}
+
+// ListAuditEvents
+type ListAuditEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	EntityType    *AuditEntityType       `protobuf:"varint,3,opt,name=entity_type,json=entityType,proto3,enum=costing.v1.AuditEntityType,oneof" json:"entity_type,omitempty"`
+	EntityCode    *string                `protobuf:"bytes,4,opt,name=entity_code,json=entityCode,proto3,oneof" json:"entity_code,omitempty"`
+	ChangedBy     *string                `protobuf:"bytes,5,opt,name=changed_by,json=changedBy,proto3,oneof" json:"changed_by,omitempty"`
+	From          *string                `protobuf:"bytes,6,opt,name=from,proto3,oneof" json:"from,omitempty"` // RFC 3339, inclusive
+	To            *string                `protobuf:"bytes,7,opt,name=to,proto3,oneof" json:"to,omitempty"`     // RFC 3339, exclusive
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsRequest) Reset() {
+	*x = ListAuditEventsRequest{}
+	mi := &file_costing_v1_audit_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsRequest) ProtoMessage() {}
+
+func (x *ListAuditEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_audit_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return file_costing_v1_audit_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListAuditEventsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListAuditEventsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAuditEventsRequest) GetEntityType() AuditEntityType {
+	if x != nil && x.EntityType != nil {
+		return *x.EntityType
+	}
+	return AuditEntityType_AUDIT_ENTITY_TYPE_UNSPECIFIED
+}
+
+func (x *ListAuditEventsRequest) GetEntityCode() string {
+	if x != nil && x.EntityCode != nil {
+		return *x.EntityCode
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetChangedBy() string {
+	if x != nil && x.ChangedBy != nil {
+		return *x.ChangedBy
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetFrom() string {
+	if x != nil && x.From != nil {
+		return *x.From
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetTo() string {
+	if x != nil && x.To != nil {
+		return *x.To
+	}
+	return ""
+}
+
+type ListAuditEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Data          []*AuditEvent          `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	Pagination    *PaginationMeta        `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsResponse) Reset() {
+	*x = ListAuditEventsResponse{}
+	mi := &file_costing_v1_audit_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsResponse) ProtoMessage() {}
+
+func (x *ListAuditEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_audit_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsResponse) Descriptor() ([]byte, []int) {
+	return file_costing_v1_audit_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListAuditEventsResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListAuditEventsResponse) GetData() []*AuditEvent {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListAuditEventsResponse) GetPagination() *PaginationMeta {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+var File_costing_v1_audit_proto protoreflect.FileDescriptor
+
+const file_costing_v1_audit_proto_rawDesc = "" +
+	"\n" +
+	"\x16costing/v1/audit.proto\x12\n" +
+	"costing.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x17costing/v1/common.proto\"\x81\x01\n" +
+	"\vFieldChange\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12.\n" +
+	"\x06before\x18\x02 \x01(\v2\x16.google.protobuf.ValueR\x06before\x12,\n" +
+	"\x05after\x18\x03 \x01(\v2\x16.google.protobuf.ValueR\x05after\"\xff\x02\n" +
+	"\n" +
+	"AuditEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12<\n" +
+	"\ventity_type\x18\x02 \x01(\x0e2\x1b.costing.v1.AuditEntityTypeR\n" +
+	"entityType\x12\x1f\n" +
+	"\ventity_code\x18\x03 \x01(\tR\n" +
+	"entityCode\x12/\n" +
+	"\x06action\x18\x04 \x01(\x0e2\x17.costing.v1.AuditActionR\x06action\x12/\n" +
+	"\x06before\x18\x05 \x01(\v2\x17.google.protobuf.StructR\x06before\x12-\n" +
+	"\x05after\x18\x06 \x01(\v2\x17.google.protobuf.StructR\x05after\x121\n" +
+	"\achanges\x18\a \x03(\v2\x17.costing.v1.FieldChangeR\achanges\x12\x1d\n" +
+	"\n" +
+	"changed_by\x18\b \x01(\tR\tchangedBy\x12\x1f\n" +
+	"\voccurred_at\x18\t \x01(\tR\n" +
+	"occurredAt\"\xe9\x02\n" +
+	"\x16ListAuditEventsRequest\x12\x1b\n" +
+	"\x04page\x18\x01 \x01(\x05B\a\xbaH\x04\x1a\x02(\x01R\x04page\x12&\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x01R\bpageSize\x12A\n" +
+	"\ventity_type\x18\x03 \x01(\x0e2\x1b.costing.v1.AuditEntityTypeH\x00R\n" +
+	"entityType\x88\x01\x01\x12-\n" +
+	"\ventity_code\x18\x04 \x01(\tB\a\xbaH\x04r\x02\x18dH\x01R\n" +
+	"entityCode\x88\x01\x01\x12+\n" +
+	"\n" +
+	"changed_by\x18\x05 \x01(\tB\a\xbaH\x04r\x02\x18dH\x02R\tchangedBy\x88\x01\x01\x12\x17\n" +
+	"\x04from\x18\x06 \x01(\tH\x03R\x04from\x88\x01\x01\x12\x13\n" +
+	"\x02to\x18\a \x01(\tH\x04R\x02to\x88\x01\x01B\x0e\n" +
+	"\f_entity_typeB\x0e\n" +
+	"\f_entity_codeB\r\n" +
+	"\v_changed_byB\a\n" +
+	"\x05_fromB\x05\n" +
+	"\x03_to\"\xaf\x01\n" +
+	"\x17ListAuditEventsResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.costing.v1.BaseResponseR\x04base\x12*\n" +
+	"\x04data\x18\x02 \x03(\v2\x16.costing.v1.AuditEventR\x04data\x12:\n" +
+	"\n" +
+	"pagination\x18\x03 \x01(\v2\x1a.costing.v1.PaginationMetaR\n" +
+	"pagination*\xa0\x02\n" +
+	"\x0fAuditEntityType\x12!\n" +
+	"\x1dAUDIT_ENTITY_TYPE_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15AUDIT_ENTITY_TYPE_UOM\x10\x01\x12$\n" +
+	" AUDIT_ENTITY_TYPE_UOM_CONVERSION\x10\x02\x12\x1f\n" +
+	"\x1bAUDIT_ENTITY_TYPE_PARAMETER\x10\x03\x12%\n" +
+	"!AUDIT_ENTITY_TYPE_PARAMETER_VALUE\x10\x04\x12\x1e\n" +
+	"\x1aAUDIT_ENTITY_TYPE_MATERIAL\x10\x05\x12\"\n" +
+	"\x1eAUDIT_ENTITY_TYPE_MACHINE_TYPE\x10\x06\x12\x1d\n" +
+	"\x19AUDIT_ENTITY_TYPE_MACHINE\x10\a*v\n" +
+	"\vAuditAction\x12\x1c\n" +
+	"\x18AUDIT_ACTION_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13AUDIT_ACTION_CREATE\x10\x01\x12\x17\n" +
+	"\x13AUDIT_ACTION_UPDATE\x10\x02\x12\x17\n" +
+	"\x13AUDIT_ACTION_DELETE\x10\x032\x84\x01\n" +
+	"\fAuditService\x12t\n" +
+	"\x0fListAuditEvents\x12\".costing.v1.ListAuditEventsRequest\x1a#.costing.v1.ListAuditEventsResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/audit-eventsB\xad\x01\n" +
+	"\x0ecom.costing.v1B\n" +
+	"AuditProtoP\x01ZFgithub.com/homindolenern/goapps-costing-v1/gen/go/costing/v1;costingv1\xa2\x02\x03CXX\xaa\x02\n" +
+	"Costing.V1\xca\x02\n" +
+	"Costing\\V1\xe2\x02\x16Costing\\V1\\GPBMetadata\xea\x02\vCosting::V1b\x06proto3"
+
+var (
+	file_costing_v1_audit_proto_rawDescOnce sync.Once
+	file_costing_v1_audit_proto_rawDescData []byte
+)
+
+func file_costing_v1_audit_proto_rawDescGZIP() []byte {
+	file_costing_v1_audit_proto_rawDescOnce.Do(func() {
+		file_costing_v1_audit_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_costing_v1_audit_proto_rawDesc), len(file_costing_v1_audit_proto_rawDesc)))
+	})
+	return file_costing_v1_audit_proto_rawDescData
+}
+
+var file_costing_v1_audit_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_costing_v1_audit_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_costing_v1_audit_proto_goTypes = []any{
+	(AuditEntityType)(0),            // 0: costing.v1.AuditEntityType
+	(AuditAction)(0),                // 1: costing.v1.AuditAction
+	(*FieldChange)(nil),             // 2: costing.v1.FieldChange
+	(*AuditEvent)(nil),              // 3: costing.v1.AuditEvent
+	(*ListAuditEventsRequest)(nil),  // 4: costing.v1.ListAuditEventsRequest
+	(*ListAuditEventsResponse)(nil), // 5: costing.v1.ListAuditEventsResponse
+	(*structpb.Value)(nil),          // 6: google.protobuf.Value
+	(*structpb.Struct)(nil),         // 7: google.protobuf.Struct
+	(*BaseResponse)(nil),            // 8: costing.v1.BaseResponse
+	(*PaginationMeta)(nil),          // 9: costing.v1.PaginationMeta
+}
+var file_costing_v1_audit_proto_depIdxs = []int32{
+	6,  // 0: costing.v1.FieldChange.before:type_name -> google.protobuf.Value
+	6,  // 1: costing.v1.FieldChange.after:type_name -> google.protobuf.Value
+	0,  // 2: costing.v1.AuditEvent.entity_type:type_name -> costing.v1.AuditEntityType
+	1,  // 3: costing.v1.AuditEvent.action:type_name -> costing.v1.AuditAction
+	7,  // 4: costing.v1.AuditEvent.before:type_name -> google.protobuf.Struct
+	7,  // 5: costing.v1.AuditEvent.after:type_name -> google.protobuf.Struct
+	2,  // 6: costing.v1.AuditEvent.changes:type_name -> costing.v1.FieldChange
+	0,  // 7: costing.v1.ListAuditEventsRequest.entity_type:type_name -> costing.v1.AuditEntityType
+	8,  // 8: costing.v1.ListAuditEventsResponse.base:type_name -> costing.v1.BaseResponse
+	3,  // 9: costing.v1.ListAuditEventsResponse.data:type_name -> costing.v1.AuditEvent
+	9,  // 10: costing.v1.ListAuditEventsResponse.pagination:type_name -> costing.v1.PaginationMeta
+	4,  // 11: costing.v1.AuditService.ListAuditEvents:input_type -> costing.v1.ListAuditEventsRequest
+	5,  // 12: costing.v1.AuditService.ListAuditEvents:output_type -> costing.v1.ListAuditEventsResponse
+	12, // [12:13] is the sub-list for method output_type
+	11, // [11:12] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_costing_v1_audit_proto_init() }
+func file_costing_v1_audit_proto_init() {
+	if File_costing_v1_audit_proto != nil {
+		return
+	}
+	file_costing_v1_common_proto_init()
+	file_costing_v1_audit_proto_msgTypes[2].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_costing_v1_audit_proto_rawDesc), len(file_costing_v1_audit_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_costing_v1_audit_proto_goTypes,
+		DependencyIndexes: file_costing_v1_audit_proto_depIdxs,
+		EnumInfos:         file_costing_v1_audit_proto_enumTypes,
+		MessageInfos:      file_costing_v1_audit_proto_msgTypes,
+	}.Build()
+	File_costing_v1_audit_proto = out.File
+	file_costing_v1_audit_proto_goTypes = nil
+	file_costing_v1_audit_proto_depIdxs = nil
+}
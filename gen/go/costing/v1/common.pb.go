@@ -21,6 +21,268 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// BatchMode controls how a batch upsert reacts to failed items
+type BatchMode int32
+
+const (
+	BatchMode_BATCH_MODE_UNSPECIFIED BatchMode = 0 // Treated as ATOMIC
+	BatchMode_BATCH_MODE_ATOMIC      BatchMode = 1 // Save nothing if any item fails
+	BatchMode_BATCH_MODE_BEST_EFFORT BatchMode = 2 // Save the valid items and report the failed ones
+)
+
+// Enum value maps for BatchMode.
+var (
+	BatchMode_name = map[int32]string{
+		0: "BATCH_MODE_UNSPECIFIED",
+		1: "BATCH_MODE_ATOMIC",
+		2: "BATCH_MODE_BEST_EFFORT",
+	}
+	BatchMode_value = map[string]int32{
+		"BATCH_MODE_UNSPECIFIED": 0,
+		"BATCH_MODE_ATOMIC":      1,
+		"BATCH_MODE_BEST_EFFORT": 2,
+	}
+)
+
+func (x BatchMode) Enum() *BatchMode {
+	p := new(BatchMode)
+	*p = x
+	return p
+}
+
+func (x BatchMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BatchMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_costing_v1_common_proto_enumTypes[0].Descriptor()
+}
+
+func (BatchMode) Type() protoreflect.EnumType {
+	return &file_costing_v1_common_proto_enumTypes[0]
+}
+
+func (x BatchMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BatchMode.Descriptor instead.
+func (BatchMode) EnumDescriptor() ([]byte, []int) {
+	return file_costing_v1_common_proto_rawDescGZIP(), []int{0}
+}
+
+// BatchItemStatus is the outcome of one batch upsert item
+type BatchItemStatus int32
+
+const (
+	BatchItemStatus_BATCH_ITEM_STATUS_UNSPECIFIED BatchItemStatus = 0
+	BatchItemStatus_BATCH_ITEM_STATUS_CREATED     BatchItemStatus = 1
+	BatchItemStatus_BATCH_ITEM_STATUS_UPDATED     BatchItemStatus = 2
+	BatchItemStatus_BATCH_ITEM_STATUS_FAILED      BatchItemStatus = 3
+	BatchItemStatus_BATCH_ITEM_STATUS_SKIPPED     BatchItemStatus = 4 // Valid, but not saved because another item of an atomic batch failed
+)
+
+// Enum value maps for BatchItemStatus.
+var (
+	BatchItemStatus_name = map[int32]string{
+		0: "BATCH_ITEM_STATUS_UNSPECIFIED",
+		1: "BATCH_ITEM_STATUS_CREATED",
+		2: "BATCH_ITEM_STATUS_UPDATED",
+		3: "BATCH_ITEM_STATUS_FAILED",
+		4: "BATCH_ITEM_STATUS_SKIPPED",
+	}
+	BatchItemStatus_value = map[string]int32{
+		"BATCH_ITEM_STATUS_UNSPECIFIED": 0,
+		"BATCH_ITEM_STATUS_CREATED":     1,
+		"BATCH_ITEM_STATUS_UPDATED":     2,
+		"BATCH_ITEM_STATUS_FAILED":      3,
+		"BATCH_ITEM_STATUS_SKIPPED":     4,
+	}
+)
+
+func (x BatchItemStatus) Enum() *BatchItemStatus {
+	p := new(BatchItemStatus)
+	*p = x
+	return p
+}
+
+func (x BatchItemStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BatchItemStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_costing_v1_common_proto_enumTypes[1].Descriptor()
+}
+
+func (BatchItemStatus) Type() protoreflect.EnumType {
+	return &file_costing_v1_common_proto_enumTypes[1]
+}
+
+func (x BatchItemStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BatchItemStatus.Descriptor instead.
+func (BatchItemStatus) EnumDescriptor() ([]byte, []int) {
+	return file_costing_v1_common_proto_rawDescGZIP(), []int{1}
+}
+
+// SortDirection is the order a list is sorted in
+type SortDirection int32
+
+const (
+	SortDirection_SORT_DIRECTION_UNSPECIFIED SortDirection = 0 // Treated as ASC
+	SortDirection_SORT_DIRECTION_ASC         SortDirection = 1
+	SortDirection_SORT_DIRECTION_DESC        SortDirection = 2
+)
+
+// Enum value maps for SortDirection.
+var (
+	SortDirection_name = map[int32]string{
+		0: "SORT_DIRECTION_UNSPECIFIED",
+		1: "SORT_DIRECTION_ASC",
+		2: "SORT_DIRECTION_DESC",
+	}
+	SortDirection_value = map[string]int32{
+		"SORT_DIRECTION_UNSPECIFIED": 0,
+		"SORT_DIRECTION_ASC":         1,
+		"SORT_DIRECTION_DESC":        2,
+	}
+)
+
+func (x SortDirection) Enum() *SortDirection {
+	p := new(SortDirection)
+	*p = x
+	return p
+}
+
+func (x SortDirection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortDirection) Descriptor() protoreflect.EnumDescriptor {
+	return file_costing_v1_common_proto_enumTypes[2].Descriptor()
+}
+
+func (SortDirection) Type() protoreflect.EnumType {
+	return &file_costing_v1_common_proto_enumTypes[2]
+}
+
+func (x SortDirection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortDirection.Descriptor instead.
+func (SortDirection) EnumDescriptor() ([]byte, []int) {
+	return file_costing_v1_common_proto_rawDescGZIP(), []int{2}
+}
+
+// ChangeType is the kind of change reported by a watch stream
+type ChangeType int32
+
+const (
+	ChangeType_CHANGE_TYPE_UNSPECIFIED ChangeType = 0
+	ChangeType_CHANGE_TYPE_CREATED     ChangeType = 1
+	ChangeType_CHANGE_TYPE_UPDATED     ChangeType = 2 // Including activation, deactivation and restore
+	ChangeType_CHANGE_TYPE_DELETED     ChangeType = 3 // Soft delete; the entity carries the deletion audit
+)
+
+// Enum value maps for ChangeType.
+var (
+	ChangeType_name = map[int32]string{
+		0: "CHANGE_TYPE_UNSPECIFIED",
+		1: "CHANGE_TYPE_CREATED",
+		2: "CHANGE_TYPE_UPDATED",
+		3: "CHANGE_TYPE_DELETED",
+	}
+	ChangeType_value = map[string]int32{
+		"CHANGE_TYPE_UNSPECIFIED": 0,
+		"CHANGE_TYPE_CREATED":     1,
+		"CHANGE_TYPE_UPDATED":     2,
+		"CHANGE_TYPE_DELETED":     3,
+	}
+)
+
+func (x ChangeType) Enum() *ChangeType {
+	p := new(ChangeType)
+	*p = x
+	return p
+}
+
+func (x ChangeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChangeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_costing_v1_common_proto_enumTypes[3].Descriptor()
+}
+
+func (ChangeType) Type() protoreflect.EnumType {
+	return &file_costing_v1_common_proto_enumTypes[3]
+}
+
+func (x ChangeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChangeType.Descriptor instead.
+func (ChangeType) EnumDescriptor() ([]byte, []int) {
+	return file_costing_v1_common_proto_rawDescGZIP(), []int{3}
+}
+
+// FileFormat is the format of an imported or exported file
+type FileFormat int32
+
+const (
+	FileFormat_FILE_FORMAT_UNSPECIFIED FileFormat = 0
+	FileFormat_FILE_FORMAT_CSV         FileFormat = 1
+	FileFormat_FILE_FORMAT_XLSX        FileFormat = 2 // First sheet of the workbook
+	FileFormat_FILE_FORMAT_JSON        FileFormat = 3 // Export only: an array of the resource messages
+)
+
+// Enum value maps for FileFormat.
+var (
+	FileFormat_name = map[int32]string{
+		0: "FILE_FORMAT_UNSPECIFIED",
+		1: "FILE_FORMAT_CSV",
+		2: "FILE_FORMAT_XLSX",
+		3: "FILE_FORMAT_JSON",
+	}
+	FileFormat_value = map[string]int32{
+		"FILE_FORMAT_UNSPECIFIED": 0,
+		"FILE_FORMAT_CSV":         1,
+		"FILE_FORMAT_XLSX":        2,
+		"FILE_FORMAT_JSON":        3,
+	}
+)
+
+func (x FileFormat) Enum() *FileFormat {
+	p := new(FileFormat)
+	*p = x
+	return p
+}
+
+func (x FileFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FileFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_costing_v1_common_proto_enumTypes[4].Descriptor()
+}
+
+func (FileFormat) Type() protoreflect.EnumType {
+	return &file_costing_v1_common_proto_enumTypes[4]
+}
+
+func (x FileFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FileFormat.Descriptor instead.
+func (FileFormat) EnumDescriptor() ([]byte, []int) {
+	return file_costing_v1_common_proto_rawDescGZIP(), []int{4}
+}
+
 // ValidationError represents a single field validation error
 type ValidationError struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -219,6 +481,8 @@ type AuditInfo struct {
 	CreatedBy     string                 `protobuf:"bytes,2,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
 	UpdatedAt     *string                `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at,omitempty"`
 	UpdatedBy     *string                `protobuf:"bytes,4,opt,name=updated_by,json=updatedBy,proto3,oneof" json:"updated_by,omitempty"`
+	DeletedAt     *string                `protobuf:"bytes,5,opt,name=deleted_at,json=deletedAt,proto3,oneof" json:"deleted_at,omitempty"` // Set when soft-deleted
+	DeletedBy     *string                `protobuf:"bytes,6,opt,name=deleted_by,json=deletedBy,proto3,oneof" json:"deleted_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -281,6 +545,419 @@ func (x *AuditInfo) GetUpdatedBy() string {
 	return ""
 }
 
+func (x *AuditInfo) GetDeletedAt() string {
+	if x != nil && x.DeletedAt != nil {
+		return *x.DeletedAt
+	}
+	return ""
+}
+
+func (x *AuditInfo) GetDeletedBy() string {
+	if x != nil && x.DeletedBy != nil {
+		return *x.DeletedBy
+	}
+	return ""
+}
+
+// BatchSummary counts the outcomes of a batch upsert
+type BatchSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Created       int32                  `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	Updated       int32                  `protobuf:"varint,3,opt,name=updated,proto3" json:"updated,omitempty"`
+	Failed        int32                  `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
+	Skipped       int32                  `protobuf:"varint,5,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchSummary) Reset() {
+	*x = BatchSummary{}
+	mi := &file_costing_v1_common_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchSummary) ProtoMessage() {}
+
+func (x *BatchSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_common_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchSummary.ProtoReflect.Descriptor instead.
+func (*BatchSummary) Descriptor() ([]byte, []int) {
+	return file_costing_v1_common_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BatchSummary) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *BatchSummary) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *BatchSummary) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *BatchSummary) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *BatchSummary) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+// ImportOptions describes an import file
+type ImportOptions struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Format         FileFormat             `protobuf:"varint,1,opt,name=format,proto3,enum=costing.v1.FileFormat" json:"format,omitempty"`
+	DryRun         bool                   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`                         // Validate every row and save nothing
+	UpdateExisting bool                   `protobuf:"varint,3,opt,name=update_existing,json=updateExisting,proto3" json:"update_existing,omitempty"` // Update codes that already exist instead of failing them
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ImportOptions) Reset() {
+	*x = ImportOptions{}
+	mi := &file_costing_v1_common_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportOptions) ProtoMessage() {}
+
+func (x *ImportOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_common_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportOptions.ProtoReflect.Descriptor instead.
+func (*ImportOptions) Descriptor() ([]byte, []int) {
+	return file_costing_v1_common_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ImportOptions) GetFormat() FileFormat {
+	if x != nil {
+		return x.Format
+	}
+	return FileFormat_FILE_FORMAT_UNSPECIFIED
+}
+
+func (x *ImportOptions) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportOptions) GetUpdateExisting() bool {
+	if x != nil {
+		return x.UpdateExisting
+	}
+	return false
+}
+
+// ImportRequest is one message of an import stream: the options first, then
+// the file in chunks
+type ImportRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*ImportRequest_Options
+	//	*ImportRequest_Chunk
+	Payload       isImportRequest_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRequest) Reset() {
+	*x = ImportRequest{}
+	mi := &file_costing_v1_common_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRequest) ProtoMessage() {}
+
+func (x *ImportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_common_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRequest.ProtoReflect.Descriptor instead.
+func (*ImportRequest) Descriptor() ([]byte, []int) {
+	return file_costing_v1_common_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ImportRequest) GetPayload() isImportRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *ImportRequest) GetOptions() *ImportOptions {
+	if x != nil {
+		if x, ok := x.Payload.(*ImportRequest_Options); ok {
+			return x.Options
+		}
+	}
+	return nil
+}
+
+func (x *ImportRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*ImportRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isImportRequest_Payload interface {
+	isImportRequest_Payload()
+}
+
+type ImportRequest_Options struct {
+	Options *ImportOptions `protobuf:"bytes,1,opt,name=options,proto3,oneof"`
+}
+
+type ImportRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*ImportRequest_Options) isImportRequest_Payload() {}
+
+func (*ImportRequest_Chunk) isImportRequest_Payload() {}
+
+// ImportRowError is a problem with one row of an imported file
+type ImportRowError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Row           int32                  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`      // Row number in the file; the header is row 1
+	Column        string                 `protobuf:"bytes,2,opt,name=column,proto3" json:"column,omitempty"` // Column header, empty if the problem is not with one cell
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRowError) Reset() {
+	*x = ImportRowError{}
+	mi := &file_costing_v1_common_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRowError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRowError) ProtoMessage() {}
+
+func (x *ImportRowError) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_common_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRowError.ProtoReflect.Descriptor instead.
+func (*ImportRowError) Descriptor() ([]byte, []int) {
+	return file_costing_v1_common_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ImportRowError) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ImportRowError) GetColumn() string {
+	if x != nil {
+		return x.Column
+	}
+	return ""
+}
+
+func (x *ImportRowError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// ImportResponse reports the outcome of an import. Rows are saved in one
+// transaction, so nothing is saved if any row has errors.
+type ImportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DryRun        bool                   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Summary       *BatchSummary          `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`
+	Errors        []*ImportRowError      `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportResponse) Reset() {
+	*x = ImportResponse{}
+	mi := &file_costing_v1_common_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportResponse) ProtoMessage() {}
+
+func (x *ImportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_common_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportResponse.ProtoReflect.Descriptor instead.
+func (*ImportResponse) Descriptor() ([]byte, []int) {
+	return file_costing_v1_common_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ImportResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ImportResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportResponse) GetSummary() *BatchSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+func (x *ImportResponse) GetErrors() []*ImportRowError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// ExportChunk is a piece of an exported file. The file is the concatenation
+// of the chunks in the order they are received.
+type ExportChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportChunk) Reset() {
+	*x = ExportChunk{}
+	mi := &file_costing_v1_common_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportChunk) ProtoMessage() {}
+
+func (x *ExportChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_common_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportChunk.ProtoReflect.Descriptor instead.
+func (*ExportChunk) Descriptor() ([]byte, []int) {
+	return file_costing_v1_common_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ExportChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
 var File_costing_v1_common_proto protoreflect.FileDescriptor
 
 const file_costing_v1_common_proto_rawDesc = "" +
@@ -303,7 +980,7 @@ const file_costing_v1_common_proto_rawDesc = "" +
 	"\vtotal_items\x18\x03 \x01(\x03R\n" +
 	"totalItems\x12\x1f\n" +
 	"\vtotal_pages\x18\x04 \x01(\x05R\n" +
-	"totalPages\"\xaf\x01\n" +
+	"totalPages\"\x95\x02\n" +
 	"\tAuditInfo\x12\x1d\n" +
 	"\n" +
 	"created_at\x18\x01 \x01(\tR\tcreatedAt\x12\x1d\n" +
@@ -312,9 +989,66 @@ const file_costing_v1_common_proto_rawDesc = "" +
 	"\n" +
 	"updated_at\x18\x03 \x01(\tH\x00R\tupdatedAt\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"updated_by\x18\x04 \x01(\tH\x01R\tupdatedBy\x88\x01\x01B\r\n" +
+	"updated_by\x18\x04 \x01(\tH\x01R\tupdatedBy\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"deleted_at\x18\x05 \x01(\tH\x02R\tdeletedAt\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"deleted_by\x18\x06 \x01(\tH\x03R\tdeletedBy\x88\x01\x01B\r\n" +
 	"\v_updated_atB\r\n" +
-	"\v_updated_byB\xae\x01\n" +
+	"\v_updated_byB\r\n" +
+	"\v_deleted_atB\r\n" +
+	"\v_deleted_by\"\x8a\x01\n" +
+	"\fBatchSummary\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\x05R\acreated\x12\x18\n" +
+	"\aupdated\x18\x03 \x01(\x05R\aupdated\x12\x16\n" +
+	"\x06failed\x18\x04 \x01(\x05R\x06failed\x12\x18\n" +
+	"\askipped\x18\x05 \x01(\x05R\askipped\"\x81\x01\n" +
+	"\rImportOptions\x12.\n" +
+	"\x06format\x18\x01 \x01(\x0e2\x16.costing.v1.FileFormatR\x06format\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\x12'\n" +
+	"\x0fupdate_existing\x18\x03 \x01(\bR\x0eupdateExisting\"i\n" +
+	"\rImportRequest\x125\n" +
+	"\aoptions\x18\x01 \x01(\v2\x19.costing.v1.ImportOptionsH\x00R\aoptions\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\t\n" +
+	"\apayload\"T\n" +
+	"\x0eImportRowError\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x05R\x03row\x12\x16\n" +
+	"\x06column\x18\x02 \x01(\tR\x06column\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\xbf\x01\n" +
+	"\x0eImportResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.costing.v1.BaseResponseR\x04base\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\x122\n" +
+	"\asummary\x18\x03 \x01(\v2\x18.costing.v1.BatchSummaryR\asummary\x122\n" +
+	"\x06errors\x18\x04 \x03(\v2\x1a.costing.v1.ImportRowErrorR\x06errors\"!\n" +
+	"\vExportChunk\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data*Z\n" +
+	"\tBatchMode\x12\x1a\n" +
+	"\x16BATCH_MODE_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11BATCH_MODE_ATOMIC\x10\x01\x12\x1a\n" +
+	"\x16BATCH_MODE_BEST_EFFORT\x10\x02*\xaf\x01\n" +
+	"\x0fBatchItemStatus\x12!\n" +
+	"\x1dBATCH_ITEM_STATUS_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19BATCH_ITEM_STATUS_CREATED\x10\x01\x12\x1d\n" +
+	"\x19BATCH_ITEM_STATUS_UPDATED\x10\x02\x12\x1c\n" +
+	"\x18BATCH_ITEM_STATUS_FAILED\x10\x03\x12\x1d\n" +
+	"\x19BATCH_ITEM_STATUS_SKIPPED\x10\x04*`\n" +
+	"\rSortDirection\x12\x1e\n" +
+	"\x1aSORT_DIRECTION_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12SORT_DIRECTION_ASC\x10\x01\x12\x17\n" +
+	"\x13SORT_DIRECTION_DESC\x10\x02*t\n" +
+	"\n" +
+	"ChangeType\x12\x1b\n" +
+	"\x17CHANGE_TYPE_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13CHANGE_TYPE_CREATED\x10\x01\x12\x17\n" +
+	"\x13CHANGE_TYPE_UPDATED\x10\x02\x12\x17\n" +
+	"\x13CHANGE_TYPE_DELETED\x10\x03*j\n" +
+	"\n" +
+	"FileFormat\x12\x1b\n" +
+	"\x17FILE_FORMAT_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fFILE_FORMAT_CSV\x10\x01\x12\x14\n" +
+	"\x10FILE_FORMAT_XLSX\x10\x02\x12\x14\n" +
+	"\x10FILE_FORMAT_JSON\x10\x03B\xae\x01\n" +
 	"\x0ecom.costing.v1B\vCommonProtoP\x01ZFgithub.com/homindolenern/goapps-costing-v1/gen/go/costing/v1;costingv1\xa2\x02\x03CXX\xaa\x02\n" +
 	"Costing.V1\xca\x02\n" +
 	"Costing\\V1\xe2\x02\x16Costing\\V1\\GPBMetadata\xea\x02\vCosting::V1b\x06proto3"
@@ -331,20 +1065,37 @@ func file_costing_v1_common_proto_rawDescGZIP() []byte {
 	return file_costing_v1_common_proto_rawDescData
 }
 
-var file_costing_v1_common_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_costing_v1_common_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_costing_v1_common_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_costing_v1_common_proto_goTypes = []any{
-	(*ValidationError)(nil), // 0: costing.v1.ValidationError
-	(*BaseResponse)(nil),    // 1: costing.v1.BaseResponse
-	(*PaginationMeta)(nil),  // 2: costing.v1.PaginationMeta
-	(*AuditInfo)(nil),       // 3: costing.v1.AuditInfo
+	(BatchMode)(0),          // 0: costing.v1.BatchMode
+	(BatchItemStatus)(0),    // 1: costing.v1.BatchItemStatus
+	(SortDirection)(0),      // 2: costing.v1.SortDirection
+	(ChangeType)(0),         // 3: costing.v1.ChangeType
+	(FileFormat)(0),         // 4: costing.v1.FileFormat
+	(*ValidationError)(nil), // 5: costing.v1.ValidationError
+	(*BaseResponse)(nil),    // 6: costing.v1.BaseResponse
+	(*PaginationMeta)(nil),  // 7: costing.v1.PaginationMeta
+	(*AuditInfo)(nil),       // 8: costing.v1.AuditInfo
+	(*BatchSummary)(nil),    // 9: costing.v1.BatchSummary
+	(*ImportOptions)(nil),   // 10: costing.v1.ImportOptions
+	(*ImportRequest)(nil),   // 11: costing.v1.ImportRequest
+	(*ImportRowError)(nil),  // 12: costing.v1.ImportRowError
+	(*ImportResponse)(nil),  // 13: costing.v1.ImportResponse
+	(*ExportChunk)(nil),     // 14: costing.v1.ExportChunk
 }
 var file_costing_v1_common_proto_depIdxs = []int32{
-	0, // 0: costing.v1.BaseResponse.validation_errors:type_name -> costing.v1.ValidationError
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	5,  // 0: costing.v1.BaseResponse.validation_errors:type_name -> costing.v1.ValidationError
+	4,  // 1: costing.v1.ImportOptions.format:type_name -> costing.v1.FileFormat
+	10, // 2: costing.v1.ImportRequest.options:type_name -> costing.v1.ImportOptions
+	6,  // 3: costing.v1.ImportResponse.base:type_name -> costing.v1.BaseResponse
+	9,  // 4: costing.v1.ImportResponse.summary:type_name -> costing.v1.BatchSummary
+	12, // 5: costing.v1.ImportResponse.errors:type_name -> costing.v1.ImportRowError
+	6,  // [6:6] is the sub-list for method output_type
+	6,  // [6:6] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_costing_v1_common_proto_init() }
@@ -353,18 +1104,23 @@ func file_costing_v1_common_proto_init() {
 		return
 	}
 	file_costing_v1_common_proto_msgTypes[3].OneofWrappers = []any{}
+	file_costing_v1_common_proto_msgTypes[6].OneofWrappers = []any{
+		(*ImportRequest_Options)(nil),
+		(*ImportRequest_Chunk)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_costing_v1_common_proto_rawDesc), len(file_costing_v1_common_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   4,
+			NumEnums:      5,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_costing_v1_common_proto_goTypes,
 		DependencyIndexes: file_costing_v1_common_proto_depIdxs,
+		EnumInfos:         file_costing_v1_common_proto_enumTypes,
 		MessageInfos:      file_costing_v1_common_proto_msgTypes,
 	}.Build()
 	File_costing_v1_common_proto = out.File
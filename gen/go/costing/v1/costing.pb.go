@@ -0,0 +1,895 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: costing/v1/costing.proto
+
+package costingv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// CostComponentType represents the kind of cost a component represents
+type CostComponentType int32
+
+const (
+	CostComponentType_COST_COMPONENT_TYPE_UNSPECIFIED CostComponentType = 0
+	CostComponentType_COST_COMPONENT_TYPE_MATERIAL    CostComponentType = 1 // Raw material consumption
+	CostComponentType_COST_COMPONENT_TYPE_ENERGY      CostComponentType = 2 // Machine electricity
+	CostComponentType_COST_COMPONENT_TYPE_LABOR       CostComponentType = 3 // Operator hours
+	CostComponentType_COST_COMPONENT_TYPE_OVERHEAD    CostComponentType = 4 // Machine overhead hours
+)
+
+// Enum value maps for CostComponentType.
+var (
+	CostComponentType_name = map[int32]string{
+		0: "COST_COMPONENT_TYPE_UNSPECIFIED",
+		1: "COST_COMPONENT_TYPE_MATERIAL",
+		2: "COST_COMPONENT_TYPE_ENERGY",
+		3: "COST_COMPONENT_TYPE_LABOR",
+		4: "COST_COMPONENT_TYPE_OVERHEAD",
+	}
+	CostComponentType_value = map[string]int32{
+		"COST_COMPONENT_TYPE_UNSPECIFIED": 0,
+		"COST_COMPONENT_TYPE_MATERIAL":    1,
+		"COST_COMPONENT_TYPE_ENERGY":      2,
+		"COST_COMPONENT_TYPE_LABOR":       3,
+		"COST_COMPONENT_TYPE_OVERHEAD":    4,
+	}
+)
+
+func (x CostComponentType) Enum() *CostComponentType {
+	p := new(CostComponentType)
+	*p = x
+	return p
+}
+
+func (x CostComponentType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CostComponentType) Descriptor() protoreflect.EnumDescriptor {
+	return file_costing_v1_costing_proto_enumTypes[0].Descriptor()
+}
+
+func (CostComponentType) Type() protoreflect.EnumType {
+	return &file_costing_v1_costing_proto_enumTypes[0]
+}
+
+func (x CostComponentType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CostComponentType.Descriptor instead.
+func (CostComponentType) EnumDescriptor() ([]byte, []int) {
+	return file_costing_v1_costing_proto_rawDescGZIP(), []int{0}
+}
+
+// MaterialInput is a raw material consumed by the recipe
+type MaterialInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MaterialCode  string                 `protobuf:"bytes,1,opt,name=material_code,json=materialCode,proto3" json:"material_code,omitempty"`
+	Quantity      float64                `protobuf:"fixed64,2,opt,name=quantity,proto3" json:"quantity,omitempty"` // Net consumption for the recipe output
+	UomCode       string                 `protobuf:"bytes,3,opt,name=uom_code,json=uomCode,proto3" json:"uom_code,omitempty"`
+	UnitPrice     float64                `protobuf:"fixed64,4,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"` // Price of one price_uom_code
+	PriceUomCode  string                 `protobuf:"bytes,5,opt,name=price_uom_code,json=priceUomCode,proto3" json:"price_uom_code,omitempty"`
+	WastePercent  float64                `protobuf:"fixed64,6,opt,name=waste_percent,json=wastePercent,proto3" json:"waste_percent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MaterialInput) Reset() {
+	*x = MaterialInput{}
+	mi := &file_costing_v1_costing_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MaterialInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaterialInput) ProtoMessage() {}
+
+func (x *MaterialInput) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_costing_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaterialInput.ProtoReflect.Descriptor instead.
+func (*MaterialInput) Descriptor() ([]byte, []int) {
+	return file_costing_v1_costing_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MaterialInput) GetMaterialCode() string {
+	if x != nil {
+		return x.MaterialCode
+	}
+	return ""
+}
+
+func (x *MaterialInput) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *MaterialInput) GetUomCode() string {
+	if x != nil {
+		return x.UomCode
+	}
+	return ""
+}
+
+func (x *MaterialInput) GetUnitPrice() float64 {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return 0
+}
+
+func (x *MaterialInput) GetPriceUomCode() string {
+	if x != nil {
+		return x.PriceUomCode
+	}
+	return ""
+}
+
+func (x *MaterialInput) GetWastePercent() float64 {
+	if x != nil {
+		return x.WastePercent
+	}
+	return 0
+}
+
+// StepParameter is a numeric machine parameter, e.g., RPM, EFFICIENCY, POWER_KWH
+type StepParameter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ParameterCode string                 `protobuf:"bytes,1,opt,name=parameter_code,json=parameterCode,proto3" json:"parameter_code,omitempty"`
+	Value         float64                `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StepParameter) Reset() {
+	*x = StepParameter{}
+	mi := &file_costing_v1_costing_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StepParameter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StepParameter) ProtoMessage() {}
+
+func (x *StepParameter) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_costing_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StepParameter.ProtoReflect.Descriptor instead.
+func (*StepParameter) Descriptor() ([]byte, []int) {
+	return file_costing_v1_costing_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StepParameter) GetParameterCode() string {
+	if x != nil {
+		return x.ParameterCode
+	}
+	return ""
+}
+
+func (x *StepParameter) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+// ProcessStepInput is a machine operation the recipe output goes through
+type ProcessStepInput struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	StepCode            string                 `protobuf:"bytes,1,opt,name=step_code,json=stepCode,proto3" json:"step_code,omitempty"`
+	MachineCode         string                 `protobuf:"bytes,2,opt,name=machine_code,json=machineCode,proto3" json:"machine_code,omitempty"`
+	OutputUomCode       string                 `protobuf:"bytes,3,opt,name=output_uom_code,json=outputUomCode,proto3" json:"output_uom_code,omitempty"` // Unit the production rate is measured in
+	Parameters          []*StepParameter       `protobuf:"bytes,4,rep,name=parameters,proto3" json:"parameters,omitempty"`
+	LaborRatePerHour    float64                `protobuf:"fixed64,5,opt,name=labor_rate_per_hour,json=laborRatePerHour,proto3" json:"labor_rate_per_hour,omitempty"`
+	OverheadRatePerHour float64                `protobuf:"fixed64,6,opt,name=overhead_rate_per_hour,json=overheadRatePerHour,proto3" json:"overhead_rate_per_hour,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ProcessStepInput) Reset() {
+	*x = ProcessStepInput{}
+	mi := &file_costing_v1_costing_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessStepInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessStepInput) ProtoMessage() {}
+
+func (x *ProcessStepInput) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_costing_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessStepInput.ProtoReflect.Descriptor instead.
+func (*ProcessStepInput) Descriptor() ([]byte, []int) {
+	return file_costing_v1_costing_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ProcessStepInput) GetStepCode() string {
+	if x != nil {
+		return x.StepCode
+	}
+	return ""
+}
+
+func (x *ProcessStepInput) GetMachineCode() string {
+	if x != nil {
+		return x.MachineCode
+	}
+	return ""
+}
+
+func (x *ProcessStepInput) GetOutputUomCode() string {
+	if x != nil {
+		return x.OutputUomCode
+	}
+	return ""
+}
+
+func (x *ProcessStepInput) GetParameters() []*StepParameter {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+func (x *ProcessStepInput) GetLaborRatePerHour() float64 {
+	if x != nil {
+		return x.LaborRatePerHour
+	}
+	return 0
+}
+
+func (x *ProcessStepInput) GetOverheadRatePerHour() float64 {
+	if x != nil {
+		return x.OverheadRatePerHour
+	}
+	return 0
+}
+
+// CostComponent is one line of the cost breakdown
+type CostComponent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          CostComponentType      `protobuf:"varint,1,opt,name=type,proto3,enum=costing.v1.CostComponentType" json:"type,omitempty"`
+	Reference     string                 `protobuf:"bytes,2,opt,name=reference,proto3" json:"reference,omitempty"` // Material code or step code
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Quantity      float64                `protobuf:"fixed64,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UomCode       string                 `protobuf:"bytes,5,opt,name=uom_code,json=uomCode,proto3" json:"uom_code,omitempty"`
+	UnitCost      float64                `protobuf:"fixed64,6,opt,name=unit_cost,json=unitCost,proto3" json:"unit_cost,omitempty"`
+	Amount        float64                `protobuf:"fixed64,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CostComponent) Reset() {
+	*x = CostComponent{}
+	mi := &file_costing_v1_costing_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CostComponent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CostComponent) ProtoMessage() {}
+
+func (x *CostComponent) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_costing_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CostComponent.ProtoReflect.Descriptor instead.
+func (*CostComponent) Descriptor() ([]byte, []int) {
+	return file_costing_v1_costing_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CostComponent) GetType() CostComponentType {
+	if x != nil {
+		return x.Type
+	}
+	return CostComponentType_COST_COMPONENT_TYPE_UNSPECIFIED
+}
+
+func (x *CostComponent) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *CostComponent) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CostComponent) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *CostComponent) GetUomCode() string {
+	if x != nil {
+		return x.UomCode
+	}
+	return ""
+}
+
+func (x *CostComponent) GetUnitCost() float64 {
+	if x != nil {
+		return x.UnitCost
+	}
+	return 0
+}
+
+func (x *CostComponent) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+// StepResult reports how a process step was costed
+type StepResult struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	StepCode       string                 `protobuf:"bytes,1,opt,name=step_code,json=stepCode,proto3" json:"step_code,omitempty"`
+	MachineCode    string                 `protobuf:"bytes,2,opt,name=machine_code,json=machineCode,proto3" json:"machine_code,omitempty"`
+	Output         float64                `protobuf:"fixed64,3,opt,name=output,proto3" json:"output,omitempty"`
+	OutputUomCode  string                 `protobuf:"bytes,4,opt,name=output_uom_code,json=outputUomCode,proto3" json:"output_uom_code,omitempty"`
+	ProductionRate float64                `protobuf:"fixed64,5,opt,name=production_rate,json=productionRate,proto3" json:"production_rate,omitempty"` // Effective output per hour
+	Hours          float64                `protobuf:"fixed64,6,opt,name=hours,proto3" json:"hours,omitempty"`
+	EnergyKwh      float64                `protobuf:"fixed64,7,opt,name=energy_kwh,json=energyKwh,proto3" json:"energy_kwh,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StepResult) Reset() {
+	*x = StepResult{}
+	mi := &file_costing_v1_costing_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StepResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StepResult) ProtoMessage() {}
+
+func (x *StepResult) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_costing_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StepResult.ProtoReflect.Descriptor instead.
+func (*StepResult) Descriptor() ([]byte, []int) {
+	return file_costing_v1_costing_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *StepResult) GetStepCode() string {
+	if x != nil {
+		return x.StepCode
+	}
+	return ""
+}
+
+func (x *StepResult) GetMachineCode() string {
+	if x != nil {
+		return x.MachineCode
+	}
+	return ""
+}
+
+func (x *StepResult) GetOutput() float64 {
+	if x != nil {
+		return x.Output
+	}
+	return 0
+}
+
+func (x *StepResult) GetOutputUomCode() string {
+	if x != nil {
+		return x.OutputUomCode
+	}
+	return ""
+}
+
+func (x *StepResult) GetProductionRate() float64 {
+	if x != nil {
+		return x.ProductionRate
+	}
+	return 0
+}
+
+func (x *StepResult) GetHours() float64 {
+	if x != nil {
+		return x.Hours
+	}
+	return 0
+}
+
+func (x *StepResult) GetEnergyKwh() float64 {
+	if x != nil {
+		return x.EnergyKwh
+	}
+	return 0
+}
+
+// CostBreakdown is the result of a cost calculation
+type CostBreakdown struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ProductCode    string                 `protobuf:"bytes,1,opt,name=product_code,json=productCode,proto3" json:"product_code,omitempty"`
+	OutputQuantity float64                `protobuf:"fixed64,2,opt,name=output_quantity,json=outputQuantity,proto3" json:"output_quantity,omitempty"`
+	OutputUomCode  string                 `protobuf:"bytes,3,opt,name=output_uom_code,json=outputUomCode,proto3" json:"output_uom_code,omitempty"`
+	Components     []*CostComponent       `protobuf:"bytes,4,rep,name=components,proto3" json:"components,omitempty"`
+	Steps          []*StepResult          `protobuf:"bytes,5,rep,name=steps,proto3" json:"steps,omitempty"`
+	MaterialCost   float64                `protobuf:"fixed64,6,opt,name=material_cost,json=materialCost,proto3" json:"material_cost,omitempty"`
+	ProcessCost    float64                `protobuf:"fixed64,7,opt,name=process_cost,json=processCost,proto3" json:"process_cost,omitempty"`
+	TotalCost      float64                `protobuf:"fixed64,8,opt,name=total_cost,json=totalCost,proto3" json:"total_cost,omitempty"`
+	UnitCost       float64                `protobuf:"fixed64,9,opt,name=unit_cost,json=unitCost,proto3" json:"unit_cost,omitempty"` // Cost of one output_uom_code
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CostBreakdown) Reset() {
+	*x = CostBreakdown{}
+	mi := &file_costing_v1_costing_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CostBreakdown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CostBreakdown) ProtoMessage() {}
+
+func (x *CostBreakdown) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_costing_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CostBreakdown.ProtoReflect.Descriptor instead.
+func (*CostBreakdown) Descriptor() ([]byte, []int) {
+	return file_costing_v1_costing_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CostBreakdown) GetProductCode() string {
+	if x != nil {
+		return x.ProductCode
+	}
+	return ""
+}
+
+func (x *CostBreakdown) GetOutputQuantity() float64 {
+	if x != nil {
+		return x.OutputQuantity
+	}
+	return 0
+}
+
+func (x *CostBreakdown) GetOutputUomCode() string {
+	if x != nil {
+		return x.OutputUomCode
+	}
+	return ""
+}
+
+func (x *CostBreakdown) GetComponents() []*CostComponent {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+func (x *CostBreakdown) GetSteps() []*StepResult {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *CostBreakdown) GetMaterialCost() float64 {
+	if x != nil {
+		return x.MaterialCost
+	}
+	return 0
+}
+
+func (x *CostBreakdown) GetProcessCost() float64 {
+	if x != nil {
+		return x.ProcessCost
+	}
+	return 0
+}
+
+func (x *CostBreakdown) GetTotalCost() float64 {
+	if x != nil {
+		return x.TotalCost
+	}
+	return 0
+}
+
+func (x *CostBreakdown) GetUnitCost() float64 {
+	if x != nil {
+		return x.UnitCost
+	}
+	return 0
+}
+
+// CalculateCost
+type CalculateCostRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ProductCode     string                 `protobuf:"bytes,1,opt,name=product_code,json=productCode,proto3" json:"product_code,omitempty"`
+	OutputQuantity  float64                `protobuf:"fixed64,2,opt,name=output_quantity,json=outputQuantity,proto3" json:"output_quantity,omitempty"`
+	OutputUomCode   string                 `protobuf:"bytes,3,opt,name=output_uom_code,json=outputUomCode,proto3" json:"output_uom_code,omitempty"`
+	Materials       []*MaterialInput       `protobuf:"bytes,4,rep,name=materials,proto3" json:"materials,omitempty"`
+	Steps           []*ProcessStepInput    `protobuf:"bytes,5,rep,name=steps,proto3" json:"steps,omitempty"`
+	ElectricityRate float64                `protobuf:"fixed64,6,opt,name=electricity_rate,json=electricityRate,proto3" json:"electricity_rate,omitempty"` // Price of one kWh
+	AsOf            *string                `protobuf:"bytes,7,opt,name=as_of,json=asOf,proto3,oneof" json:"as_of,omitempty"`                              // RFC 3339; validate parameters against the definitions in force then
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CalculateCostRequest) Reset() {
+	*x = CalculateCostRequest{}
+	mi := &file_costing_v1_costing_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalculateCostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculateCostRequest) ProtoMessage() {}
+
+func (x *CalculateCostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_costing_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculateCostRequest.ProtoReflect.Descriptor instead.
+func (*CalculateCostRequest) Descriptor() ([]byte, []int) {
+	return file_costing_v1_costing_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CalculateCostRequest) GetProductCode() string {
+	if x != nil {
+		return x.ProductCode
+	}
+	return ""
+}
+
+func (x *CalculateCostRequest) GetOutputQuantity() float64 {
+	if x != nil {
+		return x.OutputQuantity
+	}
+	return 0
+}
+
+func (x *CalculateCostRequest) GetOutputUomCode() string {
+	if x != nil {
+		return x.OutputUomCode
+	}
+	return ""
+}
+
+func (x *CalculateCostRequest) GetMaterials() []*MaterialInput {
+	if x != nil {
+		return x.Materials
+	}
+	return nil
+}
+
+func (x *CalculateCostRequest) GetSteps() []*ProcessStepInput {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *CalculateCostRequest) GetElectricityRate() float64 {
+	if x != nil {
+		return x.ElectricityRate
+	}
+	return 0
+}
+
+func (x *CalculateCostRequest) GetAsOf() string {
+	if x != nil && x.AsOf != nil {
+		return *x.AsOf
+	}
+	return ""
+}
+
+type CalculateCostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Data          *CostBreakdown         `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalculateCostResponse) Reset() {
+	*x = CalculateCostResponse{}
+	mi := &file_costing_v1_costing_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalculateCostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculateCostResponse) ProtoMessage() {}
+
+func (x *CalculateCostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_costing_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculateCostResponse.ProtoReflect.Descriptor instead.
+func (*CalculateCostResponse) Descriptor() ([]byte, []int) {
+	return file_costing_v1_costing_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CalculateCostResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *CalculateCostResponse) GetData() *CostBreakdown {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+var File_costing_v1_costing_proto protoreflect.FileDescriptor
+
+const file_costing_v1_costing_proto_rawDesc = "" +
+	"\n" +
+	"\x18costing/v1/costing.proto\x12\n" +
+	"costing.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17costing/v1/common.proto\"\xaf\x02\n" +
+	"\rMaterialInput\x12.\n" +
+	"\rmaterial_code\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x182R\fmaterialCode\x12*\n" +
+	"\bquantity\x18\x02 \x01(\x01B\x0e\xbaH\v\x12\t!\x00\x00\x00\x00\x00\x00\x00\x00R\bquantity\x12$\n" +
+	"\buom_code\x18\x03 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18\x14R\auomCode\x12-\n" +
+	"\n" +
+	"unit_price\x18\x04 \x01(\x01B\x0e\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00R\tunitPrice\x12/\n" +
+	"\x0eprice_uom_code\x18\x05 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18\x14R\fpriceUomCode\x12<\n" +
+	"\rwaste_percent\x18\x06 \x01(\x01B\x17\xbaH\x14\x12\x12\x11\x00\x00\x00\x00\x00\x00Y@)\x00\x00\x00\x00\x00\x00\x00\x00R\fwastePercent\"W\n" +
+	"\rStepParameter\x120\n" +
+	"\x0eparameter_code\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x182R\rparameterCode\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value\"\xd8\x02\n" +
+	"\x10ProcessStepInput\x12&\n" +
+	"\tstep_code\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x182R\bstepCode\x12*\n" +
+	"\fmachine_code\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x182R\vmachineCode\x121\n" +
+	"\x0foutput_uom_code\x18\x03 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18\x14R\routputUomCode\x129\n" +
+	"\n" +
+	"parameters\x18\x04 \x03(\v2\x19.costing.v1.StepParameterR\n" +
+	"parameters\x12=\n" +
+	"\x13labor_rate_per_hour\x18\x05 \x01(\x01B\x0e\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00R\x10laborRatePerHour\x12C\n" +
+	"\x16overhead_rate_per_hour\x18\x06 \x01(\x01B\x0e\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00R\x13overheadRatePerHour\"\xee\x01\n" +
+	"\rCostComponent\x121\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1d.costing.v1.CostComponentTypeR\x04type\x12\x1c\n" +
+	"\treference\x18\x02 \x01(\tR\treference\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x01R\bquantity\x12\x19\n" +
+	"\buom_code\x18\x05 \x01(\tR\auomCode\x12\x1b\n" +
+	"\tunit_cost\x18\x06 \x01(\x01R\bunitCost\x12\x16\n" +
+	"\x06amount\x18\a \x01(\x01R\x06amount\"\xea\x01\n" +
+	"\n" +
+	"StepResult\x12\x1b\n" +
+	"\tstep_code\x18\x01 \x01(\tR\bstepCode\x12!\n" +
+	"\fmachine_code\x18\x02 \x01(\tR\vmachineCode\x12\x16\n" +
+	"\x06output\x18\x03 \x01(\x01R\x06output\x12&\n" +
+	"\x0foutput_uom_code\x18\x04 \x01(\tR\routputUomCode\x12'\n" +
+	"\x0fproduction_rate\x18\x05 \x01(\x01R\x0eproductionRate\x12\x14\n" +
+	"\x05hours\x18\x06 \x01(\x01R\x05hours\x12\x1d\n" +
+	"\n" +
+	"energy_kwh\x18\a \x01(\x01R\tenergyKwh\"\xf0\x02\n" +
+	"\rCostBreakdown\x12!\n" +
+	"\fproduct_code\x18\x01 \x01(\tR\vproductCode\x12'\n" +
+	"\x0foutput_quantity\x18\x02 \x01(\x01R\x0eoutputQuantity\x12&\n" +
+	"\x0foutput_uom_code\x18\x03 \x01(\tR\routputUomCode\x129\n" +
+	"\n" +
+	"components\x18\x04 \x03(\v2\x19.costing.v1.CostComponentR\n" +
+	"components\x12,\n" +
+	"\x05steps\x18\x05 \x03(\v2\x16.costing.v1.StepResultR\x05steps\x12#\n" +
+	"\rmaterial_cost\x18\x06 \x01(\x01R\fmaterialCost\x12!\n" +
+	"\fprocess_cost\x18\a \x01(\x01R\vprocessCost\x12\x1d\n" +
+	"\n" +
+	"total_cost\x18\b \x01(\x01R\ttotalCost\x12\x1b\n" +
+	"\tunit_cost\x18\t \x01(\x01R\bunitCost\"\xfc\x02\n" +
+	"\x14CalculateCostRequest\x12,\n" +
+	"\fproduct_code\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x182R\vproductCode\x127\n" +
+	"\x0foutput_quantity\x18\x02 \x01(\x01B\x0e\xbaH\v\x12\t!\x00\x00\x00\x00\x00\x00\x00\x00R\x0eoutputQuantity\x121\n" +
+	"\x0foutput_uom_code\x18\x03 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18\x14R\routputUomCode\x127\n" +
+	"\tmaterials\x18\x04 \x03(\v2\x19.costing.v1.MaterialInputR\tmaterials\x122\n" +
+	"\x05steps\x18\x05 \x03(\v2\x1c.costing.v1.ProcessStepInputR\x05steps\x129\n" +
+	"\x10electricity_rate\x18\x06 \x01(\x01B\x0e\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00R\x0felectricityRate\x12\x18\n" +
+	"\x05as_of\x18\a \x01(\tH\x00R\x04asOf\x88\x01\x01B\b\n" +
+	"\x06_as_of\"t\n" +
+	"\x15CalculateCostResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.costing.v1.BaseResponseR\x04base\x12-\n" +
+	"\x04data\x18\x02 \x01(\v2\x19.costing.v1.CostBreakdownR\x04data*\xbb\x01\n" +
+	"\x11CostComponentType\x12#\n" +
+	"\x1fCOST_COMPONENT_TYPE_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cCOST_COMPONENT_TYPE_MATERIAL\x10\x01\x12\x1e\n" +
+	"\x1aCOST_COMPONENT_TYPE_ENERGY\x10\x02\x12\x1d\n" +
+	"\x19COST_COMPONENT_TYPE_LABOR\x10\x03\x12 \n" +
+	"\x1cCOST_COMPONENT_TYPE_OVERHEAD\x10\x042\x88\x01\n" +
+	"\x0eCostingService\x12v\n" +
+	"\rCalculateCost\x12 .costing.v1.CalculateCostRequest\x1a!.costing.v1.CalculateCostResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/costing:calculateB\xaf\x01\n" +
+	"\x0ecom.costing.v1B\fCostingProtoP\x01ZFgithub.com/homindolenern/goapps-costing-v1/gen/go/costing/v1;costingv1\xa2\x02\x03CXX\xaa\x02\n" +
+	"Costing.V1\xca\x02\n" +
+	"Costing\\V1\xe2\x02\x16Costing\\V1\\GPBMetadata\xea\x02\vCosting::V1b\x06proto3"
+
+var (
+	file_costing_v1_costing_proto_rawDescOnce sync.Once
+	file_costing_v1_costing_proto_rawDescData []byte
+)
+
+func file_costing_v1_costing_proto_rawDescGZIP() []byte {
+	file_costing_v1_costing_proto_rawDescOnce.Do(func() {
+		file_costing_v1_costing_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_costing_v1_costing_proto_rawDesc), len(file_costing_v1_costing_proto_rawDesc)))
+	})
+	return file_costing_v1_costing_proto_rawDescData
+}
+
+var file_costing_v1_costing_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_costing_v1_costing_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_costing_v1_costing_proto_goTypes = []any{
+	(CostComponentType)(0),        // 0: costing.v1.CostComponentType
+	(*MaterialInput)(nil),         // 1: costing.v1.MaterialInput
+	(*StepParameter)(nil),         // 2: costing.v1.StepParameter
+	(*ProcessStepInput)(nil),      // 3: costing.v1.ProcessStepInput
+	(*CostComponent)(nil),         // 4: costing.v1.CostComponent
+	(*StepResult)(nil),            // 5: costing.v1.StepResult
+	(*CostBreakdown)(nil),         // 6: costing.v1.CostBreakdown
+	(*CalculateCostRequest)(nil),  // 7: costing.v1.CalculateCostRequest
+	(*CalculateCostResponse)(nil), // 8: costing.v1.CalculateCostResponse
+	(*BaseResponse)(nil),          // 9: costing.v1.BaseResponse
+}
+var file_costing_v1_costing_proto_depIdxs = []int32{
+	2, // 0: costing.v1.ProcessStepInput.parameters:type_name -> costing.v1.StepParameter
+	0, // 1: costing.v1.CostComponent.type:type_name -> costing.v1.CostComponentType
+	4, // 2: costing.v1.CostBreakdown.components:type_name -> costing.v1.CostComponent
+	5, // 3: costing.v1.CostBreakdown.steps:type_name -> costing.v1.StepResult
+	1, // 4: costing.v1.CalculateCostRequest.materials:type_name -> costing.v1.MaterialInput
+	3, // 5: costing.v1.CalculateCostRequest.steps:type_name -> costing.v1.ProcessStepInput
+	9, // 6: costing.v1.CalculateCostResponse.base:type_name -> costing.v1.BaseResponse
+	6, // 7: costing.v1.CalculateCostResponse.data:type_name -> costing.v1.CostBreakdown
+	7, // 8: costing.v1.CostingService.CalculateCost:input_type -> costing.v1.CalculateCostRequest
+	8, // 9: costing.v1.CostingService.CalculateCost:output_type -> costing.v1.CalculateCostResponse
+	9, // [9:10] is the sub-list for method output_type
+	8, // [8:9] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_costing_v1_costing_proto_init() }
+func file_costing_v1_costing_proto_init() {
+	if File_costing_v1_costing_proto != nil {
+		return
+	}
+	file_costing_v1_common_proto_init()
+	file_costing_v1_costing_proto_msgTypes[6].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_costing_v1_costing_proto_rawDesc), len(file_costing_v1_costing_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_costing_v1_costing_proto_goTypes,
+		DependencyIndexes: file_costing_v1_costing_proto_depIdxs,
+		EnumInfos:         file_costing_v1_costing_proto_enumTypes,
+		MessageInfos:      file_costing_v1_costing_proto_msgTypes,
+	}.Build()
+	File_costing_v1_costing_proto = out.File
+	file_costing_v1_costing_proto_goTypes = nil
+	file_costing_v1_costing_proto_depIdxs = nil
+}
@@ -0,0 +1,127 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             (unknown)
+// source: costing/v1/costing.proto
+
+package costingv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file.
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CostingService_CalculateCost_FullMethodName = "/costing.v1.CostingService/CalculateCost"
+)
+
+// CostingServiceClient is the client API for CostingService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// CostingService calculates product costs from recipes.
+type CostingServiceClient interface {
+	// CalculateCost returns the cost breakdown of a product recipe
+	CalculateCost(ctx context.Context, in *CalculateCostRequest, opts ...grpc.CallOption) (*CalculateCostResponse, error)
+}
+
+type costingServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCostingServiceClient(cc grpc.ClientConnInterface) CostingServiceClient {
+	return &costingServiceClient{cc}
+}
+
+func (c *costingServiceClient) CalculateCost(ctx context.Context, in *CalculateCostRequest, opts ...grpc.CallOption) (*CalculateCostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CalculateCostResponse)
+	err := c.cc.Invoke(ctx, CostingService_CalculateCost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CostingServiceServer is the server API for CostingService service.
+// All implementations must embed UnimplementedCostingServiceServer.
+// for forward compatibility.
+//
+// CostingService calculates product costs from recipes.
+type CostingServiceServer interface {
+	// CalculateCost returns the cost breakdown of a product recipe
+	CalculateCost(context.Context, *CalculateCostRequest) (*CalculateCostResponse, error)
+	mustEmbedUnimplementedCostingServiceServer()
+}
+
+// UnimplementedCostingServiceServer must be embedded to have.
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCostingServiceServer struct{}
+
+func (UnimplementedCostingServiceServer) CalculateCost(context.Context, *CalculateCostRequest) (*CalculateCostResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CalculateCost not implemented")
+}
+func (UnimplementedCostingServiceServer) mustEmbedUnimplementedCostingServiceServer() {}
+func (UnimplementedCostingServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeCostingServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CostingServiceServer will.
+// result in compilation errors.
+type UnsafeCostingServiceServer interface {
+	mustEmbedUnimplementedCostingServiceServer()
+}
+
+func RegisterCostingServiceServer(s grpc.ServiceRegistrar, srv CostingServiceServer) {
+	// If the following call panics, it indicates UnimplementedCostingServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CostingService_ServiceDesc, srv)
+}
+
+func _CostingService_CalculateCost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalculateCostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CostingServiceServer).CalculateCost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CostingService_CalculateCost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CostingServiceServer).CalculateCost(ctx, req.(*CalculateCostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CostingService_ServiceDesc is the grpc.ServiceDesc for CostingService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CostingService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "costing.v1.CostingService",
+	HandlerType: (*CostingServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CalculateCost",
+			Handler:    _CostingService_CalculateCost_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "costing/v1/costing.proto",
+}
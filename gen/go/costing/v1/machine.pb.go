@@ -0,0 +1,1716 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: costing/v1/machine.proto
+
+package costingv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// TemplateParameter is a MACHINE-category parameter declared by a machine type
+type TemplateParameter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ParameterCode string                 `protobuf:"bytes,1,opt,name=parameter_code,json=parameterCode,proto3" json:"parameter_code,omitempty"`
+	IsMandatory   bool                   `protobuf:"varint,2,opt,name=is_mandatory,json=isMandatory,proto3" json:"is_mandatory,omitempty"` // Also mandatory when the parameter itself is mandatory
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TemplateParameter) Reset() {
+	*x = TemplateParameter{}
+	mi := &file_costing_v1_machine_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TemplateParameter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateParameter) ProtoMessage() {}
+
+func (x *TemplateParameter) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_machine_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateParameter.ProtoReflect.Descriptor instead.
+func (*TemplateParameter) Descriptor() ([]byte, []int) {
+	return file_costing_v1_machine_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TemplateParameter) GetParameterCode() string {
+	if x != nil {
+		return x.ParameterCode
+	}
+	return ""
+}
+
+func (x *TemplateParameter) GetIsMandatory() bool {
+	if x != nil {
+		return x.IsMandatory
+	}
+	return false
+}
+
+// MachineType represents a kind of machine and its parameter template
+type MachineType struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	MachineTypeCode string                 `protobuf:"bytes,1,opt,name=machine_type_code,json=machineTypeCode,proto3" json:"machine_type_code,omitempty"`
+	MachineTypeName string                 `protobuf:"bytes,2,opt,name=machine_type_name,json=machineTypeName,proto3" json:"machine_type_name,omitempty"`
+	Description     *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Parameters      []*TemplateParameter   `protobuf:"bytes,4,rep,name=parameters,proto3" json:"parameters,omitempty"`
+	IsActive        bool                   `protobuf:"varint,5,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	Audit           *AuditInfo             `protobuf:"bytes,6,opt,name=audit,proto3" json:"audit,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MachineType) Reset() {
+	*x = MachineType{}
+	mi := &file_costing_v1_machine_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MachineType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MachineType) ProtoMessage() {}
+
+func (x *MachineType) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_machine_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MachineType.ProtoReflect.Descriptor instead.
+func (*MachineType) Descriptor() ([]byte, []int) {
+	return file_costing_v1_machine_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MachineType) GetMachineTypeCode() string {
+	if x != nil {
+		return x.MachineTypeCode
+	}
+	return ""
+}
+
+func (x *MachineType) GetMachineTypeName() string {
+	if x != nil {
+		return x.MachineTypeName
+	}
+	return ""
+}
+
+func (x *MachineType) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *MachineType) GetParameters() []*TemplateParameter {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+func (x *MachineType) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *MachineType) GetAudit() *AuditInfo {
+	if x != nil {
+		return x.Audit
+	}
+	return nil
+}
+
+// MachineParameterValue is the value of a template parameter on a machine
+type MachineParameterValue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ParameterCode string                 `protobuf:"bytes,1,opt,name=parameter_code,json=parameterCode,proto3" json:"parameter_code,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MachineParameterValue) Reset() {
+	*x = MachineParameterValue{}
+	mi := &file_costing_v1_machine_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MachineParameterValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MachineParameterValue) ProtoMessage() {}
+
+func (x *MachineParameterValue) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_machine_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MachineParameterValue.ProtoReflect.Descriptor instead.
+func (*MachineParameterValue) Descriptor() ([]byte, []int) {
+	return file_costing_v1_machine_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MachineParameterValue) GetParameterCode() string {
+	if x != nil {
+		return x.ParameterCode
+	}
+	return ""
+}
+
+func (x *MachineParameterValue) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// Machine represents a machine instance
+type Machine struct {
+	state           protoimpl.MessageState   `protogen:"open.v1"`
+	MachineCode     string                   `protobuf:"bytes,1,opt,name=machine_code,json=machineCode,proto3" json:"machine_code,omitempty"`
+	MachineName     string                   `protobuf:"bytes,2,opt,name=machine_name,json=machineName,proto3" json:"machine_name,omitempty"`
+	MachineTypeCode string                   `protobuf:"bytes,3,opt,name=machine_type_code,json=machineTypeCode,proto3" json:"machine_type_code,omitempty"`
+	Description     *string                  `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Values          []*MachineParameterValue `protobuf:"bytes,5,rep,name=values,proto3" json:"values,omitempty"`
+	IsActive        bool                     `protobuf:"varint,6,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	Audit           *AuditInfo               `protobuf:"bytes,7,opt,name=audit,proto3" json:"audit,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Machine) Reset() {
+	*x = Machine{}
+	mi := &file_costing_v1_machine_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Machine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Machine) ProtoMessage() {}
+
+func (x *Machine) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_machine_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Machine.ProtoReflect.Descriptor instead.
+func (*Machine) Descriptor() ([]byte, []int) {
+	return file_costing_v1_machine_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Machine) GetMachineCode() string {
+	if x != nil {
+		return x.MachineCode
+	}
+	return ""
+}
+
+func (x *Machine) GetMachineName() string {
+	if x != nil {
+		return x.MachineName
+	}
+	return ""
+}
+
+func (x *Machine) GetMachineTypeCode() string {
+	if x != nil {
+		return x.MachineTypeCode
+	}
+	return ""
+}
+
+func (x *Machine) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *Machine) GetValues() []*MachineParameterValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *Machine) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *Machine) GetAudit() *AuditInfo {
+	if x != nil {
+		return x.Audit
+	}
+	return nil
+}
+
+// CreateMachineType
+type CreateMachineTypeRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	MachineTypeCode string                 `protobuf:"bytes,1,opt,name=machine_type_code,json=machineTypeCode,proto3" json:"machine_type_code,omitempty"`
+	MachineTypeName string                 `protobuf:"bytes,2,opt,name=machine_type_name,json=machineTypeName,proto3" json:"machine_type_name,omitempty"`
+	Description     *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Parameters      []*TemplateParameter   `protobuf:"bytes,4,rep,name=parameters,proto3" json:"parameters,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateMachineTypeRequest) Reset() {
+	*x = CreateMachineTypeRequest{}
+	mi := &file_costing_v1_machine_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMachineTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMachineTypeRequest) ProtoMessage() {}
+
+func (x *CreateMachineTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_machine_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMachineTypeRequest.ProtoReflect.Descriptor instead.
+func (*CreateMachineTypeRequest) Descriptor() ([]byte, []int) {
+	return file_costing_v1_machine_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateMachineTypeRequest) GetMachineTypeCode() string {
+	if x != nil {
+		return x.MachineTypeCode
+	}
+	return ""
+}
+
+func (x *CreateMachineTypeRequest) GetMachineTypeName() string {
+	if x != nil {
+		return x.MachineTypeName
+	}
+	return ""
+}
+
+func (x *CreateMachineTypeRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *CreateMachineTypeRequest) GetParameters() []*TemplateParameter {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+type CreateMachineTypeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Data          *MachineType           `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMachineTypeResponse) Reset() {
+	*x = CreateMachineTypeResponse{}
+	mi := &file_costing_v1_machine_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMachineTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMachineTypeResponse) ProtoMessage() {}
+
+func (x *CreateMachineTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_machine_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMachineTypeResponse.ProtoReflect.Descriptor instead.
+func (*CreateMachineTypeResponse) Descriptor() ([]byte, []int) {
+	return file_costing_v1_machine_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateMachineTypeResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *CreateMachineTypeResponse) GetData() *MachineType {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// GetMachineType
+type GetMachineTypeRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	MachineTypeCode string                 `protobuf:"bytes,1,opt,name=machine_type_code,json=machineTypeCode,proto3" json:"machine_type_code,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetMachineTypeRequest) Reset() {
+	*x = GetMachineTypeRequest{}
+	mi := &file_costing_v1_machine_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMachineTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMachineTypeRequest) ProtoMessage() {}
+
+func (x *GetMachineTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_machine_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMachineTypeRequest.ProtoReflect.Descriptor instead.
+func (*GetMachineTypeRequest) Descriptor() ([]byte, []int) {
+	return file_costing_v1_machine_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetMachineTypeRequest) GetMachineTypeCode() string {
+	if x != nil {
+		return x.MachineTypeCode
+	}
+	return ""
+}
+
+type GetMachineTypeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Data          *MachineType           `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMachineTypeResponse) Reset() {
+	*x = GetMachineTypeResponse{}
+	mi := &file_costing_v1_machine_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMachineTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMachineTypeResponse) ProtoMessage() {}
+
+func (x *GetMachineTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_machine_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMachineTypeResponse.ProtoReflect.Descriptor instead.
+func (*GetMachineTypeResponse) Descriptor() ([]byte, []int) {
+	return file_costing_v1_machine_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetMachineTypeResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *GetMachineTypeResponse) GetData() *MachineType {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// ListMachineTypes
+type ListMachineTypesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	IsActive      *bool                  `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMachineTypesRequest) Reset() {
+	*x = ListMachineTypesRequest{}
+	mi := &file_costing_v1_machine_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMachineTypesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMachineTypesRequest) ProtoMessage() {}
+
+func (x *ListMachineTypesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_machine_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMachineTypesRequest.ProtoReflect.Descriptor instead.
+func (*ListMachineTypesRequest) Descriptor() ([]byte, []int) {
+	return file_costing_v1_machine_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListMachineTypesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListMachineTypesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMachineTypesRequest) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
+	}
+	return false
+}
+
+type ListMachineTypesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Data          []*MachineType         `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	Pagination    *PaginationMeta        `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMachineTypesResponse) Reset() {
+	*x = ListMachineTypesResponse{}
+	mi := &file_costing_v1_machine_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMachineTypesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMachineTypesResponse) ProtoMessage() {}
+
+func (x *ListMachineTypesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_machine_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMachineTypesResponse.ProtoReflect.Descriptor instead.
+func (*ListMachineTypesResponse) Descriptor() ([]byte, []int) {
+	return file_costing_v1_machine_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListMachineTypesResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListMachineTypesResponse) GetData() []*MachineType {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListMachineTypesResponse) GetPagination() *PaginationMeta {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// UpdateMachineType
+type UpdateMachineTypeRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	MachineTypeCode string                 `protobuf:"bytes,1,opt,name=machine_type_code,json=machineTypeCode,proto3" json:"machine_type_code,omitempty"`
+	MachineTypeName string                 `protobuf:"bytes,2,opt,name=machine_type_name,json=machineTypeName,proto3" json:"machine_type_name,omitempty"`
+	Description     *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Parameters      []*TemplateParameter   `protobuf:"bytes,4,rep,name=parameters,proto3" json:"parameters,omitempty"`
+	IsActive        bool                   `protobuf:"varint,5,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateMachineTypeRequest) Reset() {
+	*x = UpdateMachineTypeRequest{}
+	mi := &file_costing_v1_machine_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMachineTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMachineTypeRequest) ProtoMessage() {}
+
+func (x *UpdateMachineTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_machine_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMachineTypeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMachineTypeRequest) Descriptor() ([]byte, []int) {
+	return file_costing_v1_machine_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateMachineTypeRequest) GetMachineTypeCode() string {
+	if x != nil {
+		return x.MachineTypeCode
+	}
+	return ""
+}
+
+func (x *UpdateMachineTypeRequest) GetMachineTypeName() string {
+	if x != nil {
+		return x.MachineTypeName
+	}
+	return ""
+}
+
+func (x *UpdateMachineTypeRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateMachineTypeRequest) GetParameters() []*TemplateParameter {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+func (x *UpdateMachineTypeRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+type UpdateMachineTypeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Data          *MachineType           `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMachineTypeResponse) Reset() {
+	*x = UpdateMachineTypeResponse{}
+	mi := &file_costing_v1_machine_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMachineTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMachineTypeResponse) ProtoMessage() {}
+
+func (x *UpdateMachineTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_machine_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMachineTypeResponse.ProtoReflect.Descriptor instead.
+func (*UpdateMachineTypeResponse) Descriptor() ([]byte, []int) {
+	return file_costing_v1_machine_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdateMachineTypeResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *UpdateMachineTypeResponse) GetData() *MachineType {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// DeleteMachineType
+type DeleteMachineTypeRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	MachineTypeCode string                 `protobuf:"bytes,1,opt,name=machine_type_code,json=machineTypeCode,proto3" json:"machine_type_code,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DeleteMachineTypeRequest) Reset() {
+	*x = DeleteMachineTypeRequest{}
+	mi := &file_costing_v1_machine_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMachineTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMachineTypeRequest) ProtoMessage() {}
+
+func (x *DeleteMachineTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_machine_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMachineTypeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMachineTypeRequest) Descriptor() ([]byte, []int) {
+	return file_costing_v1_machine_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeleteMachineTypeRequest) GetMachineTypeCode() string {
+	if x != nil {
+		return x.MachineTypeCode
+	}
+	return ""
+}
+
+type DeleteMachineTypeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMachineTypeResponse) Reset() {
+	*x = DeleteMachineTypeResponse{}
+	mi := &file_costing_v1_machine_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMachineTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMachineTypeResponse) ProtoMessage() {}
+
+func (x *DeleteMachineTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_machine_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMachineTypeResponse.ProtoReflect.Descriptor instead.
+func (*DeleteMachineTypeResponse) Descriptor() ([]byte, []int) {
+	return file_costing_v1_machine_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeleteMachineTypeResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+// CreateMachine
+type CreateMachineRequest struct {
+	state           protoimpl.MessageState   `protogen:"open.v1"`
+	MachineCode     string                   `protobuf:"bytes,1,opt,name=machine_code,json=machineCode,proto3" json:"machine_code,omitempty"`
+	MachineName     string                   `protobuf:"bytes,2,opt,name=machine_name,json=machineName,proto3" json:"machine_name,omitempty"`
+	MachineTypeCode string                   `protobuf:"bytes,3,opt,name=machine_type_code,json=machineTypeCode,proto3" json:"machine_type_code,omitempty"`
+	Description     *string                  `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Values          []*MachineParameterValue `protobuf:"bytes,5,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateMachineRequest) Reset() {
+	*x = CreateMachineRequest{}
+	mi := &file_costing_v1_machine_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMachineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMachineRequest) ProtoMessage() {}
+
+func (x *CreateMachineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_machine_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMachineRequest.ProtoReflect.Descriptor instead.
+func (*CreateMachineRequest) Descriptor() ([]byte, []int) {
+	return file_costing_v1_machine_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CreateMachineRequest) GetMachineCode() string {
+	if x != nil {
+		return x.MachineCode
+	}
+	return ""
+}
+
+func (x *CreateMachineRequest) GetMachineName() string {
+	if x != nil {
+		return x.MachineName
+	}
+	return ""
+}
+
+func (x *CreateMachineRequest) GetMachineTypeCode() string {
+	if x != nil {
+		return x.MachineTypeCode
+	}
+	return ""
+}
+
+func (x *CreateMachineRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *CreateMachineRequest) GetValues() []*MachineParameterValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type CreateMachineResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Data          *Machine               `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMachineResponse) Reset() {
+	*x = CreateMachineResponse{}
+	mi := &file_costing_v1_machine_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMachineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMachineResponse) ProtoMessage() {}
+
+func (x *CreateMachineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_machine_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMachineResponse.ProtoReflect.Descriptor instead.
+func (*CreateMachineResponse) Descriptor() ([]byte, []int) {
+	return file_costing_v1_machine_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CreateMachineResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *CreateMachineResponse) GetData() *Machine {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// GetMachine
+type GetMachineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MachineCode   string                 `protobuf:"bytes,1,opt,name=machine_code,json=machineCode,proto3" json:"machine_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMachineRequest) Reset() {
+	*x = GetMachineRequest{}
+	mi := &file_costing_v1_machine_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMachineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMachineRequest) ProtoMessage() {}
+
+func (x *GetMachineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_machine_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMachineRequest.ProtoReflect.Descriptor instead.
+func (*GetMachineRequest) Descriptor() ([]byte, []int) {
+	return file_costing_v1_machine_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetMachineRequest) GetMachineCode() string {
+	if x != nil {
+		return x.MachineCode
+	}
+	return ""
+}
+
+type GetMachineResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Data          *Machine               `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMachineResponse) Reset() {
+	*x = GetMachineResponse{}
+	mi := &file_costing_v1_machine_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMachineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMachineResponse) ProtoMessage() {}
+
+func (x *GetMachineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_machine_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMachineResponse.ProtoReflect.Descriptor instead.
+func (*GetMachineResponse) Descriptor() ([]byte, []int) {
+	return file_costing_v1_machine_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetMachineResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *GetMachineResponse) GetData() *Machine {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// ListMachines
+type ListMachinesRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Page            int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize        int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	MachineTypeCode *string                `protobuf:"bytes,3,opt,name=machine_type_code,json=machineTypeCode,proto3,oneof" json:"machine_type_code,omitempty"`
+	IsActive        *bool                  `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListMachinesRequest) Reset() {
+	*x = ListMachinesRequest{}
+	mi := &file_costing_v1_machine_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMachinesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMachinesRequest) ProtoMessage() {}
+
+func (x *ListMachinesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_machine_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMachinesRequest.ProtoReflect.Descriptor instead.
+func (*ListMachinesRequest) Descriptor() ([]byte, []int) {
+	return file_costing_v1_machine_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListMachinesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListMachinesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMachinesRequest) GetMachineTypeCode() string {
+	if x != nil && x.MachineTypeCode != nil {
+		return *x.MachineTypeCode
+	}
+	return ""
+}
+
+func (x *ListMachinesRequest) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
+	}
+	return false
+}
+
+type ListMachinesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Data          []*Machine             `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	Pagination    *PaginationMeta        `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMachinesResponse) Reset() {
+	*x = ListMachinesResponse{}
+	mi := &file_costing_v1_machine_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMachinesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMachinesResponse) ProtoMessage() {}
+
+func (x *ListMachinesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_machine_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMachinesResponse.ProtoReflect.Descriptor instead.
+func (*ListMachinesResponse) Descriptor() ([]byte, []int) {
+	return file_costing_v1_machine_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListMachinesResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListMachinesResponse) GetData() []*Machine {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListMachinesResponse) GetPagination() *PaginationMeta {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// UpdateMachine
+type UpdateMachineRequest struct {
+	state           protoimpl.MessageState   `protogen:"open.v1"`
+	MachineCode     string                   `protobuf:"bytes,1,opt,name=machine_code,json=machineCode,proto3" json:"machine_code,omitempty"`
+	MachineName     string                   `protobuf:"bytes,2,opt,name=machine_name,json=machineName,proto3" json:"machine_name,omitempty"`
+	MachineTypeCode string                   `protobuf:"bytes,3,opt,name=machine_type_code,json=machineTypeCode,proto3" json:"machine_type_code,omitempty"`
+	Description     *string                  `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Values          []*MachineParameterValue `protobuf:"bytes,5,rep,name=values,proto3" json:"values,omitempty"`
+	IsActive        bool                     `protobuf:"varint,6,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateMachineRequest) Reset() {
+	*x = UpdateMachineRequest{}
+	mi := &file_costing_v1_machine_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMachineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMachineRequest) ProtoMessage() {}
+
+func (x *UpdateMachineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_machine_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMachineRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMachineRequest) Descriptor() ([]byte, []int) {
+	return file_costing_v1_machine_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UpdateMachineRequest) GetMachineCode() string {
+	if x != nil {
+		return x.MachineCode
+	}
+	return ""
+}
+
+func (x *UpdateMachineRequest) GetMachineName() string {
+	if x != nil {
+		return x.MachineName
+	}
+	return ""
+}
+
+func (x *UpdateMachineRequest) GetMachineTypeCode() string {
+	if x != nil {
+		return x.MachineTypeCode
+	}
+	return ""
+}
+
+func (x *UpdateMachineRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateMachineRequest) GetValues() []*MachineParameterValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *UpdateMachineRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+type UpdateMachineResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Data          *Machine               `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMachineResponse) Reset() {
+	*x = UpdateMachineResponse{}
+	mi := &file_costing_v1_machine_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMachineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMachineResponse) ProtoMessage() {}
+
+func (x *UpdateMachineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_machine_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMachineResponse.ProtoReflect.Descriptor instead.
+func (*UpdateMachineResponse) Descriptor() ([]byte, []int) {
+	return file_costing_v1_machine_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UpdateMachineResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *UpdateMachineResponse) GetData() *Machine {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// DeleteMachine
+type DeleteMachineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MachineCode   string                 `protobuf:"bytes,1,opt,name=machine_code,json=machineCode,proto3" json:"machine_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMachineRequest) Reset() {
+	*x = DeleteMachineRequest{}
+	mi := &file_costing_v1_machine_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMachineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMachineRequest) ProtoMessage() {}
+
+func (x *DeleteMachineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_machine_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMachineRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMachineRequest) Descriptor() ([]byte, []int) {
+	return file_costing_v1_machine_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DeleteMachineRequest) GetMachineCode() string {
+	if x != nil {
+		return x.MachineCode
+	}
+	return ""
+}
+
+type DeleteMachineResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMachineResponse) Reset() {
+	*x = DeleteMachineResponse{}
+	mi := &file_costing_v1_machine_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMachineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMachineResponse) ProtoMessage() {}
+
+func (x *DeleteMachineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_machine_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMachineResponse.ProtoReflect.Descriptor instead.
+func (*DeleteMachineResponse) Descriptor() ([]byte, []int) {
+	return file_costing_v1_machine_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *DeleteMachineResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+var File_costing_v1_machine_proto protoreflect.FileDescriptor
+
+const file_costing_v1_machine_proto_rawDesc = "" +
+	"\n" +
+	"\x18costing/v1/machine.proto\x12\n" +
+	"costing.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17costing/v1/common.proto\"h\n" +
+	"\x11TemplateParameter\x120\n" +
+	"\x0eparameter_code\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x182R\rparameterCode\x12!\n" +
+	"\fis_mandatory\x18\x02 \x01(\bR\visMandatory\"\xa5\x02\n" +
+	"\vMachineType\x12*\n" +
+	"\x11machine_type_code\x18\x01 \x01(\tR\x0fmachineTypeCode\x12*\n" +
+	"\x11machine_type_name\x18\x02 \x01(\tR\x0fmachineTypeName\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x00R\vdescription\x88\x01\x01\x12=\n" +
+	"\n" +
+	"parameters\x18\x04 \x03(\v2\x1d.costing.v1.TemplateParameterR\n" +
+	"parameters\x12\x1b\n" +
+	"\tis_active\x18\x05 \x01(\bR\bisActive\x12+\n" +
+	"\x05audit\x18\x06 \x01(\v2\x15.costing.v1.AuditInfoR\x05auditB\x0e\n" +
+	"\f_description\"i\n" +
+	"\x15MachineParameterValue\x120\n" +
+	"\x0eparameter_code\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x182R\rparameterCode\x12\x1e\n" +
+	"\x05value\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\xe8\aR\x05value\"\xb7\x02\n" +
+	"\aMachine\x12!\n" +
+	"\fmachine_code\x18\x01 \x01(\tR\vmachineCode\x12!\n" +
+	"\fmachine_name\x18\x02 \x01(\tR\vmachineName\x12*\n" +
+	"\x11machine_type_code\x18\x03 \x01(\tR\x0fmachineTypeCode\x12%\n" +
+	"\vdescription\x18\x04 \x01(\tH\x00R\vdescription\x88\x01\x01\x129\n" +
+	"\x06values\x18\x05 \x03(\v2!.costing.v1.MachineParameterValueR\x06values\x12\x1b\n" +
+	"\tis_active\x18\x06 \x01(\bR\bisActive\x12+\n" +
+	"\x05audit\x18\a \x01(\v2\x15.costing.v1.AuditInfoR\x05auditB\x0e\n" +
+	"\f_description\"\xa6\x02\n" +
+	"\x18CreateMachineTypeRequest\x12H\n" +
+	"\x11machine_type_code\x18\x01 \x01(\tB\x1c\xbaH\x19r\x17\x10\x01\x18\x1e2\x11^[A-Z][A-Z0-9_]*$R\x0fmachineTypeCode\x126\n" +
+	"\x11machine_type_name\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xc8\x01R\x0fmachineTypeName\x12/\n" +
+	"\vdescription\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\xe8\aH\x00R\vdescription\x88\x01\x01\x12G\n" +
+	"\n" +
+	"parameters\x18\x04 \x03(\v2\x1d.costing.v1.TemplateParameterB\b\xbaH\x05\x92\x01\x02\x10dR\n" +
+	"parametersB\x0e\n" +
+	"\f_description\"v\n" +
+	"\x19CreateMachineTypeResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.costing.v1.BaseResponseR\x04base\x12+\n" +
+	"\x04data\x18\x02 \x01(\v2\x17.costing.v1.MachineTypeR\x04data\"N\n" +
+	"\x15GetMachineTypeRequest\x125\n" +
+	"\x11machine_type_code\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18\x1eR\x0fmachineTypeCode\"s\n" +
+	"\x16GetMachineTypeResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.costing.v1.BaseResponseR\x04base\x12+\n" +
+	"\x04data\x18\x02 \x01(\v2\x17.costing.v1.MachineTypeR\x04data\"\x8e\x01\n" +
+	"\x17ListMachineTypesRequest\x12\x1b\n" +
+	"\x04page\x18\x01 \x01(\x05B\a\xbaH\x04\x1a\x02(\x01R\x04page\x12&\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x01R\bpageSize\x12 \n" +
+	"\tis_active\x18\x03 \x01(\bH\x00R\bisActive\x88\x01\x01B\f\n" +
+	"\n" +
+	"_is_active\"\xb1\x01\n" +
+	"\x18ListMachineTypesResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.costing.v1.BaseResponseR\x04base\x12+\n" +
+	"\x04data\x18\x02 \x03(\v2\x17.costing.v1.MachineTypeR\x04data\x12:\n" +
+	"\n" +
+	"pagination\x18\x03 \x01(\v2\x1a.costing.v1.PaginationMetaR\n" +
+	"pagination\"\xb0\x02\n" +
+	"\x18UpdateMachineTypeRequest\x125\n" +
+	"\x11machine_type_code\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18\x1eR\x0fmachineTypeCode\x126\n" +
+	"\x11machine_type_name\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xc8\x01R\x0fmachineTypeName\x12/\n" +
+	"\vdescription\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\xe8\aH\x00R\vdescription\x88\x01\x01\x12G\n" +
+	"\n" +
+	"parameters\x18\x04 \x03(\v2\x1d.costing.v1.TemplateParameterB\b\xbaH\x05\x92\x01\x02\x10dR\n" +
+	"parameters\x12\x1b\n" +
+	"\tis_active\x18\x05 \x01(\bR\bisActiveB\x0e\n" +
+	"\f_description\"v\n" +
+	"\x19UpdateMachineTypeResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.costing.v1.BaseResponseR\x04base\x12+\n" +
+	"\x04data\x18\x02 \x01(\v2\x17.costing.v1.MachineTypeR\x04data\"Q\n" +
+	"\x18DeleteMachineTypeRequest\x125\n" +
+	"\x11machine_type_code\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18\x1eR\x0fmachineTypeCode\"I\n" +
+	"\x19DeleteMachineTypeResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.costing.v1.BaseResponseR\x04base\"\xc7\x02\n" +
+	"\x14CreateMachineRequest\x12C\n" +
+	"\fmachine_code\x18\x01 \x01(\tB \xbaH\x1dr\x1b\x10\x01\x1822\x15^[A-Z0-9][A-Z0-9_-]*$R\vmachineCode\x12-\n" +
+	"\fmachine_name\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xc8\x01R\vmachineName\x125\n" +
+	"\x11machine_type_code\x18\x03 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18\x1eR\x0fmachineTypeCode\x12/\n" +
+	"\vdescription\x18\x04 \x01(\tB\b\xbaH\x05r\x03\x18\xe8\aH\x00R\vdescription\x88\x01\x01\x12C\n" +
+	"\x06values\x18\x05 \x03(\v2!.costing.v1.MachineParameterValueB\b\xbaH\x05\x92\x01\x02\x10dR\x06valuesB\x0e\n" +
+	"\f_description\"n\n" +
+	"\x15CreateMachineResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.costing.v1.BaseResponseR\x04base\x12'\n" +
+	"\x04data\x18\x02 \x01(\v2\x13.costing.v1.MachineR\x04data\"A\n" +
+	"\x11GetMachineRequest\x12,\n" +
+	"\fmachine_code\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x182R\vmachineCode\"k\n" +
+	"\x12GetMachineResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.costing.v1.BaseResponseR\x04base\x12'\n" +
+	"\x04data\x18\x02 \x01(\v2\x13.costing.v1.MachineR\x04data\"\xda\x01\n" +
+	"\x13ListMachinesRequest\x12\x1b\n" +
+	"\x04page\x18\x01 \x01(\x05B\a\xbaH\x04\x1a\x02(\x01R\x04page\x12&\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x01R\bpageSize\x128\n" +
+	"\x11machine_type_code\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x18\x1eH\x00R\x0fmachineTypeCode\x88\x01\x01\x12 \n" +
+	"\tis_active\x18\x04 \x01(\bH\x01R\bisActive\x88\x01\x01B\x14\n" +
+	"\x12_machine_type_codeB\f\n" +
+	"\n" +
+	"_is_active\"\xa9\x01\n" +
+	"\x14ListMachinesResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.costing.v1.BaseResponseR\x04base\x12'\n" +
+	"\x04data\x18\x02 \x03(\v2\x13.costing.v1.MachineR\x04data\x12:\n" +
+	"\n" +
+	"pagination\x18\x03 \x01(\v2\x1a.costing.v1.PaginationMetaR\n" +
+	"pagination\"\xcd\x02\n" +
+	"\x14UpdateMachineRequest\x12,\n" +
+	"\fmachine_code\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x182R\vmachineCode\x12-\n" +
+	"\fmachine_name\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xc8\x01R\vmachineName\x125\n" +
+	"\x11machine_type_code\x18\x03 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18\x1eR\x0fmachineTypeCode\x12/\n" +
+	"\vdescription\x18\x04 \x01(\tB\b\xbaH\x05r\x03\x18\xe8\aH\x00R\vdescription\x88\x01\x01\x12C\n" +
+	"\x06values\x18\x05 \x03(\v2!.costing.v1.MachineParameterValueB\b\xbaH\x05\x92\x01\x02\x10dR\x06values\x12\x1b\n" +
+	"\tis_active\x18\x06 \x01(\bR\bisActiveB\x0e\n" +
+	"\f_description\"n\n" +
+	"\x15UpdateMachineResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.costing.v1.BaseResponseR\x04base\x12'\n" +
+	"\x04data\x18\x02 \x01(\v2\x13.costing.v1.MachineR\x04data\"D\n" +
+	"\x14DeleteMachineRequest\x12,\n" +
+	"\fmachine_code\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x182R\vmachineCode\"E\n" +
+	"\x15DeleteMachineResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.costing.v1.BaseResponseR\x04base2\xfd\t\n" +
+	"\x0eMachineService\x12~\n" +
+	"\x11CreateMachineType\x12$.costing.v1.CreateMachineTypeRequest\x1a%.costing.v1.CreateMachineTypeResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/machine-types\x12\x86\x01\n" +
+	"\x0eGetMachineType\x12!.costing.v1.GetMachineTypeRequest\x1a\".costing.v1.GetMachineTypeResponse\"-\x82\xd3\xe4\x93\x02'\x12%/v1/machine-types/{machine_type_code}\x12x\n" +
+	"\x10ListMachineTypes\x12#.costing.v1.ListMachineTypesRequest\x1a$.costing.v1.ListMachineTypesResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/machine-types\x12\x92\x01\n" +
+	"\x11UpdateMachineType\x12$.costing.v1.UpdateMachineTypeRequest\x1a%.costing.v1.UpdateMachineTypeResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\x1a%/v1/machine-types/{machine_type_code}\x12\x8f\x01\n" +
+	"\x11DeleteMachineType\x12$.costing.v1.DeleteMachineTypeRequest\x1a%.costing.v1.DeleteMachineTypeResponse\"-\x82\xd3\xe4\x93\x02'*%/v1/machine-types/{machine_type_code}\x12m\n" +
+	"\rCreateMachine\x12 .costing.v1.CreateMachineRequest\x1a!.costing.v1.CreateMachineResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/machines\x12p\n" +
+	"\n" +
+	"GetMachine\x12\x1d.costing.v1.GetMachineRequest\x1a\x1e.costing.v1.GetMachineResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/machines/{machine_code}\x12g\n" +
+	"\fListMachines\x12\x1f.costing.v1.ListMachinesRequest\x1a .costing.v1.ListMachinesResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/machines\x12|\n" +
+	"\rUpdateMachine\x12 .costing.v1.UpdateMachineRequest\x1a!.costing.v1.UpdateMachineResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\x1a\x1b/v1/machines/{machine_code}\x12y\n" +
+	"\rDeleteMachine\x12 .costing.v1.DeleteMachineRequest\x1a!.costing.v1.DeleteMachineResponse\"#\x82\xd3\xe4\x93\x02\x1d*\x1b/v1/machines/{machine_code}B\xaf\x01\n" +
+	"\x0ecom.costing.v1B\fMachineProtoP\x01ZFgithub.com/homindolenern/goapps-costing-v1/gen/go/costing/v1;costingv1\xa2\x02\x03CXX\xaa\x02\n" +
+	"Costing.V1\xca\x02\n" +
+	"Costing\\V1\xe2\x02\x16Costing\\V1\\GPBMetadata\xea\x02\vCosting::V1b\x06proto3"
+
+var (
+	file_costing_v1_machine_proto_rawDescOnce sync.Once
+	file_costing_v1_machine_proto_rawDescData []byte
+)
+
+func file_costing_v1_machine_proto_rawDescGZIP() []byte {
+	file_costing_v1_machine_proto_rawDescOnce.Do(func() {
+		file_costing_v1_machine_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_costing_v1_machine_proto_rawDesc), len(file_costing_v1_machine_proto_rawDesc)))
+	})
+	return file_costing_v1_machine_proto_rawDescData
+}
+
+var file_costing_v1_machine_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_costing_v1_machine_proto_goTypes = []any{
+	(*TemplateParameter)(nil),         // 0: costing.v1.TemplateParameter
+	(*MachineType)(nil),               // 1: costing.v1.MachineType
+	(*MachineParameterValue)(nil),     // 2: costing.v1.MachineParameterValue
+	(*Machine)(nil),                   // 3: costing.v1.Machine
+	(*CreateMachineTypeRequest)(nil),  // 4: costing.v1.CreateMachineTypeRequest
+	(*CreateMachineTypeResponse)(nil), // 5: costing.v1.CreateMachineTypeResponse
+	(*GetMachineTypeRequest)(nil),     // 6: costing.v1.GetMachineTypeRequest
+	(*GetMachineTypeResponse)(nil),    // 7: costing.v1.GetMachineTypeResponse
+	(*ListMachineTypesRequest)(nil),   // 8: costing.v1.ListMachineTypesRequest
+	(*ListMachineTypesResponse)(nil),  // 9: costing.v1.ListMachineTypesResponse
+	(*UpdateMachineTypeRequest)(nil),  // 10: costing.v1.UpdateMachineTypeRequest
+	(*UpdateMachineTypeResponse)(nil), // 11: costing.v1.UpdateMachineTypeResponse
+	(*DeleteMachineTypeRequest)(nil),  // 12: costing.v1.DeleteMachineTypeRequest
+	(*DeleteMachineTypeResponse)(nil), // 13: costing.v1.DeleteMachineTypeResponse
+	(*CreateMachineRequest)(nil),      // 14: costing.v1.CreateMachineRequest
+	(*CreateMachineResponse)(nil),     // 15: costing.v1.CreateMachineResponse
+	(*GetMachineRequest)(nil),         // 16: costing.v1.GetMachineRequest
+	(*GetMachineResponse)(nil),        // 17: costing.v1.GetMachineResponse
+	(*ListMachinesRequest)(nil),       // 18: costing.v1.ListMachinesRequest
+	(*ListMachinesResponse)(nil),      // 19: costing.v1.ListMachinesResponse
+	(*UpdateMachineRequest)(nil),      // 20: costing.v1.UpdateMachineRequest
+	(*UpdateMachineResponse)(nil),     // 21: costing.v1.UpdateMachineResponse
+	(*DeleteMachineRequest)(nil),      // 22: costing.v1.DeleteMachineRequest
+	(*DeleteMachineResponse)(nil),     // 23: costing.v1.DeleteMachineResponse
+	(*AuditInfo)(nil),                 // 24: costing.v1.AuditInfo
+	(*BaseResponse)(nil),              // 25: costing.v1.BaseResponse
+	(*PaginationMeta)(nil),            // 26: costing.v1.PaginationMeta
+}
+var file_costing_v1_machine_proto_depIdxs = []int32{
+	0,  // 0: costing.v1.MachineType.parameters:type_name -> costing.v1.TemplateParameter
+	24, // 1: costing.v1.MachineType.audit:type_name -> costing.v1.AuditInfo
+	2,  // 2: costing.v1.Machine.values:type_name -> costing.v1.MachineParameterValue
+	24, // 3: costing.v1.Machine.audit:type_name -> costing.v1.AuditInfo
+	0,  // 4: costing.v1.CreateMachineTypeRequest.parameters:type_name -> costing.v1.TemplateParameter
+	25, // 5: costing.v1.CreateMachineTypeResponse.base:type_name -> costing.v1.BaseResponse
+	1,  // 6: costing.v1.CreateMachineTypeResponse.data:type_name -> costing.v1.MachineType
+	25, // 7: costing.v1.GetMachineTypeResponse.base:type_name -> costing.v1.BaseResponse
+	1,  // 8: costing.v1.GetMachineTypeResponse.data:type_name -> costing.v1.MachineType
+	25, // 9: costing.v1.ListMachineTypesResponse.base:type_name -> costing.v1.BaseResponse
+	1,  // 10: costing.v1.ListMachineTypesResponse.data:type_name -> costing.v1.MachineType
+	26, // 11: costing.v1.ListMachineTypesResponse.pagination:type_name -> costing.v1.PaginationMeta
+	0,  // 12: costing.v1.UpdateMachineTypeRequest.parameters:type_name -> costing.v1.TemplateParameter
+	25, // 13: costing.v1.UpdateMachineTypeResponse.base:type_name -> costing.v1.BaseResponse
+	1,  // 14: costing.v1.UpdateMachineTypeResponse.data:type_name -> costing.v1.MachineType
+	25, // 15: costing.v1.DeleteMachineTypeResponse.base:type_name -> costing.v1.BaseResponse
+	2,  // 16: costing.v1.CreateMachineRequest.values:type_name -> costing.v1.MachineParameterValue
+	25, // 17: costing.v1.CreateMachineResponse.base:type_name -> costing.v1.BaseResponse
+	3,  // 18: costing.v1.CreateMachineResponse.data:type_name -> costing.v1.Machine
+	25, // 19: costing.v1.GetMachineResponse.base:type_name -> costing.v1.BaseResponse
+	3,  // 20: costing.v1.GetMachineResponse.data:type_name -> costing.v1.Machine
+	25, // 21: costing.v1.ListMachinesResponse.base:type_name -> costing.v1.BaseResponse
+	3,  // 22: costing.v1.ListMachinesResponse.data:type_name -> costing.v1.Machine
+	26, // 23: costing.v1.ListMachinesResponse.pagination:type_name -> costing.v1.PaginationMeta
+	2,  // 24: costing.v1.UpdateMachineRequest.values:type_name -> costing.v1.MachineParameterValue
+	25, // 25: costing.v1.UpdateMachineResponse.base:type_name -> costing.v1.BaseResponse
+	3,  // 26: costing.v1.UpdateMachineResponse.data:type_name -> costing.v1.Machine
+	25, // 27: costing.v1.DeleteMachineResponse.base:type_name -> costing.v1.BaseResponse
+	4,  // 28: costing.v1.MachineService.CreateMachineType:input_type -> costing.v1.CreateMachineTypeRequest
+	6,  // 29: costing.v1.MachineService.GetMachineType:input_type -> costing.v1.GetMachineTypeRequest
+	8,  // 30: costing.v1.MachineService.ListMachineTypes:input_type -> costing.v1.ListMachineTypesRequest
+	10, // 31: costing.v1.MachineService.UpdateMachineType:input_type -> costing.v1.UpdateMachineTypeRequest
+	12, // 32: costing.v1.MachineService.DeleteMachineType:input_type -> costing.v1.DeleteMachineTypeRequest
+	14, // 33: costing.v1.MachineService.CreateMachine:input_type -> costing.v1.CreateMachineRequest
+	16, // 34: costing.v1.MachineService.GetMachine:input_type -> costing.v1.GetMachineRequest
+	18, // 35: costing.v1.MachineService.ListMachines:input_type -> costing.v1.ListMachinesRequest
+	20, // 36: costing.v1.MachineService.UpdateMachine:input_type -> costing.v1.UpdateMachineRequest
+	22, // 37: costing.v1.MachineService.DeleteMachine:input_type -> costing.v1.DeleteMachineRequest
+	5,  // 38: costing.v1.MachineService.CreateMachineType:output_type -> costing.v1.CreateMachineTypeResponse
+	7,  // 39: costing.v1.MachineService.GetMachineType:output_type -> costing.v1.GetMachineTypeResponse
+	9,  // 40: costing.v1.MachineService.ListMachineTypes:output_type -> costing.v1.ListMachineTypesResponse
+	11, // 41: costing.v1.MachineService.UpdateMachineType:output_type -> costing.v1.UpdateMachineTypeResponse
+	13, // 42: costing.v1.MachineService.DeleteMachineType:output_type -> costing.v1.DeleteMachineTypeResponse
+	15, // 43: costing.v1.MachineService.CreateMachine:output_type -> costing.v1.CreateMachineResponse
+	17, // 44: costing.v1.MachineService.GetMachine:output_type -> costing.v1.GetMachineResponse
+	19, // 45: costing.v1.MachineService.ListMachines:output_type -> costing.v1.ListMachinesResponse
+	21, // 46: costing.v1.MachineService.UpdateMachine:output_type -> costing.v1.UpdateMachineResponse
+	23, // 47: costing.v1.MachineService.DeleteMachine:output_type -> costing.v1.DeleteMachineResponse
+	38, // [38:48] is the sub-list for method output_type
+	28, // [28:38] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
+}
+
+func init() { file_costing_v1_machine_proto_init() }
+func file_costing_v1_machine_proto_init() {
+	if File_costing_v1_machine_proto != nil {
+		return
+	}
+	file_costing_v1_common_proto_init()
+	file_costing_v1_machine_proto_msgTypes[1].OneofWrappers = []any{}
+	file_costing_v1_machine_proto_msgTypes[3].OneofWrappers = []any{}
+	file_costing_v1_machine_proto_msgTypes[4].OneofWrappers = []any{}
+	file_costing_v1_machine_proto_msgTypes[8].OneofWrappers = []any{}
+	file_costing_v1_machine_proto_msgTypes[10].OneofWrappers = []any{}
+	file_costing_v1_machine_proto_msgTypes[14].OneofWrappers = []any{}
+	file_costing_v1_machine_proto_msgTypes[18].OneofWrappers = []any{}
+	file_costing_v1_machine_proto_msgTypes[20].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_costing_v1_machine_proto_rawDesc), len(file_costing_v1_machine_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   24,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_costing_v1_machine_proto_goTypes,
+		DependencyIndexes: file_costing_v1_machine_proto_depIdxs,
+		MessageInfos:      file_costing_v1_machine_proto_msgTypes,
+	}.Build()
+	File_costing_v1_machine_proto = out.File
+	file_costing_v1_machine_proto_goTypes = nil
+	file_costing_v1_machine_proto_depIdxs = nil
+}
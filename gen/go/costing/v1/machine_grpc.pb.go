@@ -0,0 +1,487 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             (unknown)
+// source: costing/v1/machine.proto
+
+package costingv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file.
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	MachineService_CreateMachineType_FullMethodName = "/costing.v1.MachineService/CreateMachineType"
+	MachineService_GetMachineType_FullMethodName    = "/costing.v1.MachineService/GetMachineType"
+	MachineService_ListMachineTypes_FullMethodName  = "/costing.v1.MachineService/ListMachineTypes"
+	MachineService_UpdateMachineType_FullMethodName = "/costing.v1.MachineService/UpdateMachineType"
+	MachineService_DeleteMachineType_FullMethodName = "/costing.v1.MachineService/DeleteMachineType"
+	MachineService_CreateMachine_FullMethodName     = "/costing.v1.MachineService/CreateMachine"
+	MachineService_GetMachine_FullMethodName        = "/costing.v1.MachineService/GetMachine"
+	MachineService_ListMachines_FullMethodName      = "/costing.v1.MachineService/ListMachines"
+	MachineService_UpdateMachine_FullMethodName     = "/costing.v1.MachineService/UpdateMachine"
+	MachineService_DeleteMachine_FullMethodName     = "/costing.v1.MachineService/DeleteMachine"
+)
+
+// MachineServiceClient is the client API for MachineService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// MachineService provides CRUD operations for machine types and machines.
+type MachineServiceClient interface {
+	// CreateMachineType creates a new machine type with its parameter template
+	CreateMachineType(ctx context.Context, in *CreateMachineTypeRequest, opts ...grpc.CallOption) (*CreateMachineTypeResponse, error)
+	// GetMachineType retrieves a machine type by code
+	GetMachineType(ctx context.Context, in *GetMachineTypeRequest, opts ...grpc.CallOption) (*GetMachineTypeResponse, error)
+	// ListMachineTypes retrieves a paginated list of machine types
+	ListMachineTypes(ctx context.Context, in *ListMachineTypesRequest, opts ...grpc.CallOption) (*ListMachineTypesResponse, error)
+	// UpdateMachineType updates a machine type and replaces its parameter template
+	UpdateMachineType(ctx context.Context, in *UpdateMachineTypeRequest, opts ...grpc.CallOption) (*UpdateMachineTypeResponse, error)
+	// DeleteMachineType deletes a machine type without machines
+	DeleteMachineType(ctx context.Context, in *DeleteMachineTypeRequest, opts ...grpc.CallOption) (*DeleteMachineTypeResponse, error)
+	// CreateMachine creates a new machine with its parameter values
+	CreateMachine(ctx context.Context, in *CreateMachineRequest, opts ...grpc.CallOption) (*CreateMachineResponse, error)
+	// GetMachine retrieves a machine by code
+	GetMachine(ctx context.Context, in *GetMachineRequest, opts ...grpc.CallOption) (*GetMachineResponse, error)
+	// ListMachines retrieves a paginated list of machines
+	ListMachines(ctx context.Context, in *ListMachinesRequest, opts ...grpc.CallOption) (*ListMachinesResponse, error)
+	// UpdateMachine updates a machine and replaces its parameter values
+	UpdateMachine(ctx context.Context, in *UpdateMachineRequest, opts ...grpc.CallOption) (*UpdateMachineResponse, error)
+	// DeleteMachine deletes a machine and its parameter values
+	DeleteMachine(ctx context.Context, in *DeleteMachineRequest, opts ...grpc.CallOption) (*DeleteMachineResponse, error)
+}
+
+type machineServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMachineServiceClient(cc grpc.ClientConnInterface) MachineServiceClient {
+	return &machineServiceClient{cc}
+}
+
+func (c *machineServiceClient) CreateMachineType(ctx context.Context, in *CreateMachineTypeRequest, opts ...grpc.CallOption) (*CreateMachineTypeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateMachineTypeResponse)
+	err := c.cc.Invoke(ctx, MachineService_CreateMachineType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) GetMachineType(ctx context.Context, in *GetMachineTypeRequest, opts ...grpc.CallOption) (*GetMachineTypeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMachineTypeResponse)
+	err := c.cc.Invoke(ctx, MachineService_GetMachineType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) ListMachineTypes(ctx context.Context, in *ListMachineTypesRequest, opts ...grpc.CallOption) (*ListMachineTypesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMachineTypesResponse)
+	err := c.cc.Invoke(ctx, MachineService_ListMachineTypes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) UpdateMachineType(ctx context.Context, in *UpdateMachineTypeRequest, opts ...grpc.CallOption) (*UpdateMachineTypeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateMachineTypeResponse)
+	err := c.cc.Invoke(ctx, MachineService_UpdateMachineType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) DeleteMachineType(ctx context.Context, in *DeleteMachineTypeRequest, opts ...grpc.CallOption) (*DeleteMachineTypeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteMachineTypeResponse)
+	err := c.cc.Invoke(ctx, MachineService_DeleteMachineType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) CreateMachine(ctx context.Context, in *CreateMachineRequest, opts ...grpc.CallOption) (*CreateMachineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateMachineResponse)
+	err := c.cc.Invoke(ctx, MachineService_CreateMachine_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) GetMachine(ctx context.Context, in *GetMachineRequest, opts ...grpc.CallOption) (*GetMachineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMachineResponse)
+	err := c.cc.Invoke(ctx, MachineService_GetMachine_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) ListMachines(ctx context.Context, in *ListMachinesRequest, opts ...grpc.CallOption) (*ListMachinesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMachinesResponse)
+	err := c.cc.Invoke(ctx, MachineService_ListMachines_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) UpdateMachine(ctx context.Context, in *UpdateMachineRequest, opts ...grpc.CallOption) (*UpdateMachineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateMachineResponse)
+	err := c.cc.Invoke(ctx, MachineService_UpdateMachine_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) DeleteMachine(ctx context.Context, in *DeleteMachineRequest, opts ...grpc.CallOption) (*DeleteMachineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteMachineResponse)
+	err := c.cc.Invoke(ctx, MachineService_DeleteMachine_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MachineServiceServer is the server API for MachineService service.
+// All implementations must embed UnimplementedMachineServiceServer.
+// for forward compatibility.
+//
+// MachineService provides CRUD operations for machine types and machines.
+type MachineServiceServer interface {
+	// CreateMachineType creates a new machine type with its parameter template
+	CreateMachineType(context.Context, *CreateMachineTypeRequest) (*CreateMachineTypeResponse, error)
+	// GetMachineType retrieves a machine type by code
+	GetMachineType(context.Context, *GetMachineTypeRequest) (*GetMachineTypeResponse, error)
+	// ListMachineTypes retrieves a paginated list of machine types
+	ListMachineTypes(context.Context, *ListMachineTypesRequest) (*ListMachineTypesResponse, error)
+	// UpdateMachineType updates a machine type and replaces its parameter template
+	UpdateMachineType(context.Context, *UpdateMachineTypeRequest) (*UpdateMachineTypeResponse, error)
+	// DeleteMachineType deletes a machine type without machines
+	DeleteMachineType(context.Context, *DeleteMachineTypeRequest) (*DeleteMachineTypeResponse, error)
+	// CreateMachine creates a new machine with its parameter values
+	CreateMachine(context.Context, *CreateMachineRequest) (*CreateMachineResponse, error)
+	// GetMachine retrieves a machine by code
+	GetMachine(context.Context, *GetMachineRequest) (*GetMachineResponse, error)
+	// ListMachines retrieves a paginated list of machines
+	ListMachines(context.Context, *ListMachinesRequest) (*ListMachinesResponse, error)
+	// UpdateMachine updates a machine and replaces its parameter values
+	UpdateMachine(context.Context, *UpdateMachineRequest) (*UpdateMachineResponse, error)
+	// DeleteMachine deletes a machine and its parameter values
+	DeleteMachine(context.Context, *DeleteMachineRequest) (*DeleteMachineResponse, error)
+	mustEmbedUnimplementedMachineServiceServer()
+}
+
+// UnimplementedMachineServiceServer must be embedded to have.
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMachineServiceServer struct{}
+
+func (UnimplementedMachineServiceServer) CreateMachineType(context.Context, *CreateMachineTypeRequest) (*CreateMachineTypeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateMachineType not implemented")
+}
+func (UnimplementedMachineServiceServer) GetMachineType(context.Context, *GetMachineTypeRequest) (*GetMachineTypeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMachineType not implemented")
+}
+func (UnimplementedMachineServiceServer) ListMachineTypes(context.Context, *ListMachineTypesRequest) (*ListMachineTypesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMachineTypes not implemented")
+}
+func (UnimplementedMachineServiceServer) UpdateMachineType(context.Context, *UpdateMachineTypeRequest) (*UpdateMachineTypeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateMachineType not implemented")
+}
+func (UnimplementedMachineServiceServer) DeleteMachineType(context.Context, *DeleteMachineTypeRequest) (*DeleteMachineTypeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteMachineType not implemented")
+}
+func (UnimplementedMachineServiceServer) CreateMachine(context.Context, *CreateMachineRequest) (*CreateMachineResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateMachine not implemented")
+}
+func (UnimplementedMachineServiceServer) GetMachine(context.Context, *GetMachineRequest) (*GetMachineResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMachine not implemented")
+}
+func (UnimplementedMachineServiceServer) ListMachines(context.Context, *ListMachinesRequest) (*ListMachinesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMachines not implemented")
+}
+func (UnimplementedMachineServiceServer) UpdateMachine(context.Context, *UpdateMachineRequest) (*UpdateMachineResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateMachine not implemented")
+}
+func (UnimplementedMachineServiceServer) DeleteMachine(context.Context, *DeleteMachineRequest) (*DeleteMachineResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteMachine not implemented")
+}
+func (UnimplementedMachineServiceServer) mustEmbedUnimplementedMachineServiceServer() {}
+func (UnimplementedMachineServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeMachineServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MachineServiceServer will.
+// result in compilation errors.
+type UnsafeMachineServiceServer interface {
+	mustEmbedUnimplementedMachineServiceServer()
+}
+
+func RegisterMachineServiceServer(s grpc.ServiceRegistrar, srv MachineServiceServer) {
+	// If the following call panics, it indicates UnimplementedMachineServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MachineService_ServiceDesc, srv)
+}
+
+func _MachineService_CreateMachineType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMachineTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).CreateMachineType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_CreateMachineType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).CreateMachineType(ctx, req.(*CreateMachineTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_GetMachineType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMachineTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).GetMachineType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_GetMachineType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).GetMachineType(ctx, req.(*GetMachineTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_ListMachineTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMachineTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).ListMachineTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_ListMachineTypes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).ListMachineTypes(ctx, req.(*ListMachineTypesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_UpdateMachineType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMachineTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).UpdateMachineType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_UpdateMachineType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).UpdateMachineType(ctx, req.(*UpdateMachineTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_DeleteMachineType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMachineTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).DeleteMachineType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_DeleteMachineType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).DeleteMachineType(ctx, req.(*DeleteMachineTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_CreateMachine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMachineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).CreateMachine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_CreateMachine_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).CreateMachine(ctx, req.(*CreateMachineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_GetMachine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMachineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).GetMachine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_GetMachine_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).GetMachine(ctx, req.(*GetMachineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_ListMachines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMachinesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).ListMachines(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_ListMachines_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).ListMachines(ctx, req.(*ListMachinesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_UpdateMachine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMachineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).UpdateMachine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_UpdateMachine_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).UpdateMachine(ctx, req.(*UpdateMachineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_DeleteMachine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMachineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).DeleteMachine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_DeleteMachine_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).DeleteMachine(ctx, req.(*DeleteMachineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MachineService_ServiceDesc is the grpc.ServiceDesc for MachineService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MachineService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "costing.v1.MachineService",
+	HandlerType: (*MachineServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateMachineType",
+			Handler:    _MachineService_CreateMachineType_Handler,
+		},
+		{
+			MethodName: "GetMachineType",
+			Handler:    _MachineService_GetMachineType_Handler,
+		},
+		{
+			MethodName: "ListMachineTypes",
+			Handler:    _MachineService_ListMachineTypes_Handler,
+		},
+		{
+			MethodName: "UpdateMachineType",
+			Handler:    _MachineService_UpdateMachineType_Handler,
+		},
+		{
+			MethodName: "DeleteMachineType",
+			Handler:    _MachineService_DeleteMachineType_Handler,
+		},
+		{
+			MethodName: "CreateMachine",
+			Handler:    _MachineService_CreateMachine_Handler,
+		},
+		{
+			MethodName: "GetMachine",
+			Handler:    _MachineService_GetMachine_Handler,
+		},
+		{
+			MethodName: "ListMachines",
+			Handler:    _MachineService_ListMachines_Handler,
+		},
+		{
+			MethodName: "UpdateMachine",
+			Handler:    _MachineService_UpdateMachine_Handler,
+		},
+		{
+			MethodName: "DeleteMachine",
+			Handler:    _MachineService_DeleteMachine_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "costing/v1/machine.proto",
+}
@@ -0,0 +1,983 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: costing/v1/material.proto
+
+package costingv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// MaterialType represents the kind of material
+type MaterialType int32
+
+const (
+	MaterialType_MATERIAL_TYPE_UNSPECIFIED MaterialType = 0
+	MaterialType_MATERIAL_TYPE_FIBRE       MaterialType = 1 // Cotton, polyester staple, viscose
+	MaterialType_MATERIAL_TYPE_YARN        MaterialType = 2 // Bought-in or intermediate yarn
+	MaterialType_MATERIAL_TYPE_CHEMICAL    MaterialType = 3 // Dyes, auxiliaries, lubricants
+	MaterialType_MATERIAL_TYPE_PACKAGING   MaterialType = 4 // Cones, cartons, bags
+)
+
+// Enum value maps for MaterialType.
+var (
+	MaterialType_name = map[int32]string{
+		0: "MATERIAL_TYPE_UNSPECIFIED",
+		1: "MATERIAL_TYPE_FIBRE",
+		2: "MATERIAL_TYPE_YARN",
+		3: "MATERIAL_TYPE_CHEMICAL",
+		4: "MATERIAL_TYPE_PACKAGING",
+	}
+	MaterialType_value = map[string]int32{
+		"MATERIAL_TYPE_UNSPECIFIED": 0,
+		"MATERIAL_TYPE_FIBRE":       1,
+		"MATERIAL_TYPE_YARN":        2,
+		"MATERIAL_TYPE_CHEMICAL":    3,
+		"MATERIAL_TYPE_PACKAGING":   4,
+	}
+)
+
+func (x MaterialType) Enum() *MaterialType {
+	p := new(MaterialType)
+	*p = x
+	return p
+}
+
+func (x MaterialType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MaterialType) Descriptor() protoreflect.EnumDescriptor {
+	return file_costing_v1_material_proto_enumTypes[0].Descriptor()
+}
+
+func (MaterialType) Type() protoreflect.EnumType {
+	return &file_costing_v1_material_proto_enumTypes[0]
+}
+
+func (x MaterialType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MaterialType.Descriptor instead.
+func (MaterialType) EnumDescriptor() ([]byte, []int) {
+	return file_costing_v1_material_proto_rawDescGZIP(), []int{0}
+}
+
+// Material represents a material master entity
+type Material struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	MaterialCode    string                 `protobuf:"bytes,1,opt,name=material_code,json=materialCode,proto3" json:"material_code,omitempty"`
+	MaterialName    string                 `protobuf:"bytes,2,opt,name=material_name,json=materialName,proto3" json:"material_name,omitempty"`
+	MaterialType    MaterialType           `protobuf:"varint,3,opt,name=material_type,json=materialType,proto3,enum=costing.v1.MaterialType" json:"material_type,omitempty"`
+	PurchaseUomCode string                 `protobuf:"bytes,4,opt,name=purchase_uom_code,json=purchaseUomCode,proto3" json:"purchase_uom_code,omitempty"` // Default purchase UOM
+	StandardPrice   float64                `protobuf:"fixed64,5,opt,name=standard_price,json=standardPrice,proto3" json:"standard_price,omitempty"`       // Price of one purchase UOM
+	Currency        string                 `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`                                        // ISO 4217, e.g., USD, IDR
+	Description     *string                `protobuf:"bytes,7,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	IsActive        bool                   `protobuf:"varint,8,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	Audit           *AuditInfo             `protobuf:"bytes,9,opt,name=audit,proto3" json:"audit,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Material) Reset() {
+	*x = Material{}
+	mi := &file_costing_v1_material_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Material) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Material) ProtoMessage() {}
+
+func (x *Material) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_material_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Material.ProtoReflect.Descriptor instead.
+func (*Material) Descriptor() ([]byte, []int) {
+	return file_costing_v1_material_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Material) GetMaterialCode() string {
+	if x != nil {
+		return x.MaterialCode
+	}
+	return ""
+}
+
+func (x *Material) GetMaterialName() string {
+	if x != nil {
+		return x.MaterialName
+	}
+	return ""
+}
+
+func (x *Material) GetMaterialType() MaterialType {
+	if x != nil {
+		return x.MaterialType
+	}
+	return MaterialType_MATERIAL_TYPE_UNSPECIFIED
+}
+
+func (x *Material) GetPurchaseUomCode() string {
+	if x != nil {
+		return x.PurchaseUomCode
+	}
+	return ""
+}
+
+func (x *Material) GetStandardPrice() float64 {
+	if x != nil {
+		return x.StandardPrice
+	}
+	return 0
+}
+
+func (x *Material) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Material) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *Material) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *Material) GetAudit() *AuditInfo {
+	if x != nil {
+		return x.Audit
+	}
+	return nil
+}
+
+// CreateMaterial
+type CreateMaterialRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	MaterialCode    string                 `protobuf:"bytes,1,opt,name=material_code,json=materialCode,proto3" json:"material_code,omitempty"`
+	MaterialName    string                 `protobuf:"bytes,2,opt,name=material_name,json=materialName,proto3" json:"material_name,omitempty"`
+	MaterialType    MaterialType           `protobuf:"varint,3,opt,name=material_type,json=materialType,proto3,enum=costing.v1.MaterialType" json:"material_type,omitempty"`
+	PurchaseUomCode string                 `protobuf:"bytes,4,opt,name=purchase_uom_code,json=purchaseUomCode,proto3" json:"purchase_uom_code,omitempty"`
+	StandardPrice   float64                `protobuf:"fixed64,5,opt,name=standard_price,json=standardPrice,proto3" json:"standard_price,omitempty"`
+	Currency        string                 `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
+	Description     *string                `protobuf:"bytes,7,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateMaterialRequest) Reset() {
+	*x = CreateMaterialRequest{}
+	mi := &file_costing_v1_material_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMaterialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMaterialRequest) ProtoMessage() {}
+
+func (x *CreateMaterialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_material_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMaterialRequest.ProtoReflect.Descriptor instead.
+func (*CreateMaterialRequest) Descriptor() ([]byte, []int) {
+	return file_costing_v1_material_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateMaterialRequest) GetMaterialCode() string {
+	if x != nil {
+		return x.MaterialCode
+	}
+	return ""
+}
+
+func (x *CreateMaterialRequest) GetMaterialName() string {
+	if x != nil {
+		return x.MaterialName
+	}
+	return ""
+}
+
+func (x *CreateMaterialRequest) GetMaterialType() MaterialType {
+	if x != nil {
+		return x.MaterialType
+	}
+	return MaterialType_MATERIAL_TYPE_UNSPECIFIED
+}
+
+func (x *CreateMaterialRequest) GetPurchaseUomCode() string {
+	if x != nil {
+		return x.PurchaseUomCode
+	}
+	return ""
+}
+
+func (x *CreateMaterialRequest) GetStandardPrice() float64 {
+	if x != nil {
+		return x.StandardPrice
+	}
+	return 0
+}
+
+func (x *CreateMaterialRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CreateMaterialRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+type CreateMaterialResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Data          *Material              `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMaterialResponse) Reset() {
+	*x = CreateMaterialResponse{}
+	mi := &file_costing_v1_material_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMaterialResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMaterialResponse) ProtoMessage() {}
+
+func (x *CreateMaterialResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_material_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMaterialResponse.ProtoReflect.Descriptor instead.
+func (*CreateMaterialResponse) Descriptor() ([]byte, []int) {
+	return file_costing_v1_material_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateMaterialResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *CreateMaterialResponse) GetData() *Material {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// GetMaterial
+type GetMaterialRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MaterialCode  string                 `protobuf:"bytes,1,opt,name=material_code,json=materialCode,proto3" json:"material_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMaterialRequest) Reset() {
+	*x = GetMaterialRequest{}
+	mi := &file_costing_v1_material_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMaterialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMaterialRequest) ProtoMessage() {}
+
+func (x *GetMaterialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_material_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMaterialRequest.ProtoReflect.Descriptor instead.
+func (*GetMaterialRequest) Descriptor() ([]byte, []int) {
+	return file_costing_v1_material_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetMaterialRequest) GetMaterialCode() string {
+	if x != nil {
+		return x.MaterialCode
+	}
+	return ""
+}
+
+type GetMaterialResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Data          *Material              `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMaterialResponse) Reset() {
+	*x = GetMaterialResponse{}
+	mi := &file_costing_v1_material_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMaterialResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMaterialResponse) ProtoMessage() {}
+
+func (x *GetMaterialResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_material_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMaterialResponse.ProtoReflect.Descriptor instead.
+func (*GetMaterialResponse) Descriptor() ([]byte, []int) {
+	return file_costing_v1_material_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetMaterialResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *GetMaterialResponse) GetData() *Material {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// ListMaterials
+type ListMaterialsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	MaterialType  *MaterialType          `protobuf:"varint,3,opt,name=material_type,json=materialType,proto3,enum=costing.v1.MaterialType,oneof" json:"material_type,omitempty"`
+	IsActive      *bool                  `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMaterialsRequest) Reset() {
+	*x = ListMaterialsRequest{}
+	mi := &file_costing_v1_material_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMaterialsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMaterialsRequest) ProtoMessage() {}
+
+func (x *ListMaterialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_material_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMaterialsRequest.ProtoReflect.Descriptor instead.
+func (*ListMaterialsRequest) Descriptor() ([]byte, []int) {
+	return file_costing_v1_material_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListMaterialsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListMaterialsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMaterialsRequest) GetMaterialType() MaterialType {
+	if x != nil && x.MaterialType != nil {
+		return *x.MaterialType
+	}
+	return MaterialType_MATERIAL_TYPE_UNSPECIFIED
+}
+
+func (x *ListMaterialsRequest) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
+	}
+	return false
+}
+
+type ListMaterialsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Data          []*Material            `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	Pagination    *PaginationMeta        `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMaterialsResponse) Reset() {
+	*x = ListMaterialsResponse{}
+	mi := &file_costing_v1_material_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMaterialsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMaterialsResponse) ProtoMessage() {}
+
+func (x *ListMaterialsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_material_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMaterialsResponse.ProtoReflect.Descriptor instead.
+func (*ListMaterialsResponse) Descriptor() ([]byte, []int) {
+	return file_costing_v1_material_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListMaterialsResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListMaterialsResponse) GetData() []*Material {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListMaterialsResponse) GetPagination() *PaginationMeta {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// UpdateMaterial
+type UpdateMaterialRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	MaterialCode    string                 `protobuf:"bytes,1,opt,name=material_code,json=materialCode,proto3" json:"material_code,omitempty"`
+	MaterialName    string                 `protobuf:"bytes,2,opt,name=material_name,json=materialName,proto3" json:"material_name,omitempty"`
+	MaterialType    MaterialType           `protobuf:"varint,3,opt,name=material_type,json=materialType,proto3,enum=costing.v1.MaterialType" json:"material_type,omitempty"`
+	PurchaseUomCode string                 `protobuf:"bytes,4,opt,name=purchase_uom_code,json=purchaseUomCode,proto3" json:"purchase_uom_code,omitempty"`
+	StandardPrice   float64                `protobuf:"fixed64,5,opt,name=standard_price,json=standardPrice,proto3" json:"standard_price,omitempty"`
+	Currency        string                 `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
+	Description     *string                `protobuf:"bytes,7,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	IsActive        bool                   `protobuf:"varint,8,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateMaterialRequest) Reset() {
+	*x = UpdateMaterialRequest{}
+	mi := &file_costing_v1_material_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMaterialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMaterialRequest) ProtoMessage() {}
+
+func (x *UpdateMaterialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_material_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMaterialRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMaterialRequest) Descriptor() ([]byte, []int) {
+	return file_costing_v1_material_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateMaterialRequest) GetMaterialCode() string {
+	if x != nil {
+		return x.MaterialCode
+	}
+	return ""
+}
+
+func (x *UpdateMaterialRequest) GetMaterialName() string {
+	if x != nil {
+		return x.MaterialName
+	}
+	return ""
+}
+
+func (x *UpdateMaterialRequest) GetMaterialType() MaterialType {
+	if x != nil {
+		return x.MaterialType
+	}
+	return MaterialType_MATERIAL_TYPE_UNSPECIFIED
+}
+
+func (x *UpdateMaterialRequest) GetPurchaseUomCode() string {
+	if x != nil {
+		return x.PurchaseUomCode
+	}
+	return ""
+}
+
+func (x *UpdateMaterialRequest) GetStandardPrice() float64 {
+	if x != nil {
+		return x.StandardPrice
+	}
+	return 0
+}
+
+func (x *UpdateMaterialRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *UpdateMaterialRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateMaterialRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+type UpdateMaterialResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Data          *Material              `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMaterialResponse) Reset() {
+	*x = UpdateMaterialResponse{}
+	mi := &file_costing_v1_material_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMaterialResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMaterialResponse) ProtoMessage() {}
+
+func (x *UpdateMaterialResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_material_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMaterialResponse.ProtoReflect.Descriptor instead.
+func (*UpdateMaterialResponse) Descriptor() ([]byte, []int) {
+	return file_costing_v1_material_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateMaterialResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *UpdateMaterialResponse) GetData() *Material {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// DeleteMaterial
+type DeleteMaterialRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MaterialCode  string                 `protobuf:"bytes,1,opt,name=material_code,json=materialCode,proto3" json:"material_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMaterialRequest) Reset() {
+	*x = DeleteMaterialRequest{}
+	mi := &file_costing_v1_material_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMaterialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMaterialRequest) ProtoMessage() {}
+
+func (x *DeleteMaterialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_material_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMaterialRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMaterialRequest) Descriptor() ([]byte, []int) {
+	return file_costing_v1_material_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteMaterialRequest) GetMaterialCode() string {
+	if x != nil {
+		return x.MaterialCode
+	}
+	return ""
+}
+
+type DeleteMaterialResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMaterialResponse) Reset() {
+	*x = DeleteMaterialResponse{}
+	mi := &file_costing_v1_material_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMaterialResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMaterialResponse) ProtoMessage() {}
+
+func (x *DeleteMaterialResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_material_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMaterialResponse.ProtoReflect.Descriptor instead.
+func (*DeleteMaterialResponse) Descriptor() ([]byte, []int) {
+	return file_costing_v1_material_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteMaterialResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+var File_costing_v1_material_proto protoreflect.FileDescriptor
+
+const file_costing_v1_material_proto_rawDesc = "" +
+	"\n" +
+	"\x19costing/v1/material.proto\x12\n" +
+	"costing.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17costing/v1/common.proto\"\x83\x03\n" +
+	"\bMaterial\x12#\n" +
+	"\rmaterial_code\x18\x01 \x01(\tR\fmaterialCode\x12#\n" +
+	"\rmaterial_name\x18\x02 \x01(\tR\fmaterialName\x12=\n" +
+	"\rmaterial_type\x18\x03 \x01(\x0e2\x18.costing.v1.MaterialTypeR\fmaterialType\x12*\n" +
+	"\x11purchase_uom_code\x18\x04 \x01(\tR\x0fpurchaseUomCode\x12%\n" +
+	"\x0estandard_price\x18\x05 \x01(\x01R\rstandardPrice\x12\x1a\n" +
+	"\bcurrency\x18\x06 \x01(\tR\bcurrency\x12%\n" +
+	"\vdescription\x18\a \x01(\tH\x00R\vdescription\x88\x01\x01\x12\x1b\n" +
+	"\tis_active\x18\b \x01(\bR\bisActive\x12+\n" +
+	"\x05audit\x18\t \x01(\v2\x15.costing.v1.AuditInfoR\x05auditB\x0e\n" +
+	"\f_description\"\xb8\x03\n" +
+	"\x15CreateMaterialRequest\x12E\n" +
+	"\rmaterial_code\x18\x01 \x01(\tB \xbaH\x1dr\x1b\x10\x01\x1822\x15^[A-Z0-9][A-Z0-9_-]*$R\fmaterialCode\x12/\n" +
+	"\rmaterial_name\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xc8\x01R\fmaterialName\x12I\n" +
+	"\rmaterial_type\x18\x03 \x01(\x0e2\x18.costing.v1.MaterialTypeB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\fmaterialType\x125\n" +
+	"\x11purchase_uom_code\x18\x04 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18\x14R\x0fpurchaseUomCode\x125\n" +
+	"\x0estandard_price\x18\x05 \x01(\x01B\x0e\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00R\rstandardPrice\x12-\n" +
+	"\bcurrency\x18\x06 \x01(\tB\x11\xbaH\x0er\f2\n" +
+	"^[A-Z]{3}$R\bcurrency\x12/\n" +
+	"\vdescription\x18\a \x01(\tB\b\xbaH\x05r\x03\x18\xe8\aH\x00R\vdescription\x88\x01\x01B\x0e\n" +
+	"\f_description\"p\n" +
+	"\x16CreateMaterialResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.costing.v1.BaseResponseR\x04base\x12(\n" +
+	"\x04data\x18\x02 \x01(\v2\x14.costing.v1.MaterialR\x04data\"D\n" +
+	"\x12GetMaterialRequest\x12.\n" +
+	"\rmaterial_code\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x182R\fmaterialCode\"m\n" +
+	"\x13GetMaterialResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.costing.v1.BaseResponseR\x04base\x12(\n" +
+	"\x04data\x18\x02 \x01(\v2\x14.costing.v1.MaterialR\x04data\"\xe1\x01\n" +
+	"\x14ListMaterialsRequest\x12\x1b\n" +
+	"\x04page\x18\x01 \x01(\x05B\a\xbaH\x04\x1a\x02(\x01R\x04page\x12&\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x01R\bpageSize\x12B\n" +
+	"\rmaterial_type\x18\x03 \x01(\x0e2\x18.costing.v1.MaterialTypeH\x00R\fmaterialType\x88\x01\x01\x12 \n" +
+	"\tis_active\x18\x04 \x01(\bH\x01R\bisActive\x88\x01\x01B\x10\n" +
+	"\x0e_material_typeB\f\n" +
+	"\n" +
+	"_is_active\"\xab\x01\n" +
+	"\x15ListMaterialsResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.costing.v1.BaseResponseR\x04base\x12(\n" +
+	"\x04data\x18\x02 \x03(\v2\x14.costing.v1.MaterialR\x04data\x12:\n" +
+	"\n" +
+	"pagination\x18\x03 \x01(\v2\x1a.costing.v1.PaginationMetaR\n" +
+	"pagination\"\xbe\x03\n" +
+	"\x15UpdateMaterialRequest\x12.\n" +
+	"\rmaterial_code\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x182R\fmaterialCode\x12/\n" +
+	"\rmaterial_name\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xc8\x01R\fmaterialName\x12I\n" +
+	"\rmaterial_type\x18\x03 \x01(\x0e2\x18.costing.v1.MaterialTypeB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\fmaterialType\x125\n" +
+	"\x11purchase_uom_code\x18\x04 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18\x14R\x0fpurchaseUomCode\x125\n" +
+	"\x0estandard_price\x18\x05 \x01(\x01B\x0e\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00R\rstandardPrice\x12-\n" +
+	"\bcurrency\x18\x06 \x01(\tB\x11\xbaH\x0er\f2\n" +
+	"^[A-Z]{3}$R\bcurrency\x12/\n" +
+	"\vdescription\x18\a \x01(\tB\b\xbaH\x05r\x03\x18\xe8\aH\x00R\vdescription\x88\x01\x01\x12\x1b\n" +
+	"\tis_active\x18\b \x01(\bR\bisActiveB\x0e\n" +
+	"\f_description\"p\n" +
+	"\x16UpdateMaterialResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.costing.v1.BaseResponseR\x04base\x12(\n" +
+	"\x04data\x18\x02 \x01(\v2\x14.costing.v1.MaterialR\x04data\"G\n" +
+	"\x15DeleteMaterialRequest\x12.\n" +
+	"\rmaterial_code\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x182R\fmaterialCode\"F\n" +
+	"\x16DeleteMaterialResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.costing.v1.BaseResponseR\x04base*\x97\x01\n" +
+	"\fMaterialType\x12\x1d\n" +
+	"\x19MATERIAL_TYPE_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13MATERIAL_TYPE_FIBRE\x10\x01\x12\x16\n" +
+	"\x12MATERIAL_TYPE_YARN\x10\x02\x12\x1a\n" +
+	"\x16MATERIAL_TYPE_CHEMICAL\x10\x03\x12\x1b\n" +
+	"\x17MATERIAL_TYPE_PACKAGING\x10\x042\xec\x04\n" +
+	"\x0fMaterialService\x12q\n" +
+	"\x0eCreateMaterial\x12!.costing.v1.CreateMaterialRequest\x1a\".costing.v1.CreateMaterialResponse\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/v1/materials\x12u\n" +
+	"\vGetMaterial\x12\x1e.costing.v1.GetMaterialRequest\x1a\x1f.costing.v1.GetMaterialResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/materials/{material_code}\x12k\n" +
+	"\rListMaterials\x12 .costing.v1.ListMaterialsRequest\x1a!.costing.v1.ListMaterialsResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/v1/materials\x12\x81\x01\n" +
+	"\x0eUpdateMaterial\x12!.costing.v1.UpdateMaterialRequest\x1a\".costing.v1.UpdateMaterialResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\x1a\x1d/v1/materials/{material_code}\x12~\n" +
+	"\x0eDeleteMaterial\x12!.costing.v1.DeleteMaterialRequest\x1a\".costing.v1.DeleteMaterialResponse\"%\x82\xd3\xe4\x93\x02\x1f*\x1d/v1/materials/{material_code}B\xb0\x01\n" +
+	"\x0ecom.costing.v1B\rMaterialProtoP\x01ZFgithub.com/homindolenern/goapps-costing-v1/gen/go/costing/v1;costingv1\xa2\x02\x03CXX\xaa\x02\n" +
+	"Costing.V1\xca\x02\n" +
+	"Costing\\V1\xe2\x02\x16Costing\\V1\\GPBMetadata\xea\x02\vCosting::V1b\x06proto3"
+
+var (
+	file_costing_v1_material_proto_rawDescOnce sync.Once
+	file_costing_v1_material_proto_rawDescData []byte
+)
+
+func file_costing_v1_material_proto_rawDescGZIP() []byte {
+	file_costing_v1_material_proto_rawDescOnce.Do(func() {
+		file_costing_v1_material_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_costing_v1_material_proto_rawDesc), len(file_costing_v1_material_proto_rawDesc)))
+	})
+	return file_costing_v1_material_proto_rawDescData
+}
+
+var file_costing_v1_material_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_costing_v1_material_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_costing_v1_material_proto_goTypes = []any{
+	(MaterialType)(0),              // 0: costing.v1.MaterialType
+	(*Material)(nil),               // 1: costing.v1.Material
+	(*CreateMaterialRequest)(nil),  // 2: costing.v1.CreateMaterialRequest
+	(*CreateMaterialResponse)(nil), // 3: costing.v1.CreateMaterialResponse
+	(*GetMaterialRequest)(nil),     // 4: costing.v1.GetMaterialRequest
+	(*GetMaterialResponse)(nil),    // 5: costing.v1.GetMaterialResponse
+	(*ListMaterialsRequest)(nil),   // 6: costing.v1.ListMaterialsRequest
+	(*ListMaterialsResponse)(nil),  // 7: costing.v1.ListMaterialsResponse
+	(*UpdateMaterialRequest)(nil),  // 8: costing.v1.UpdateMaterialRequest
+	(*UpdateMaterialResponse)(nil), // 9: costing.v1.UpdateMaterialResponse
+	(*DeleteMaterialRequest)(nil),  // 10: costing.v1.DeleteMaterialRequest
+	(*DeleteMaterialResponse)(nil), // 11: costing.v1.DeleteMaterialResponse
+	(*AuditInfo)(nil),              // 12: costing.v1.AuditInfo
+	(*BaseResponse)(nil),           // 13: costing.v1.BaseResponse
+	(*PaginationMeta)(nil),         // 14: costing.v1.PaginationMeta
+}
+var file_costing_v1_material_proto_depIdxs = []int32{
+	0,  // 0: costing.v1.Material.material_type:type_name -> costing.v1.MaterialType
+	12, // 1: costing.v1.Material.audit:type_name -> costing.v1.AuditInfo
+	0,  // 2: costing.v1.CreateMaterialRequest.material_type:type_name -> costing.v1.MaterialType
+	13, // 3: costing.v1.CreateMaterialResponse.base:type_name -> costing.v1.BaseResponse
+	1,  // 4: costing.v1.CreateMaterialResponse.data:type_name -> costing.v1.Material
+	13, // 5: costing.v1.GetMaterialResponse.base:type_name -> costing.v1.BaseResponse
+	1,  // 6: costing.v1.GetMaterialResponse.data:type_name -> costing.v1.Material
+	0,  // 7: costing.v1.ListMaterialsRequest.material_type:type_name -> costing.v1.MaterialType
+	13, // 8: costing.v1.ListMaterialsResponse.base:type_name -> costing.v1.BaseResponse
+	1,  // 9: costing.v1.ListMaterialsResponse.data:type_name -> costing.v1.Material
+	14, // 10: costing.v1.ListMaterialsResponse.pagination:type_name -> costing.v1.PaginationMeta
+	0,  // 11: costing.v1.UpdateMaterialRequest.material_type:type_name -> costing.v1.MaterialType
+	13, // 12: costing.v1.UpdateMaterialResponse.base:type_name -> costing.v1.BaseResponse
+	1,  // 13: costing.v1.UpdateMaterialResponse.data:type_name -> costing.v1.Material
+	13, // 14: costing.v1.DeleteMaterialResponse.base:type_name -> costing.v1.BaseResponse
+	2,  // 15: costing.v1.MaterialService.CreateMaterial:input_type -> costing.v1.CreateMaterialRequest
+	4,  // 16: costing.v1.MaterialService.GetMaterial:input_type -> costing.v1.GetMaterialRequest
+	6,  // 17: costing.v1.MaterialService.ListMaterials:input_type -> costing.v1.ListMaterialsRequest
+	8,  // 18: costing.v1.MaterialService.UpdateMaterial:input_type -> costing.v1.UpdateMaterialRequest
+	10, // 19: costing.v1.MaterialService.DeleteMaterial:input_type -> costing.v1.DeleteMaterialRequest
+	3,  // 20: costing.v1.MaterialService.CreateMaterial:output_type -> costing.v1.CreateMaterialResponse
+	5,  // 21: costing.v1.MaterialService.GetMaterial:output_type -> costing.v1.GetMaterialResponse
+	7,  // 22: costing.v1.MaterialService.ListMaterials:output_type -> costing.v1.ListMaterialsResponse
+	9,  // 23: costing.v1.MaterialService.UpdateMaterial:output_type -> costing.v1.UpdateMaterialResponse
+	11, // 24: costing.v1.MaterialService.DeleteMaterial:output_type -> costing.v1.DeleteMaterialResponse
+	20, // [20:25] is the sub-list for method output_type
+	15, // [15:20] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_costing_v1_material_proto_init() }
+func file_costing_v1_material_proto_init() {
+	if File_costing_v1_material_proto != nil {
+		return
+	}
+	file_costing_v1_common_proto_init()
+	file_costing_v1_material_proto_msgTypes[0].OneofWrappers = []any{}
+	file_costing_v1_material_proto_msgTypes[1].OneofWrappers = []any{}
+	file_costing_v1_material_proto_msgTypes[5].OneofWrappers = []any{}
+	file_costing_v1_material_proto_msgTypes[7].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_costing_v1_material_proto_rawDesc), len(file_costing_v1_material_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_costing_v1_material_proto_goTypes,
+		DependencyIndexes: file_costing_v1_material_proto_depIdxs,
+		EnumInfos:         file_costing_v1_material_proto_enumTypes,
+		MessageInfos:      file_costing_v1_material_proto_msgTypes,
+	}.Build()
+	File_costing_v1_material_proto = out.File
+	file_costing_v1_material_proto_goTypes = nil
+	file_costing_v1_material_proto_depIdxs = nil
+}
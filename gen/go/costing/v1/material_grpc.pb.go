@@ -0,0 +1,287 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             (unknown)
+// source: costing/v1/material.proto
+
+package costingv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file.
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	MaterialService_CreateMaterial_FullMethodName = "/costing.v1.MaterialService/CreateMaterial"
+	MaterialService_GetMaterial_FullMethodName    = "/costing.v1.MaterialService/GetMaterial"
+	MaterialService_ListMaterials_FullMethodName  = "/costing.v1.MaterialService/ListMaterials"
+	MaterialService_UpdateMaterial_FullMethodName = "/costing.v1.MaterialService/UpdateMaterial"
+	MaterialService_DeleteMaterial_FullMethodName = "/costing.v1.MaterialService/DeleteMaterial"
+)
+
+// MaterialServiceClient is the client API for MaterialService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// MaterialService provides CRUD operations for Materials.
+type MaterialServiceClient interface {
+	// CreateMaterial creates a new Material
+	CreateMaterial(ctx context.Context, in *CreateMaterialRequest, opts ...grpc.CallOption) (*CreateMaterialResponse, error)
+	// GetMaterial retrieves a Material by code
+	GetMaterial(ctx context.Context, in *GetMaterialRequest, opts ...grpc.CallOption) (*GetMaterialResponse, error)
+	// ListMaterials retrieves a paginated list of Materials
+	ListMaterials(ctx context.Context, in *ListMaterialsRequest, opts ...grpc.CallOption) (*ListMaterialsResponse, error)
+	// UpdateMaterial updates an existing Material
+	UpdateMaterial(ctx context.Context, in *UpdateMaterialRequest, opts ...grpc.CallOption) (*UpdateMaterialResponse, error)
+	// DeleteMaterial deletes a Material by code
+	DeleteMaterial(ctx context.Context, in *DeleteMaterialRequest, opts ...grpc.CallOption) (*DeleteMaterialResponse, error)
+}
+
+type materialServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMaterialServiceClient(cc grpc.ClientConnInterface) MaterialServiceClient {
+	return &materialServiceClient{cc}
+}
+
+func (c *materialServiceClient) CreateMaterial(ctx context.Context, in *CreateMaterialRequest, opts ...grpc.CallOption) (*CreateMaterialResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateMaterialResponse)
+	err := c.cc.Invoke(ctx, MaterialService_CreateMaterial_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *materialServiceClient) GetMaterial(ctx context.Context, in *GetMaterialRequest, opts ...grpc.CallOption) (*GetMaterialResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMaterialResponse)
+	err := c.cc.Invoke(ctx, MaterialService_GetMaterial_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *materialServiceClient) ListMaterials(ctx context.Context, in *ListMaterialsRequest, opts ...grpc.CallOption) (*ListMaterialsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMaterialsResponse)
+	err := c.cc.Invoke(ctx, MaterialService_ListMaterials_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *materialServiceClient) UpdateMaterial(ctx context.Context, in *UpdateMaterialRequest, opts ...grpc.CallOption) (*UpdateMaterialResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateMaterialResponse)
+	err := c.cc.Invoke(ctx, MaterialService_UpdateMaterial_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *materialServiceClient) DeleteMaterial(ctx context.Context, in *DeleteMaterialRequest, opts ...grpc.CallOption) (*DeleteMaterialResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteMaterialResponse)
+	err := c.cc.Invoke(ctx, MaterialService_DeleteMaterial_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MaterialServiceServer is the server API for MaterialService service.
+// All implementations must embed UnimplementedMaterialServiceServer.
+// for forward compatibility.
+//
+// MaterialService provides CRUD operations for Materials.
+type MaterialServiceServer interface {
+	// CreateMaterial creates a new Material
+	CreateMaterial(context.Context, *CreateMaterialRequest) (*CreateMaterialResponse, error)
+	// GetMaterial retrieves a Material by code
+	GetMaterial(context.Context, *GetMaterialRequest) (*GetMaterialResponse, error)
+	// ListMaterials retrieves a paginated list of Materials
+	ListMaterials(context.Context, *ListMaterialsRequest) (*ListMaterialsResponse, error)
+	// UpdateMaterial updates an existing Material
+	UpdateMaterial(context.Context, *UpdateMaterialRequest) (*UpdateMaterialResponse, error)
+	// DeleteMaterial deletes a Material by code
+	DeleteMaterial(context.Context, *DeleteMaterialRequest) (*DeleteMaterialResponse, error)
+	mustEmbedUnimplementedMaterialServiceServer()
+}
+
+// UnimplementedMaterialServiceServer must be embedded to have.
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMaterialServiceServer struct{}
+
+func (UnimplementedMaterialServiceServer) CreateMaterial(context.Context, *CreateMaterialRequest) (*CreateMaterialResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateMaterial not implemented")
+}
+func (UnimplementedMaterialServiceServer) GetMaterial(context.Context, *GetMaterialRequest) (*GetMaterialResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMaterial not implemented")
+}
+func (UnimplementedMaterialServiceServer) ListMaterials(context.Context, *ListMaterialsRequest) (*ListMaterialsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMaterials not implemented")
+}
+func (UnimplementedMaterialServiceServer) UpdateMaterial(context.Context, *UpdateMaterialRequest) (*UpdateMaterialResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateMaterial not implemented")
+}
+func (UnimplementedMaterialServiceServer) DeleteMaterial(context.Context, *DeleteMaterialRequest) (*DeleteMaterialResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteMaterial not implemented")
+}
+func (UnimplementedMaterialServiceServer) mustEmbedUnimplementedMaterialServiceServer() {}
+func (UnimplementedMaterialServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeMaterialServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MaterialServiceServer will.
+// result in compilation errors.
+type UnsafeMaterialServiceServer interface {
+	mustEmbedUnimplementedMaterialServiceServer()
+}
+
+func RegisterMaterialServiceServer(s grpc.ServiceRegistrar, srv MaterialServiceServer) {
+	// If the following call panics, it indicates UnimplementedMaterialServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MaterialService_ServiceDesc, srv)
+}
+
+func _MaterialService_CreateMaterial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMaterialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaterialServiceServer).CreateMaterial(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MaterialService_CreateMaterial_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaterialServiceServer).CreateMaterial(ctx, req.(*CreateMaterialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MaterialService_GetMaterial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMaterialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaterialServiceServer).GetMaterial(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MaterialService_GetMaterial_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaterialServiceServer).GetMaterial(ctx, req.(*GetMaterialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MaterialService_ListMaterials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMaterialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaterialServiceServer).ListMaterials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MaterialService_ListMaterials_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaterialServiceServer).ListMaterials(ctx, req.(*ListMaterialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MaterialService_UpdateMaterial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMaterialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaterialServiceServer).UpdateMaterial(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MaterialService_UpdateMaterial_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaterialServiceServer).UpdateMaterial(ctx, req.(*UpdateMaterialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MaterialService_DeleteMaterial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMaterialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaterialServiceServer).DeleteMaterial(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MaterialService_DeleteMaterial_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaterialServiceServer).DeleteMaterial(ctx, req.(*DeleteMaterialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MaterialService_ServiceDesc is the grpc.ServiceDesc for MaterialService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MaterialService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "costing.v1.MaterialService",
+	HandlerType: (*MaterialServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateMaterial",
+			Handler:    _MaterialService_CreateMaterial_Handler,
+		},
+		{
+			MethodName: "GetMaterial",
+			Handler:    _MaterialService_GetMaterial_Handler,
+		},
+		{
+			MethodName: "ListMaterials",
+			Handler:    _MaterialService_ListMaterials_Handler,
+		},
+		{
+			MethodName: "UpdateMaterial",
+			Handler:    _MaterialService_UpdateMaterial_Handler,
+		},
+		{
+			MethodName: "DeleteMaterial",
+			Handler:    _MaterialService_DeleteMaterial_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "costing/v1/material.proto",
+}
@@ -138,6 +138,68 @@ func (ParameterDataType) EnumDescriptor() ([]byte, []int) {
 	return file_costing_v1_parameter_proto_rawDescGZIP(), []int{1}
 }
 
+// ParameterSortField is a field parameters can be listed by
+type ParameterSortField int32
+
+const (
+	ParameterSortField_PARAMETER_SORT_FIELD_UNSPECIFIED ParameterSortField = 0 // Treated as CODE
+	ParameterSortField_PARAMETER_SORT_FIELD_CODE        ParameterSortField = 1
+	ParameterSortField_PARAMETER_SORT_FIELD_NAME        ParameterSortField = 2
+	ParameterSortField_PARAMETER_SORT_FIELD_CATEGORY    ParameterSortField = 3
+	ParameterSortField_PARAMETER_SORT_FIELD_DATA_TYPE   ParameterSortField = 4
+	ParameterSortField_PARAMETER_SORT_FIELD_CREATED_AT  ParameterSortField = 5
+	ParameterSortField_PARAMETER_SORT_FIELD_UPDATED_AT  ParameterSortField = 6 // Never updated parameters sort last
+)
+
+// Enum value maps for ParameterSortField.
+var (
+	ParameterSortField_name = map[int32]string{
+		0: "PARAMETER_SORT_FIELD_UNSPECIFIED",
+		1: "PARAMETER_SORT_FIELD_CODE",
+		2: "PARAMETER_SORT_FIELD_NAME",
+		3: "PARAMETER_SORT_FIELD_CATEGORY",
+		4: "PARAMETER_SORT_FIELD_DATA_TYPE",
+		5: "PARAMETER_SORT_FIELD_CREATED_AT",
+		6: "PARAMETER_SORT_FIELD_UPDATED_AT",
+	}
+	ParameterSortField_value = map[string]int32{
+		"PARAMETER_SORT_FIELD_UNSPECIFIED": 0,
+		"PARAMETER_SORT_FIELD_CODE":        1,
+		"PARAMETER_SORT_FIELD_NAME":        2,
+		"PARAMETER_SORT_FIELD_CATEGORY":    3,
+		"PARAMETER_SORT_FIELD_DATA_TYPE":   4,
+		"PARAMETER_SORT_FIELD_CREATED_AT":  5,
+		"PARAMETER_SORT_FIELD_UPDATED_AT":  6,
+	}
+)
+
+func (x ParameterSortField) Enum() *ParameterSortField {
+	p := new(ParameterSortField)
+	*p = x
+	return p
+}
+
+func (x ParameterSortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ParameterSortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_costing_v1_parameter_proto_enumTypes[2].Descriptor()
+}
+
+func (ParameterSortField) Type() protoreflect.EnumType {
+	return &file_costing_v1_parameter_proto_enumTypes[2]
+}
+
+func (x ParameterSortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ParameterSortField.Descriptor instead.
+func (ParameterSortField) EnumDescriptor() ([]byte, []int) {
+	return file_costing_v1_parameter_proto_rawDescGZIP(), []int{2}
+}
+
 // Parameter represents a configuration parameter entity
 type Parameter struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
@@ -153,6 +215,7 @@ type Parameter struct {
 	Description       *string                `protobuf:"bytes,10,opt,name=description,proto3,oneof" json:"description,omitempty"`
 	IsActive          bool                   `protobuf:"varint,11,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
 	Audit             *AuditInfo             `protobuf:"bytes,12,opt,name=audit,proto3" json:"audit,omitempty"`
+	Version           int32                  `protobuf:"varint,13,opt,name=version,proto3" json:"version,omitempty"` // Incremented on every update; returned as ETag over HTTP
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
@@ -271,6 +334,13 @@ func (x *Parameter) GetAudit() *AuditInfo {
 	return nil
 }
 
+func (x *Parameter) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 // CreateParameter
 type CreateParameterRequest struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
@@ -278,15 +348,16 @@ type CreateParameterRequest struct {
 	ParameterName     string                 `protobuf:"bytes,2,opt,name=parameter_name,json=parameterName,proto3" json:"parameter_name,omitempty"`
 	ParameterCategory ParameterCategory      `protobuf:"varint,3,opt,name=parameter_category,json=parameterCategory,proto3,enum=costing.v1.ParameterCategory" json:"parameter_category,omitempty"`
 	DataType          ParameterDataType      `protobuf:"varint,4,opt,name=data_type,json=dataType,proto3,enum=costing.v1.ParameterDataType" json:"data_type,omitempty"`
-	Uom               *string                `protobuf:"bytes,5,opt,name=uom,proto3,oneof" json:"uom,omitempty"`
-	MinValue          *float64               `protobuf:"fixed64,6,opt,name=min_value,json=minValue,proto3,oneof" json:"min_value,omitempty"`
-	MaxValue          *float64               `protobuf:"fixed64,7,opt,name=max_value,json=maxValue,proto3,oneof" json:"max_value,omitempty"`
-	AllowedValues     []string               `protobuf:"bytes,8,rep,name=allowed_values,json=allowedValues,proto3" json:"allowed_values,omitempty"`
-	IsMandatory       bool                   `protobuf:"varint,9,opt,name=is_mandatory,json=isMandatory,proto3" json:"is_mandatory,omitempty"`
-	Description       *string                `protobuf:"bytes,10,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	IsActive          bool                   `protobuf:"varint,11,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// Code of an existing UOM; only allowed on NUMERIC parameters
+	Uom           *string  `protobuf:"bytes,5,opt,name=uom,proto3,oneof" json:"uom,omitempty"`
+	MinValue      *float64 `protobuf:"fixed64,6,opt,name=min_value,json=minValue,proto3,oneof" json:"min_value,omitempty"`
+	MaxValue      *float64 `protobuf:"fixed64,7,opt,name=max_value,json=maxValue,proto3,oneof" json:"max_value,omitempty"`
+	AllowedValues []string `protobuf:"bytes,8,rep,name=allowed_values,json=allowedValues,proto3" json:"allowed_values,omitempty"`
+	IsMandatory   bool     `protobuf:"varint,9,opt,name=is_mandatory,json=isMandatory,proto3" json:"is_mandatory,omitempty"`
+	Description   *string  `protobuf:"bytes,10,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	IsActive      bool     `protobuf:"varint,11,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateParameterRequest) Reset() {
@@ -452,6 +523,7 @@ func (x *CreateParameterResponse) GetData() *Parameter {
 type GetParameterRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ParameterCode string                 `protobuf:"bytes,1,opt,name=parameter_code,json=parameterCode,proto3" json:"parameter_code,omitempty"`
+	AsOf          *string                `protobuf:"bytes,2,opt,name=as_of,json=asOf,proto3,oneof" json:"as_of,omitempty"` // RFC 3339; the definition in force at that time
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -493,6 +565,13 @@ func (x *GetParameterRequest) GetParameterCode() string {
 	return ""
 }
 
+func (x *GetParameterRequest) GetAsOf() string {
+	if x != nil && x.AsOf != nil {
+		return *x.AsOf
+	}
+	return ""
+}
+
 type GetParameterResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
@@ -545,31 +624,31 @@ func (x *GetParameterResponse) GetData() *Parameter {
 	return nil
 }
 
-// ListParameters
-type ListParametersRequest struct {
+// ParameterVersion is a definition of a Parameter with the period it was in force
+type ParameterVersion struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	Category      *ParameterCategory     `protobuf:"varint,3,opt,name=category,proto3,enum=costing.v1.ParameterCategory,oneof" json:"category,omitempty"`
-	IsActive      *bool                  `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	Parameter     *Parameter             `protobuf:"bytes,1,opt,name=parameter,proto3" json:"parameter,omitempty"` // parameter.version numbers the definition
+	EffectiveFrom string                 `protobuf:"bytes,2,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
+	EffectiveTo   *string                `protobuf:"bytes,3,opt,name=effective_to,json=effectiveTo,proto3,oneof" json:"effective_to,omitempty"` // Unset while in force
+	RecordedBy    string                 `protobuf:"bytes,4,opt,name=recorded_by,json=recordedBy,proto3" json:"recorded_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListParametersRequest) Reset() {
-	*x = ListParametersRequest{}
+func (x *ParameterVersion) Reset() {
+	*x = ParameterVersion{}
 	mi := &file_costing_v1_parameter_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListParametersRequest) String() string {
+func (x *ParameterVersion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListParametersRequest) ProtoMessage() {}
+func (*ParameterVersion) ProtoMessage() {}
 
-func (x *ListParametersRequest) ProtoReflect() protoreflect.Message {
+func (x *ParameterVersion) ProtoReflect() protoreflect.Message {
 	mi := &file_costing_v1_parameter_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -581,62 +660,63 @@ func (x *ListParametersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListParametersRequest.ProtoReflect.Descriptor instead.
-func (*ListParametersRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ParameterVersion.ProtoReflect.Descriptor instead.
+func (*ParameterVersion) Descriptor() ([]byte, []int) {
 	return file_costing_v1_parameter_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *ListParametersRequest) GetPage() int32 {
+func (x *ParameterVersion) GetParameter() *Parameter {
 	if x != nil {
-		return x.Page
+		return x.Parameter
 	}
-	return 0
+	return nil
 }
 
-func (x *ListParametersRequest) GetPageSize() int32 {
+func (x *ParameterVersion) GetEffectiveFrom() string {
 	if x != nil {
-		return x.PageSize
+		return x.EffectiveFrom
 	}
-	return 0
+	return ""
 }
 
-func (x *ListParametersRequest) GetCategory() ParameterCategory {
-	if x != nil && x.Category != nil {
-		return *x.Category
+func (x *ParameterVersion) GetEffectiveTo() string {
+	if x != nil && x.EffectiveTo != nil {
+		return *x.EffectiveTo
 	}
-	return ParameterCategory_PARAMETER_CATEGORY_UNSPECIFIED
+	return ""
 }
 
-func (x *ListParametersRequest) GetIsActive() bool {
-	if x != nil && x.IsActive != nil {
-		return *x.IsActive
+func (x *ParameterVersion) GetRecordedBy() string {
+	if x != nil {
+		return x.RecordedBy
 	}
-	return false
+	return ""
 }
 
-type ListParametersResponse struct {
+// ListParameterVersions
+type ListParameterVersionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	Data          []*Parameter           `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
-	Pagination    *PaginationMeta        `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	ParameterCode string                 `protobuf:"bytes,1,opt,name=parameter_code,json=parameterCode,proto3" json:"parameter_code,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListParametersResponse) Reset() {
-	*x = ListParametersResponse{}
+func (x *ListParameterVersionsRequest) Reset() {
+	*x = ListParameterVersionsRequest{}
 	mi := &file_costing_v1_parameter_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListParametersResponse) String() string {
+func (x *ListParameterVersionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListParametersResponse) ProtoMessage() {}
+func (*ListParameterVersionsRequest) ProtoMessage() {}
 
-func (x *ListParametersResponse) ProtoReflect() protoreflect.Message {
+func (x *ListParameterVersionsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_costing_v1_parameter_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -648,64 +728,55 @@ func (x *ListParametersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListParametersResponse.ProtoReflect.Descriptor instead.
-func (*ListParametersResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListParameterVersionsRequest.ProtoReflect.Descriptor instead.
+func (*ListParameterVersionsRequest) Descriptor() ([]byte, []int) {
 	return file_costing_v1_parameter_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ListParametersResponse) GetBase() *BaseResponse {
+func (x *ListParameterVersionsRequest) GetParameterCode() string {
 	if x != nil {
-		return x.Base
+		return x.ParameterCode
 	}
-	return nil
+	return ""
 }
 
-func (x *ListParametersResponse) GetData() []*Parameter {
+func (x *ListParameterVersionsRequest) GetPage() int32 {
 	if x != nil {
-		return x.Data
+		return x.Page
 	}
-	return nil
+	return 0
 }
 
-func (x *ListParametersResponse) GetPagination() *PaginationMeta {
+func (x *ListParameterVersionsRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.Pagination
+		return x.PageSize
 	}
-	return nil
+	return 0
 }
 
-// UpdateParameter
-type UpdateParameterRequest struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	ParameterCode     string                 `protobuf:"bytes,1,opt,name=parameter_code,json=parameterCode,proto3" json:"parameter_code,omitempty"`
-	ParameterName     string                 `protobuf:"bytes,2,opt,name=parameter_name,json=parameterName,proto3" json:"parameter_name,omitempty"`
-	ParameterCategory ParameterCategory      `protobuf:"varint,3,opt,name=parameter_category,json=parameterCategory,proto3,enum=costing.v1.ParameterCategory" json:"parameter_category,omitempty"`
-	DataType          ParameterDataType      `protobuf:"varint,4,opt,name=data_type,json=dataType,proto3,enum=costing.v1.ParameterDataType" json:"data_type,omitempty"`
-	Uom               *string                `protobuf:"bytes,5,opt,name=uom,proto3,oneof" json:"uom,omitempty"`
-	MinValue          *float64               `protobuf:"fixed64,6,opt,name=min_value,json=minValue,proto3,oneof" json:"min_value,omitempty"`
-	MaxValue          *float64               `protobuf:"fixed64,7,opt,name=max_value,json=maxValue,proto3,oneof" json:"max_value,omitempty"`
-	AllowedValues     []string               `protobuf:"bytes,8,rep,name=allowed_values,json=allowedValues,proto3" json:"allowed_values,omitempty"`
-	IsMandatory       bool                   `protobuf:"varint,9,opt,name=is_mandatory,json=isMandatory,proto3" json:"is_mandatory,omitempty"`
-	Description       *string                `protobuf:"bytes,10,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	IsActive          bool                   `protobuf:"varint,11,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type ListParameterVersionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Data          []*ParameterVersion    `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	Pagination    *PaginationMeta        `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateParameterRequest) Reset() {
-	*x = UpdateParameterRequest{}
+func (x *ListParameterVersionsResponse) Reset() {
+	*x = ListParameterVersionsResponse{}
 	mi := &file_costing_v1_parameter_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateParameterRequest) String() string {
+func (x *ListParameterVersionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateParameterRequest) ProtoMessage() {}
+func (*ListParameterVersionsResponse) ProtoMessage() {}
 
-func (x *UpdateParameterRequest) ProtoReflect() protoreflect.Message {
+func (x *ListParameterVersionsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_costing_v1_parameter_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -717,110 +788,72 @@ func (x *UpdateParameterRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateParameterRequest.ProtoReflect.Descriptor instead.
-func (*UpdateParameterRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListParameterVersionsResponse.ProtoReflect.Descriptor instead.
+func (*ListParameterVersionsResponse) Descriptor() ([]byte, []int) {
 	return file_costing_v1_parameter_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *UpdateParameterRequest) GetParameterCode() string {
-	if x != nil {
-		return x.ParameterCode
-	}
-	return ""
-}
-
-func (x *UpdateParameterRequest) GetParameterName() string {
-	if x != nil {
-		return x.ParameterName
-	}
-	return ""
-}
-
-func (x *UpdateParameterRequest) GetParameterCategory() ParameterCategory {
-	if x != nil {
-		return x.ParameterCategory
-	}
-	return ParameterCategory_PARAMETER_CATEGORY_UNSPECIFIED
-}
-
-func (x *UpdateParameterRequest) GetDataType() ParameterDataType {
-	if x != nil {
-		return x.DataType
-	}
-	return ParameterDataType_PARAMETER_DATA_TYPE_UNSPECIFIED
-}
-
-func (x *UpdateParameterRequest) GetUom() string {
-	if x != nil && x.Uom != nil {
-		return *x.Uom
-	}
-	return ""
-}
-
-func (x *UpdateParameterRequest) GetMinValue() float64 {
-	if x != nil && x.MinValue != nil {
-		return *x.MinValue
-	}
-	return 0
-}
-
-func (x *UpdateParameterRequest) GetMaxValue() float64 {
-	if x != nil && x.MaxValue != nil {
-		return *x.MaxValue
-	}
-	return 0
-}
-
-func (x *UpdateParameterRequest) GetAllowedValues() []string {
+func (x *ListParameterVersionsResponse) GetBase() *BaseResponse {
 	if x != nil {
-		return x.AllowedValues
+		return x.Base
 	}
 	return nil
 }
 
-func (x *UpdateParameterRequest) GetIsMandatory() bool {
+func (x *ListParameterVersionsResponse) GetData() []*ParameterVersion {
 	if x != nil {
-		return x.IsMandatory
-	}
-	return false
-}
-
-func (x *UpdateParameterRequest) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateParameterRequest) GetIsActive() bool {
+func (x *ListParameterVersionsResponse) GetPagination() *PaginationMeta {
 	if x != nil {
-		return x.IsActive
+		return x.Pagination
 	}
-	return false
+	return nil
 }
 
-type UpdateParameterResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	Data          *Parameter             `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+// ListParameters
+type ListParametersRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Page           int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize       int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Category       *ParameterCategory     `protobuf:"varint,3,opt,name=category,proto3,enum=costing.v1.ParameterCategory,oneof" json:"category,omitempty"`
+	IsActive       *bool                  `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,5,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"` // Include soft-deleted parameters
+	// Substring of the code, name or description, ignoring case
+	Search        string             `protobuf:"bytes,6,opt,name=search,proto3" json:"search,omitempty"`
+	DataType      *ParameterDataType `protobuf:"varint,7,opt,name=data_type,json=dataType,proto3,enum=costing.v1.ParameterDataType,oneof" json:"data_type,omitempty"`
+	Uom           *string            `protobuf:"bytes,8,opt,name=uom,proto3,oneof" json:"uom,omitempty"`
+	IsMandatory   *bool              `protobuf:"varint,9,opt,name=is_mandatory,json=isMandatory,proto3,oneof" json:"is_mandatory,omitempty"`
+	CreatedBy     *string            `protobuf:"bytes,10,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`
+	CreatedFrom   *string            `protobuf:"bytes,11,opt,name=created_from,json=createdFrom,proto3,oneof" json:"created_from,omitempty"` // RFC 3339, inclusive
+	CreatedTo     *string            `protobuf:"bytes,12,opt,name=created_to,json=createdTo,proto3,oneof" json:"created_to,omitempty"`       // RFC 3339, exclusive
+	UpdatedFrom   *string            `protobuf:"bytes,13,opt,name=updated_from,json=updatedFrom,proto3,oneof" json:"updated_from,omitempty"` // RFC 3339, inclusive
+	UpdatedTo     *string            `protobuf:"bytes,14,opt,name=updated_to,json=updatedTo,proto3,oneof" json:"updated_to,omitempty"`       // RFC 3339, exclusive
+	SortBy        ParameterSortField `protobuf:"varint,15,opt,name=sort_by,json=sortBy,proto3,enum=costing.v1.ParameterSortField" json:"sort_by,omitempty"`
+	SortDirection SortDirection      `protobuf:"varint,16,opt,name=sort_direction,json=sortDirection,proto3,enum=costing.v1.SortDirection" json:"sort_direction,omitempty"`
+	// next_page_token of a previous response with the same filters and sort; replaces page
+	PageToken     string `protobuf:"bytes,17,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateParameterResponse) Reset() {
-	*x = UpdateParameterResponse{}
+func (x *ListParametersRequest) Reset() {
+	*x = ListParametersRequest{}
 	mi := &file_costing_v1_parameter_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateParameterResponse) String() string {
+func (x *ListParametersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateParameterResponse) ProtoMessage() {}
+func (*ListParametersRequest) ProtoMessage() {}
 
-func (x *UpdateParameterResponse) ProtoReflect() protoreflect.Message {
+func (x *ListParametersRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_costing_v1_parameter_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -832,47 +865,154 @@ func (x *UpdateParameterResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateParameterResponse.ProtoReflect.Descriptor instead.
-func (*UpdateParameterResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListParametersRequest.ProtoReflect.Descriptor instead.
+func (*ListParametersRequest) Descriptor() ([]byte, []int) {
 	return file_costing_v1_parameter_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *UpdateParameterResponse) GetBase() *BaseResponse {
+func (x *ListParametersRequest) GetPage() int32 {
 	if x != nil {
-		return x.Base
+		return x.Page
 	}
-	return nil
+	return 0
 }
 
-func (x *UpdateParameterResponse) GetData() *Parameter {
+func (x *ListParametersRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.Data
+		return x.PageSize
 	}
-	return nil
+	return 0
 }
 
-// DeleteParameter
-type DeleteParameterRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ParameterCode string                 `protobuf:"bytes,1,opt,name=parameter_code,json=parameterCode,proto3" json:"parameter_code,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ListParametersRequest) GetCategory() ParameterCategory {
+	if x != nil && x.Category != nil {
+		return *x.Category
+	}
+	return ParameterCategory_PARAMETER_CATEGORY_UNSPECIFIED
 }
 
-func (x *DeleteParameterRequest) Reset() {
-	*x = DeleteParameterRequest{}
-	mi := &file_costing_v1_parameter_proto_msgTypes[9]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ListParametersRequest) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
+	}
+	return false
 }
 
-func (x *DeleteParameterRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ListParametersRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
 }
 
-func (*DeleteParameterRequest) ProtoMessage() {}
-
-func (x *DeleteParameterRequest) ProtoReflect() protoreflect.Message {
+func (x *ListParametersRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *ListParametersRequest) GetDataType() ParameterDataType {
+	if x != nil && x.DataType != nil {
+		return *x.DataType
+	}
+	return ParameterDataType_PARAMETER_DATA_TYPE_UNSPECIFIED
+}
+
+func (x *ListParametersRequest) GetUom() string {
+	if x != nil && x.Uom != nil {
+		return *x.Uom
+	}
+	return ""
+}
+
+func (x *ListParametersRequest) GetIsMandatory() bool {
+	if x != nil && x.IsMandatory != nil {
+		return *x.IsMandatory
+	}
+	return false
+}
+
+func (x *ListParametersRequest) GetCreatedBy() string {
+	if x != nil && x.CreatedBy != nil {
+		return *x.CreatedBy
+	}
+	return ""
+}
+
+func (x *ListParametersRequest) GetCreatedFrom() string {
+	if x != nil && x.CreatedFrom != nil {
+		return *x.CreatedFrom
+	}
+	return ""
+}
+
+func (x *ListParametersRequest) GetCreatedTo() string {
+	if x != nil && x.CreatedTo != nil {
+		return *x.CreatedTo
+	}
+	return ""
+}
+
+func (x *ListParametersRequest) GetUpdatedFrom() string {
+	if x != nil && x.UpdatedFrom != nil {
+		return *x.UpdatedFrom
+	}
+	return ""
+}
+
+func (x *ListParametersRequest) GetUpdatedTo() string {
+	if x != nil && x.UpdatedTo != nil {
+		return *x.UpdatedTo
+	}
+	return ""
+}
+
+func (x *ListParametersRequest) GetSortBy() ParameterSortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return ParameterSortField_PARAMETER_SORT_FIELD_UNSPECIFIED
+}
+
+func (x *ListParametersRequest) GetSortDirection() SortDirection {
+	if x != nil {
+		return x.SortDirection
+	}
+	return SortDirection_SORT_DIRECTION_UNSPECIFIED
+}
+
+func (x *ListParametersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListParametersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Data          []*Parameter           `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	Pagination    *PaginationMeta        `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListParametersResponse) Reset() {
+	*x = ListParametersResponse{}
+	mi := &file_costing_v1_parameter_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListParametersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListParametersResponse) ProtoMessage() {}
+
+func (x *ListParametersResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_costing_v1_parameter_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -884,40 +1024,934 @@ func (x *DeleteParameterRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteParameterRequest.ProtoReflect.Descriptor instead.
-func (*DeleteParameterRequest) Descriptor() ([]byte, []int) {
-	return file_costing_v1_parameter_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ListParametersResponse.ProtoReflect.Descriptor instead.
+func (*ListParametersResponse) Descriptor() ([]byte, []int) {
+	return file_costing_v1_parameter_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListParametersResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListParametersResponse) GetData() []*Parameter {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListParametersResponse) GetPagination() *PaginationMeta {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListParametersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// ExportParameters
+type ExportParametersRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Format         FileFormat             `protobuf:"varint,1,opt,name=format,proto3,enum=costing.v1.FileFormat" json:"format,omitempty"`
+	Category       *ParameterCategory     `protobuf:"varint,2,opt,name=category,proto3,enum=costing.v1.ParameterCategory,oneof" json:"category,omitempty"`
+	IsActive       *bool                  `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,4,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"` // Include soft-deleted parameters
+	// Substring of the code, name or description, ignoring case
+	Search        string             `protobuf:"bytes,5,opt,name=search,proto3" json:"search,omitempty"`
+	DataType      *ParameterDataType `protobuf:"varint,6,opt,name=data_type,json=dataType,proto3,enum=costing.v1.ParameterDataType,oneof" json:"data_type,omitempty"`
+	Uom           *string            `protobuf:"bytes,7,opt,name=uom,proto3,oneof" json:"uom,omitempty"`
+	IsMandatory   *bool              `protobuf:"varint,8,opt,name=is_mandatory,json=isMandatory,proto3,oneof" json:"is_mandatory,omitempty"`
+	CreatedBy     *string            `protobuf:"bytes,9,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`
+	CreatedFrom   *string            `protobuf:"bytes,10,opt,name=created_from,json=createdFrom,proto3,oneof" json:"created_from,omitempty"` // RFC 3339, inclusive
+	CreatedTo     *string            `protobuf:"bytes,11,opt,name=created_to,json=createdTo,proto3,oneof" json:"created_to,omitempty"`       // RFC 3339, exclusive
+	UpdatedFrom   *string            `protobuf:"bytes,12,opt,name=updated_from,json=updatedFrom,proto3,oneof" json:"updated_from,omitempty"` // RFC 3339, inclusive
+	UpdatedTo     *string            `protobuf:"bytes,13,opt,name=updated_to,json=updatedTo,proto3,oneof" json:"updated_to,omitempty"`       // RFC 3339, exclusive
+	SortBy        ParameterSortField `protobuf:"varint,14,opt,name=sort_by,json=sortBy,proto3,enum=costing.v1.ParameterSortField" json:"sort_by,omitempty"`
+	SortDirection SortDirection      `protobuf:"varint,15,opt,name=sort_direction,json=sortDirection,proto3,enum=costing.v1.SortDirection" json:"sort_direction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportParametersRequest) Reset() {
+	*x = ExportParametersRequest{}
+	mi := &file_costing_v1_parameter_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportParametersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportParametersRequest) ProtoMessage() {}
+
+func (x *ExportParametersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_parameter_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportParametersRequest.ProtoReflect.Descriptor instead.
+func (*ExportParametersRequest) Descriptor() ([]byte, []int) {
+	return file_costing_v1_parameter_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ExportParametersRequest) GetFormat() FileFormat {
+	if x != nil {
+		return x.Format
+	}
+	return FileFormat_FILE_FORMAT_UNSPECIFIED
+}
+
+func (x *ExportParametersRequest) GetCategory() ParameterCategory {
+	if x != nil && x.Category != nil {
+		return *x.Category
+	}
+	return ParameterCategory_PARAMETER_CATEGORY_UNSPECIFIED
+}
+
+func (x *ExportParametersRequest) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
+	}
+	return false
+}
+
+func (x *ExportParametersRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+func (x *ExportParametersRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *ExportParametersRequest) GetDataType() ParameterDataType {
+	if x != nil && x.DataType != nil {
+		return *x.DataType
+	}
+	return ParameterDataType_PARAMETER_DATA_TYPE_UNSPECIFIED
+}
+
+func (x *ExportParametersRequest) GetUom() string {
+	if x != nil && x.Uom != nil {
+		return *x.Uom
+	}
+	return ""
+}
+
+func (x *ExportParametersRequest) GetIsMandatory() bool {
+	if x != nil && x.IsMandatory != nil {
+		return *x.IsMandatory
+	}
+	return false
+}
+
+func (x *ExportParametersRequest) GetCreatedBy() string {
+	if x != nil && x.CreatedBy != nil {
+		return *x.CreatedBy
+	}
+	return ""
+}
+
+func (x *ExportParametersRequest) GetCreatedFrom() string {
+	if x != nil && x.CreatedFrom != nil {
+		return *x.CreatedFrom
+	}
+	return ""
+}
+
+func (x *ExportParametersRequest) GetCreatedTo() string {
+	if x != nil && x.CreatedTo != nil {
+		return *x.CreatedTo
+	}
+	return ""
+}
+
+func (x *ExportParametersRequest) GetUpdatedFrom() string {
+	if x != nil && x.UpdatedFrom != nil {
+		return *x.UpdatedFrom
+	}
+	return ""
+}
+
+func (x *ExportParametersRequest) GetUpdatedTo() string {
+	if x != nil && x.UpdatedTo != nil {
+		return *x.UpdatedTo
+	}
+	return ""
+}
+
+func (x *ExportParametersRequest) GetSortBy() ParameterSortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return ParameterSortField_PARAMETER_SORT_FIELD_UNSPECIFIED
+}
+
+func (x *ExportParametersRequest) GetSortDirection() SortDirection {
+	if x != nil {
+		return x.SortDirection
+	}
+	return SortDirection_SORT_DIRECTION_UNSPECIFIED
+}
+
+// WatchParameters
+type WatchParametersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResumeToken   string                 `protobuf:"bytes,1,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"` // resume_token of the last change received
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchParametersRequest) Reset() {
+	*x = WatchParametersRequest{}
+	mi := &file_costing_v1_parameter_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchParametersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchParametersRequest) ProtoMessage() {}
+
+func (x *WatchParametersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_parameter_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchParametersRequest.ProtoReflect.Descriptor instead.
+func (*WatchParametersRequest) Descriptor() ([]byte, []int) {
+	return file_costing_v1_parameter_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *WatchParametersRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+// ParameterChange is one change of a WatchParameters stream
+type ParameterChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChangeType    ChangeType             `protobuf:"varint,1,opt,name=change_type,json=changeType,proto3,enum=costing.v1.ChangeType" json:"change_type,omitempty"`
+	EventType     string                 `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"` // Domain event, e.g. parameter.deactivated
+	Parameter     *Parameter             `protobuf:"bytes,3,opt,name=parameter,proto3" json:"parameter,omitempty"`                  // State right after the change
+	OccurredAt    string                 `protobuf:"bytes,4,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	ResumeToken   string                 `protobuf:"bytes,5,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"` // Resumes the watch after this change
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParameterChange) Reset() {
+	*x = ParameterChange{}
+	mi := &file_costing_v1_parameter_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParameterChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParameterChange) ProtoMessage() {}
+
+func (x *ParameterChange) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_parameter_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParameterChange.ProtoReflect.Descriptor instead.
+func (*ParameterChange) Descriptor() ([]byte, []int) {
+	return file_costing_v1_parameter_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ParameterChange) GetChangeType() ChangeType {
+	if x != nil {
+		return x.ChangeType
+	}
+	return ChangeType_CHANGE_TYPE_UNSPECIFIED
+}
+
+func (x *ParameterChange) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *ParameterChange) GetParameter() *Parameter {
+	if x != nil {
+		return x.Parameter
+	}
+	return nil
+}
+
+func (x *ParameterChange) GetOccurredAt() string {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return ""
+}
+
+func (x *ParameterChange) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+// UpdateParameter
+type UpdateParameterRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ParameterCode     string                 `protobuf:"bytes,1,opt,name=parameter_code,json=parameterCode,proto3" json:"parameter_code,omitempty"`
+	ParameterName     string                 `protobuf:"bytes,2,opt,name=parameter_name,json=parameterName,proto3" json:"parameter_name,omitempty"`
+	ParameterCategory ParameterCategory      `protobuf:"varint,3,opt,name=parameter_category,json=parameterCategory,proto3,enum=costing.v1.ParameterCategory" json:"parameter_category,omitempty"`
+	DataType          ParameterDataType      `protobuf:"varint,4,opt,name=data_type,json=dataType,proto3,enum=costing.v1.ParameterDataType" json:"data_type,omitempty"`
+	// Code of an existing UOM; only allowed on NUMERIC parameters
+	Uom           *string  `protobuf:"bytes,5,opt,name=uom,proto3,oneof" json:"uom,omitempty"`
+	MinValue      *float64 `protobuf:"fixed64,6,opt,name=min_value,json=minValue,proto3,oneof" json:"min_value,omitempty"`
+	MaxValue      *float64 `protobuf:"fixed64,7,opt,name=max_value,json=maxValue,proto3,oneof" json:"max_value,omitempty"`
+	AllowedValues []string `protobuf:"bytes,8,rep,name=allowed_values,json=allowedValues,proto3" json:"allowed_values,omitempty"`
+	IsMandatory   bool     `protobuf:"varint,9,opt,name=is_mandatory,json=isMandatory,proto3" json:"is_mandatory,omitempty"`
+	Description   *string  `protobuf:"bytes,10,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	IsActive      bool     `protobuf:"varint,11,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	// Version the update is based on; taken from If-Match over HTTP when not set
+	Version       int32 `protobuf:"varint,12,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateParameterRequest) Reset() {
+	*x = UpdateParameterRequest{}
+	mi := &file_costing_v1_parameter_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateParameterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateParameterRequest) ProtoMessage() {}
+
+func (x *UpdateParameterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_parameter_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateParameterRequest.ProtoReflect.Descriptor instead.
+func (*UpdateParameterRequest) Descriptor() ([]byte, []int) {
+	return file_costing_v1_parameter_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UpdateParameterRequest) GetParameterCode() string {
+	if x != nil {
+		return x.ParameterCode
+	}
+	return ""
+}
+
+func (x *UpdateParameterRequest) GetParameterName() string {
+	if x != nil {
+		return x.ParameterName
+	}
+	return ""
+}
+
+func (x *UpdateParameterRequest) GetParameterCategory() ParameterCategory {
+	if x != nil {
+		return x.ParameterCategory
+	}
+	return ParameterCategory_PARAMETER_CATEGORY_UNSPECIFIED
+}
+
+func (x *UpdateParameterRequest) GetDataType() ParameterDataType {
+	if x != nil {
+		return x.DataType
+	}
+	return ParameterDataType_PARAMETER_DATA_TYPE_UNSPECIFIED
+}
+
+func (x *UpdateParameterRequest) GetUom() string {
+	if x != nil && x.Uom != nil {
+		return *x.Uom
+	}
+	return ""
+}
+
+func (x *UpdateParameterRequest) GetMinValue() float64 {
+	if x != nil && x.MinValue != nil {
+		return *x.MinValue
+	}
+	return 0
+}
+
+func (x *UpdateParameterRequest) GetMaxValue() float64 {
+	if x != nil && x.MaxValue != nil {
+		return *x.MaxValue
+	}
+	return 0
+}
+
+func (x *UpdateParameterRequest) GetAllowedValues() []string {
+	if x != nil {
+		return x.AllowedValues
+	}
+	return nil
+}
+
+func (x *UpdateParameterRequest) GetIsMandatory() bool {
+	if x != nil {
+		return x.IsMandatory
+	}
+	return false
+}
+
+func (x *UpdateParameterRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateParameterRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *UpdateParameterRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type UpdateParameterResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Base  *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Data  *Parameter             `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"` // Unset when the edit is held for approval
+	// Set, with a 202 base response, when the edit is held for approval
+	ChangeRequest *ParameterChangeRequest `protobuf:"bytes,3,opt,name=change_request,json=changeRequest,proto3" json:"change_request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateParameterResponse) Reset() {
+	*x = UpdateParameterResponse{}
+	mi := &file_costing_v1_parameter_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateParameterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateParameterResponse) ProtoMessage() {}
+
+func (x *UpdateParameterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_parameter_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateParameterResponse.ProtoReflect.Descriptor instead.
+func (*UpdateParameterResponse) Descriptor() ([]byte, []int) {
+	return file_costing_v1_parameter_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdateParameterResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *UpdateParameterResponse) GetData() *Parameter {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *UpdateParameterResponse) GetChangeRequest() *ParameterChangeRequest {
+	if x != nil {
+		return x.ChangeRequest
+	}
+	return nil
+}
+
+// DeleteParameter
+type DeleteParameterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ParameterCode string                 `protobuf:"bytes,1,opt,name=parameter_code,json=parameterCode,proto3" json:"parameter_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteParameterRequest) Reset() {
+	*x = DeleteParameterRequest{}
+	mi := &file_costing_v1_parameter_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteParameterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteParameterRequest) ProtoMessage() {}
+
+func (x *DeleteParameterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_parameter_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteParameterRequest.ProtoReflect.Descriptor instead.
+func (*DeleteParameterRequest) Descriptor() ([]byte, []int) {
+	return file_costing_v1_parameter_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DeleteParameterRequest) GetParameterCode() string {
+	if x != nil {
+		return x.ParameterCode
+	}
+	return ""
+}
+
+type DeleteParameterResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Base  *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// Set, with a 202 base response, when the delete is held for approval
+	ChangeRequest *ParameterChangeRequest `protobuf:"bytes,2,opt,name=change_request,json=changeRequest,proto3" json:"change_request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteParameterResponse) Reset() {
+	*x = DeleteParameterResponse{}
+	mi := &file_costing_v1_parameter_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteParameterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteParameterResponse) ProtoMessage() {}
+
+func (x *DeleteParameterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_parameter_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteParameterResponse.ProtoReflect.Descriptor instead.
+func (*DeleteParameterResponse) Descriptor() ([]byte, []int) {
+	return file_costing_v1_parameter_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DeleteParameterResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *DeleteParameterResponse) GetChangeRequest() *ParameterChangeRequest {
+	if x != nil {
+		return x.ChangeRequest
+	}
+	return nil
+}
+
+// RestoreParameter
+type RestoreParameterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ParameterCode string                 `protobuf:"bytes,1,opt,name=parameter_code,json=parameterCode,proto3" json:"parameter_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreParameterRequest) Reset() {
+	*x = RestoreParameterRequest{}
+	mi := &file_costing_v1_parameter_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreParameterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreParameterRequest) ProtoMessage() {}
+
+func (x *RestoreParameterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_parameter_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreParameterRequest.ProtoReflect.Descriptor instead.
+func (*RestoreParameterRequest) Descriptor() ([]byte, []int) {
+	return file_costing_v1_parameter_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RestoreParameterRequest) GetParameterCode() string {
+	if x != nil {
+		return x.ParameterCode
+	}
+	return ""
+}
+
+type RestoreParameterResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Base  *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Data  *Parameter             `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"` // Unset when the restore is held for approval
+	// Set, with a 202 base response, when the restore is held for approval
+	ChangeRequest *ParameterChangeRequest `protobuf:"bytes,3,opt,name=change_request,json=changeRequest,proto3" json:"change_request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreParameterResponse) Reset() {
+	*x = RestoreParameterResponse{}
+	mi := &file_costing_v1_parameter_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreParameterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreParameterResponse) ProtoMessage() {}
+
+func (x *RestoreParameterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_parameter_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreParameterResponse.ProtoReflect.Descriptor instead.
+func (*RestoreParameterResponse) Descriptor() ([]byte, []int) {
+	return file_costing_v1_parameter_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RestoreParameterResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *RestoreParameterResponse) GetData() *Parameter {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *RestoreParameterResponse) GetChangeRequest() *ParameterChangeRequest {
+	if x != nil {
+		return x.ChangeRequest
+	}
+	return nil
+}
+
+// BatchUpsertParameters
+type UpsertParameterItem struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ParameterCode     string                 `protobuf:"bytes,1,opt,name=parameter_code,json=parameterCode,proto3" json:"parameter_code,omitempty"`
+	ParameterName     string                 `protobuf:"bytes,2,opt,name=parameter_name,json=parameterName,proto3" json:"parameter_name,omitempty"`
+	ParameterCategory ParameterCategory      `protobuf:"varint,3,opt,name=parameter_category,json=parameterCategory,proto3,enum=costing.v1.ParameterCategory" json:"parameter_category,omitempty"`
+	DataType          ParameterDataType      `protobuf:"varint,4,opt,name=data_type,json=dataType,proto3,enum=costing.v1.ParameterDataType" json:"data_type,omitempty"`
+	// Code of an existing UOM; only allowed on NUMERIC parameters
+	Uom           *string  `protobuf:"bytes,5,opt,name=uom,proto3,oneof" json:"uom,omitempty"`
+	MinValue      *float64 `protobuf:"fixed64,6,opt,name=min_value,json=minValue,proto3,oneof" json:"min_value,omitempty"`
+	MaxValue      *float64 `protobuf:"fixed64,7,opt,name=max_value,json=maxValue,proto3,oneof" json:"max_value,omitempty"`
+	AllowedValues []string `protobuf:"bytes,8,rep,name=allowed_values,json=allowedValues,proto3" json:"allowed_values,omitempty"`
+	IsMandatory   bool     `protobuf:"varint,9,opt,name=is_mandatory,json=isMandatory,proto3" json:"is_mandatory,omitempty"`
+	Description   *string  `protobuf:"bytes,10,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	// Unchanged when not set; new parameters start active
+	IsActive *bool `protobuf:"varint,11,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	// Version the update is based on; 0 updates the current revision
+	Version       int32 `protobuf:"varint,12,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertParameterItem) Reset() {
+	*x = UpsertParameterItem{}
+	mi := &file_costing_v1_parameter_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertParameterItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertParameterItem) ProtoMessage() {}
+
+func (x *UpsertParameterItem) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_parameter_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertParameterItem.ProtoReflect.Descriptor instead.
+func (*UpsertParameterItem) Descriptor() ([]byte, []int) {
+	return file_costing_v1_parameter_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *DeleteParameterRequest) GetParameterCode() string {
+func (x *UpsertParameterItem) GetParameterCode() string {
 	if x != nil {
 		return x.ParameterCode
 	}
 	return ""
 }
 
-type DeleteParameterResponse struct {
+func (x *UpsertParameterItem) GetParameterName() string {
+	if x != nil {
+		return x.ParameterName
+	}
+	return ""
+}
+
+func (x *UpsertParameterItem) GetParameterCategory() ParameterCategory {
+	if x != nil {
+		return x.ParameterCategory
+	}
+	return ParameterCategory_PARAMETER_CATEGORY_UNSPECIFIED
+}
+
+func (x *UpsertParameterItem) GetDataType() ParameterDataType {
+	if x != nil {
+		return x.DataType
+	}
+	return ParameterDataType_PARAMETER_DATA_TYPE_UNSPECIFIED
+}
+
+func (x *UpsertParameterItem) GetUom() string {
+	if x != nil && x.Uom != nil {
+		return *x.Uom
+	}
+	return ""
+}
+
+func (x *UpsertParameterItem) GetMinValue() float64 {
+	if x != nil && x.MinValue != nil {
+		return *x.MinValue
+	}
+	return 0
+}
+
+func (x *UpsertParameterItem) GetMaxValue() float64 {
+	if x != nil && x.MaxValue != nil {
+		return *x.MaxValue
+	}
+	return 0
+}
+
+func (x *UpsertParameterItem) GetAllowedValues() []string {
+	if x != nil {
+		return x.AllowedValues
+	}
+	return nil
+}
+
+func (x *UpsertParameterItem) GetIsMandatory() bool {
+	if x != nil {
+		return x.IsMandatory
+	}
+	return false
+}
+
+func (x *UpsertParameterItem) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpsertParameterItem) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
+	}
+	return false
+}
+
+func (x *UpsertParameterItem) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type BatchUpsertParametersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 1 to 500 items, each validated and reported on its own
+	Items         []*UpsertParameterItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Mode          BatchMode              `protobuf:"varint,2,opt,name=mode,proto3,enum=costing.v1.BatchMode" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpsertParametersRequest) Reset() {
+	*x = BatchUpsertParametersRequest{}
+	mi := &file_costing_v1_parameter_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpsertParametersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpsertParametersRequest) ProtoMessage() {}
+
+func (x *BatchUpsertParametersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_parameter_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpsertParametersRequest.ProtoReflect.Descriptor instead.
+func (*BatchUpsertParametersRequest) Descriptor() ([]byte, []int) {
+	return file_costing_v1_parameter_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *BatchUpsertParametersRequest) GetItems() []*UpsertParameterItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *BatchUpsertParametersRequest) GetMode() BatchMode {
+	if x != nil {
+		return x.Mode
+	}
+	return BatchMode_BATCH_MODE_UNSPECIFIED
+}
+
+type UpsertParameterResult struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"` // Position of the item in the request
+	ParameterCode string                 `protobuf:"bytes,2,opt,name=parameter_code,json=parameterCode,proto3" json:"parameter_code,omitempty"`
+	Status        BatchItemStatus        `protobuf:"varint,3,opt,name=status,proto3,enum=costing.v1.BatchItemStatus" json:"status,omitempty"`
+	Base          *BaseResponse          `protobuf:"bytes,4,opt,name=base,proto3" json:"base,omitempty"` // Outcome of the item, with validation errors if it failed
+	Data          *Parameter             `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"` // Set for created and updated items
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteParameterResponse) Reset() {
-	*x = DeleteParameterResponse{}
-	mi := &file_costing_v1_parameter_proto_msgTypes[10]
+func (x *UpsertParameterResult) Reset() {
+	*x = UpsertParameterResult{}
+	mi := &file_costing_v1_parameter_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteParameterResponse) String() string {
+func (x *UpsertParameterResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteParameterResponse) ProtoMessage() {}
+func (*UpsertParameterResult) ProtoMessage() {}
 
-func (x *DeleteParameterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_costing_v1_parameter_proto_msgTypes[10]
+func (x *UpsertParameterResult) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_parameter_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -928,24 +1962,112 @@ func (x *DeleteParameterResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteParameterResponse.ProtoReflect.Descriptor instead.
-func (*DeleteParameterResponse) Descriptor() ([]byte, []int) {
-	return file_costing_v1_parameter_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use UpsertParameterResult.ProtoReflect.Descriptor instead.
+func (*UpsertParameterResult) Descriptor() ([]byte, []int) {
+	return file_costing_v1_parameter_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *DeleteParameterResponse) GetBase() *BaseResponse {
+func (x *UpsertParameterResult) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *UpsertParameterResult) GetParameterCode() string {
+	if x != nil {
+		return x.ParameterCode
+	}
+	return ""
+}
+
+func (x *UpsertParameterResult) GetStatus() BatchItemStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BatchItemStatus_BATCH_ITEM_STATUS_UNSPECIFIED
+}
+
+func (x *UpsertParameterResult) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *UpsertParameterResult) GetData() *Parameter {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type BatchUpsertParametersResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Base          *BaseResponse            `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Results       []*UpsertParameterResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	Summary       *BatchSummary            `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpsertParametersResponse) Reset() {
+	*x = BatchUpsertParametersResponse{}
+	mi := &file_costing_v1_parameter_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpsertParametersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpsertParametersResponse) ProtoMessage() {}
+
+func (x *BatchUpsertParametersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_parameter_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpsertParametersResponse.ProtoReflect.Descriptor instead.
+func (*BatchUpsertParametersResponse) Descriptor() ([]byte, []int) {
+	return file_costing_v1_parameter_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *BatchUpsertParametersResponse) GetBase() *BaseResponse {
 	if x != nil {
 		return x.Base
 	}
 	return nil
 }
 
+func (x *BatchUpsertParametersResponse) GetResults() []*UpsertParameterResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BatchUpsertParametersResponse) GetSummary() *BatchSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
 var File_costing_v1_parameter_proto protoreflect.FileDescriptor
 
 const file_costing_v1_parameter_proto_rawDesc = "" +
 	"\n" +
 	"\x1acosting/v1/parameter.proto\x12\n" +
-	"costing.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17costing/v1/common.proto\"\xad\x04\n" +
+	"costing.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17costing/v1/common.proto\x1a!costing/v1/parameter_change.proto\"\xc7\x04\n" +
 	"\tParameter\x12%\n" +
 	"\x0eparameter_code\x18\x01 \x01(\tR\rparameterCode\x12%\n" +
 	"\x0eparameter_name\x18\x02 \x01(\tR\rparameterName\x12L\n" +
@@ -959,7 +2081,8 @@ const file_costing_v1_parameter_proto_rawDesc = "" +
 	"\vdescription\x18\n" +
 	" \x01(\tH\x03R\vdescription\x88\x01\x01\x12\x1b\n" +
 	"\tis_active\x18\v \x01(\bR\bisActive\x12+\n" +
-	"\x05audit\x18\f \x01(\v2\x15.costing.v1.AuditInfoR\x05auditB\x06\n" +
+	"\x05audit\x18\f \x01(\v2\x15.costing.v1.AuditInfoR\x05audit\x12\x18\n" +
+	"\aversion\x18\r \x01(\x05R\aversionB\x06\n" +
 	"\x04_uomB\f\n" +
 	"\n" +
 	"_min_valueB\f\n" +
@@ -990,26 +2113,117 @@ const file_costing_v1_parameter_proto_rawDesc = "" +
 	"\f_description\"r\n" +
 	"\x17CreateParameterResponse\x12,\n" +
 	"\x04base\x18\x01 \x01(\v2\x18.costing.v1.BaseResponseR\x04base\x12)\n" +
-	"\x04data\x18\x02 \x01(\v2\x15.costing.v1.ParameterR\x04data\"G\n" +
+	"\x04data\x18\x02 \x01(\v2\x15.costing.v1.ParameterR\x04data\"k\n" +
 	"\x13GetParameterRequest\x120\n" +
-	"\x0eparameter_code\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x182R\rparameterCode\"o\n" +
+	"\x0eparameter_code\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x182R\rparameterCode\x12\x18\n" +
+	"\x05as_of\x18\x02 \x01(\tH\x00R\x04asOf\x88\x01\x01B\b\n" +
+	"\x06_as_of\"o\n" +
 	"\x14GetParameterResponse\x12,\n" +
 	"\x04base\x18\x01 \x01(\v2\x18.costing.v1.BaseResponseR\x04base\x12)\n" +
-	"\x04data\x18\x02 \x01(\v2\x15.costing.v1.ParameterR\x04data\"\xd9\x01\n" +
+	"\x04data\x18\x02 \x01(\v2\x15.costing.v1.ParameterR\x04data\"\xc8\x01\n" +
+	"\x10ParameterVersion\x123\n" +
+	"\tparameter\x18\x01 \x01(\v2\x15.costing.v1.ParameterR\tparameter\x12%\n" +
+	"\x0eeffective_from\x18\x02 \x01(\tR\reffectiveFrom\x12&\n" +
+	"\feffective_to\x18\x03 \x01(\tH\x00R\veffectiveTo\x88\x01\x01\x12\x1f\n" +
+	"\vrecorded_by\x18\x04 \x01(\tR\n" +
+	"recordedByB\x0f\n" +
+	"\r_effective_to\"\x95\x01\n" +
+	"\x1cListParameterVersionsRequest\x120\n" +
+	"\x0eparameter_code\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x182R\rparameterCode\x12\x1b\n" +
+	"\x04page\x18\x02 \x01(\x05B\a\xbaH\x04\x1a\x02(\x01R\x04page\x12&\n" +
+	"\tpage_size\x18\x03 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x01R\bpageSize\"\xbb\x01\n" +
+	"\x1dListParameterVersionsResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.costing.v1.BaseResponseR\x04base\x120\n" +
+	"\x04data\x18\x02 \x03(\v2\x1c.costing.v1.ParameterVersionR\x04data\x12:\n" +
+	"\n" +
+	"pagination\x18\x03 \x01(\v2\x1a.costing.v1.PaginationMetaR\n" +
+	"pagination\"\xa9\a\n" +
 	"\x15ListParametersRequest\x12\x1b\n" +
 	"\x04page\x18\x01 \x01(\x05B\a\xbaH\x04\x1a\x02(\x01R\x04page\x12&\n" +
 	"\tpage_size\x18\x02 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x01R\bpageSize\x12>\n" +
 	"\bcategory\x18\x03 \x01(\x0e2\x1d.costing.v1.ParameterCategoryH\x00R\bcategory\x88\x01\x01\x12 \n" +
-	"\tis_active\x18\x04 \x01(\bH\x01R\bisActive\x88\x01\x01B\v\n" +
+	"\tis_active\x18\x04 \x01(\bH\x01R\bisActive\x88\x01\x01\x12'\n" +
+	"\x0finclude_deleted\x18\x05 \x01(\bR\x0eincludeDeleted\x12\x1f\n" +
+	"\x06search\x18\x06 \x01(\tB\a\xbaH\x04r\x02\x18dR\x06search\x12I\n" +
+	"\tdata_type\x18\a \x01(\x0e2\x1d.costing.v1.ParameterDataTypeB\b\xbaH\x05\x82\x01\x02\x10\x01H\x02R\bdataType\x88\x01\x01\x12\x1e\n" +
+	"\x03uom\x18\b \x01(\tB\a\xbaH\x04r\x02\x18\x14H\x03R\x03uom\x88\x01\x01\x12&\n" +
+	"\fis_mandatory\x18\t \x01(\bH\x04R\visMandatory\x88\x01\x01\x12+\n" +
+	"\n" +
+	"created_by\x18\n" +
+	" \x01(\tB\a\xbaH\x04r\x02\x18dH\x05R\tcreatedBy\x88\x01\x01\x12&\n" +
+	"\fcreated_from\x18\v \x01(\tH\x06R\vcreatedFrom\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"created_to\x18\f \x01(\tH\aR\tcreatedTo\x88\x01\x01\x12&\n" +
+	"\fupdated_from\x18\r \x01(\tH\bR\vupdatedFrom\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"updated_to\x18\x0e \x01(\tH\tR\tupdatedTo\x88\x01\x01\x12A\n" +
+	"\asort_by\x18\x0f \x01(\x0e2\x1e.costing.v1.ParameterSortFieldB\b\xbaH\x05\x82\x01\x02\x10\x01R\x06sortBy\x12J\n" +
+	"\x0esort_direction\x18\x10 \x01(\x0e2\x19.costing.v1.SortDirectionB\b\xbaH\x05\x82\x01\x02\x10\x01R\rsortDirection\x12'\n" +
+	"\n" +
+	"page_token\x18\x11 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x04R\tpageTokenB\v\n" +
 	"\t_categoryB\f\n" +
 	"\n" +
-	"_is_active\"\xad\x01\n" +
+	"_is_activeB\f\n" +
+	"\n" +
+	"_data_typeB\x06\n" +
+	"\x04_uomB\x0f\n" +
+	"\r_is_mandatoryB\r\n" +
+	"\v_created_byB\x0f\n" +
+	"\r_created_fromB\r\n" +
+	"\v_created_toB\x0f\n" +
+	"\r_updated_fromB\r\n" +
+	"\v_updated_to\"\xd5\x01\n" +
 	"\x16ListParametersResponse\x12,\n" +
 	"\x04base\x18\x01 \x01(\v2\x18.costing.v1.BaseResponseR\x04base\x12)\n" +
 	"\x04data\x18\x02 \x03(\v2\x15.costing.v1.ParameterR\x04data\x12:\n" +
 	"\n" +
 	"pagination\x18\x03 \x01(\v2\x1a.costing.v1.PaginationMetaR\n" +
-	"pagination\"\xbc\x04\n" +
+	"pagination\x12&\n" +
+	"\x0fnext_page_token\x18\x04 \x01(\tR\rnextPageToken\"\xf9\x06\n" +
+	"\x17ExportParametersRequest\x12:\n" +
+	"\x06format\x18\x01 \x01(\x0e2\x16.costing.v1.FileFormatB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x06format\x12>\n" +
+	"\bcategory\x18\x02 \x01(\x0e2\x1d.costing.v1.ParameterCategoryH\x00R\bcategory\x88\x01\x01\x12 \n" +
+	"\tis_active\x18\x03 \x01(\bH\x01R\bisActive\x88\x01\x01\x12'\n" +
+	"\x0finclude_deleted\x18\x04 \x01(\bR\x0eincludeDeleted\x12\x1f\n" +
+	"\x06search\x18\x05 \x01(\tB\a\xbaH\x04r\x02\x18dR\x06search\x12I\n" +
+	"\tdata_type\x18\x06 \x01(\x0e2\x1d.costing.v1.ParameterDataTypeB\b\xbaH\x05\x82\x01\x02\x10\x01H\x02R\bdataType\x88\x01\x01\x12\x1e\n" +
+	"\x03uom\x18\a \x01(\tB\a\xbaH\x04r\x02\x18\x14H\x03R\x03uom\x88\x01\x01\x12&\n" +
+	"\fis_mandatory\x18\b \x01(\bH\x04R\visMandatory\x88\x01\x01\x12+\n" +
+	"\n" +
+	"created_by\x18\t \x01(\tB\a\xbaH\x04r\x02\x18dH\x05R\tcreatedBy\x88\x01\x01\x12&\n" +
+	"\fcreated_from\x18\n" +
+	" \x01(\tH\x06R\vcreatedFrom\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"created_to\x18\v \x01(\tH\aR\tcreatedTo\x88\x01\x01\x12&\n" +
+	"\fupdated_from\x18\f \x01(\tH\bR\vupdatedFrom\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"updated_to\x18\r \x01(\tH\tR\tupdatedTo\x88\x01\x01\x12A\n" +
+	"\asort_by\x18\x0e \x01(\x0e2\x1e.costing.v1.ParameterSortFieldB\b\xbaH\x05\x82\x01\x02\x10\x01R\x06sortBy\x12J\n" +
+	"\x0esort_direction\x18\x0f \x01(\x0e2\x19.costing.v1.SortDirectionB\b\xbaH\x05\x82\x01\x02\x10\x01R\rsortDirectionB\v\n" +
+	"\t_categoryB\f\n" +
+	"\n" +
+	"_is_activeB\f\n" +
+	"\n" +
+	"_data_typeB\x06\n" +
+	"\x04_uomB\x0f\n" +
+	"\r_is_mandatoryB\r\n" +
+	"\v_created_byB\x0f\n" +
+	"\r_created_fromB\r\n" +
+	"\v_created_toB\x0f\n" +
+	"\r_updated_fromB\r\n" +
+	"\v_updated_to\"D\n" +
+	"\x16WatchParametersRequest\x12*\n" +
+	"\fresume_token\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x18@R\vresumeToken\"\xe2\x01\n" +
+	"\x0fParameterChange\x127\n" +
+	"\vchange_type\x18\x01 \x01(\x0e2\x16.costing.v1.ChangeTypeR\n" +
+	"changeType\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x02 \x01(\tR\teventType\x123\n" +
+	"\tparameter\x18\x03 \x01(\v2\x15.costing.v1.ParameterR\tparameter\x12\x1f\n" +
+	"\voccurred_at\x18\x04 \x01(\tR\n" +
+	"occurredAt\x12!\n" +
+	"\fresume_token\x18\x05 \x01(\tR\vresumeToken\"\xe8\x04\n" +
 	"\x16UpdateParameterRequest\x120\n" +
 	"\x0eparameter_code\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x182R\rparameterCode\x121\n" +
 	"\x0eparameter_name\x18\x02 \x01(\tB\n" +
@@ -1017,28 +2231,75 @@ const file_costing_v1_parameter_proto_rawDesc = "" +
 	"\x12parameter_category\x18\x03 \x01(\x0e2\x1d.costing.v1.ParameterCategoryB\n" +
 	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x11parameterCategory\x12F\n" +
 	"\tdata_type\x18\x04 \x01(\x0e2\x1d.costing.v1.ParameterDataTypeB\n" +
-	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\bdataType\x12\x15\n" +
-	"\x03uom\x18\x05 \x01(\tH\x00R\x03uom\x88\x01\x01\x12 \n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\bdataType\x12\x1e\n" +
+	"\x03uom\x18\x05 \x01(\tB\a\xbaH\x04r\x02\x18\x14H\x00R\x03uom\x88\x01\x01\x12 \n" +
 	"\tmin_value\x18\x06 \x01(\x01H\x01R\bminValue\x88\x01\x01\x12 \n" +
 	"\tmax_value\x18\a \x01(\x01H\x02R\bmaxValue\x88\x01\x01\x12%\n" +
 	"\x0eallowed_values\x18\b \x03(\tR\rallowedValues\x12!\n" +
 	"\fis_mandatory\x18\t \x01(\bR\visMandatory\x12%\n" +
 	"\vdescription\x18\n" +
 	" \x01(\tH\x03R\vdescription\x88\x01\x01\x12\x1b\n" +
-	"\tis_active\x18\v \x01(\bR\bisActiveB\x06\n" +
+	"\tis_active\x18\v \x01(\bR\bisActive\x12!\n" +
+	"\aversion\x18\f \x01(\x05B\a\xbaH\x04\x1a\x02(\x01R\aversionB\x06\n" +
 	"\x04_uomB\f\n" +
 	"\n" +
 	"_min_valueB\f\n" +
 	"\n" +
 	"_max_valueB\x0e\n" +
-	"\f_description\"r\n" +
+	"\f_description\"\xbd\x01\n" +
 	"\x17UpdateParameterResponse\x12,\n" +
 	"\x04base\x18\x01 \x01(\v2\x18.costing.v1.BaseResponseR\x04base\x12)\n" +
-	"\x04data\x18\x02 \x01(\v2\x15.costing.v1.ParameterR\x04data\"J\n" +
+	"\x04data\x18\x02 \x01(\v2\x15.costing.v1.ParameterR\x04data\x12I\n" +
+	"\x0echange_request\x18\x03 \x01(\v2\".costing.v1.ParameterChangeRequestR\rchangeRequest\"J\n" +
 	"\x16DeleteParameterRequest\x120\n" +
-	"\x0eparameter_code\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x182R\rparameterCode\"G\n" +
+	"\x0eparameter_code\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x182R\rparameterCode\"\x92\x01\n" +
 	"\x17DeleteParameterResponse\x12,\n" +
-	"\x04base\x18\x01 \x01(\v2\x18.costing.v1.BaseResponseR\x04base*\xd7\x01\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.costing.v1.BaseResponseR\x04base\x12I\n" +
+	"\x0echange_request\x18\x02 \x01(\v2\".costing.v1.ParameterChangeRequestR\rchangeRequest\"K\n" +
+	"\x17RestoreParameterRequest\x120\n" +
+	"\x0eparameter_code\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x182R\rparameterCode\"\xbe\x01\n" +
+	"\x18RestoreParameterResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.costing.v1.BaseResponseR\x04base\x12)\n" +
+	"\x04data\x18\x02 \x01(\v2\x15.costing.v1.ParameterR\x04data\x12I\n" +
+	"\x0echange_request\x18\x03 \x01(\v2\".costing.v1.ParameterChangeRequestR\rchangeRequest\"\x95\x05\n" +
+	"\x13UpsertParameterItem\x12C\n" +
+	"\x0eparameter_code\x18\x01 \x01(\tB\x1c\xbaH\x19r\x17\x10\x01\x1822\x11^[A-Z][A-Z0-9_]*$R\rparameterCode\x121\n" +
+	"\x0eparameter_name\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xc8\x01R\rparameterName\x12X\n" +
+	"\x12parameter_category\x18\x03 \x01(\x0e2\x1d.costing.v1.ParameterCategoryB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x11parameterCategory\x12F\n" +
+	"\tdata_type\x18\x04 \x01(\x0e2\x1d.costing.v1.ParameterDataTypeB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\bdataType\x12\x1e\n" +
+	"\x03uom\x18\x05 \x01(\tB\a\xbaH\x04r\x02\x18\x14H\x00R\x03uom\x88\x01\x01\x12 \n" +
+	"\tmin_value\x18\x06 \x01(\x01H\x01R\bminValue\x88\x01\x01\x12 \n" +
+	"\tmax_value\x18\a \x01(\x01H\x02R\bmaxValue\x88\x01\x01\x12%\n" +
+	"\x0eallowed_values\x18\b \x03(\tR\rallowedValues\x12!\n" +
+	"\fis_mandatory\x18\t \x01(\bR\visMandatory\x12/\n" +
+	"\vdescription\x18\n" +
+	" \x01(\tB\b\xbaH\x05r\x03\x18\xe8\aH\x03R\vdescription\x88\x01\x01\x12 \n" +
+	"\tis_active\x18\v \x01(\bH\x04R\bisActive\x88\x01\x01\x12!\n" +
+	"\aversion\x18\f \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\aversionB\x06\n" +
+	"\x04_uomB\f\n" +
+	"\n" +
+	"_min_valueB\f\n" +
+	"\n" +
+	"_max_valueB\x0e\n" +
+	"\f_descriptionB\f\n" +
+	"\n" +
+	"_is_active\"\x80\x01\n" +
+	"\x1cBatchUpsertParametersRequest\x125\n" +
+	"\x05items\x18\x01 \x03(\v2\x1f.costing.v1.UpsertParameterItemR\x05items\x12)\n" +
+	"\x04mode\x18\x02 \x01(\x0e2\x15.costing.v1.BatchModeR\x04mode\"\xe2\x01\n" +
+	"\x15UpsertParameterResult\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12%\n" +
+	"\x0eparameter_code\x18\x02 \x01(\tR\rparameterCode\x123\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x1b.costing.v1.BatchItemStatusR\x06status\x12,\n" +
+	"\x04base\x18\x04 \x01(\v2\x18.costing.v1.BaseResponseR\x04base\x12)\n" +
+	"\x04data\x18\x05 \x01(\v2\x15.costing.v1.ParameterR\x04data\"\xbe\x01\n" +
+	"\x1dBatchUpsertParametersResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.costing.v1.BaseResponseR\x04base\x12;\n" +
+	"\aresults\x18\x02 \x03(\v2!.costing.v1.UpsertParameterResultR\aresults\x122\n" +
+	"\asummary\x18\x03 \x01(\v2\x18.costing.v1.BatchSummaryR\asummary*\xd7\x01\n" +
 	"\x11ParameterCategory\x12\"\n" +
 	"\x1ePARAMETER_CATEGORY_UNSPECIFIED\x10\x00\x12\x1e\n" +
 	"\x1aPARAMETER_CATEGORY_MACHINE\x10\x01\x12\x1f\n" +
@@ -1051,13 +2312,28 @@ const file_costing_v1_parameter_proto_rawDesc = "" +
 	"\x1bPARAMETER_DATA_TYPE_NUMERIC\x10\x01\x12\x1c\n" +
 	"\x18PARAMETER_DATA_TYPE_TEXT\x10\x02\x12\x1f\n" +
 	"\x1bPARAMETER_DATA_TYPE_BOOLEAN\x10\x03\x12 \n" +
-	"\x1cPARAMETER_DATA_TYPE_DROPDOWN\x10\x042\x85\x05\n" +
+	"\x1cPARAMETER_DATA_TYPE_DROPDOWN\x10\x04*\x89\x02\n" +
+	"\x12ParameterSortField\x12$\n" +
+	" PARAMETER_SORT_FIELD_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19PARAMETER_SORT_FIELD_CODE\x10\x01\x12\x1d\n" +
+	"\x19PARAMETER_SORT_FIELD_NAME\x10\x02\x12!\n" +
+	"\x1dPARAMETER_SORT_FIELD_CATEGORY\x10\x03\x12\"\n" +
+	"\x1ePARAMETER_SORT_FIELD_DATA_TYPE\x10\x04\x12#\n" +
+	"\x1fPARAMETER_SORT_FIELD_CREATED_AT\x10\x05\x12#\n" +
+	"\x1fPARAMETER_SORT_FIELD_UPDATED_AT\x10\x062\xc7\n" +
+	"\n" +
 	"\x10ParameterService\x12u\n" +
 	"\x0fCreateParameter\x12\".costing.v1.CreateParameterRequest\x1a#.costing.v1.CreateParameterResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/parameters\x12z\n" +
-	"\fGetParameter\x12\x1f.costing.v1.GetParameterRequest\x1a .costing.v1.GetParameterResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/v1/parameters/{parameter_code}\x12o\n" +
+	"\fGetParameter\x12\x1f.costing.v1.GetParameterRequest\x1a .costing.v1.GetParameterResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/v1/parameters/{parameter_code}\x12\x9e\x01\n" +
+	"\x15ListParameterVersions\x12(.costing.v1.ListParameterVersionsRequest\x1a).costing.v1.ListParameterVersionsResponse\"0\x82\xd3\xe4\x93\x02*\x12(/v1/parameters/{parameter_code}/versions\x12o\n" +
 	"\x0eListParameters\x12!.costing.v1.ListParametersRequest\x1a\".costing.v1.ListParametersResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/parameters\x12\x86\x01\n" +
 	"\x0fUpdateParameter\x12\".costing.v1.UpdateParameterRequest\x1a#.costing.v1.UpdateParameterResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\x1a\x1f/v1/parameters/{parameter_code}\x12\x83\x01\n" +
-	"\x0fDeleteParameter\x12\".costing.v1.DeleteParameterRequest\x1a#.costing.v1.DeleteParameterResponse\"'\x82\xd3\xe4\x93\x02!*\x1f/v1/parameters/{parameter_code}B\xb1\x01\n" +
+	"\x0fDeleteParameter\x12\".costing.v1.DeleteParameterRequest\x1a#.costing.v1.DeleteParameterResponse\"'\x82\xd3\xe4\x93\x02!*\x1f/v1/parameters/{parameter_code}\x12\x91\x01\n" +
+	"\x10RestoreParameter\x12#.costing.v1.RestoreParameterRequest\x1a$.costing.v1.RestoreParameterResponse\"2\x82\xd3\xe4\x93\x02,:\x01*\"'/v1/parameters/{parameter_code}:restore\x12\x93\x01\n" +
+	"\x15BatchUpsertParameters\x12(.costing.v1.BatchUpsertParametersRequest\x1a).costing.v1.BatchUpsertParametersResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/parameters:batchUpsert\x12K\n" +
+	"\x10ImportParameters\x12\x19.costing.v1.ImportRequest\x1a\x1a.costing.v1.ImportResponse(\x01\x12R\n" +
+	"\x10ExportParameters\x12#.costing.v1.ExportParametersRequest\x1a\x17.costing.v1.ExportChunk0\x01\x12T\n" +
+	"\x0fWatchParameters\x12\".costing.v1.WatchParametersRequest\x1a\x1b.costing.v1.ParameterChange0\x01B\xb1\x01\n" +
 	"\x0ecom.costing.v1B\x0eParameterProtoP\x01ZFgithub.com/homindolenern/goapps-costing-v1/gen/go/costing/v1;costingv1\xa2\x02\x03CXX\xaa\x02\n" +
 	"Costing.V1\xca\x02\n" +
 	"Costing\\V1\xe2\x02\x16Costing\\V1\\GPBMetadata\xea\x02\vCosting::V1b\x06proto3"
@@ -1074,60 +2350,124 @@ func file_costing_v1_parameter_proto_rawDescGZIP() []byte {
 	return file_costing_v1_parameter_proto_rawDescData
 }
 
-var file_costing_v1_parameter_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_costing_v1_parameter_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_costing_v1_parameter_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_costing_v1_parameter_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_costing_v1_parameter_proto_goTypes = []any{
-	(ParameterCategory)(0),          // 0: costing.v1.ParameterCategory
-	(ParameterDataType)(0),          // 1: costing.v1.ParameterDataType
-	(*Parameter)(nil),               // 2: costing.v1.Parameter
-	(*CreateParameterRequest)(nil),  // 3: costing.v1.CreateParameterRequest
-	(*CreateParameterResponse)(nil), // 4: costing.v1.CreateParameterResponse
-	(*GetParameterRequest)(nil),     // 5: costing.v1.GetParameterRequest
-	(*GetParameterResponse)(nil),    // 6: costing.v1.GetParameterResponse
-	(*ListParametersRequest)(nil),   // 7: costing.v1.ListParametersRequest
-	(*ListParametersResponse)(nil),  // 8: costing.v1.ListParametersResponse
-	(*UpdateParameterRequest)(nil),  // 9: costing.v1.UpdateParameterRequest
-	(*UpdateParameterResponse)(nil), // 10: costing.v1.UpdateParameterResponse
-	(*DeleteParameterRequest)(nil),  // 11: costing.v1.DeleteParameterRequest
-	(*DeleteParameterResponse)(nil), // 12: costing.v1.DeleteParameterResponse
-	(*AuditInfo)(nil),               // 13: costing.v1.AuditInfo
-	(*BaseResponse)(nil),            // 14: costing.v1.BaseResponse
-	(*PaginationMeta)(nil),          // 15: costing.v1.PaginationMeta
+	(ParameterCategory)(0),                // 0: costing.v1.ParameterCategory
+	(ParameterDataType)(0),                // 1: costing.v1.ParameterDataType
+	(ParameterSortField)(0),               // 2: costing.v1.ParameterSortField
+	(*Parameter)(nil),                     // 3: costing.v1.Parameter
+	(*CreateParameterRequest)(nil),        // 4: costing.v1.CreateParameterRequest
+	(*CreateParameterResponse)(nil),       // 5: costing.v1.CreateParameterResponse
+	(*GetParameterRequest)(nil),           // 6: costing.v1.GetParameterRequest
+	(*GetParameterResponse)(nil),          // 7: costing.v1.GetParameterResponse
+	(*ParameterVersion)(nil),              // 8: costing.v1.ParameterVersion
+	(*ListParameterVersionsRequest)(nil),  // 9: costing.v1.ListParameterVersionsRequest
+	(*ListParameterVersionsResponse)(nil), // 10: costing.v1.ListParameterVersionsResponse
+	(*ListParametersRequest)(nil),         // 11: costing.v1.ListParametersRequest
+	(*ListParametersResponse)(nil),        // 12: costing.v1.ListParametersResponse
+	(*ExportParametersRequest)(nil),       // 13: costing.v1.ExportParametersRequest
+	(*WatchParametersRequest)(nil),        // 14: costing.v1.WatchParametersRequest
+	(*ParameterChange)(nil),               // 15: costing.v1.ParameterChange
+	(*UpdateParameterRequest)(nil),        // 16: costing.v1.UpdateParameterRequest
+	(*UpdateParameterResponse)(nil),       // 17: costing.v1.UpdateParameterResponse
+	(*DeleteParameterRequest)(nil),        // 18: costing.v1.DeleteParameterRequest
+	(*DeleteParameterResponse)(nil),       // 19: costing.v1.DeleteParameterResponse
+	(*RestoreParameterRequest)(nil),       // 20: costing.v1.RestoreParameterRequest
+	(*RestoreParameterResponse)(nil),      // 21: costing.v1.RestoreParameterResponse
+	(*UpsertParameterItem)(nil),           // 22: costing.v1.UpsertParameterItem
+	(*BatchUpsertParametersRequest)(nil),  // 23: costing.v1.BatchUpsertParametersRequest
+	(*UpsertParameterResult)(nil),         // 24: costing.v1.UpsertParameterResult
+	(*BatchUpsertParametersResponse)(nil), // 25: costing.v1.BatchUpsertParametersResponse
+	(*AuditInfo)(nil),                     // 26: costing.v1.AuditInfo
+	(*BaseResponse)(nil),                  // 27: costing.v1.BaseResponse
+	(*PaginationMeta)(nil),                // 28: costing.v1.PaginationMeta
+	(SortDirection)(0),                    // 29: costing.v1.SortDirection
+	(FileFormat)(0),                       // 30: costing.v1.FileFormat
+	(ChangeType)(0),                       // 31: costing.v1.ChangeType
+	(*ParameterChangeRequest)(nil),        // 32: costing.v1.ParameterChangeRequest
+	(BatchMode)(0),                        // 33: costing.v1.BatchMode
+	(BatchItemStatus)(0),                  // 34: costing.v1.BatchItemStatus
+	(*BatchSummary)(nil),                  // 35: costing.v1.BatchSummary
+	(*ImportRequest)(nil),                 // 36: costing.v1.ImportRequest
+	(*ImportResponse)(nil),                // 37: costing.v1.ImportResponse
+	(*ExportChunk)(nil),                   // 38: costing.v1.ExportChunk
 }
 var file_costing_v1_parameter_proto_depIdxs = []int32{
 	0,  // 0: costing.v1.Parameter.parameter_category:type_name -> costing.v1.ParameterCategory
 	1,  // 1: costing.v1.Parameter.data_type:type_name -> costing.v1.ParameterDataType
-	13, // 2: costing.v1.Parameter.audit:type_name -> costing.v1.AuditInfo
+	26, // 2: costing.v1.Parameter.audit:type_name -> costing.v1.AuditInfo
 	0,  // 3: costing.v1.CreateParameterRequest.parameter_category:type_name -> costing.v1.ParameterCategory
 	1,  // 4: costing.v1.CreateParameterRequest.data_type:type_name -> costing.v1.ParameterDataType
-	14, // 5: costing.v1.CreateParameterResponse.base:type_name -> costing.v1.BaseResponse
-	2,  // 6: costing.v1.CreateParameterResponse.data:type_name -> costing.v1.Parameter
-	14, // 7: costing.v1.GetParameterResponse.base:type_name -> costing.v1.BaseResponse
-	2,  // 8: costing.v1.GetParameterResponse.data:type_name -> costing.v1.Parameter
-	0,  // 9: costing.v1.ListParametersRequest.category:type_name -> costing.v1.ParameterCategory
-	14, // 10: costing.v1.ListParametersResponse.base:type_name -> costing.v1.BaseResponse
-	2,  // 11: costing.v1.ListParametersResponse.data:type_name -> costing.v1.Parameter
-	15, // 12: costing.v1.ListParametersResponse.pagination:type_name -> costing.v1.PaginationMeta
-	0,  // 13: costing.v1.UpdateParameterRequest.parameter_category:type_name -> costing.v1.ParameterCategory
-	1,  // 14: costing.v1.UpdateParameterRequest.data_type:type_name -> costing.v1.ParameterDataType
-	14, // 15: costing.v1.UpdateParameterResponse.base:type_name -> costing.v1.BaseResponse
-	2,  // 16: costing.v1.UpdateParameterResponse.data:type_name -> costing.v1.Parameter
-	14, // 17: costing.v1.DeleteParameterResponse.base:type_name -> costing.v1.BaseResponse
-	3,  // 18: costing.v1.ParameterService.CreateParameter:input_type -> costing.v1.CreateParameterRequest
-	5,  // 19: costing.v1.ParameterService.GetParameter:input_type -> costing.v1.GetParameterRequest
-	7,  // 20: costing.v1.ParameterService.ListParameters:input_type -> costing.v1.ListParametersRequest
-	9,  // 21: costing.v1.ParameterService.UpdateParameter:input_type -> costing.v1.UpdateParameterRequest
-	11, // 22: costing.v1.ParameterService.DeleteParameter:input_type -> costing.v1.DeleteParameterRequest
-	4,  // 23: costing.v1.ParameterService.CreateParameter:output_type -> costing.v1.CreateParameterResponse
-	6,  // 24: costing.v1.ParameterService.GetParameter:output_type -> costing.v1.GetParameterResponse
-	8,  // 25: costing.v1.ParameterService.ListParameters:output_type -> costing.v1.ListParametersResponse
-	10, // 26: costing.v1.ParameterService.UpdateParameter:output_type -> costing.v1.UpdateParameterResponse
-	12, // 27: costing.v1.ParameterService.DeleteParameter:output_type -> costing.v1.DeleteParameterResponse
-	23, // [23:28] is the sub-list for method output_type
-	18, // [18:23] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+	27, // 5: costing.v1.CreateParameterResponse.base:type_name -> costing.v1.BaseResponse
+	3,  // 6: costing.v1.CreateParameterResponse.data:type_name -> costing.v1.Parameter
+	27, // 7: costing.v1.GetParameterResponse.base:type_name -> costing.v1.BaseResponse
+	3,  // 8: costing.v1.GetParameterResponse.data:type_name -> costing.v1.Parameter
+	3,  // 9: costing.v1.ParameterVersion.parameter:type_name -> costing.v1.Parameter
+	27, // 10: costing.v1.ListParameterVersionsResponse.base:type_name -> costing.v1.BaseResponse
+	8,  // 11: costing.v1.ListParameterVersionsResponse.data:type_name -> costing.v1.ParameterVersion
+	28, // 12: costing.v1.ListParameterVersionsResponse.pagination:type_name -> costing.v1.PaginationMeta
+	0,  // 13: costing.v1.ListParametersRequest.category:type_name -> costing.v1.ParameterCategory
+	1,  // 14: costing.v1.ListParametersRequest.data_type:type_name -> costing.v1.ParameterDataType
+	2,  // 15: costing.v1.ListParametersRequest.sort_by:type_name -> costing.v1.ParameterSortField
+	29, // 16: costing.v1.ListParametersRequest.sort_direction:type_name -> costing.v1.SortDirection
+	27, // 17: costing.v1.ListParametersResponse.base:type_name -> costing.v1.BaseResponse
+	3,  // 18: costing.v1.ListParametersResponse.data:type_name -> costing.v1.Parameter
+	28, // 19: costing.v1.ListParametersResponse.pagination:type_name -> costing.v1.PaginationMeta
+	30, // 20: costing.v1.ExportParametersRequest.format:type_name -> costing.v1.FileFormat
+	0,  // 21: costing.v1.ExportParametersRequest.category:type_name -> costing.v1.ParameterCategory
+	1,  // 22: costing.v1.ExportParametersRequest.data_type:type_name -> costing.v1.ParameterDataType
+	2,  // 23: costing.v1.ExportParametersRequest.sort_by:type_name -> costing.v1.ParameterSortField
+	29, // 24: costing.v1.ExportParametersRequest.sort_direction:type_name -> costing.v1.SortDirection
+	31, // 25: costing.v1.ParameterChange.change_type:type_name -> costing.v1.ChangeType
+	3,  // 26: costing.v1.ParameterChange.parameter:type_name -> costing.v1.Parameter
+	0,  // 27: costing.v1.UpdateParameterRequest.parameter_category:type_name -> costing.v1.ParameterCategory
+	1,  // 28: costing.v1.UpdateParameterRequest.data_type:type_name -> costing.v1.ParameterDataType
+	27, // 29: costing.v1.UpdateParameterResponse.base:type_name -> costing.v1.BaseResponse
+	3,  // 30: costing.v1.UpdateParameterResponse.data:type_name -> costing.v1.Parameter
+	32, // 31: costing.v1.UpdateParameterResponse.change_request:type_name -> costing.v1.ParameterChangeRequest
+	27, // 32: costing.v1.DeleteParameterResponse.base:type_name -> costing.v1.BaseResponse
+	32, // 33: costing.v1.DeleteParameterResponse.change_request:type_name -> costing.v1.ParameterChangeRequest
+	27, // 34: costing.v1.RestoreParameterResponse.base:type_name -> costing.v1.BaseResponse
+	3,  // 35: costing.v1.RestoreParameterResponse.data:type_name -> costing.v1.Parameter
+	32, // 36: costing.v1.RestoreParameterResponse.change_request:type_name -> costing.v1.ParameterChangeRequest
+	0,  // 37: costing.v1.UpsertParameterItem.parameter_category:type_name -> costing.v1.ParameterCategory
+	1,  // 38: costing.v1.UpsertParameterItem.data_type:type_name -> costing.v1.ParameterDataType
+	22, // 39: costing.v1.BatchUpsertParametersRequest.items:type_name -> costing.v1.UpsertParameterItem
+	33, // 40: costing.v1.BatchUpsertParametersRequest.mode:type_name -> costing.v1.BatchMode
+	34, // 41: costing.v1.UpsertParameterResult.status:type_name -> costing.v1.BatchItemStatus
+	27, // 42: costing.v1.UpsertParameterResult.base:type_name -> costing.v1.BaseResponse
+	3,  // 43: costing.v1.UpsertParameterResult.data:type_name -> costing.v1.Parameter
+	27, // 44: costing.v1.BatchUpsertParametersResponse.base:type_name -> costing.v1.BaseResponse
+	24, // 45: costing.v1.BatchUpsertParametersResponse.results:type_name -> costing.v1.UpsertParameterResult
+	35, // 46: costing.v1.BatchUpsertParametersResponse.summary:type_name -> costing.v1.BatchSummary
+	4,  // 47: costing.v1.ParameterService.CreateParameter:input_type -> costing.v1.CreateParameterRequest
+	6,  // 48: costing.v1.ParameterService.GetParameter:input_type -> costing.v1.GetParameterRequest
+	9,  // 49: costing.v1.ParameterService.ListParameterVersions:input_type -> costing.v1.ListParameterVersionsRequest
+	11, // 50: costing.v1.ParameterService.ListParameters:input_type -> costing.v1.ListParametersRequest
+	16, // 51: costing.v1.ParameterService.UpdateParameter:input_type -> costing.v1.UpdateParameterRequest
+	18, // 52: costing.v1.ParameterService.DeleteParameter:input_type -> costing.v1.DeleteParameterRequest
+	20, // 53: costing.v1.ParameterService.RestoreParameter:input_type -> costing.v1.RestoreParameterRequest
+	23, // 54: costing.v1.ParameterService.BatchUpsertParameters:input_type -> costing.v1.BatchUpsertParametersRequest
+	36, // 55: costing.v1.ParameterService.ImportParameters:input_type -> costing.v1.ImportRequest
+	13, // 56: costing.v1.ParameterService.ExportParameters:input_type -> costing.v1.ExportParametersRequest
+	14, // 57: costing.v1.ParameterService.WatchParameters:input_type -> costing.v1.WatchParametersRequest
+	5,  // 58: costing.v1.ParameterService.CreateParameter:output_type -> costing.v1.CreateParameterResponse
+	7,  // 59: costing.v1.ParameterService.GetParameter:output_type -> costing.v1.GetParameterResponse
+	10, // 60: costing.v1.ParameterService.ListParameterVersions:output_type -> costing.v1.ListParameterVersionsResponse
+	12, // 61: costing.v1.ParameterService.ListParameters:output_type -> costing.v1.ListParametersResponse
+	17, // 62: costing.v1.ParameterService.UpdateParameter:output_type -> costing.v1.UpdateParameterResponse
+	19, // 63: costing.v1.ParameterService.DeleteParameter:output_type -> costing.v1.DeleteParameterResponse
+	21, // 64: costing.v1.ParameterService.RestoreParameter:output_type -> costing.v1.RestoreParameterResponse
+	25, // 65: costing.v1.ParameterService.BatchUpsertParameters:output_type -> costing.v1.BatchUpsertParametersResponse
+	37, // 66: costing.v1.ParameterService.ImportParameters:output_type -> costing.v1.ImportResponse
+	38, // 67: costing.v1.ParameterService.ExportParameters:output_type -> costing.v1.ExportChunk
+	15, // 68: costing.v1.ParameterService.WatchParameters:output_type -> costing.v1.ParameterChange
+	58, // [58:69] is the sub-list for method output_type
+	47, // [47:58] is the sub-list for method input_type
+	47, // [47:47] is the sub-list for extension type_name
+	47, // [47:47] is the sub-list for extension extendee
+	0,  // [0:47] is the sub-list for field type_name
 }
 
 func init() { file_costing_v1_parameter_proto_init() }
@@ -1136,17 +2476,22 @@ func file_costing_v1_parameter_proto_init() {
 		return
 	}
 	file_costing_v1_common_proto_init()
+	file_costing_v1_parameter_change_proto_init()
 	file_costing_v1_parameter_proto_msgTypes[0].OneofWrappers = []any{}
 	file_costing_v1_parameter_proto_msgTypes[1].OneofWrappers = []any{}
+	file_costing_v1_parameter_proto_msgTypes[3].OneofWrappers = []any{}
 	file_costing_v1_parameter_proto_msgTypes[5].OneofWrappers = []any{}
-	file_costing_v1_parameter_proto_msgTypes[7].OneofWrappers = []any{}
+	file_costing_v1_parameter_proto_msgTypes[8].OneofWrappers = []any{}
+	file_costing_v1_parameter_proto_msgTypes[10].OneofWrappers = []any{}
+	file_costing_v1_parameter_proto_msgTypes[13].OneofWrappers = []any{}
+	file_costing_v1_parameter_proto_msgTypes[19].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_costing_v1_parameter_proto_rawDesc), len(file_costing_v1_parameter_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   11,
+			NumEnums:      3,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
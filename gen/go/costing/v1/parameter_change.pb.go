@@ -0,0 +1,877 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: costing/v1/parameter_change.proto
+
+package costingv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ParameterChangeStatus represents the review state of a change request
+type ParameterChangeStatus int32
+
+const (
+	ParameterChangeStatus_PARAMETER_CHANGE_STATUS_UNSPECIFIED ParameterChangeStatus = 0
+	ParameterChangeStatus_PARAMETER_CHANGE_STATUS_PENDING     ParameterChangeStatus = 1 // Awaiting review
+	ParameterChangeStatus_PARAMETER_CHANGE_STATUS_APPROVED    ParameterChangeStatus = 2 // Applied to the parameter
+	ParameterChangeStatus_PARAMETER_CHANGE_STATUS_REJECTED    ParameterChangeStatus = 3 // Never applied
+)
+
+// Enum value maps for ParameterChangeStatus.
+var (
+	ParameterChangeStatus_name = map[int32]string{
+		0: "PARAMETER_CHANGE_STATUS_UNSPECIFIED",
+		1: "PARAMETER_CHANGE_STATUS_PENDING",
+		2: "PARAMETER_CHANGE_STATUS_APPROVED",
+		3: "PARAMETER_CHANGE_STATUS_REJECTED",
+	}
+	ParameterChangeStatus_value = map[string]int32{
+		"PARAMETER_CHANGE_STATUS_UNSPECIFIED": 0,
+		"PARAMETER_CHANGE_STATUS_PENDING":     1,
+		"PARAMETER_CHANGE_STATUS_APPROVED":    2,
+		"PARAMETER_CHANGE_STATUS_REJECTED":    3,
+	}
+)
+
+func (x ParameterChangeStatus) Enum() *ParameterChangeStatus {
+	p := new(ParameterChangeStatus)
+	*p = x
+	return p
+}
+
+func (x ParameterChangeStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ParameterChangeStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_costing_v1_parameter_change_proto_enumTypes[0].Descriptor()
+}
+
+func (ParameterChangeStatus) Type() protoreflect.EnumType {
+	return &file_costing_v1_parameter_change_proto_enumTypes[0]
+}
+
+func (x ParameterChangeStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ParameterChangeStatus.Descriptor instead.
+func (ParameterChangeStatus) EnumDescriptor() ([]byte, []int) {
+	return file_costing_v1_parameter_change_proto_rawDescGZIP(), []int{0}
+}
+
+// ParameterChangeAction is what a change request does to its Parameter
+type ParameterChangeAction int32
+
+const (
+	ParameterChangeAction_PARAMETER_CHANGE_ACTION_UNSPECIFIED ParameterChangeAction = 0
+	ParameterChangeAction_PARAMETER_CHANGE_ACTION_UPDATE      ParameterChangeAction = 1 // Applies the edit
+	ParameterChangeAction_PARAMETER_CHANGE_ACTION_DELETE      ParameterChangeAction = 2 // Soft-deletes the parameter
+	ParameterChangeAction_PARAMETER_CHANGE_ACTION_RESTORE     ParameterChangeAction = 3 // Restores the soft-deleted parameter
+)
+
+// Enum value maps for ParameterChangeAction.
+var (
+	ParameterChangeAction_name = map[int32]string{
+		0: "PARAMETER_CHANGE_ACTION_UNSPECIFIED",
+		1: "PARAMETER_CHANGE_ACTION_UPDATE",
+		2: "PARAMETER_CHANGE_ACTION_DELETE",
+		3: "PARAMETER_CHANGE_ACTION_RESTORE",
+	}
+	ParameterChangeAction_value = map[string]int32{
+		"PARAMETER_CHANGE_ACTION_UNSPECIFIED": 0,
+		"PARAMETER_CHANGE_ACTION_UPDATE":      1,
+		"PARAMETER_CHANGE_ACTION_DELETE":      2,
+		"PARAMETER_CHANGE_ACTION_RESTORE":     3,
+	}
+)
+
+func (x ParameterChangeAction) Enum() *ParameterChangeAction {
+	p := new(ParameterChangeAction)
+	*p = x
+	return p
+}
+
+func (x ParameterChangeAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ParameterChangeAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_costing_v1_parameter_change_proto_enumTypes[1].Descriptor()
+}
+
+func (ParameterChangeAction) Type() protoreflect.EnumType {
+	return &file_costing_v1_parameter_change_proto_enumTypes[1]
+}
+
+func (x ParameterChangeAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ParameterChangeAction.Descriptor instead.
+func (ParameterChangeAction) EnumDescriptor() ([]byte, []int) {
+	return file_costing_v1_parameter_change_proto_rawDescGZIP(), []int{1}
+}
+
+// ParameterChangeRequest is an edit, delete or restore of a Parameter held for review
+type ParameterChangeRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ChangeRequestId int64                  `protobuf:"varint,1,opt,name=change_request_id,json=changeRequestId,proto3" json:"change_request_id,omitempty"`
+	ParameterCode   string                 `protobuf:"bytes,2,opt,name=parameter_code,json=parameterCode,proto3" json:"parameter_code,omitempty"`
+	BaseVersion     int32                  `protobuf:"varint,3,opt,name=base_version,json=baseVersion,proto3" json:"base_version,omitempty"` // Parameter version the edit was made against
+	Status          ParameterChangeStatus  `protobuf:"varint,4,opt,name=status,proto3,enum=costing.v1.ParameterChangeStatus" json:"status,omitempty"`
+	Changes         []*FieldChange         `protobuf:"bytes,5,rep,name=changes,proto3" json:"changes,omitempty"`
+	RequestedBy     string                 `protobuf:"bytes,6,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	RequestedAt     string                 `protobuf:"bytes,7,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"`
+	ReviewedBy      *string                `protobuf:"bytes,8,opt,name=reviewed_by,json=reviewedBy,proto3,oneof" json:"reviewed_by,omitempty"`
+	ReviewedAt      *string                `protobuf:"bytes,9,opt,name=reviewed_at,json=reviewedAt,proto3,oneof" json:"reviewed_at,omitempty"`
+	ReviewComment   *string                `protobuf:"bytes,10,opt,name=review_comment,json=reviewComment,proto3,oneof" json:"review_comment,omitempty"`
+	Action          ParameterChangeAction  `protobuf:"varint,11,opt,name=action,proto3,enum=costing.v1.ParameterChangeAction" json:"action,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ParameterChangeRequest) Reset() {
+	*x = ParameterChangeRequest{}
+	mi := &file_costing_v1_parameter_change_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParameterChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParameterChangeRequest) ProtoMessage() {}
+
+func (x *ParameterChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_parameter_change_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParameterChangeRequest.ProtoReflect.Descriptor instead.
+func (*ParameterChangeRequest) Descriptor() ([]byte, []int) {
+	return file_costing_v1_parameter_change_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ParameterChangeRequest) GetChangeRequestId() int64 {
+	if x != nil {
+		return x.ChangeRequestId
+	}
+	return 0
+}
+
+func (x *ParameterChangeRequest) GetParameterCode() string {
+	if x != nil {
+		return x.ParameterCode
+	}
+	return ""
+}
+
+func (x *ParameterChangeRequest) GetBaseVersion() int32 {
+	if x != nil {
+		return x.BaseVersion
+	}
+	return 0
+}
+
+func (x *ParameterChangeRequest) GetStatus() ParameterChangeStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ParameterChangeStatus_PARAMETER_CHANGE_STATUS_UNSPECIFIED
+}
+
+func (x *ParameterChangeRequest) GetChanges() []*FieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *ParameterChangeRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *ParameterChangeRequest) GetRequestedAt() string {
+	if x != nil {
+		return x.RequestedAt
+	}
+	return ""
+}
+
+func (x *ParameterChangeRequest) GetReviewedBy() string {
+	if x != nil && x.ReviewedBy != nil {
+		return *x.ReviewedBy
+	}
+	return ""
+}
+
+func (x *ParameterChangeRequest) GetReviewedAt() string {
+	if x != nil && x.ReviewedAt != nil {
+		return *x.ReviewedAt
+	}
+	return ""
+}
+
+func (x *ParameterChangeRequest) GetReviewComment() string {
+	if x != nil && x.ReviewComment != nil {
+		return *x.ReviewComment
+	}
+	return ""
+}
+
+func (x *ParameterChangeRequest) GetAction() ParameterChangeAction {
+	if x != nil {
+		return x.Action
+	}
+	return ParameterChangeAction_PARAMETER_CHANGE_ACTION_UNSPECIFIED
+}
+
+// ListParameterChangeRequests
+type ListParameterChangeRequestsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	ParameterCode *string                `protobuf:"bytes,3,opt,name=parameter_code,json=parameterCode,proto3,oneof" json:"parameter_code,omitempty"`
+	Status        *ParameterChangeStatus `protobuf:"varint,4,opt,name=status,proto3,enum=costing.v1.ParameterChangeStatus,oneof" json:"status,omitempty"`
+	RequestedBy   *string                `protobuf:"bytes,5,opt,name=requested_by,json=requestedBy,proto3,oneof" json:"requested_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListParameterChangeRequestsRequest) Reset() {
+	*x = ListParameterChangeRequestsRequest{}
+	mi := &file_costing_v1_parameter_change_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListParameterChangeRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListParameterChangeRequestsRequest) ProtoMessage() {}
+
+func (x *ListParameterChangeRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_parameter_change_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListParameterChangeRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListParameterChangeRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_costing_v1_parameter_change_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListParameterChangeRequestsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListParameterChangeRequestsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListParameterChangeRequestsRequest) GetParameterCode() string {
+	if x != nil && x.ParameterCode != nil {
+		return *x.ParameterCode
+	}
+	return ""
+}
+
+func (x *ListParameterChangeRequestsRequest) GetStatus() ParameterChangeStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ParameterChangeStatus_PARAMETER_CHANGE_STATUS_UNSPECIFIED
+}
+
+func (x *ListParameterChangeRequestsRequest) GetRequestedBy() string {
+	if x != nil && x.RequestedBy != nil {
+		return *x.RequestedBy
+	}
+	return ""
+}
+
+type ListParameterChangeRequestsResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Base          *BaseResponse             `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Data          []*ParameterChangeRequest `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	Pagination    *PaginationMeta           `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListParameterChangeRequestsResponse) Reset() {
+	*x = ListParameterChangeRequestsResponse{}
+	mi := &file_costing_v1_parameter_change_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListParameterChangeRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListParameterChangeRequestsResponse) ProtoMessage() {}
+
+func (x *ListParameterChangeRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_parameter_change_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListParameterChangeRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListParameterChangeRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_costing_v1_parameter_change_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListParameterChangeRequestsResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListParameterChangeRequestsResponse) GetData() []*ParameterChangeRequest {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListParameterChangeRequestsResponse) GetPagination() *PaginationMeta {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// GetParameterChangeRequest
+type GetParameterChangeRequestRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ChangeRequestId int64                  `protobuf:"varint,1,opt,name=change_request_id,json=changeRequestId,proto3" json:"change_request_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetParameterChangeRequestRequest) Reset() {
+	*x = GetParameterChangeRequestRequest{}
+	mi := &file_costing_v1_parameter_change_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetParameterChangeRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetParameterChangeRequestRequest) ProtoMessage() {}
+
+func (x *GetParameterChangeRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_parameter_change_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetParameterChangeRequestRequest.ProtoReflect.Descriptor instead.
+func (*GetParameterChangeRequestRequest) Descriptor() ([]byte, []int) {
+	return file_costing_v1_parameter_change_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetParameterChangeRequestRequest) GetChangeRequestId() int64 {
+	if x != nil {
+		return x.ChangeRequestId
+	}
+	return 0
+}
+
+type GetParameterChangeRequestResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Base          *BaseResponse           `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Data          *ParameterChangeRequest `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetParameterChangeRequestResponse) Reset() {
+	*x = GetParameterChangeRequestResponse{}
+	mi := &file_costing_v1_parameter_change_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetParameterChangeRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetParameterChangeRequestResponse) ProtoMessage() {}
+
+func (x *GetParameterChangeRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_parameter_change_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetParameterChangeRequestResponse.ProtoReflect.Descriptor instead.
+func (*GetParameterChangeRequestResponse) Descriptor() ([]byte, []int) {
+	return file_costing_v1_parameter_change_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetParameterChangeRequestResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *GetParameterChangeRequestResponse) GetData() *ParameterChangeRequest {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// ApproveChange
+type ApproveChangeRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ChangeRequestId int64                  `protobuf:"varint,1,opt,name=change_request_id,json=changeRequestId,proto3" json:"change_request_id,omitempty"`
+	Comment         *string                `protobuf:"bytes,2,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ApproveChangeRequest) Reset() {
+	*x = ApproveChangeRequest{}
+	mi := &file_costing_v1_parameter_change_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveChangeRequest) ProtoMessage() {}
+
+func (x *ApproveChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_parameter_change_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveChangeRequest.ProtoReflect.Descriptor instead.
+func (*ApproveChangeRequest) Descriptor() ([]byte, []int) {
+	return file_costing_v1_parameter_change_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ApproveChangeRequest) GetChangeRequestId() int64 {
+	if x != nil {
+		return x.ChangeRequestId
+	}
+	return 0
+}
+
+func (x *ApproveChangeRequest) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
+	}
+	return ""
+}
+
+type ApproveChangeResponse struct {
+	state            protoimpl.MessageState  `protogen:"open.v1"`
+	Base             *BaseResponse           `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Data             *ParameterChangeRequest `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	ParameterVersion int32                   `protobuf:"varint,3,opt,name=parameter_version,json=parameterVersion,proto3" json:"parameter_version,omitempty"` // Version of the changed parameter
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ApproveChangeResponse) Reset() {
+	*x = ApproveChangeResponse{}
+	mi := &file_costing_v1_parameter_change_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveChangeResponse) ProtoMessage() {}
+
+func (x *ApproveChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_parameter_change_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveChangeResponse.ProtoReflect.Descriptor instead.
+func (*ApproveChangeResponse) Descriptor() ([]byte, []int) {
+	return file_costing_v1_parameter_change_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ApproveChangeResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ApproveChangeResponse) GetData() *ParameterChangeRequest {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ApproveChangeResponse) GetParameterVersion() int32 {
+	if x != nil {
+		return x.ParameterVersion
+	}
+	return 0
+}
+
+// RejectChange
+type RejectChangeRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ChangeRequestId int64                  `protobuf:"varint,1,opt,name=change_request_id,json=changeRequestId,proto3" json:"change_request_id,omitempty"`
+	Comment         *string                `protobuf:"bytes,2,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RejectChangeRequest) Reset() {
+	*x = RejectChangeRequest{}
+	mi := &file_costing_v1_parameter_change_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectChangeRequest) ProtoMessage() {}
+
+func (x *RejectChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_parameter_change_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectChangeRequest.ProtoReflect.Descriptor instead.
+func (*RejectChangeRequest) Descriptor() ([]byte, []int) {
+	return file_costing_v1_parameter_change_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RejectChangeRequest) GetChangeRequestId() int64 {
+	if x != nil {
+		return x.ChangeRequestId
+	}
+	return 0
+}
+
+func (x *RejectChangeRequest) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
+	}
+	return ""
+}
+
+type RejectChangeResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Base          *BaseResponse           `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Data          *ParameterChangeRequest `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectChangeResponse) Reset() {
+	*x = RejectChangeResponse{}
+	mi := &file_costing_v1_parameter_change_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectChangeResponse) ProtoMessage() {}
+
+func (x *RejectChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_parameter_change_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectChangeResponse.ProtoReflect.Descriptor instead.
+func (*RejectChangeResponse) Descriptor() ([]byte, []int) {
+	return file_costing_v1_parameter_change_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RejectChangeResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *RejectChangeResponse) GetData() *ParameterChangeRequest {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+var File_costing_v1_parameter_change_proto protoreflect.FileDescriptor
+
+const file_costing_v1_parameter_change_proto_rawDesc = "" +
+	"\n" +
+	"!costing/v1/parameter_change.proto\x12\n" +
+	"costing.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x16costing/v1/audit.proto\x1a\x17costing/v1/common.proto\"\xa8\x04\n" +
+	"\x16ParameterChangeRequest\x12*\n" +
+	"\x11change_request_id\x18\x01 \x01(\x03R\x0fchangeRequestId\x12%\n" +
+	"\x0eparameter_code\x18\x02 \x01(\tR\rparameterCode\x12!\n" +
+	"\fbase_version\x18\x03 \x01(\x05R\vbaseVersion\x129\n" +
+	"\x06status\x18\x04 \x01(\x0e2!.costing.v1.ParameterChangeStatusR\x06status\x121\n" +
+	"\achanges\x18\x05 \x03(\v2\x17.costing.v1.FieldChangeR\achanges\x12!\n" +
+	"\frequested_by\x18\x06 \x01(\tR\vrequestedBy\x12!\n" +
+	"\frequested_at\x18\a \x01(\tR\vrequestedAt\x12$\n" +
+	"\vreviewed_by\x18\b \x01(\tH\x00R\n" +
+	"reviewedBy\x88\x01\x01\x12$\n" +
+	"\vreviewed_at\x18\t \x01(\tH\x01R\n" +
+	"reviewedAt\x88\x01\x01\x12*\n" +
+	"\x0ereview_comment\x18\n" +
+	" \x01(\tH\x02R\rreviewComment\x88\x01\x01\x129\n" +
+	"\x06action\x18\v \x01(\x0e2!.costing.v1.ParameterChangeActionR\x06actionB\x0e\n" +
+	"\f_reviewed_byB\x0e\n" +
+	"\f_reviewed_atB\x11\n" +
+	"\x0f_review_comment\"\xc8\x02\n" +
+	"\"ListParameterChangeRequestsRequest\x12\x1b\n" +
+	"\x04page\x18\x01 \x01(\x05B\a\xbaH\x04\x1a\x02(\x01R\x04page\x12&\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x01R\bpageSize\x123\n" +
+	"\x0eparameter_code\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x182H\x00R\rparameterCode\x88\x01\x01\x12H\n" +
+	"\x06status\x18\x04 \x01(\x0e2!.costing.v1.ParameterChangeStatusB\b\xbaH\x05\x82\x01\x02\x10\x01H\x01R\x06status\x88\x01\x01\x12/\n" +
+	"\frequested_by\x18\x05 \x01(\tB\a\xbaH\x04r\x02\x18dH\x02R\vrequestedBy\x88\x01\x01B\x11\n" +
+	"\x0f_parameter_codeB\t\n" +
+	"\a_statusB\x0f\n" +
+	"\r_requested_by\"\xc7\x01\n" +
+	"#ListParameterChangeRequestsResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.costing.v1.BaseResponseR\x04base\x126\n" +
+	"\x04data\x18\x02 \x03(\v2\".costing.v1.ParameterChangeRequestR\x04data\x12:\n" +
+	"\n" +
+	"pagination\x18\x03 \x01(\v2\x1a.costing.v1.PaginationMetaR\n" +
+	"pagination\"W\n" +
+	" GetParameterChangeRequestRequest\x123\n" +
+	"\x11change_request_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x0fchangeRequestId\"\x89\x01\n" +
+	"!GetParameterChangeRequestResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.costing.v1.BaseResponseR\x04base\x126\n" +
+	"\x04data\x18\x02 \x01(\v2\".costing.v1.ParameterChangeRequestR\x04data\"\x80\x01\n" +
+	"\x14ApproveChangeRequest\x123\n" +
+	"\x11change_request_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x0fchangeRequestId\x12'\n" +
+	"\acomment\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\xf4\x03H\x00R\acomment\x88\x01\x01B\n" +
+	"\n" +
+	"\b_comment\"\xaa\x01\n" +
+	"\x15ApproveChangeResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.costing.v1.BaseResponseR\x04base\x126\n" +
+	"\x04data\x18\x02 \x01(\v2\".costing.v1.ParameterChangeRequestR\x04data\x12+\n" +
+	"\x11parameter_version\x18\x03 \x01(\x05R\x10parameterVersion\"\x7f\n" +
+	"\x13RejectChangeRequest\x123\n" +
+	"\x11change_request_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x0fchangeRequestId\x12'\n" +
+	"\acomment\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\xf4\x03H\x00R\acomment\x88\x01\x01B\n" +
+	"\n" +
+	"\b_comment\"|\n" +
+	"\x14RejectChangeResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.costing.v1.BaseResponseR\x04base\x126\n" +
+	"\x04data\x18\x02 \x01(\v2\".costing.v1.ParameterChangeRequestR\x04data*\xb1\x01\n" +
+	"\x15ParameterChangeStatus\x12'\n" +
+	"#PARAMETER_CHANGE_STATUS_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fPARAMETER_CHANGE_STATUS_PENDING\x10\x01\x12$\n" +
+	" PARAMETER_CHANGE_STATUS_APPROVED\x10\x02\x12$\n" +
+	" PARAMETER_CHANGE_STATUS_REJECTED\x10\x03*\xad\x01\n" +
+	"\x15ParameterChangeAction\x12'\n" +
+	"#PARAMETER_CHANGE_ACTION_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1ePARAMETER_CHANGE_ACTION_UPDATE\x10\x01\x12\"\n" +
+	"\x1ePARAMETER_CHANGE_ACTION_DELETE\x10\x02\x12#\n" +
+	"\x1fPARAMETER_CHANGE_ACTION_RESTORE\x10\x032\xac\x05\n" +
+	"\x16ParameterChangeService\x12\xa5\x01\n" +
+	"\x1bListParameterChangeRequests\x12..costing.v1.ListParameterChangeRequestsRequest\x1a/.costing.v1.ListParameterChangeRequestsResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/parameter-change-requests\x12\xb3\x01\n" +
+	"\x19GetParameterChangeRequest\x12,.costing.v1.GetParameterChangeRequestRequest\x1a-.costing.v1.GetParameterChangeRequestResponse\"9\x82\xd3\xe4\x93\x023\x121/v1/parameter-change-requests/{change_request_id}\x12\x9a\x01\n" +
+	"\rApproveChange\x12 .costing.v1.ApproveChangeRequest\x1a!.costing.v1.ApproveChangeResponse\"D\x82\xd3\xe4\x93\x02>:\x01*\"9/v1/parameter-change-requests/{change_request_id}:approve\x12\x96\x01\n" +
+	"\fRejectChange\x12\x1f.costing.v1.RejectChangeRequest\x1a .costing.v1.RejectChangeResponse\"C\x82\xd3\xe4\x93\x02=:\x01*\"8/v1/parameter-change-requests/{change_request_id}:rejectB\xb8\x01\n" +
+	"\x0ecom.costing.v1B\x15Parameter_changeProtoP\x01ZFgithub.com/homindolenern/goapps-costing-v1/gen/go/costing/v1;costingv1\xa2\x02\x03CXX\xaa\x02\n" +
+	"Costing.V1\xca\x02\n" +
+	"Costing\\V1\xe2\x02\x16Costing\\V1\\GPBMetadata\xea\x02\vCosting::V1b\x06proto3"
+
+var (
+	file_costing_v1_parameter_change_proto_rawDescOnce sync.Once
+	file_costing_v1_parameter_change_proto_rawDescData []byte
+)
+
+func file_costing_v1_parameter_change_proto_rawDescGZIP() []byte {
+	file_costing_v1_parameter_change_proto_rawDescOnce.Do(func() {
+		file_costing_v1_parameter_change_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_costing_v1_parameter_change_proto_rawDesc), len(file_costing_v1_parameter_change_proto_rawDesc)))
+	})
+	return file_costing_v1_parameter_change_proto_rawDescData
+}
+
+var file_costing_v1_parameter_change_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_costing_v1_parameter_change_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_costing_v1_parameter_change_proto_goTypes = []any{
+	(ParameterChangeStatus)(0),                  // 0: costing.v1.ParameterChangeStatus
+	(ParameterChangeAction)(0),                  // 1: costing.v1.ParameterChangeAction
+	(*ParameterChangeRequest)(nil),              // 2: costing.v1.ParameterChangeRequest
+	(*ListParameterChangeRequestsRequest)(nil),  // 3: costing.v1.ListParameterChangeRequestsRequest
+	(*ListParameterChangeRequestsResponse)(nil), // 4: costing.v1.ListParameterChangeRequestsResponse
+	(*GetParameterChangeRequestRequest)(nil),    // 5: costing.v1.GetParameterChangeRequestRequest
+	(*GetParameterChangeRequestResponse)(nil),   // 6: costing.v1.GetParameterChangeRequestResponse
+	(*ApproveChangeRequest)(nil),                // 7: costing.v1.ApproveChangeRequest
+	(*ApproveChangeResponse)(nil),               // 8: costing.v1.ApproveChangeResponse
+	(*RejectChangeRequest)(nil),                 // 9: costing.v1.RejectChangeRequest
+	(*RejectChangeResponse)(nil),                // 10: costing.v1.RejectChangeResponse
+	(*FieldChange)(nil),                         // 11: costing.v1.FieldChange
+	(*BaseResponse)(nil),                        // 12: costing.v1.BaseResponse
+	(*PaginationMeta)(nil),                      // 13: costing.v1.PaginationMeta
+}
+var file_costing_v1_parameter_change_proto_depIdxs = []int32{
+	0,  // 0: costing.v1.ParameterChangeRequest.status:type_name -> costing.v1.ParameterChangeStatus
+	11, // 1: costing.v1.ParameterChangeRequest.changes:type_name -> costing.v1.FieldChange
+	1,  // 2: costing.v1.ParameterChangeRequest.action:type_name -> costing.v1.ParameterChangeAction
+	0,  // 3: costing.v1.ListParameterChangeRequestsRequest.status:type_name -> costing.v1.ParameterChangeStatus
+	12, // 4: costing.v1.ListParameterChangeRequestsResponse.base:type_name -> costing.v1.BaseResponse
+	2,  // 5: costing.v1.ListParameterChangeRequestsResponse.data:type_name -> costing.v1.ParameterChangeRequest
+	13, // 6: costing.v1.ListParameterChangeRequestsResponse.pagination:type_name -> costing.v1.PaginationMeta
+	12, // 7: costing.v1.GetParameterChangeRequestResponse.base:type_name -> costing.v1.BaseResponse
+	2,  // 8: costing.v1.GetParameterChangeRequestResponse.data:type_name -> costing.v1.ParameterChangeRequest
+	12, // 9: costing.v1.ApproveChangeResponse.base:type_name -> costing.v1.BaseResponse
+	2,  // 10: costing.v1.ApproveChangeResponse.data:type_name -> costing.v1.ParameterChangeRequest
+	12, // 11: costing.v1.RejectChangeResponse.base:type_name -> costing.v1.BaseResponse
+	2,  // 12: costing.v1.RejectChangeResponse.data:type_name -> costing.v1.ParameterChangeRequest
+	3,  // 13: costing.v1.ParameterChangeService.ListParameterChangeRequests:input_type -> costing.v1.ListParameterChangeRequestsRequest
+	5,  // 14: costing.v1.ParameterChangeService.GetParameterChangeRequest:input_type -> costing.v1.GetParameterChangeRequestRequest
+	7,  // 15: costing.v1.ParameterChangeService.ApproveChange:input_type -> costing.v1.ApproveChangeRequest
+	9,  // 16: costing.v1.ParameterChangeService.RejectChange:input_type -> costing.v1.RejectChangeRequest
+	4,  // 17: costing.v1.ParameterChangeService.ListParameterChangeRequests:output_type -> costing.v1.ListParameterChangeRequestsResponse
+	6,  // 18: costing.v1.ParameterChangeService.GetParameterChangeRequest:output_type -> costing.v1.GetParameterChangeRequestResponse
+	8,  // 19: costing.v1.ParameterChangeService.ApproveChange:output_type -> costing.v1.ApproveChangeResponse
+	10, // 20: costing.v1.ParameterChangeService.RejectChange:output_type -> costing.v1.RejectChangeResponse
+	17, // [17:21] is the sub-list for method output_type
+	13, // [13:17] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
+}
+
+func init() { file_costing_v1_parameter_change_proto_init() }
+func file_costing_v1_parameter_change_proto_init() {
+	if File_costing_v1_parameter_change_proto != nil {
+		return
+	}
+	file_costing_v1_audit_proto_init()
+	file_costing_v1_common_proto_init()
+	file_costing_v1_parameter_change_proto_msgTypes[0].OneofWrappers = []any{}
+	file_costing_v1_parameter_change_proto_msgTypes[1].OneofWrappers = []any{}
+	file_costing_v1_parameter_change_proto_msgTypes[5].OneofWrappers = []any{}
+	file_costing_v1_parameter_change_proto_msgTypes[7].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_costing_v1_parameter_change_proto_rawDesc), len(file_costing_v1_parameter_change_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_costing_v1_parameter_change_proto_goTypes,
+		DependencyIndexes: file_costing_v1_parameter_change_proto_depIdxs,
+		EnumInfos:         file_costing_v1_parameter_change_proto_enumTypes,
+		MessageInfos:      file_costing_v1_parameter_change_proto_msgTypes,
+	}.Build()
+	File_costing_v1_parameter_change_proto = out.File
+	file_costing_v1_parameter_change_proto_goTypes = nil
+	file_costing_v1_parameter_change_proto_depIdxs = nil
+}
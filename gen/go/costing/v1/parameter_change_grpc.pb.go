@@ -0,0 +1,252 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             (unknown)
+// source: costing/v1/parameter_change.proto
+
+package costingv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file.
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ParameterChangeService_ListParameterChangeRequests_FullMethodName = "/costing.v1.ParameterChangeService/ListParameterChangeRequests"
+	ParameterChangeService_GetParameterChangeRequest_FullMethodName   = "/costing.v1.ParameterChangeService/GetParameterChangeRequest"
+	ParameterChangeService_ApproveChange_FullMethodName               = "/costing.v1.ParameterChangeService/ApproveChange"
+	ParameterChangeService_RejectChange_FullMethodName                = "/costing.v1.ParameterChangeService/RejectChange"
+)
+
+// ParameterChangeServiceClient is the client API for ParameterChangeService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ParameterChangeService reviews the Parameter changes held for four-eyes approval.
+// Changes are submitted with ParameterService.UpdateParameter, DeleteParameter
+// and RestoreParameter.
+type ParameterChangeServiceClient interface {
+	// ListParameterChangeRequests retrieves a paginated list of change requests, newest first
+	ListParameterChangeRequests(ctx context.Context, in *ListParameterChangeRequestsRequest, opts ...grpc.CallOption) (*ListParameterChangeRequestsResponse, error)
+	// GetParameterChangeRequest retrieves a change request by ID
+	GetParameterChangeRequest(ctx context.Context, in *GetParameterChangeRequestRequest, opts ...grpc.CallOption) (*GetParameterChangeRequestResponse, error)
+	// ApproveChange applies a pending change request; its author cannot approve it
+	ApproveChange(ctx context.Context, in *ApproveChangeRequest, opts ...grpc.CallOption) (*ApproveChangeResponse, error)
+	// RejectChange closes a pending change request without applying it
+	RejectChange(ctx context.Context, in *RejectChangeRequest, opts ...grpc.CallOption) (*RejectChangeResponse, error)
+}
+
+type parameterChangeServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewParameterChangeServiceClient(cc grpc.ClientConnInterface) ParameterChangeServiceClient {
+	return &parameterChangeServiceClient{cc}
+}
+
+func (c *parameterChangeServiceClient) ListParameterChangeRequests(ctx context.Context, in *ListParameterChangeRequestsRequest, opts ...grpc.CallOption) (*ListParameterChangeRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListParameterChangeRequestsResponse)
+	err := c.cc.Invoke(ctx, ParameterChangeService_ListParameterChangeRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *parameterChangeServiceClient) GetParameterChangeRequest(ctx context.Context, in *GetParameterChangeRequestRequest, opts ...grpc.CallOption) (*GetParameterChangeRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetParameterChangeRequestResponse)
+	err := c.cc.Invoke(ctx, ParameterChangeService_GetParameterChangeRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *parameterChangeServiceClient) ApproveChange(ctx context.Context, in *ApproveChangeRequest, opts ...grpc.CallOption) (*ApproveChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveChangeResponse)
+	err := c.cc.Invoke(ctx, ParameterChangeService_ApproveChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *parameterChangeServiceClient) RejectChange(ctx context.Context, in *RejectChangeRequest, opts ...grpc.CallOption) (*RejectChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RejectChangeResponse)
+	err := c.cc.Invoke(ctx, ParameterChangeService_RejectChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ParameterChangeServiceServer is the server API for ParameterChangeService service.
+// All implementations must embed UnimplementedParameterChangeServiceServer.
+// for forward compatibility.
+//
+// ParameterChangeService reviews the Parameter changes held for four-eyes approval.
+// Changes are submitted with ParameterService.UpdateParameter, DeleteParameter
+// and RestoreParameter.
+type ParameterChangeServiceServer interface {
+	// ListParameterChangeRequests retrieves a paginated list of change requests, newest first
+	ListParameterChangeRequests(context.Context, *ListParameterChangeRequestsRequest) (*ListParameterChangeRequestsResponse, error)
+	// GetParameterChangeRequest retrieves a change request by ID
+	GetParameterChangeRequest(context.Context, *GetParameterChangeRequestRequest) (*GetParameterChangeRequestResponse, error)
+	// ApproveChange applies a pending change request; its author cannot approve it
+	ApproveChange(context.Context, *ApproveChangeRequest) (*ApproveChangeResponse, error)
+	// RejectChange closes a pending change request without applying it
+	RejectChange(context.Context, *RejectChangeRequest) (*RejectChangeResponse, error)
+	mustEmbedUnimplementedParameterChangeServiceServer()
+}
+
+// UnimplementedParameterChangeServiceServer must be embedded to have.
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedParameterChangeServiceServer struct{}
+
+func (UnimplementedParameterChangeServiceServer) ListParameterChangeRequests(context.Context, *ListParameterChangeRequestsRequest) (*ListParameterChangeRequestsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListParameterChangeRequests not implemented")
+}
+func (UnimplementedParameterChangeServiceServer) GetParameterChangeRequest(context.Context, *GetParameterChangeRequestRequest) (*GetParameterChangeRequestResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetParameterChangeRequest not implemented")
+}
+func (UnimplementedParameterChangeServiceServer) ApproveChange(context.Context, *ApproveChangeRequest) (*ApproveChangeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApproveChange not implemented")
+}
+func (UnimplementedParameterChangeServiceServer) RejectChange(context.Context, *RejectChangeRequest) (*RejectChangeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RejectChange not implemented")
+}
+func (UnimplementedParameterChangeServiceServer) mustEmbedUnimplementedParameterChangeServiceServer() {
+}
+func (UnimplementedParameterChangeServiceServer) testEmbeddedByValue() {}
+
+// UnsafeParameterChangeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ParameterChangeServiceServer will.
+// result in compilation errors.
+type UnsafeParameterChangeServiceServer interface {
+	mustEmbedUnimplementedParameterChangeServiceServer()
+}
+
+func RegisterParameterChangeServiceServer(s grpc.ServiceRegistrar, srv ParameterChangeServiceServer) {
+	// If the following call panics, it indicates UnimplementedParameterChangeServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ParameterChangeService_ServiceDesc, srv)
+}
+
+func _ParameterChangeService_ListParameterChangeRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListParameterChangeRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ParameterChangeServiceServer).ListParameterChangeRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ParameterChangeService_ListParameterChangeRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ParameterChangeServiceServer).ListParameterChangeRequests(ctx, req.(*ListParameterChangeRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ParameterChangeService_GetParameterChangeRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetParameterChangeRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ParameterChangeServiceServer).GetParameterChangeRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ParameterChangeService_GetParameterChangeRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ParameterChangeServiceServer).GetParameterChangeRequest(ctx, req.(*GetParameterChangeRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ParameterChangeService_ApproveChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ParameterChangeServiceServer).ApproveChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ParameterChangeService_ApproveChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ParameterChangeServiceServer).ApproveChange(ctx, req.(*ApproveChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ParameterChangeService_RejectChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ParameterChangeServiceServer).RejectChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ParameterChangeService_RejectChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ParameterChangeServiceServer).RejectChange(ctx, req.(*RejectChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ParameterChangeService_ServiceDesc is the grpc.ServiceDesc for ParameterChangeService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ParameterChangeService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "costing.v1.ParameterChangeService",
+	HandlerType: (*ParameterChangeServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListParameterChangeRequests",
+			Handler:    _ParameterChangeService_ListParameterChangeRequests_Handler,
+		},
+		{
+			MethodName: "GetParameterChangeRequest",
+			Handler:    _ParameterChangeService_GetParameterChangeRequest_Handler,
+		},
+		{
+			MethodName: "ApproveChange",
+			Handler:    _ParameterChangeService_ApproveChange_Handler,
+		},
+		{
+			MethodName: "RejectChange",
+			Handler:    _ParameterChangeService_RejectChange_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "costing/v1/parameter_change.proto",
+}
@@ -19,11 +19,17 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ParameterService_CreateParameter_FullMethodName = "/costing.v1.ParameterService/CreateParameter"
-	ParameterService_GetParameter_FullMethodName    = "/costing.v1.ParameterService/GetParameter"
-	ParameterService_ListParameters_FullMethodName  = "/costing.v1.ParameterService/ListParameters"
-	ParameterService_UpdateParameter_FullMethodName = "/costing.v1.ParameterService/UpdateParameter"
-	ParameterService_DeleteParameter_FullMethodName = "/costing.v1.ParameterService/DeleteParameter"
+	ParameterService_CreateParameter_FullMethodName       = "/costing.v1.ParameterService/CreateParameter"
+	ParameterService_GetParameter_FullMethodName          = "/costing.v1.ParameterService/GetParameter"
+	ParameterService_ListParameterVersions_FullMethodName = "/costing.v1.ParameterService/ListParameterVersions"
+	ParameterService_ListParameters_FullMethodName        = "/costing.v1.ParameterService/ListParameters"
+	ParameterService_UpdateParameter_FullMethodName       = "/costing.v1.ParameterService/UpdateParameter"
+	ParameterService_DeleteParameter_FullMethodName       = "/costing.v1.ParameterService/DeleteParameter"
+	ParameterService_RestoreParameter_FullMethodName      = "/costing.v1.ParameterService/RestoreParameter"
+	ParameterService_BatchUpsertParameters_FullMethodName = "/costing.v1.ParameterService/BatchUpsertParameters"
+	ParameterService_ImportParameters_FullMethodName      = "/costing.v1.ParameterService/ImportParameters"
+	ParameterService_ExportParameters_FullMethodName      = "/costing.v1.ParameterService/ExportParameters"
+	ParameterService_WatchParameters_FullMethodName       = "/costing.v1.ParameterService/WatchParameters"
 )
 
 // ParameterServiceClient is the client API for ParameterService service.
@@ -34,14 +40,30 @@ const (
 type ParameterServiceClient interface {
 	// CreateParameter creates a new Parameter
 	CreateParameter(ctx context.Context, in *CreateParameterRequest, opts ...grpc.CallOption) (*CreateParameterResponse, error)
-	// GetParameter retrieves a Parameter by code
+	// GetParameter retrieves a Parameter by code, or its definition in force at as_of
 	GetParameter(ctx context.Context, in *GetParameterRequest, opts ...grpc.CallOption) (*GetParameterResponse, error)
+	// ListParameterVersions retrieves the definitions a Parameter has had, newest first
+	ListParameterVersions(ctx context.Context, in *ListParameterVersionsRequest, opts ...grpc.CallOption) (*ListParameterVersionsResponse, error)
 	// ListParameters retrieves a paginated list of Parameters
 	ListParameters(ctx context.Context, in *ListParametersRequest, opts ...grpc.CallOption) (*ListParametersResponse, error)
-	// UpdateParameter updates an existing Parameter
+	// UpdateParameter updates an existing Parameter, or holds the edit for approval when the policy covers it
 	UpdateParameter(ctx context.Context, in *UpdateParameterRequest, opts ...grpc.CallOption) (*UpdateParameterResponse, error)
-	// DeleteParameter deletes a Parameter by code
+	// DeleteParameter soft-deletes a Parameter by code
 	DeleteParameter(ctx context.Context, in *DeleteParameterRequest, opts ...grpc.CallOption) (*DeleteParameterResponse, error)
+	// RestoreParameter restores a soft-deleted Parameter
+	RestoreParameter(ctx context.Context, in *RestoreParameterRequest, opts ...grpc.CallOption) (*RestoreParameterResponse, error)
+	// BatchUpsertParameters creates or updates many Parameters in one transaction
+	BatchUpsertParameters(ctx context.Context, in *BatchUpsertParametersRequest, opts ...grpc.CallOption) (*BatchUpsertParametersResponse, error)
+	// ImportParameters creates Parameters from a streamed CSV or XLSX file.
+	// Over HTTP, the file is uploaded to POST /v1/parameters:import.
+	ImportParameters(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportRequest, ImportResponse], error)
+	// ExportParameters streams every Parameters matching the List filters as a CSV,
+	// XLSX or JSON file. Over HTTP, the file is downloaded from GET /v1/parameters:export.
+	ExportParameters(ctx context.Context, in *ExportParametersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportChunk], error)
+	// WatchParameters streams every committed change of a Parameter after
+	// resume_token, or from now when it is empty. Over HTTP, the
+	// changes are sent as Server-Sent Events from GET /v1/parameters:watch.
+	WatchParameters(ctx context.Context, in *WatchParametersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ParameterChange], error)
 }
 
 type parameterServiceClient struct {
@@ -72,6 +94,16 @@ func (c *parameterServiceClient) GetParameter(ctx context.Context, in *GetParame
 	return out, nil
 }
 
+func (c *parameterServiceClient) ListParameterVersions(ctx context.Context, in *ListParameterVersionsRequest, opts ...grpc.CallOption) (*ListParameterVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListParameterVersionsResponse)
+	err := c.cc.Invoke(ctx, ParameterService_ListParameterVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *parameterServiceClient) ListParameters(ctx context.Context, in *ListParametersRequest, opts ...grpc.CallOption) (*ListParametersResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListParametersResponse)
@@ -102,6 +134,77 @@ func (c *parameterServiceClient) DeleteParameter(ctx context.Context, in *Delete
 	return out, nil
 }
 
+func (c *parameterServiceClient) RestoreParameter(ctx context.Context, in *RestoreParameterRequest, opts ...grpc.CallOption) (*RestoreParameterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreParameterResponse)
+	err := c.cc.Invoke(ctx, ParameterService_RestoreParameter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *parameterServiceClient) BatchUpsertParameters(ctx context.Context, in *BatchUpsertParametersRequest, opts ...grpc.CallOption) (*BatchUpsertParametersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchUpsertParametersResponse)
+	err := c.cc.Invoke(ctx, ParameterService_BatchUpsertParameters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *parameterServiceClient) ImportParameters(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportRequest, ImportResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ParameterService_ServiceDesc.Streams[0], ParameterService_ImportParameters_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportRequest, ImportResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ParameterService_ImportParametersClient = grpc.ClientStreamingClient[ImportRequest, ImportResponse]
+
+func (c *parameterServiceClient) ExportParameters(ctx context.Context, in *ExportParametersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ParameterService_ServiceDesc.Streams[1], ParameterService_ExportParameters_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportParametersRequest, ExportChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ParameterService_ExportParametersClient = grpc.ServerStreamingClient[ExportChunk]
+
+func (c *parameterServiceClient) WatchParameters(ctx context.Context, in *WatchParametersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ParameterChange], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ParameterService_ServiceDesc.Streams[2], ParameterService_WatchParameters_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchParametersRequest, ParameterChange]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ParameterService_WatchParametersClient = grpc.ServerStreamingClient[ParameterChange]
+
 // ParameterServiceServer is the server API for ParameterService service.
 // All implementations must embed UnimplementedParameterServiceServer
 // for forward compatibility.
@@ -110,14 +213,30 @@ func (c *parameterServiceClient) DeleteParameter(ctx context.Context, in *Delete
 type ParameterServiceServer interface {
 	// CreateParameter creates a new Parameter
 	CreateParameter(context.Context, *CreateParameterRequest) (*CreateParameterResponse, error)
-	// GetParameter retrieves a Parameter by code
+	// GetParameter retrieves a Parameter by code, or its definition in force at as_of
 	GetParameter(context.Context, *GetParameterRequest) (*GetParameterResponse, error)
+	// ListParameterVersions retrieves the definitions a Parameter has had, newest first
+	ListParameterVersions(context.Context, *ListParameterVersionsRequest) (*ListParameterVersionsResponse, error)
 	// ListParameters retrieves a paginated list of Parameters
 	ListParameters(context.Context, *ListParametersRequest) (*ListParametersResponse, error)
-	// UpdateParameter updates an existing Parameter
+	// UpdateParameter updates an existing Parameter, or holds the edit for approval when the policy covers it
 	UpdateParameter(context.Context, *UpdateParameterRequest) (*UpdateParameterResponse, error)
-	// DeleteParameter deletes a Parameter by code
+	// DeleteParameter soft-deletes a Parameter by code
 	DeleteParameter(context.Context, *DeleteParameterRequest) (*DeleteParameterResponse, error)
+	// RestoreParameter restores a soft-deleted Parameter
+	RestoreParameter(context.Context, *RestoreParameterRequest) (*RestoreParameterResponse, error)
+	// BatchUpsertParameters creates or updates many Parameters in one transaction
+	BatchUpsertParameters(context.Context, *BatchUpsertParametersRequest) (*BatchUpsertParametersResponse, error)
+	// ImportParameters creates Parameters from a streamed CSV or XLSX file.
+	// Over HTTP, the file is uploaded to POST /v1/parameters:import.
+	ImportParameters(grpc.ClientStreamingServer[ImportRequest, ImportResponse]) error
+	// ExportParameters streams every Parameters matching the List filters as a CSV,
+	// XLSX or JSON file. Over HTTP, the file is downloaded from GET /v1/parameters:export.
+	ExportParameters(*ExportParametersRequest, grpc.ServerStreamingServer[ExportChunk]) error
+	// WatchParameters streams every committed change of a Parameter after
+	// resume_token, or from now when it is empty. Over HTTP, the
+	// changes are sent as Server-Sent Events from GET /v1/parameters:watch.
+	WatchParameters(*WatchParametersRequest, grpc.ServerStreamingServer[ParameterChange]) error
 	mustEmbedUnimplementedParameterServiceServer()
 }
 
@@ -134,6 +253,9 @@ func (UnimplementedParameterServiceServer) CreateParameter(context.Context, *Cre
 func (UnimplementedParameterServiceServer) GetParameter(context.Context, *GetParameterRequest) (*GetParameterResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetParameter not implemented")
 }
+func (UnimplementedParameterServiceServer) ListParameterVersions(context.Context, *ListParameterVersionsRequest) (*ListParameterVersionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListParameterVersions not implemented")
+}
 func (UnimplementedParameterServiceServer) ListParameters(context.Context, *ListParametersRequest) (*ListParametersResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListParameters not implemented")
 }
@@ -143,6 +265,21 @@ func (UnimplementedParameterServiceServer) UpdateParameter(context.Context, *Upd
 func (UnimplementedParameterServiceServer) DeleteParameter(context.Context, *DeleteParameterRequest) (*DeleteParameterResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteParameter not implemented")
 }
+func (UnimplementedParameterServiceServer) RestoreParameter(context.Context, *RestoreParameterRequest) (*RestoreParameterResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreParameter not implemented")
+}
+func (UnimplementedParameterServiceServer) BatchUpsertParameters(context.Context, *BatchUpsertParametersRequest) (*BatchUpsertParametersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchUpsertParameters not implemented")
+}
+func (UnimplementedParameterServiceServer) ImportParameters(grpc.ClientStreamingServer[ImportRequest, ImportResponse]) error {
+	return status.Error(codes.Unimplemented, "method ImportParameters not implemented")
+}
+func (UnimplementedParameterServiceServer) ExportParameters(*ExportParametersRequest, grpc.ServerStreamingServer[ExportChunk]) error {
+	return status.Error(codes.Unimplemented, "method ExportParameters not implemented")
+}
+func (UnimplementedParameterServiceServer) WatchParameters(*WatchParametersRequest, grpc.ServerStreamingServer[ParameterChange]) error {
+	return status.Error(codes.Unimplemented, "method WatchParameters not implemented")
+}
 func (UnimplementedParameterServiceServer) mustEmbedUnimplementedParameterServiceServer() {}
 func (UnimplementedParameterServiceServer) testEmbeddedByValue()                          {}
 
@@ -200,6 +337,24 @@ func _ParameterService_GetParameter_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ParameterService_ListParameterVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListParameterVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ParameterServiceServer).ListParameterVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ParameterService_ListParameterVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ParameterServiceServer).ListParameterVersions(ctx, req.(*ListParameterVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ParameterService_ListParameters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListParametersRequest)
 	if err := dec(in); err != nil {
@@ -254,6 +409,71 @@ func _ParameterService_DeleteParameter_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ParameterService_RestoreParameter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreParameterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ParameterServiceServer).RestoreParameter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ParameterService_RestoreParameter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ParameterServiceServer).RestoreParameter(ctx, req.(*RestoreParameterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ParameterService_BatchUpsertParameters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpsertParametersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ParameterServiceServer).BatchUpsertParameters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ParameterService_BatchUpsertParameters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ParameterServiceServer).BatchUpsertParameters(ctx, req.(*BatchUpsertParametersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ParameterService_ImportParameters_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ParameterServiceServer).ImportParameters(&grpc.GenericServerStream[ImportRequest, ImportResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ParameterService_ImportParametersServer = grpc.ClientStreamingServer[ImportRequest, ImportResponse]
+
+func _ParameterService_ExportParameters_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportParametersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ParameterServiceServer).ExportParameters(m, &grpc.GenericServerStream[ExportParametersRequest, ExportChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ParameterService_ExportParametersServer = grpc.ServerStreamingServer[ExportChunk]
+
+func _ParameterService_WatchParameters_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchParametersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ParameterServiceServer).WatchParameters(m, &grpc.GenericServerStream[WatchParametersRequest, ParameterChange]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ParameterService_WatchParametersServer = grpc.ServerStreamingServer[ParameterChange]
+
 // ParameterService_ServiceDesc is the grpc.ServiceDesc for ParameterService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -269,6 +489,10 @@ var ParameterService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetParameter",
 			Handler:    _ParameterService_GetParameter_Handler,
 		},
+		{
+			MethodName: "ListParameterVersions",
+			Handler:    _ParameterService_ListParameterVersions_Handler,
+		},
 		{
 			MethodName: "ListParameters",
 			Handler:    _ParameterService_ListParameters_Handler,
@@ -281,7 +505,31 @@ var ParameterService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteParameter",
 			Handler:    _ParameterService_DeleteParameter_Handler,
 		},
+		{
+			MethodName: "RestoreParameter",
+			Handler:    _ParameterService_RestoreParameter_Handler,
+		},
+		{
+			MethodName: "BatchUpsertParameters",
+			Handler:    _ParameterService_BatchUpsertParameters_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportParameters",
+			Handler:       _ParameterService_ImportParameters_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportParameters",
+			Handler:       _ParameterService_ExportParameters_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchParameters",
+			Handler:       _ParameterService_WatchParameters_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "costing/v1/parameter.proto",
 }
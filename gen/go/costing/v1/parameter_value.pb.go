@@ -0,0 +1,996 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: costing/v1/parameter_value.proto
+
+package costingv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SubjectType represents what a parameter value is recorded for
+type SubjectType int32
+
+const (
+	SubjectType_SUBJECT_TYPE_UNSPECIFIED SubjectType = 0
+	SubjectType_SUBJECT_TYPE_MACHINE     SubjectType = 1
+	SubjectType_SUBJECT_TYPE_MATERIAL    SubjectType = 2
+	SubjectType_SUBJECT_TYPE_PRODUCT     SubjectType = 3
+)
+
+// Enum value maps for SubjectType.
+var (
+	SubjectType_name = map[int32]string{
+		0: "SUBJECT_TYPE_UNSPECIFIED",
+		1: "SUBJECT_TYPE_MACHINE",
+		2: "SUBJECT_TYPE_MATERIAL",
+		3: "SUBJECT_TYPE_PRODUCT",
+	}
+	SubjectType_value = map[string]int32{
+		"SUBJECT_TYPE_UNSPECIFIED": 0,
+		"SUBJECT_TYPE_MACHINE":     1,
+		"SUBJECT_TYPE_MATERIAL":    2,
+		"SUBJECT_TYPE_PRODUCT":     3,
+	}
+)
+
+func (x SubjectType) Enum() *SubjectType {
+	p := new(SubjectType)
+	*p = x
+	return p
+}
+
+func (x SubjectType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SubjectType) Descriptor() protoreflect.EnumDescriptor {
+	return file_costing_v1_parameter_value_proto_enumTypes[0].Descriptor()
+}
+
+func (SubjectType) Type() protoreflect.EnumType {
+	return &file_costing_v1_parameter_value_proto_enumTypes[0]
+}
+
+func (x SubjectType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SubjectType.Descriptor instead.
+func (SubjectType) EnumDescriptor() ([]byte, []int) {
+	return file_costing_v1_parameter_value_proto_rawDescGZIP(), []int{0}
+}
+
+// ParameterValue is the value of a parameter for a subject over an effective period
+type ParameterValue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SubjectType   SubjectType            `protobuf:"varint,2,opt,name=subject_type,json=subjectType,proto3,enum=costing.v1.SubjectType" json:"subject_type,omitempty"`
+	SubjectCode   string                 `protobuf:"bytes,3,opt,name=subject_code,json=subjectCode,proto3" json:"subject_code,omitempty"`
+	ParameterCode string                 `protobuf:"bytes,4,opt,name=parameter_code,json=parameterCode,proto3" json:"parameter_code,omitempty"`
+	DataType      ParameterDataType      `protobuf:"varint,5,opt,name=data_type,json=dataType,proto3,enum=costing.v1.ParameterDataType" json:"data_type,omitempty"`
+	Value         string                 `protobuf:"bytes,6,opt,name=value,proto3" json:"value,omitempty"`                                           // Normalized value, e.g., "12.5", "true"
+	NumericValue  *float64               `protobuf:"fixed64,7,opt,name=numeric_value,json=numericValue,proto3,oneof" json:"numeric_value,omitempty"` // Set for NUMERIC parameters
+	EffectiveFrom string                 `protobuf:"bytes,8,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`      // YYYY-MM-DD, inclusive
+	EffectiveTo   *string                `protobuf:"bytes,9,opt,name=effective_to,json=effectiveTo,proto3,oneof" json:"effective_to,omitempty"`      // YYYY-MM-DD, exclusive; unset while current
+	Remarks       *string                `protobuf:"bytes,10,opt,name=remarks,proto3,oneof" json:"remarks,omitempty"`
+	Audit         *AuditInfo             `protobuf:"bytes,11,opt,name=audit,proto3" json:"audit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParameterValue) Reset() {
+	*x = ParameterValue{}
+	mi := &file_costing_v1_parameter_value_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParameterValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParameterValue) ProtoMessage() {}
+
+func (x *ParameterValue) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_parameter_value_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParameterValue.ProtoReflect.Descriptor instead.
+func (*ParameterValue) Descriptor() ([]byte, []int) {
+	return file_costing_v1_parameter_value_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ParameterValue) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ParameterValue) GetSubjectType() SubjectType {
+	if x != nil {
+		return x.SubjectType
+	}
+	return SubjectType_SUBJECT_TYPE_UNSPECIFIED
+}
+
+func (x *ParameterValue) GetSubjectCode() string {
+	if x != nil {
+		return x.SubjectCode
+	}
+	return ""
+}
+
+func (x *ParameterValue) GetParameterCode() string {
+	if x != nil {
+		return x.ParameterCode
+	}
+	return ""
+}
+
+func (x *ParameterValue) GetDataType() ParameterDataType {
+	if x != nil {
+		return x.DataType
+	}
+	return ParameterDataType_PARAMETER_DATA_TYPE_UNSPECIFIED
+}
+
+func (x *ParameterValue) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *ParameterValue) GetNumericValue() float64 {
+	if x != nil && x.NumericValue != nil {
+		return *x.NumericValue
+	}
+	return 0
+}
+
+func (x *ParameterValue) GetEffectiveFrom() string {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return ""
+}
+
+func (x *ParameterValue) GetEffectiveTo() string {
+	if x != nil && x.EffectiveTo != nil {
+		return *x.EffectiveTo
+	}
+	return ""
+}
+
+func (x *ParameterValue) GetRemarks() string {
+	if x != nil && x.Remarks != nil {
+		return *x.Remarks
+	}
+	return ""
+}
+
+func (x *ParameterValue) GetAudit() *AuditInfo {
+	if x != nil {
+		return x.Audit
+	}
+	return nil
+}
+
+// CreateParameterValue
+type CreateParameterValueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SubjectType   SubjectType            `protobuf:"varint,1,opt,name=subject_type,json=subjectType,proto3,enum=costing.v1.SubjectType" json:"subject_type,omitempty"`
+	SubjectCode   string                 `protobuf:"bytes,2,opt,name=subject_code,json=subjectCode,proto3" json:"subject_code,omitempty"`
+	ParameterCode string                 `protobuf:"bytes,3,opt,name=parameter_code,json=parameterCode,proto3" json:"parameter_code,omitempty"`
+	Value         string                 `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	EffectiveFrom string                 `protobuf:"bytes,5,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
+	EffectiveTo   *string                `protobuf:"bytes,6,opt,name=effective_to,json=effectiveTo,proto3,oneof" json:"effective_to,omitempty"`
+	Remarks       *string                `protobuf:"bytes,7,opt,name=remarks,proto3,oneof" json:"remarks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateParameterValueRequest) Reset() {
+	*x = CreateParameterValueRequest{}
+	mi := &file_costing_v1_parameter_value_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateParameterValueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateParameterValueRequest) ProtoMessage() {}
+
+func (x *CreateParameterValueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_parameter_value_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateParameterValueRequest.ProtoReflect.Descriptor instead.
+func (*CreateParameterValueRequest) Descriptor() ([]byte, []int) {
+	return file_costing_v1_parameter_value_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateParameterValueRequest) GetSubjectType() SubjectType {
+	if x != nil {
+		return x.SubjectType
+	}
+	return SubjectType_SUBJECT_TYPE_UNSPECIFIED
+}
+
+func (x *CreateParameterValueRequest) GetSubjectCode() string {
+	if x != nil {
+		return x.SubjectCode
+	}
+	return ""
+}
+
+func (x *CreateParameterValueRequest) GetParameterCode() string {
+	if x != nil {
+		return x.ParameterCode
+	}
+	return ""
+}
+
+func (x *CreateParameterValueRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *CreateParameterValueRequest) GetEffectiveFrom() string {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return ""
+}
+
+func (x *CreateParameterValueRequest) GetEffectiveTo() string {
+	if x != nil && x.EffectiveTo != nil {
+		return *x.EffectiveTo
+	}
+	return ""
+}
+
+func (x *CreateParameterValueRequest) GetRemarks() string {
+	if x != nil && x.Remarks != nil {
+		return *x.Remarks
+	}
+	return ""
+}
+
+type CreateParameterValueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Data          *ParameterValue        `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateParameterValueResponse) Reset() {
+	*x = CreateParameterValueResponse{}
+	mi := &file_costing_v1_parameter_value_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateParameterValueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateParameterValueResponse) ProtoMessage() {}
+
+func (x *CreateParameterValueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_parameter_value_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateParameterValueResponse.ProtoReflect.Descriptor instead.
+func (*CreateParameterValueResponse) Descriptor() ([]byte, []int) {
+	return file_costing_v1_parameter_value_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateParameterValueResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *CreateParameterValueResponse) GetData() *ParameterValue {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// GetParameterValue
+type GetParameterValueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetParameterValueRequest) Reset() {
+	*x = GetParameterValueRequest{}
+	mi := &file_costing_v1_parameter_value_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetParameterValueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetParameterValueRequest) ProtoMessage() {}
+
+func (x *GetParameterValueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_parameter_value_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetParameterValueRequest.ProtoReflect.Descriptor instead.
+func (*GetParameterValueRequest) Descriptor() ([]byte, []int) {
+	return file_costing_v1_parameter_value_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetParameterValueRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetParameterValueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Data          *ParameterValue        `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetParameterValueResponse) Reset() {
+	*x = GetParameterValueResponse{}
+	mi := &file_costing_v1_parameter_value_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetParameterValueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetParameterValueResponse) ProtoMessage() {}
+
+func (x *GetParameterValueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_parameter_value_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetParameterValueResponse.ProtoReflect.Descriptor instead.
+func (*GetParameterValueResponse) Descriptor() ([]byte, []int) {
+	return file_costing_v1_parameter_value_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetParameterValueResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *GetParameterValueResponse) GetData() *ParameterValue {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// ListParameterValues
+type ListParameterValuesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	SubjectType   *SubjectType           `protobuf:"varint,3,opt,name=subject_type,json=subjectType,proto3,enum=costing.v1.SubjectType,oneof" json:"subject_type,omitempty"`
+	SubjectCode   *string                `protobuf:"bytes,4,opt,name=subject_code,json=subjectCode,proto3,oneof" json:"subject_code,omitempty"`
+	ParameterCode *string                `protobuf:"bytes,5,opt,name=parameter_code,json=parameterCode,proto3,oneof" json:"parameter_code,omitempty"`
+	AsOf          *string                `protobuf:"bytes,6,opt,name=as_of,json=asOf,proto3,oneof" json:"as_of,omitempty"` // Only values effective on this date
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListParameterValuesRequest) Reset() {
+	*x = ListParameterValuesRequest{}
+	mi := &file_costing_v1_parameter_value_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListParameterValuesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListParameterValuesRequest) ProtoMessage() {}
+
+func (x *ListParameterValuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_parameter_value_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListParameterValuesRequest.ProtoReflect.Descriptor instead.
+func (*ListParameterValuesRequest) Descriptor() ([]byte, []int) {
+	return file_costing_v1_parameter_value_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListParameterValuesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListParameterValuesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListParameterValuesRequest) GetSubjectType() SubjectType {
+	if x != nil && x.SubjectType != nil {
+		return *x.SubjectType
+	}
+	return SubjectType_SUBJECT_TYPE_UNSPECIFIED
+}
+
+func (x *ListParameterValuesRequest) GetSubjectCode() string {
+	if x != nil && x.SubjectCode != nil {
+		return *x.SubjectCode
+	}
+	return ""
+}
+
+func (x *ListParameterValuesRequest) GetParameterCode() string {
+	if x != nil && x.ParameterCode != nil {
+		return *x.ParameterCode
+	}
+	return ""
+}
+
+func (x *ListParameterValuesRequest) GetAsOf() string {
+	if x != nil && x.AsOf != nil {
+		return *x.AsOf
+	}
+	return ""
+}
+
+type ListParameterValuesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Data          []*ParameterValue      `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	Pagination    *PaginationMeta        `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListParameterValuesResponse) Reset() {
+	*x = ListParameterValuesResponse{}
+	mi := &file_costing_v1_parameter_value_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListParameterValuesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListParameterValuesResponse) ProtoMessage() {}
+
+func (x *ListParameterValuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_parameter_value_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListParameterValuesResponse.ProtoReflect.Descriptor instead.
+func (*ListParameterValuesResponse) Descriptor() ([]byte, []int) {
+	return file_costing_v1_parameter_value_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListParameterValuesResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListParameterValuesResponse) GetData() []*ParameterValue {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListParameterValuesResponse) GetPagination() *PaginationMeta {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// UpdateParameterValue
+type UpdateParameterValueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	EffectiveFrom string                 `protobuf:"bytes,3,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
+	EffectiveTo   *string                `protobuf:"bytes,4,opt,name=effective_to,json=effectiveTo,proto3,oneof" json:"effective_to,omitempty"`
+	Remarks       *string                `protobuf:"bytes,5,opt,name=remarks,proto3,oneof" json:"remarks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateParameterValueRequest) Reset() {
+	*x = UpdateParameterValueRequest{}
+	mi := &file_costing_v1_parameter_value_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateParameterValueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateParameterValueRequest) ProtoMessage() {}
+
+func (x *UpdateParameterValueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_parameter_value_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateParameterValueRequest.ProtoReflect.Descriptor instead.
+func (*UpdateParameterValueRequest) Descriptor() ([]byte, []int) {
+	return file_costing_v1_parameter_value_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateParameterValueRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateParameterValueRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *UpdateParameterValueRequest) GetEffectiveFrom() string {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return ""
+}
+
+func (x *UpdateParameterValueRequest) GetEffectiveTo() string {
+	if x != nil && x.EffectiveTo != nil {
+		return *x.EffectiveTo
+	}
+	return ""
+}
+
+func (x *UpdateParameterValueRequest) GetRemarks() string {
+	if x != nil && x.Remarks != nil {
+		return *x.Remarks
+	}
+	return ""
+}
+
+type UpdateParameterValueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Data          *ParameterValue        `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateParameterValueResponse) Reset() {
+	*x = UpdateParameterValueResponse{}
+	mi := &file_costing_v1_parameter_value_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateParameterValueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateParameterValueResponse) ProtoMessage() {}
+
+func (x *UpdateParameterValueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_parameter_value_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateParameterValueResponse.ProtoReflect.Descriptor instead.
+func (*UpdateParameterValueResponse) Descriptor() ([]byte, []int) {
+	return file_costing_v1_parameter_value_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateParameterValueResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *UpdateParameterValueResponse) GetData() *ParameterValue {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// DeleteParameterValue
+type DeleteParameterValueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteParameterValueRequest) Reset() {
+	*x = DeleteParameterValueRequest{}
+	mi := &file_costing_v1_parameter_value_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteParameterValueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteParameterValueRequest) ProtoMessage() {}
+
+func (x *DeleteParameterValueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_parameter_value_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteParameterValueRequest.ProtoReflect.Descriptor instead.
+func (*DeleteParameterValueRequest) Descriptor() ([]byte, []int) {
+	return file_costing_v1_parameter_value_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteParameterValueRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteParameterValueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteParameterValueResponse) Reset() {
+	*x = DeleteParameterValueResponse{}
+	mi := &file_costing_v1_parameter_value_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteParameterValueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteParameterValueResponse) ProtoMessage() {}
+
+func (x *DeleteParameterValueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_parameter_value_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteParameterValueResponse.ProtoReflect.Descriptor instead.
+func (*DeleteParameterValueResponse) Descriptor() ([]byte, []int) {
+	return file_costing_v1_parameter_value_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteParameterValueResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+var File_costing_v1_parameter_value_proto protoreflect.FileDescriptor
+
+const file_costing_v1_parameter_value_proto_rawDesc = "" +
+	"\n" +
+	" costing/v1/parameter_value.proto\x12\n" +
+	"costing.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17costing/v1/common.proto\x1a\x1acosting/v1/parameter.proto\"\xec\x03\n" +
+	"\x0eParameterValue\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12:\n" +
+	"\fsubject_type\x18\x02 \x01(\x0e2\x17.costing.v1.SubjectTypeR\vsubjectType\x12!\n" +
+	"\fsubject_code\x18\x03 \x01(\tR\vsubjectCode\x12%\n" +
+	"\x0eparameter_code\x18\x04 \x01(\tR\rparameterCode\x12:\n" +
+	"\tdata_type\x18\x05 \x01(\x0e2\x1d.costing.v1.ParameterDataTypeR\bdataType\x12\x14\n" +
+	"\x05value\x18\x06 \x01(\tR\x05value\x12(\n" +
+	"\rnumeric_value\x18\a \x01(\x01H\x00R\fnumericValue\x88\x01\x01\x12%\n" +
+	"\x0eeffective_from\x18\b \x01(\tR\reffectiveFrom\x12&\n" +
+	"\feffective_to\x18\t \x01(\tH\x01R\veffectiveTo\x88\x01\x01\x12\x1d\n" +
+	"\aremarks\x18\n" +
+	" \x01(\tH\x02R\aremarks\x88\x01\x01\x12+\n" +
+	"\x05audit\x18\v \x01(\v2\x15.costing.v1.AuditInfoR\x05auditB\x10\n" +
+	"\x0e_numeric_valueB\x0f\n" +
+	"\r_effective_toB\n" +
+	"\n" +
+	"\b_remarks\"\xc6\x03\n" +
+	"\x1bCreateParameterValueRequest\x12F\n" +
+	"\fsubject_type\x18\x01 \x01(\x0e2\x17.costing.v1.SubjectTypeB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\vsubjectType\x12,\n" +
+	"\fsubject_code\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x182R\vsubjectCode\x120\n" +
+	"\x0eparameter_code\x18\x03 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x182R\rparameterCode\x12 \n" +
+	"\x05value\x18\x04 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xe8\aR\x05value\x12J\n" +
+	"\x0eeffective_from\x18\x05 \x01(\tB#\xbaH r\x1e2\x1c^[0-9]{4}-[0-9]{2}-[0-9]{2}$R\reffectiveFrom\x12K\n" +
+	"\feffective_to\x18\x06 \x01(\tB#\xbaH r\x1e2\x1c^[0-9]{4}-[0-9]{2}-[0-9]{2}$H\x00R\veffectiveTo\x88\x01\x01\x12'\n" +
+	"\aremarks\x18\a \x01(\tB\b\xbaH\x05r\x03\x18\xe8\aH\x01R\aremarks\x88\x01\x01B\x0f\n" +
+	"\r_effective_toB\n" +
+	"\n" +
+	"\b_remarks\"|\n" +
+	"\x1cCreateParameterValueResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.costing.v1.BaseResponseR\x04base\x12.\n" +
+	"\x04data\x18\x02 \x01(\v2\x1a.costing.v1.ParameterValueR\x04data\"3\n" +
+	"\x18GetParameterValueRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\"y\n" +
+	"\x19GetParameterValueResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.costing.v1.BaseResponseR\x04base\x12.\n" +
+	"\x04data\x18\x02 \x01(\v2\x1a.costing.v1.ParameterValueR\x04data\"\xf4\x02\n" +
+	"\x1aListParameterValuesRequest\x12\x1b\n" +
+	"\x04page\x18\x01 \x01(\x05B\a\xbaH\x04\x1a\x02(\x01R\x04page\x12&\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x01R\bpageSize\x12?\n" +
+	"\fsubject_type\x18\x03 \x01(\x0e2\x17.costing.v1.SubjectTypeH\x00R\vsubjectType\x88\x01\x01\x12&\n" +
+	"\fsubject_code\x18\x04 \x01(\tH\x01R\vsubjectCode\x88\x01\x01\x12*\n" +
+	"\x0eparameter_code\x18\x05 \x01(\tH\x02R\rparameterCode\x88\x01\x01\x12=\n" +
+	"\x05as_of\x18\x06 \x01(\tB#\xbaH r\x1e2\x1c^[0-9]{4}-[0-9]{2}-[0-9]{2}$H\x03R\x04asOf\x88\x01\x01B\x0f\n" +
+	"\r_subject_typeB\x0f\n" +
+	"\r_subject_codeB\x11\n" +
+	"\x0f_parameter_codeB\b\n" +
+	"\x06_as_of\"\xb7\x01\n" +
+	"\x1bListParameterValuesResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.costing.v1.BaseResponseR\x04base\x12.\n" +
+	"\x04data\x18\x02 \x03(\v2\x1a.costing.v1.ParameterValueR\x04data\x12:\n" +
+	"\n" +
+	"pagination\x18\x03 \x01(\v2\x1a.costing.v1.PaginationMetaR\n" +
+	"pagination\"\xb7\x02\n" +
+	"\x1bUpdateParameterValueRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\x12 \n" +
+	"\x05value\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xe8\aR\x05value\x12J\n" +
+	"\x0eeffective_from\x18\x03 \x01(\tB#\xbaH r\x1e2\x1c^[0-9]{4}-[0-9]{2}-[0-9]{2}$R\reffectiveFrom\x12K\n" +
+	"\feffective_to\x18\x04 \x01(\tB#\xbaH r\x1e2\x1c^[0-9]{4}-[0-9]{2}-[0-9]{2}$H\x00R\veffectiveTo\x88\x01\x01\x12'\n" +
+	"\aremarks\x18\x05 \x01(\tB\b\xbaH\x05r\x03\x18\xe8\aH\x01R\aremarks\x88\x01\x01B\x0f\n" +
+	"\r_effective_toB\n" +
+	"\n" +
+	"\b_remarks\"|\n" +
+	"\x1cUpdateParameterValueResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.costing.v1.BaseResponseR\x04base\x12.\n" +
+	"\x04data\x18\x02 \x01(\v2\x1a.costing.v1.ParameterValueR\x04data\"6\n" +
+	"\x1bDeleteParameterValueRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\"L\n" +
+	"\x1cDeleteParameterValueResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.costing.v1.BaseResponseR\x04base*z\n" +
+	"\vSubjectType\x12\x1c\n" +
+	"\x18SUBJECT_TYPE_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14SUBJECT_TYPE_MACHINE\x10\x01\x12\x19\n" +
+	"\x15SUBJECT_TYPE_MATERIAL\x10\x02\x12\x18\n" +
+	"\x14SUBJECT_TYPE_PRODUCT\x10\x032\xd2\x05\n" +
+	"\x15ParameterValueService\x12\x8a\x01\n" +
+	"\x14CreateParameterValue\x12'.costing.v1.CreateParameterValueRequest\x1a(.costing.v1.CreateParameterValueResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/parameter-values\x12\x83\x01\n" +
+	"\x11GetParameterValue\x12$.costing.v1.GetParameterValueRequest\x1a%.costing.v1.GetParameterValueResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/parameter-values/{id}\x12\x84\x01\n" +
+	"\x13ListParameterValues\x12&.costing.v1.ListParameterValuesRequest\x1a'.costing.v1.ListParameterValuesResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/parameter-values\x12\x8f\x01\n" +
+	"\x14UpdateParameterValue\x12'.costing.v1.UpdateParameterValueRequest\x1a(.costing.v1.UpdateParameterValueResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\x1a\x19/v1/parameter-values/{id}\x12\x8c\x01\n" +
+	"\x14DeleteParameterValue\x12'.costing.v1.DeleteParameterValueRequest\x1a(.costing.v1.DeleteParameterValueResponse\"!\x82\xd3\xe4\x93\x02\x1b*\x19/v1/parameter-values/{id}B\xb7\x01\n" +
+	"\x0ecom.costing.v1B\x14Parameter_valueProtoP\x01ZFgithub.com/homindolenern/goapps-costing-v1/gen/go/costing/v1;costingv1\xa2\x02\x03CXX\xaa\x02\n" +
+	"Costing.V1\xca\x02\n" +
+	"Costing\\V1\xe2\x02\x16Costing\\V1\\GPBMetadata\xea\x02\vCosting::V1b\x06proto3"
+
+var (
+	file_costing_v1_parameter_value_proto_rawDescOnce sync.Once
+	file_costing_v1_parameter_value_proto_rawDescData []byte
+)
+
+func file_costing_v1_parameter_value_proto_rawDescGZIP() []byte {
+	file_costing_v1_parameter_value_proto_rawDescOnce.Do(func() {
+		file_costing_v1_parameter_value_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_costing_v1_parameter_value_proto_rawDesc), len(file_costing_v1_parameter_value_proto_rawDesc)))
+	})
+	return file_costing_v1_parameter_value_proto_rawDescData
+}
+
+var file_costing_v1_parameter_value_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_costing_v1_parameter_value_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_costing_v1_parameter_value_proto_goTypes = []any{
+	(SubjectType)(0),                     // 0: costing.v1.SubjectType
+	(*ParameterValue)(nil),               // 1: costing.v1.ParameterValue
+	(*CreateParameterValueRequest)(nil),  // 2: costing.v1.CreateParameterValueRequest
+	(*CreateParameterValueResponse)(nil), // 3: costing.v1.CreateParameterValueResponse
+	(*GetParameterValueRequest)(nil),     // 4: costing.v1.GetParameterValueRequest
+	(*GetParameterValueResponse)(nil),    // 5: costing.v1.GetParameterValueResponse
+	(*ListParameterValuesRequest)(nil),   // 6: costing.v1.ListParameterValuesRequest
+	(*ListParameterValuesResponse)(nil),  // 7: costing.v1.ListParameterValuesResponse
+	(*UpdateParameterValueRequest)(nil),  // 8: costing.v1.UpdateParameterValueRequest
+	(*UpdateParameterValueResponse)(nil), // 9: costing.v1.UpdateParameterValueResponse
+	(*DeleteParameterValueRequest)(nil),  // 10: costing.v1.DeleteParameterValueRequest
+	(*DeleteParameterValueResponse)(nil), // 11: costing.v1.DeleteParameterValueResponse
+	(ParameterDataType)(0),               // 12: costing.v1.ParameterDataType
+	(*AuditInfo)(nil),                    // 13: costing.v1.AuditInfo
+	(*BaseResponse)(nil),                 // 14: costing.v1.BaseResponse
+	(*PaginationMeta)(nil),               // 15: costing.v1.PaginationMeta
+}
+var file_costing_v1_parameter_value_proto_depIdxs = []int32{
+	0,  // 0: costing.v1.ParameterValue.subject_type:type_name -> costing.v1.SubjectType
+	12, // 1: costing.v1.ParameterValue.data_type:type_name -> costing.v1.ParameterDataType
+	13, // 2: costing.v1.ParameterValue.audit:type_name -> costing.v1.AuditInfo
+	0,  // 3: costing.v1.CreateParameterValueRequest.subject_type:type_name -> costing.v1.SubjectType
+	14, // 4: costing.v1.CreateParameterValueResponse.base:type_name -> costing.v1.BaseResponse
+	1,  // 5: costing.v1.CreateParameterValueResponse.data:type_name -> costing.v1.ParameterValue
+	14, // 6: costing.v1.GetParameterValueResponse.base:type_name -> costing.v1.BaseResponse
+	1,  // 7: costing.v1.GetParameterValueResponse.data:type_name -> costing.v1.ParameterValue
+	0,  // 8: costing.v1.ListParameterValuesRequest.subject_type:type_name -> costing.v1.SubjectType
+	14, // 9: costing.v1.ListParameterValuesResponse.base:type_name -> costing.v1.BaseResponse
+	1,  // 10: costing.v1.ListParameterValuesResponse.data:type_name -> costing.v1.ParameterValue
+	15, // 11: costing.v1.ListParameterValuesResponse.pagination:type_name -> costing.v1.PaginationMeta
+	14, // 12: costing.v1.UpdateParameterValueResponse.base:type_name -> costing.v1.BaseResponse
+	1,  // 13: costing.v1.UpdateParameterValueResponse.data:type_name -> costing.v1.ParameterValue
+	14, // 14: costing.v1.DeleteParameterValueResponse.base:type_name -> costing.v1.BaseResponse
+	2,  // 15: costing.v1.ParameterValueService.CreateParameterValue:input_type -> costing.v1.CreateParameterValueRequest
+	4,  // 16: costing.v1.ParameterValueService.GetParameterValue:input_type -> costing.v1.GetParameterValueRequest
+	6,  // 17: costing.v1.ParameterValueService.ListParameterValues:input_type -> costing.v1.ListParameterValuesRequest
+	8,  // 18: costing.v1.ParameterValueService.UpdateParameterValue:input_type -> costing.v1.UpdateParameterValueRequest
+	10, // 19: costing.v1.ParameterValueService.DeleteParameterValue:input_type -> costing.v1.DeleteParameterValueRequest
+	3,  // 20: costing.v1.ParameterValueService.CreateParameterValue:output_type -> costing.v1.CreateParameterValueResponse
+	5,  // 21: costing.v1.ParameterValueService.GetParameterValue:output_type -> costing.v1.GetParameterValueResponse
+	7,  // 22: costing.v1.ParameterValueService.ListParameterValues:output_type -> costing.v1.ListParameterValuesResponse
+	9,  // 23: costing.v1.ParameterValueService.UpdateParameterValue:output_type -> costing.v1.UpdateParameterValueResponse
+	11, // 24: costing.v1.ParameterValueService.DeleteParameterValue:output_type -> costing.v1.DeleteParameterValueResponse
+	20, // [20:25] is the sub-list for method output_type
+	15, // [15:20] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_costing_v1_parameter_value_proto_init() }
+func file_costing_v1_parameter_value_proto_init() {
+	if File_costing_v1_parameter_value_proto != nil {
+		return
+	}
+	file_costing_v1_common_proto_init()
+	file_costing_v1_parameter_proto_init()
+	file_costing_v1_parameter_value_proto_msgTypes[0].OneofWrappers = []any{}
+	file_costing_v1_parameter_value_proto_msgTypes[1].OneofWrappers = []any{}
+	file_costing_v1_parameter_value_proto_msgTypes[5].OneofWrappers = []any{}
+	file_costing_v1_parameter_value_proto_msgTypes[7].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_costing_v1_parameter_value_proto_rawDesc), len(file_costing_v1_parameter_value_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_costing_v1_parameter_value_proto_goTypes,
+		DependencyIndexes: file_costing_v1_parameter_value_proto_depIdxs,
+		EnumInfos:         file_costing_v1_parameter_value_proto_enumTypes,
+		MessageInfos:      file_costing_v1_parameter_value_proto_msgTypes,
+	}.Build()
+	File_costing_v1_parameter_value_proto = out.File
+	file_costing_v1_parameter_value_proto_goTypes = nil
+	file_costing_v1_parameter_value_proto_depIdxs = nil
+}
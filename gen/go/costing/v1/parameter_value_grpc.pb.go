@@ -0,0 +1,287 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             (unknown)
+// source: costing/v1/parameter_value.proto
+
+package costingv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file.
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ParameterValueService_CreateParameterValue_FullMethodName = "/costing.v1.ParameterValueService/CreateParameterValue"
+	ParameterValueService_GetParameterValue_FullMethodName    = "/costing.v1.ParameterValueService/GetParameterValue"
+	ParameterValueService_ListParameterValues_FullMethodName  = "/costing.v1.ParameterValueService/ListParameterValues"
+	ParameterValueService_UpdateParameterValue_FullMethodName = "/costing.v1.ParameterValueService/UpdateParameterValue"
+	ParameterValueService_DeleteParameterValue_FullMethodName = "/costing.v1.ParameterValueService/DeleteParameterValue"
+)
+
+// ParameterValueServiceClient is the client API for ParameterValueService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ParameterValueService records parameter values for machines, materials and products.
+type ParameterValueServiceClient interface {
+	// CreateParameterValue records a new value, superseding the current open-ended one
+	CreateParameterValue(ctx context.Context, in *CreateParameterValueRequest, opts ...grpc.CallOption) (*CreateParameterValueResponse, error)
+	// GetParameterValue retrieves a Parameter Value by id
+	GetParameterValue(ctx context.Context, in *GetParameterValueRequest, opts ...grpc.CallOption) (*GetParameterValueResponse, error)
+	// ListParameterValues retrieves a paginated list of Parameter Values
+	ListParameterValues(ctx context.Context, in *ListParameterValuesRequest, opts ...grpc.CallOption) (*ListParameterValuesResponse, error)
+	// UpdateParameterValue updates an existing Parameter Value
+	UpdateParameterValue(ctx context.Context, in *UpdateParameterValueRequest, opts ...grpc.CallOption) (*UpdateParameterValueResponse, error)
+	// DeleteParameterValue deletes a Parameter Value by id
+	DeleteParameterValue(ctx context.Context, in *DeleteParameterValueRequest, opts ...grpc.CallOption) (*DeleteParameterValueResponse, error)
+}
+
+type parameterValueServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewParameterValueServiceClient(cc grpc.ClientConnInterface) ParameterValueServiceClient {
+	return &parameterValueServiceClient{cc}
+}
+
+func (c *parameterValueServiceClient) CreateParameterValue(ctx context.Context, in *CreateParameterValueRequest, opts ...grpc.CallOption) (*CreateParameterValueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateParameterValueResponse)
+	err := c.cc.Invoke(ctx, ParameterValueService_CreateParameterValue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *parameterValueServiceClient) GetParameterValue(ctx context.Context, in *GetParameterValueRequest, opts ...grpc.CallOption) (*GetParameterValueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetParameterValueResponse)
+	err := c.cc.Invoke(ctx, ParameterValueService_GetParameterValue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *parameterValueServiceClient) ListParameterValues(ctx context.Context, in *ListParameterValuesRequest, opts ...grpc.CallOption) (*ListParameterValuesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListParameterValuesResponse)
+	err := c.cc.Invoke(ctx, ParameterValueService_ListParameterValues_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *parameterValueServiceClient) UpdateParameterValue(ctx context.Context, in *UpdateParameterValueRequest, opts ...grpc.CallOption) (*UpdateParameterValueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateParameterValueResponse)
+	err := c.cc.Invoke(ctx, ParameterValueService_UpdateParameterValue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *parameterValueServiceClient) DeleteParameterValue(ctx context.Context, in *DeleteParameterValueRequest, opts ...grpc.CallOption) (*DeleteParameterValueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteParameterValueResponse)
+	err := c.cc.Invoke(ctx, ParameterValueService_DeleteParameterValue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ParameterValueServiceServer is the server API for ParameterValueService service.
+// All implementations must embed UnimplementedParameterValueServiceServer.
+// for forward compatibility.
+//
+// ParameterValueService records parameter values for machines, materials and products.
+type ParameterValueServiceServer interface {
+	// CreateParameterValue records a new value, superseding the current open-ended one
+	CreateParameterValue(context.Context, *CreateParameterValueRequest) (*CreateParameterValueResponse, error)
+	// GetParameterValue retrieves a Parameter Value by id
+	GetParameterValue(context.Context, *GetParameterValueRequest) (*GetParameterValueResponse, error)
+	// ListParameterValues retrieves a paginated list of Parameter Values
+	ListParameterValues(context.Context, *ListParameterValuesRequest) (*ListParameterValuesResponse, error)
+	// UpdateParameterValue updates an existing Parameter Value
+	UpdateParameterValue(context.Context, *UpdateParameterValueRequest) (*UpdateParameterValueResponse, error)
+	// DeleteParameterValue deletes a Parameter Value by id
+	DeleteParameterValue(context.Context, *DeleteParameterValueRequest) (*DeleteParameterValueResponse, error)
+	mustEmbedUnimplementedParameterValueServiceServer()
+}
+
+// UnimplementedParameterValueServiceServer must be embedded to have.
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedParameterValueServiceServer struct{}
+
+func (UnimplementedParameterValueServiceServer) CreateParameterValue(context.Context, *CreateParameterValueRequest) (*CreateParameterValueResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateParameterValue not implemented")
+}
+func (UnimplementedParameterValueServiceServer) GetParameterValue(context.Context, *GetParameterValueRequest) (*GetParameterValueResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetParameterValue not implemented")
+}
+func (UnimplementedParameterValueServiceServer) ListParameterValues(context.Context, *ListParameterValuesRequest) (*ListParameterValuesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListParameterValues not implemented")
+}
+func (UnimplementedParameterValueServiceServer) UpdateParameterValue(context.Context, *UpdateParameterValueRequest) (*UpdateParameterValueResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateParameterValue not implemented")
+}
+func (UnimplementedParameterValueServiceServer) DeleteParameterValue(context.Context, *DeleteParameterValueRequest) (*DeleteParameterValueResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteParameterValue not implemented")
+}
+func (UnimplementedParameterValueServiceServer) mustEmbedUnimplementedParameterValueServiceServer() {}
+func (UnimplementedParameterValueServiceServer) testEmbeddedByValue()                               {}
+
+// UnsafeParameterValueServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ParameterValueServiceServer will.
+// result in compilation errors.
+type UnsafeParameterValueServiceServer interface {
+	mustEmbedUnimplementedParameterValueServiceServer()
+}
+
+func RegisterParameterValueServiceServer(s grpc.ServiceRegistrar, srv ParameterValueServiceServer) {
+	// If the following call panics, it indicates UnimplementedParameterValueServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ParameterValueService_ServiceDesc, srv)
+}
+
+func _ParameterValueService_CreateParameterValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateParameterValueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ParameterValueServiceServer).CreateParameterValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ParameterValueService_CreateParameterValue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ParameterValueServiceServer).CreateParameterValue(ctx, req.(*CreateParameterValueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ParameterValueService_GetParameterValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetParameterValueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ParameterValueServiceServer).GetParameterValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ParameterValueService_GetParameterValue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ParameterValueServiceServer).GetParameterValue(ctx, req.(*GetParameterValueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ParameterValueService_ListParameterValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListParameterValuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ParameterValueServiceServer).ListParameterValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ParameterValueService_ListParameterValues_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ParameterValueServiceServer).ListParameterValues(ctx, req.(*ListParameterValuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ParameterValueService_UpdateParameterValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateParameterValueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ParameterValueServiceServer).UpdateParameterValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ParameterValueService_UpdateParameterValue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ParameterValueServiceServer).UpdateParameterValue(ctx, req.(*UpdateParameterValueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ParameterValueService_DeleteParameterValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteParameterValueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ParameterValueServiceServer).DeleteParameterValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ParameterValueService_DeleteParameterValue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ParameterValueServiceServer).DeleteParameterValue(ctx, req.(*DeleteParameterValueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ParameterValueService_ServiceDesc is the grpc.ServiceDesc for ParameterValueService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ParameterValueService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "costing.v1.ParameterValueService",
+	HandlerType: (*ParameterValueServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateParameterValue",
+			Handler:    _ParameterValueService_CreateParameterValue_Handler,
+		},
+		{
+			MethodName: "GetParameterValue",
+			Handler:    _ParameterValueService_GetParameterValue_Handler,
+		},
+		{
+			MethodName: "ListParameterValues",
+			Handler:    _ParameterValueService_ListParameterValues_Handler,
+		},
+		{
+			MethodName: "UpdateParameterValue",
+			Handler:    _ParameterValueService_UpdateParameterValue_Handler,
+		},
+		{
+			MethodName: "DeleteParameterValue",
+			Handler:    _ParameterValueService_DeleteParameterValue_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "costing/v1/parameter_value.proto",
+}
@@ -81,14 +81,16 @@ func (UOMCategory) EnumDescriptor() ([]byte, []int) {
 
 // UOM represents a Unit of Measure entity
 type UOM struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UomCode       string                 `protobuf:"bytes,1,opt,name=uom_code,json=uomCode,proto3" json:"uom_code,omitempty"`
-	UomName       string                 `protobuf:"bytes,2,opt,name=uom_name,json=uomName,proto3" json:"uom_name,omitempty"`
-	UomCategory   UOMCategory            `protobuf:"varint,3,opt,name=uom_category,json=uomCategory,proto3,enum=costing.v1.UOMCategory" json:"uom_category,omitempty"`
-	IsBaseUom     bool                   `protobuf:"varint,4,opt,name=is_base_uom,json=isBaseUom,proto3" json:"is_base_uom,omitempty"`
-	Audit         *AuditInfo             `protobuf:"bytes,5,opt,name=audit,proto3" json:"audit,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UomCode          string                 `protobuf:"bytes,1,opt,name=uom_code,json=uomCode,proto3" json:"uom_code,omitempty"`
+	UomName          string                 `protobuf:"bytes,2,opt,name=uom_name,json=uomName,proto3" json:"uom_name,omitempty"`
+	UomCategory      UOMCategory            `protobuf:"varint,3,opt,name=uom_category,json=uomCategory,proto3,enum=costing.v1.UOMCategory" json:"uom_category,omitempty"`
+	IsBaseUom        bool                   `protobuf:"varint,4,opt,name=is_base_uom,json=isBaseUom,proto3" json:"is_base_uom,omitempty"`
+	Audit            *AuditInfo             `protobuf:"bytes,5,opt,name=audit,proto3" json:"audit,omitempty"`
+	ConversionFactor float64                `protobuf:"fixed64,6,opt,name=conversion_factor,json=conversionFactor,proto3" json:"conversion_factor,omitempty"` // Base units of the category in one unit
+	Version          int32                  `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`                                            // Incremented on every update; returned as ETag over HTTP
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *UOM) Reset() {
@@ -156,20 +158,113 @@ func (x *UOM) GetAudit() *AuditInfo {
 	return nil
 }
 
-// CreateUOM
-type CreateUOMRequest struct {
+func (x *UOM) GetConversionFactor() float64 {
+	if x != nil {
+		return x.ConversionFactor
+	}
+	return 0
+}
+
+func (x *UOM) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// UOMConversion is an explicit conversion between UOMs of different categories
+type UOMConversion struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UomCode       string                 `protobuf:"bytes,1,opt,name=uom_code,json=uomCode,proto3" json:"uom_code,omitempty"`
-	UomName       string                 `protobuf:"bytes,2,opt,name=uom_name,json=uomName,proto3" json:"uom_name,omitempty"`
-	UomCategory   UOMCategory            `protobuf:"varint,3,opt,name=uom_category,json=uomCategory,proto3,enum=costing.v1.UOMCategory" json:"uom_category,omitempty"`
-	IsBaseUom     bool                   `protobuf:"varint,4,opt,name=is_base_uom,json=isBaseUom,proto3" json:"is_base_uom,omitempty"`
+	FromUomCode   string                 `protobuf:"bytes,1,opt,name=from_uom_code,json=fromUomCode,proto3" json:"from_uom_code,omitempty"`
+	ToUomCode     string                 `protobuf:"bytes,2,opt,name=to_uom_code,json=toUomCode,proto3" json:"to_uom_code,omitempty"`
+	Factor        float64                `protobuf:"fixed64,3,opt,name=factor,proto3" json:"factor,omitempty"` // 1 from_uom = factor to_uom
+	Description   *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Audit         *AuditInfo             `protobuf:"bytes,5,opt,name=audit,proto3" json:"audit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *UOMConversion) Reset() {
+	*x = UOMConversion{}
+	mi := &file_costing_v1_uom_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UOMConversion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UOMConversion) ProtoMessage() {}
+
+func (x *UOMConversion) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_uom_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UOMConversion.ProtoReflect.Descriptor instead.
+func (*UOMConversion) Descriptor() ([]byte, []int) {
+	return file_costing_v1_uom_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UOMConversion) GetFromUomCode() string {
+	if x != nil {
+		return x.FromUomCode
+	}
+	return ""
+}
+
+func (x *UOMConversion) GetToUomCode() string {
+	if x != nil {
+		return x.ToUomCode
+	}
+	return ""
+}
+
+func (x *UOMConversion) GetFactor() float64 {
+	if x != nil {
+		return x.Factor
+	}
+	return 0
+}
+
+func (x *UOMConversion) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UOMConversion) GetAudit() *AuditInfo {
+	if x != nil {
+		return x.Audit
+	}
+	return nil
+}
+
+// CreateUOM
+type CreateUOMRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	UomCode     string                 `protobuf:"bytes,1,opt,name=uom_code,json=uomCode,proto3" json:"uom_code,omitempty"`
+	UomName     string                 `protobuf:"bytes,2,opt,name=uom_name,json=uomName,proto3" json:"uom_name,omitempty"`
+	UomCategory UOMCategory            `protobuf:"varint,3,opt,name=uom_category,json=uomCategory,proto3,enum=costing.v1.UOMCategory" json:"uom_category,omitempty"`
+	IsBaseUom   bool                   `protobuf:"varint,4,opt,name=is_base_uom,json=isBaseUom,proto3" json:"is_base_uom,omitempty"`
+	// Defaults to 1; must be 1 for the base UOM
+	ConversionFactor *float64 `protobuf:"fixed64,5,opt,name=conversion_factor,json=conversionFactor,proto3,oneof" json:"conversion_factor,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
 func (x *CreateUOMRequest) Reset() {
 	*x = CreateUOMRequest{}
-	mi := &file_costing_v1_uom_proto_msgTypes[1]
+	mi := &file_costing_v1_uom_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -181,7 +276,7 @@ func (x *CreateUOMRequest) String() string {
 func (*CreateUOMRequest) ProtoMessage() {}
 
 func (x *CreateUOMRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_costing_v1_uom_proto_msgTypes[1]
+	mi := &file_costing_v1_uom_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -194,7 +289,7 @@ func (x *CreateUOMRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateUOMRequest.ProtoReflect.Descriptor instead.
 func (*CreateUOMRequest) Descriptor() ([]byte, []int) {
-	return file_costing_v1_uom_proto_rawDescGZIP(), []int{1}
+	return file_costing_v1_uom_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *CreateUOMRequest) GetUomCode() string {
@@ -225,6 +320,13 @@ func (x *CreateUOMRequest) GetIsBaseUom() bool {
 	return false
 }
 
+func (x *CreateUOMRequest) GetConversionFactor() float64 {
+	if x != nil && x.ConversionFactor != nil {
+		return *x.ConversionFactor
+	}
+	return 0
+}
+
 type CreateUOMResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
@@ -235,7 +337,7 @@ type CreateUOMResponse struct {
 
 func (x *CreateUOMResponse) Reset() {
 	*x = CreateUOMResponse{}
-	mi := &file_costing_v1_uom_proto_msgTypes[2]
+	mi := &file_costing_v1_uom_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -247,7 +349,7 @@ func (x *CreateUOMResponse) String() string {
 func (*CreateUOMResponse) ProtoMessage() {}
 
 func (x *CreateUOMResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_costing_v1_uom_proto_msgTypes[2]
+	mi := &file_costing_v1_uom_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -260,7 +362,7 @@ func (x *CreateUOMResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateUOMResponse.ProtoReflect.Descriptor instead.
 func (*CreateUOMResponse) Descriptor() ([]byte, []int) {
-	return file_costing_v1_uom_proto_rawDescGZIP(), []int{2}
+	return file_costing_v1_uom_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CreateUOMResponse) GetBase() *BaseResponse {
@@ -287,7 +389,7 @@ type GetUOMRequest struct {
 
 func (x *GetUOMRequest) Reset() {
 	*x = GetUOMRequest{}
-	mi := &file_costing_v1_uom_proto_msgTypes[3]
+	mi := &file_costing_v1_uom_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -299,7 +401,7 @@ func (x *GetUOMRequest) String() string {
 func (*GetUOMRequest) ProtoMessage() {}
 
 func (x *GetUOMRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_costing_v1_uom_proto_msgTypes[3]
+	mi := &file_costing_v1_uom_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -312,7 +414,7 @@ func (x *GetUOMRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUOMRequest.ProtoReflect.Descriptor instead.
 func (*GetUOMRequest) Descriptor() ([]byte, []int) {
-	return file_costing_v1_uom_proto_rawDescGZIP(), []int{3}
+	return file_costing_v1_uom_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetUOMRequest) GetUomCode() string {
@@ -332,7 +434,7 @@ type GetUOMResponse struct {
 
 func (x *GetUOMResponse) Reset() {
 	*x = GetUOMResponse{}
-	mi := &file_costing_v1_uom_proto_msgTypes[4]
+	mi := &file_costing_v1_uom_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -344,7 +446,7 @@ func (x *GetUOMResponse) String() string {
 func (*GetUOMResponse) ProtoMessage() {}
 
 func (x *GetUOMResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_costing_v1_uom_proto_msgTypes[4]
+	mi := &file_costing_v1_uom_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -357,7 +459,7 @@ func (x *GetUOMResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUOMResponse.ProtoReflect.Descriptor instead.
 func (*GetUOMResponse) Descriptor() ([]byte, []int) {
-	return file_costing_v1_uom_proto_rawDescGZIP(), []int{4}
+	return file_costing_v1_uom_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetUOMResponse) GetBase() *BaseResponse {
@@ -376,17 +478,20 @@ func (x *GetUOMResponse) GetData() *UOM {
 
 // ListUOMs
 type ListUOMsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	Category      *UOMCategory           `protobuf:"varint,3,opt,name=category,proto3,enum=costing.v1.UOMCategory,oneof" json:"category,omitempty"`
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Page           int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize       int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Category       *UOMCategory           `protobuf:"varint,3,opt,name=category,proto3,enum=costing.v1.UOMCategory,oneof" json:"category,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,4,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"` // Include soft-deleted UOMs
+	// next_page_token of a previous response with the same filters; replaces page
+	PageToken     string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListUOMsRequest) Reset() {
 	*x = ListUOMsRequest{}
-	mi := &file_costing_v1_uom_proto_msgTypes[5]
+	mi := &file_costing_v1_uom_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -398,7 +503,7 @@ func (x *ListUOMsRequest) String() string {
 func (*ListUOMsRequest) ProtoMessage() {}
 
 func (x *ListUOMsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_costing_v1_uom_proto_msgTypes[5]
+	mi := &file_costing_v1_uom_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -411,7 +516,7 @@ func (x *ListUOMsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUOMsRequest.ProtoReflect.Descriptor instead.
 func (*ListUOMsRequest) Descriptor() ([]byte, []int) {
-	return file_costing_v1_uom_proto_rawDescGZIP(), []int{5}
+	return file_costing_v1_uom_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ListUOMsRequest) GetPage() int32 {
@@ -435,18 +540,33 @@ func (x *ListUOMsRequest) GetCategory() UOMCategory {
 	return UOMCategory_UOM_CATEGORY_UNSPECIFIED
 }
 
+func (x *ListUOMsRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+func (x *ListUOMsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
 type ListUOMsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	Data          []*UOM                 `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
 	Pagination    *PaginationMeta        `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListUOMsResponse) Reset() {
 	*x = ListUOMsResponse{}
-	mi := &file_costing_v1_uom_proto_msgTypes[6]
+	mi := &file_costing_v1_uom_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -458,7 +578,7 @@ func (x *ListUOMsResponse) String() string {
 func (*ListUOMsResponse) ProtoMessage() {}
 
 func (x *ListUOMsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_costing_v1_uom_proto_msgTypes[6]
+	mi := &file_costing_v1_uom_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -471,7 +591,7 @@ func (x *ListUOMsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUOMsResponse.ProtoReflect.Descriptor instead.
 func (*ListUOMsResponse) Descriptor() ([]byte, []int) {
-	return file_costing_v1_uom_proto_rawDescGZIP(), []int{6}
+	return file_costing_v1_uom_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ListUOMsResponse) GetBase() *BaseResponse {
@@ -495,32 +615,38 @@ func (x *ListUOMsResponse) GetPagination() *PaginationMeta {
 	return nil
 }
 
-// UpdateUOM
-type UpdateUOMRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UomCode       string                 `protobuf:"bytes,1,opt,name=uom_code,json=uomCode,proto3" json:"uom_code,omitempty"`
-	UomName       string                 `protobuf:"bytes,2,opt,name=uom_name,json=uomName,proto3" json:"uom_name,omitempty"`
-	UomCategory   UOMCategory            `protobuf:"varint,3,opt,name=uom_category,json=uomCategory,proto3,enum=costing.v1.UOMCategory" json:"uom_category,omitempty"`
-	IsBaseUom     bool                   `protobuf:"varint,4,opt,name=is_base_uom,json=isBaseUom,proto3" json:"is_base_uom,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ListUOMsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
 }
 
-func (x *UpdateUOMRequest) Reset() {
-	*x = UpdateUOMRequest{}
-	mi := &file_costing_v1_uom_proto_msgTypes[7]
+// ExportUOMs
+type ExportUOMsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Format         FileFormat             `protobuf:"varint,1,opt,name=format,proto3,enum=costing.v1.FileFormat" json:"format,omitempty"`
+	Category       *UOMCategory           `protobuf:"varint,2,opt,name=category,proto3,enum=costing.v1.UOMCategory,oneof" json:"category,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,3,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"` // Include soft-deleted UOMs
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ExportUOMsRequest) Reset() {
+	*x = ExportUOMsRequest{}
+	mi := &file_costing_v1_uom_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateUOMRequest) String() string {
+func (x *ExportUOMsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUOMRequest) ProtoMessage() {}
+func (*ExportUOMsRequest) ProtoMessage() {}
 
-func (x *UpdateUOMRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_costing_v1_uom_proto_msgTypes[7]
+func (x *ExportUOMsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_uom_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -531,62 +657,55 @@ func (x *UpdateUOMRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUOMRequest.ProtoReflect.Descriptor instead.
-func (*UpdateUOMRequest) Descriptor() ([]byte, []int) {
-	return file_costing_v1_uom_proto_rawDescGZIP(), []int{7}
-}
-
-func (x *UpdateUOMRequest) GetUomCode() string {
-	if x != nil {
-		return x.UomCode
-	}
-	return ""
+// Deprecated: Use ExportUOMsRequest.ProtoReflect.Descriptor instead.
+func (*ExportUOMsRequest) Descriptor() ([]byte, []int) {
+	return file_costing_v1_uom_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *UpdateUOMRequest) GetUomName() string {
+func (x *ExportUOMsRequest) GetFormat() FileFormat {
 	if x != nil {
-		return x.UomName
+		return x.Format
 	}
-	return ""
+	return FileFormat_FILE_FORMAT_UNSPECIFIED
 }
 
-func (x *UpdateUOMRequest) GetUomCategory() UOMCategory {
-	if x != nil {
-		return x.UomCategory
+func (x *ExportUOMsRequest) GetCategory() UOMCategory {
+	if x != nil && x.Category != nil {
+		return *x.Category
 	}
 	return UOMCategory_UOM_CATEGORY_UNSPECIFIED
 }
 
-func (x *UpdateUOMRequest) GetIsBaseUom() bool {
+func (x *ExportUOMsRequest) GetIncludeDeleted() bool {
 	if x != nil {
-		return x.IsBaseUom
+		return x.IncludeDeleted
 	}
 	return false
 }
 
-type UpdateUOMResponse struct {
+// WatchUOMs
+type WatchUOMsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	Data          *UOM                   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	ResumeToken   string                 `protobuf:"bytes,1,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"` // resume_token of the last change received
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateUOMResponse) Reset() {
-	*x = UpdateUOMResponse{}
-	mi := &file_costing_v1_uom_proto_msgTypes[8]
+func (x *WatchUOMsRequest) Reset() {
+	*x = WatchUOMsRequest{}
+	mi := &file_costing_v1_uom_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateUOMResponse) String() string {
+func (x *WatchUOMsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUOMResponse) ProtoMessage() {}
+func (*WatchUOMsRequest) ProtoMessage() {}
 
-func (x *UpdateUOMResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_costing_v1_uom_proto_msgTypes[8]
+func (x *WatchUOMsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_uom_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -597,48 +716,45 @@ func (x *UpdateUOMResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUOMResponse.ProtoReflect.Descriptor instead.
-func (*UpdateUOMResponse) Descriptor() ([]byte, []int) {
-	return file_costing_v1_uom_proto_rawDescGZIP(), []int{8}
-}
-
-func (x *UpdateUOMResponse) GetBase() *BaseResponse {
-	if x != nil {
-		return x.Base
-	}
-	return nil
+// Deprecated: Use WatchUOMsRequest.ProtoReflect.Descriptor instead.
+func (*WatchUOMsRequest) Descriptor() ([]byte, []int) {
+	return file_costing_v1_uom_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *UpdateUOMResponse) GetData() *UOM {
+func (x *WatchUOMsRequest) GetResumeToken() string {
 	if x != nil {
-		return x.Data
+		return x.ResumeToken
 	}
-	return nil
+	return ""
 }
 
-// DeleteUOM
-type DeleteUOMRequest struct {
+// UOMChange is one change of a WatchUOMs stream
+type UOMChange struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UomCode       string                 `protobuf:"bytes,1,opt,name=uom_code,json=uomCode,proto3" json:"uom_code,omitempty"`
+	ChangeType    ChangeType             `protobuf:"varint,1,opt,name=change_type,json=changeType,proto3,enum=costing.v1.ChangeType" json:"change_type,omitempty"`
+	EventType     string                 `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"` // Domain event, e.g. uom.restored
+	Uom           *UOM                   `protobuf:"bytes,3,opt,name=uom,proto3" json:"uom,omitempty"`                              // State right after the change
+	OccurredAt    string                 `protobuf:"bytes,4,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	ResumeToken   string                 `protobuf:"bytes,5,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"` // Resumes the watch after this change
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteUOMRequest) Reset() {
-	*x = DeleteUOMRequest{}
-	mi := &file_costing_v1_uom_proto_msgTypes[9]
+func (x *UOMChange) Reset() {
+	*x = UOMChange{}
+	mi := &file_costing_v1_uom_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteUOMRequest) String() string {
+func (x *UOMChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteUOMRequest) ProtoMessage() {}
+func (*UOMChange) ProtoMessage() {}
 
-func (x *DeleteUOMRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_costing_v1_uom_proto_msgTypes[9]
+func (x *UOMChange) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_uom_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -649,40 +765,76 @@ func (x *DeleteUOMRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteUOMRequest.ProtoReflect.Descriptor instead.
-func (*DeleteUOMRequest) Descriptor() ([]byte, []int) {
-	return file_costing_v1_uom_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use UOMChange.ProtoReflect.Descriptor instead.
+func (*UOMChange) Descriptor() ([]byte, []int) {
+	return file_costing_v1_uom_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *DeleteUOMRequest) GetUomCode() string {
+func (x *UOMChange) GetChangeType() ChangeType {
 	if x != nil {
-		return x.UomCode
+		return x.ChangeType
+	}
+	return ChangeType_CHANGE_TYPE_UNSPECIFIED
+}
+
+func (x *UOMChange) GetEventType() string {
+	if x != nil {
+		return x.EventType
 	}
 	return ""
 }
 
-type DeleteUOMResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+func (x *UOMChange) GetUom() *UOM {
+	if x != nil {
+		return x.Uom
+	}
+	return nil
+}
+
+func (x *UOMChange) GetOccurredAt() string {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return ""
+}
+
+func (x *UOMChange) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+// UpdateUOM
+type UpdateUOMRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	UomCode     string                 `protobuf:"bytes,1,opt,name=uom_code,json=uomCode,proto3" json:"uom_code,omitempty"`
+	UomName     string                 `protobuf:"bytes,2,opt,name=uom_name,json=uomName,proto3" json:"uom_name,omitempty"`
+	UomCategory UOMCategory            `protobuf:"varint,3,opt,name=uom_category,json=uomCategory,proto3,enum=costing.v1.UOMCategory" json:"uom_category,omitempty"`
+	IsBaseUom   bool                   `protobuf:"varint,4,opt,name=is_base_uom,json=isBaseUom,proto3" json:"is_base_uom,omitempty"`
+	// Keeps the current factor when not set
+	ConversionFactor *float64 `protobuf:"fixed64,5,opt,name=conversion_factor,json=conversionFactor,proto3,oneof" json:"conversion_factor,omitempty"`
+	// Version the update is based on; taken from If-Match over HTTP when not set
+	Version       int32 `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteUOMResponse) Reset() {
-	*x = DeleteUOMResponse{}
-	mi := &file_costing_v1_uom_proto_msgTypes[10]
+func (x *UpdateUOMRequest) Reset() {
+	*x = UpdateUOMRequest{}
+	mi := &file_costing_v1_uom_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteUOMResponse) String() string {
+func (x *UpdateUOMRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteUOMResponse) ProtoMessage() {}
+func (*UpdateUOMRequest) ProtoMessage() {}
 
-func (x *DeleteUOMResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_costing_v1_uom_proto_msgTypes[10]
+func (x *UpdateUOMRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_uom_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -693,36 +845,1109 @@ func (x *DeleteUOMResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteUOMResponse.ProtoReflect.Descriptor instead.
-func (*DeleteUOMResponse) Descriptor() ([]byte, []int) {
-	return file_costing_v1_uom_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use UpdateUOMRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUOMRequest) Descriptor() ([]byte, []int) {
+	return file_costing_v1_uom_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *DeleteUOMResponse) GetBase() *BaseResponse {
+func (x *UpdateUOMRequest) GetUomCode() string {
 	if x != nil {
-		return x.Base
+		return x.UomCode
 	}
-	return nil
+	return ""
 }
 
-var File_costing_v1_uom_proto protoreflect.FileDescriptor
+func (x *UpdateUOMRequest) GetUomName() string {
+	if x != nil {
+		return x.UomName
+	}
+	return ""
+}
+
+func (x *UpdateUOMRequest) GetUomCategory() UOMCategory {
+	if x != nil {
+		return x.UomCategory
+	}
+	return UOMCategory_UOM_CATEGORY_UNSPECIFIED
+}
+
+func (x *UpdateUOMRequest) GetIsBaseUom() bool {
+	if x != nil {
+		return x.IsBaseUom
+	}
+	return false
+}
+
+func (x *UpdateUOMRequest) GetConversionFactor() float64 {
+	if x != nil && x.ConversionFactor != nil {
+		return *x.ConversionFactor
+	}
+	return 0
+}
+
+func (x *UpdateUOMRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type UpdateUOMResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Data          *UOM                   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUOMResponse) Reset() {
+	*x = UpdateUOMResponse{}
+	mi := &file_costing_v1_uom_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUOMResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUOMResponse) ProtoMessage() {}
+
+func (x *UpdateUOMResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_uom_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUOMResponse.ProtoReflect.Descriptor instead.
+func (*UpdateUOMResponse) Descriptor() ([]byte, []int) {
+	return file_costing_v1_uom_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UpdateUOMResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *UpdateUOMResponse) GetData() *UOM {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// DeleteUOM
+type DeleteUOMRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	UomCode string                 `protobuf:"bytes,1,opt,name=uom_code,json=uomCode,proto3" json:"uom_code,omitempty"`
+	// Delete even if active parameters still use the UOM
+	Force         bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUOMRequest) Reset() {
+	*x = DeleteUOMRequest{}
+	mi := &file_costing_v1_uom_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUOMRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUOMRequest) ProtoMessage() {}
+
+func (x *DeleteUOMRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_uom_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUOMRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUOMRequest) Descriptor() ([]byte, []int) {
+	return file_costing_v1_uom_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeleteUOMRequest) GetUomCode() string {
+	if x != nil {
+		return x.UomCode
+	}
+	return ""
+}
+
+func (x *DeleteUOMRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type DeleteUOMResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUOMResponse) Reset() {
+	*x = DeleteUOMResponse{}
+	mi := &file_costing_v1_uom_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUOMResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUOMResponse) ProtoMessage() {}
+
+func (x *DeleteUOMResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_uom_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUOMResponse.ProtoReflect.Descriptor instead.
+func (*DeleteUOMResponse) Descriptor() ([]byte, []int) {
+	return file_costing_v1_uom_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DeleteUOMResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+// RestoreUOM
+type RestoreUOMRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UomCode       string                 `protobuf:"bytes,1,opt,name=uom_code,json=uomCode,proto3" json:"uom_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreUOMRequest) Reset() {
+	*x = RestoreUOMRequest{}
+	mi := &file_costing_v1_uom_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreUOMRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreUOMRequest) ProtoMessage() {}
+
+func (x *RestoreUOMRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_uom_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreUOMRequest.ProtoReflect.Descriptor instead.
+func (*RestoreUOMRequest) Descriptor() ([]byte, []int) {
+	return file_costing_v1_uom_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RestoreUOMRequest) GetUomCode() string {
+	if x != nil {
+		return x.UomCode
+	}
+	return ""
+}
+
+type RestoreUOMResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Data          *UOM                   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreUOMResponse) Reset() {
+	*x = RestoreUOMResponse{}
+	mi := &file_costing_v1_uom_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreUOMResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreUOMResponse) ProtoMessage() {}
+
+func (x *RestoreUOMResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_uom_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreUOMResponse.ProtoReflect.Descriptor instead.
+func (*RestoreUOMResponse) Descriptor() ([]byte, []int) {
+	return file_costing_v1_uom_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RestoreUOMResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *RestoreUOMResponse) GetData() *UOM {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// ConvertQuantity
+type ConvertQuantityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quantity      float64                `protobuf:"fixed64,1,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	FromUomCode   string                 `protobuf:"bytes,2,opt,name=from_uom_code,json=fromUomCode,proto3" json:"from_uom_code,omitempty"`
+	ToUomCode     string                 `protobuf:"bytes,3,opt,name=to_uom_code,json=toUomCode,proto3" json:"to_uom_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConvertQuantityRequest) Reset() {
+	*x = ConvertQuantityRequest{}
+	mi := &file_costing_v1_uom_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConvertQuantityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertQuantityRequest) ProtoMessage() {}
+
+func (x *ConvertQuantityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_uom_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertQuantityRequest.ProtoReflect.Descriptor instead.
+func (*ConvertQuantityRequest) Descriptor() ([]byte, []int) {
+	return file_costing_v1_uom_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ConvertQuantityRequest) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ConvertQuantityRequest) GetFromUomCode() string {
+	if x != nil {
+		return x.FromUomCode
+	}
+	return ""
+}
+
+func (x *ConvertQuantityRequest) GetToUomCode() string {
+	if x != nil {
+		return x.ToUomCode
+	}
+	return ""
+}
+
+type ConvertQuantityResult struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Quantity          float64                `protobuf:"fixed64,1,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	FromUomCode       string                 `protobuf:"bytes,2,opt,name=from_uom_code,json=fromUomCode,proto3" json:"from_uom_code,omitempty"`
+	ToUomCode         string                 `protobuf:"bytes,3,opt,name=to_uom_code,json=toUomCode,proto3" json:"to_uom_code,omitempty"`
+	ConvertedQuantity float64                `protobuf:"fixed64,4,opt,name=converted_quantity,json=convertedQuantity,proto3" json:"converted_quantity,omitempty"`
+	Factor            float64                `protobuf:"fixed64,5,opt,name=factor,proto3" json:"factor,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ConvertQuantityResult) Reset() {
+	*x = ConvertQuantityResult{}
+	mi := &file_costing_v1_uom_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConvertQuantityResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertQuantityResult) ProtoMessage() {}
+
+func (x *ConvertQuantityResult) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_uom_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertQuantityResult.ProtoReflect.Descriptor instead.
+func (*ConvertQuantityResult) Descriptor() ([]byte, []int) {
+	return file_costing_v1_uom_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ConvertQuantityResult) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ConvertQuantityResult) GetFromUomCode() string {
+	if x != nil {
+		return x.FromUomCode
+	}
+	return ""
+}
+
+func (x *ConvertQuantityResult) GetToUomCode() string {
+	if x != nil {
+		return x.ToUomCode
+	}
+	return ""
+}
+
+func (x *ConvertQuantityResult) GetConvertedQuantity() float64 {
+	if x != nil {
+		return x.ConvertedQuantity
+	}
+	return 0
+}
+
+func (x *ConvertQuantityResult) GetFactor() float64 {
+	if x != nil {
+		return x.Factor
+	}
+	return 0
+}
+
+type ConvertQuantityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Data          *ConvertQuantityResult `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConvertQuantityResponse) Reset() {
+	*x = ConvertQuantityResponse{}
+	mi := &file_costing_v1_uom_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConvertQuantityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertQuantityResponse) ProtoMessage() {}
+
+func (x *ConvertQuantityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_uom_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertQuantityResponse.ProtoReflect.Descriptor instead.
+func (*ConvertQuantityResponse) Descriptor() ([]byte, []int) {
+	return file_costing_v1_uom_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ConvertQuantityResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ConvertQuantityResponse) GetData() *ConvertQuantityResult {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// ListConversions
+type ListConversionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UomCode       *string                `protobuf:"bytes,1,opt,name=uom_code,json=uomCode,proto3,oneof" json:"uom_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConversionsRequest) Reset() {
+	*x = ListConversionsRequest{}
+	mi := &file_costing_v1_uom_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConversionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConversionsRequest) ProtoMessage() {}
+
+func (x *ListConversionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_uom_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConversionsRequest.ProtoReflect.Descriptor instead.
+func (*ListConversionsRequest) Descriptor() ([]byte, []int) {
+	return file_costing_v1_uom_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListConversionsRequest) GetUomCode() string {
+	if x != nil && x.UomCode != nil {
+		return *x.UomCode
+	}
+	return ""
+}
+
+type ListConversionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Data          []*UOMConversion       `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConversionsResponse) Reset() {
+	*x = ListConversionsResponse{}
+	mi := &file_costing_v1_uom_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConversionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConversionsResponse) ProtoMessage() {}
+
+func (x *ListConversionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_uom_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConversionsResponse.ProtoReflect.Descriptor instead.
+func (*ListConversionsResponse) Descriptor() ([]byte, []int) {
+	return file_costing_v1_uom_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListConversionsResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListConversionsResponse) GetData() []*UOMConversion {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// CreateConversion
+type CreateConversionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromUomCode   string                 `protobuf:"bytes,1,opt,name=from_uom_code,json=fromUomCode,proto3" json:"from_uom_code,omitempty"`
+	ToUomCode     string                 `protobuf:"bytes,2,opt,name=to_uom_code,json=toUomCode,proto3" json:"to_uom_code,omitempty"`
+	Factor        float64                `protobuf:"fixed64,3,opt,name=factor,proto3" json:"factor,omitempty"`
+	Description   *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateConversionRequest) Reset() {
+	*x = CreateConversionRequest{}
+	mi := &file_costing_v1_uom_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateConversionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateConversionRequest) ProtoMessage() {}
+
+func (x *CreateConversionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_uom_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateConversionRequest.ProtoReflect.Descriptor instead.
+func (*CreateConversionRequest) Descriptor() ([]byte, []int) {
+	return file_costing_v1_uom_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CreateConversionRequest) GetFromUomCode() string {
+	if x != nil {
+		return x.FromUomCode
+	}
+	return ""
+}
+
+func (x *CreateConversionRequest) GetToUomCode() string {
+	if x != nil {
+		return x.ToUomCode
+	}
+	return ""
+}
+
+func (x *CreateConversionRequest) GetFactor() float64 {
+	if x != nil {
+		return x.Factor
+	}
+	return 0
+}
+
+func (x *CreateConversionRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+type CreateConversionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Data          *UOMConversion         `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateConversionResponse) Reset() {
+	*x = CreateConversionResponse{}
+	mi := &file_costing_v1_uom_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateConversionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateConversionResponse) ProtoMessage() {}
+
+func (x *CreateConversionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_uom_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateConversionResponse.ProtoReflect.Descriptor instead.
+func (*CreateConversionResponse) Descriptor() ([]byte, []int) {
+	return file_costing_v1_uom_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CreateConversionResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *CreateConversionResponse) GetData() *UOMConversion {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// DeleteConversion
+type DeleteConversionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromUomCode   string                 `protobuf:"bytes,1,opt,name=from_uom_code,json=fromUomCode,proto3" json:"from_uom_code,omitempty"`
+	ToUomCode     string                 `protobuf:"bytes,2,opt,name=to_uom_code,json=toUomCode,proto3" json:"to_uom_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteConversionRequest) Reset() {
+	*x = DeleteConversionRequest{}
+	mi := &file_costing_v1_uom_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteConversionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteConversionRequest) ProtoMessage() {}
+
+func (x *DeleteConversionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_uom_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteConversionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteConversionRequest) Descriptor() ([]byte, []int) {
+	return file_costing_v1_uom_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DeleteConversionRequest) GetFromUomCode() string {
+	if x != nil {
+		return x.FromUomCode
+	}
+	return ""
+}
+
+func (x *DeleteConversionRequest) GetToUomCode() string {
+	if x != nil {
+		return x.ToUomCode
+	}
+	return ""
+}
+
+type DeleteConversionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteConversionResponse) Reset() {
+	*x = DeleteConversionResponse{}
+	mi := &file_costing_v1_uom_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteConversionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteConversionResponse) ProtoMessage() {}
+
+func (x *DeleteConversionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_uom_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteConversionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteConversionResponse) Descriptor() ([]byte, []int) {
+	return file_costing_v1_uom_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DeleteConversionResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+// BatchUpsertUOMs
+type UpsertUOMItem struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	UomCode     string                 `protobuf:"bytes,1,opt,name=uom_code,json=uomCode,proto3" json:"uom_code,omitempty"`
+	UomName     string                 `protobuf:"bytes,2,opt,name=uom_name,json=uomName,proto3" json:"uom_name,omitempty"`
+	UomCategory UOMCategory            `protobuf:"varint,3,opt,name=uom_category,json=uomCategory,proto3,enum=costing.v1.UOMCategory" json:"uom_category,omitempty"`
+	// Only for new UOMs; promoting or demoting a base UOM requires UpdateUOM
+	IsBaseUom        bool     `protobuf:"varint,4,opt,name=is_base_uom,json=isBaseUom,proto3" json:"is_base_uom,omitempty"`
+	ConversionFactor *float64 `protobuf:"fixed64,5,opt,name=conversion_factor,json=conversionFactor,proto3,oneof" json:"conversion_factor,omitempty"`
+	// Version the update is based on; 0 updates the current revision
+	Version       int32 `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertUOMItem) Reset() {
+	*x = UpsertUOMItem{}
+	mi := &file_costing_v1_uom_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertUOMItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertUOMItem) ProtoMessage() {}
+
+func (x *UpsertUOMItem) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_uom_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertUOMItem.ProtoReflect.Descriptor instead.
+func (*UpsertUOMItem) Descriptor() ([]byte, []int) {
+	return file_costing_v1_uom_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *UpsertUOMItem) GetUomCode() string {
+	if x != nil {
+		return x.UomCode
+	}
+	return ""
+}
+
+func (x *UpsertUOMItem) GetUomName() string {
+	if x != nil {
+		return x.UomName
+	}
+	return ""
+}
+
+func (x *UpsertUOMItem) GetUomCategory() UOMCategory {
+	if x != nil {
+		return x.UomCategory
+	}
+	return UOMCategory_UOM_CATEGORY_UNSPECIFIED
+}
+
+func (x *UpsertUOMItem) GetIsBaseUom() bool {
+	if x != nil {
+		return x.IsBaseUom
+	}
+	return false
+}
+
+func (x *UpsertUOMItem) GetConversionFactor() float64 {
+	if x != nil && x.ConversionFactor != nil {
+		return *x.ConversionFactor
+	}
+	return 0
+}
+
+func (x *UpsertUOMItem) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type BatchUpsertUOMsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 1 to 500 items, each validated and reported on its own
+	Items         []*UpsertUOMItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Mode          BatchMode        `protobuf:"varint,2,opt,name=mode,proto3,enum=costing.v1.BatchMode" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpsertUOMsRequest) Reset() {
+	*x = BatchUpsertUOMsRequest{}
+	mi := &file_costing_v1_uom_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpsertUOMsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpsertUOMsRequest) ProtoMessage() {}
+
+func (x *BatchUpsertUOMsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_uom_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpsertUOMsRequest.ProtoReflect.Descriptor instead.
+func (*BatchUpsertUOMsRequest) Descriptor() ([]byte, []int) {
+	return file_costing_v1_uom_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *BatchUpsertUOMsRequest) GetItems() []*UpsertUOMItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *BatchUpsertUOMsRequest) GetMode() BatchMode {
+	if x != nil {
+		return x.Mode
+	}
+	return BatchMode_BATCH_MODE_UNSPECIFIED
+}
+
+type UpsertUOMResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"` // Position of the item in the request
+	UomCode       string                 `protobuf:"bytes,2,opt,name=uom_code,json=uomCode,proto3" json:"uom_code,omitempty"`
+	Status        BatchItemStatus        `protobuf:"varint,3,opt,name=status,proto3,enum=costing.v1.BatchItemStatus" json:"status,omitempty"`
+	Base          *BaseResponse          `protobuf:"bytes,4,opt,name=base,proto3" json:"base,omitempty"` // Outcome of the item, with validation errors if it failed
+	Data          *UOM                   `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"` // Set for created and updated items
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertUOMResult) Reset() {
+	*x = UpsertUOMResult{}
+	mi := &file_costing_v1_uom_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertUOMResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertUOMResult) ProtoMessage() {}
+
+func (x *UpsertUOMResult) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_uom_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertUOMResult.ProtoReflect.Descriptor instead.
+func (*UpsertUOMResult) Descriptor() ([]byte, []int) {
+	return file_costing_v1_uom_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *UpsertUOMResult) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *UpsertUOMResult) GetUomCode() string {
+	if x != nil {
+		return x.UomCode
+	}
+	return ""
+}
+
+func (x *UpsertUOMResult) GetStatus() BatchItemStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BatchItemStatus_BATCH_ITEM_STATUS_UNSPECIFIED
+}
+
+func (x *UpsertUOMResult) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *UpsertUOMResult) GetData() *UOM {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type BatchUpsertUOMsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Results       []*UpsertUOMResult     `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	Summary       *BatchSummary          `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpsertUOMsResponse) Reset() {
+	*x = BatchUpsertUOMsResponse{}
+	mi := &file_costing_v1_uom_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpsertUOMsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpsertUOMsResponse) ProtoMessage() {}
+
+func (x *BatchUpsertUOMsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_costing_v1_uom_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpsertUOMsResponse.ProtoReflect.Descriptor instead.
+func (*BatchUpsertUOMsResponse) Descriptor() ([]byte, []int) {
+	return file_costing_v1_uom_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *BatchUpsertUOMsResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *BatchUpsertUOMsResponse) GetResults() []*UpsertUOMResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BatchUpsertUOMsResponse) GetSummary() *BatchSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+var File_costing_v1_uom_proto protoreflect.FileDescriptor
 
 const file_costing_v1_uom_proto_rawDesc = "" +
 	"\n" +
 	"\x14costing/v1/uom.proto\x12\n" +
-	"costing.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17costing/v1/common.proto\"\xc4\x01\n" +
+	"costing.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17costing/v1/common.proto\"\x8b\x02\n" +
 	"\x03UOM\x12\x19\n" +
 	"\buom_code\x18\x01 \x01(\tR\auomCode\x12\x19\n" +
 	"\buom_name\x18\x02 \x01(\tR\auomName\x12:\n" +
 	"\fuom_category\x18\x03 \x01(\x0e2\x17.costing.v1.UOMCategoryR\vuomCategory\x12\x1e\n" +
 	"\vis_base_uom\x18\x04 \x01(\bR\tisBaseUom\x12+\n" +
-	"\x05audit\x18\x05 \x01(\v2\x15.costing.v1.AuditInfoR\x05audit\"\xd9\x01\n" +
+	"\x05audit\x18\x05 \x01(\v2\x15.costing.v1.AuditInfoR\x05audit\x12+\n" +
+	"\x11conversion_factor\x18\x06 \x01(\x01R\x10conversionFactor\x12\x18\n" +
+	"\aversion\x18\a \x01(\x05R\aversion\"\xcf\x01\n" +
+	"\rUOMConversion\x12\"\n" +
+	"\rfrom_uom_code\x18\x01 \x01(\tR\vfromUomCode\x12\x1e\n" +
+	"\vto_uom_code\x18\x02 \x01(\tR\ttoUomCode\x12\x16\n" +
+	"\x06factor\x18\x03 \x01(\x01R\x06factor\x12%\n" +
+	"\vdescription\x18\x04 \x01(\tH\x00R\vdescription\x88\x01\x01\x12+\n" +
+	"\x05audit\x18\x05 \x01(\v2\x15.costing.v1.AuditInfoR\x05auditB\x0e\n" +
+	"\f_description\"\xb1\x02\n" +
 	"\x10CreateUOMRequest\x127\n" +
 	"\buom_code\x18\x01 \x01(\tB\x1c\xbaH\x19r\x17\x10\x01\x18\x142\x11^[A-Z][A-Z0-9_]*$R\auomCode\x12$\n" +
 	"\buom_name\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18dR\auomName\x12F\n" +
 	"\fuom_category\x18\x03 \x01(\x0e2\x17.costing.v1.UOMCategoryB\n" +
 	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\vuomCategory\x12\x1e\n" +
-	"\vis_base_uom\x18\x04 \x01(\bR\tisBaseUom\"f\n" +
+	"\vis_base_uom\x18\x04 \x01(\bR\tisBaseUom\x12@\n" +
+	"\x11conversion_factor\x18\x05 \x01(\x01B\x0e\xbaH\v\x12\t!\x00\x00\x00\x00\x00\x00\x00\x00H\x00R\x10conversionFactor\x88\x01\x01B\x14\n" +
+	"\x12_conversion_factor\"f\n" +
 	"\x11CreateUOMResponse\x12,\n" +
 	"\x04base\x18\x01 \x01(\v2\x18.costing.v1.BaseResponseR\x04base\x12#\n" +
 	"\x04data\x18\x02 \x01(\v2\x0f.costing.v1.UOMR\x04data\"5\n" +
@@ -730,37 +1955,122 @@ const file_costing_v1_uom_proto_rawDesc = "" +
 	"\buom_code\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18\x14R\auomCode\"c\n" +
 	"\x0eGetUOMResponse\x12,\n" +
 	"\x04base\x18\x01 \x01(\v2\x18.costing.v1.BaseResponseR\x04base\x12#\n" +
-	"\x04data\x18\x02 \x01(\v2\x0f.costing.v1.UOMR\x04data\"\x9d\x01\n" +
+	"\x04data\x18\x02 \x01(\v2\x0f.costing.v1.UOMR\x04data\"\xef\x01\n" +
 	"\x0fListUOMsRequest\x12\x1b\n" +
 	"\x04page\x18\x01 \x01(\x05B\a\xbaH\x04\x1a\x02(\x01R\x04page\x12&\n" +
 	"\tpage_size\x18\x02 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x01R\bpageSize\x128\n" +
-	"\bcategory\x18\x03 \x01(\x0e2\x17.costing.v1.UOMCategoryH\x00R\bcategory\x88\x01\x01B\v\n" +
-	"\t_category\"\xa1\x01\n" +
+	"\bcategory\x18\x03 \x01(\x0e2\x17.costing.v1.UOMCategoryH\x00R\bcategory\x88\x01\x01\x12'\n" +
+	"\x0finclude_deleted\x18\x04 \x01(\bR\x0eincludeDeleted\x12'\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x04R\tpageTokenB\v\n" +
+	"\t_category\"\xc9\x01\n" +
 	"\x10ListUOMsResponse\x12,\n" +
 	"\x04base\x18\x01 \x01(\v2\x18.costing.v1.BaseResponseR\x04base\x12#\n" +
 	"\x04data\x18\x02 \x03(\v2\x0f.costing.v1.UOMR\x04data\x12:\n" +
 	"\n" +
 	"pagination\x18\x03 \x01(\v2\x1a.costing.v1.PaginationMetaR\n" +
-	"pagination\"\xc6\x01\n" +
+	"pagination\x12&\n" +
+	"\x0fnext_page_token\x18\x04 \x01(\tR\rnextPageToken\"\xbf\x01\n" +
+	"\x11ExportUOMsRequest\x12:\n" +
+	"\x06format\x18\x01 \x01(\x0e2\x16.costing.v1.FileFormatB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x06format\x128\n" +
+	"\bcategory\x18\x02 \x01(\x0e2\x17.costing.v1.UOMCategoryH\x00R\bcategory\x88\x01\x01\x12'\n" +
+	"\x0finclude_deleted\x18\x03 \x01(\bR\x0eincludeDeletedB\v\n" +
+	"\t_category\">\n" +
+	"\x10WatchUOMsRequest\x12*\n" +
+	"\fresume_token\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x18@R\vresumeToken\"\xca\x01\n" +
+	"\tUOMChange\x127\n" +
+	"\vchange_type\x18\x01 \x01(\x0e2\x16.costing.v1.ChangeTypeR\n" +
+	"changeType\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x02 \x01(\tR\teventType\x12!\n" +
+	"\x03uom\x18\x03 \x01(\v2\x0f.costing.v1.UOMR\x03uom\x12\x1f\n" +
+	"\voccurred_at\x18\x04 \x01(\tR\n" +
+	"occurredAt\x12!\n" +
+	"\fresume_token\x18\x05 \x01(\tR\vresumeToken\"\xc1\x02\n" +
 	"\x10UpdateUOMRequest\x12$\n" +
 	"\buom_code\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18\x14R\auomCode\x12$\n" +
 	"\buom_name\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18dR\auomName\x12F\n" +
 	"\fuom_category\x18\x03 \x01(\x0e2\x17.costing.v1.UOMCategoryB\n" +
 	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\vuomCategory\x12\x1e\n" +
-	"\vis_base_uom\x18\x04 \x01(\bR\tisBaseUom\"f\n" +
+	"\vis_base_uom\x18\x04 \x01(\bR\tisBaseUom\x12@\n" +
+	"\x11conversion_factor\x18\x05 \x01(\x01B\x0e\xbaH\v\x12\t!\x00\x00\x00\x00\x00\x00\x00\x00H\x00R\x10conversionFactor\x88\x01\x01\x12!\n" +
+	"\aversion\x18\x06 \x01(\x05B\a\xbaH\x04\x1a\x02(\x01R\aversionB\x14\n" +
+	"\x12_conversion_factor\"f\n" +
 	"\x11UpdateUOMResponse\x12,\n" +
 	"\x04base\x18\x01 \x01(\v2\x18.costing.v1.BaseResponseR\x04base\x12#\n" +
-	"\x04data\x18\x02 \x01(\v2\x0f.costing.v1.UOMR\x04data\"8\n" +
+	"\x04data\x18\x02 \x01(\v2\x0f.costing.v1.UOMR\x04data\"N\n" +
 	"\x10DeleteUOMRequest\x12$\n" +
-	"\buom_code\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18\x14R\auomCode\"A\n" +
+	"\buom_code\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18\x14R\auomCode\x12\x14\n" +
+	"\x05force\x18\x02 \x01(\bR\x05force\"A\n" +
 	"\x11DeleteUOMResponse\x12,\n" +
-	"\x04base\x18\x01 \x01(\v2\x18.costing.v1.BaseResponseR\x04base*\x91\x01\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.costing.v1.BaseResponseR\x04base\"9\n" +
+	"\x11RestoreUOMRequest\x12$\n" +
+	"\buom_code\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18\x14R\auomCode\"g\n" +
+	"\x12RestoreUOMResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.costing.v1.BaseResponseR\x04base\x12#\n" +
+	"\x04data\x18\x02 \x01(\v2\x0f.costing.v1.UOMR\x04data\"\x8e\x01\n" +
+	"\x16ConvertQuantityRequest\x12\x1a\n" +
+	"\bquantity\x18\x01 \x01(\x01R\bquantity\x12-\n" +
+	"\rfrom_uom_code\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18\x14R\vfromUomCode\x12)\n" +
+	"\vto_uom_code\x18\x03 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18\x14R\ttoUomCode\"\xbe\x01\n" +
+	"\x15ConvertQuantityResult\x12\x1a\n" +
+	"\bquantity\x18\x01 \x01(\x01R\bquantity\x12\"\n" +
+	"\rfrom_uom_code\x18\x02 \x01(\tR\vfromUomCode\x12\x1e\n" +
+	"\vto_uom_code\x18\x03 \x01(\tR\ttoUomCode\x12-\n" +
+	"\x12converted_quantity\x18\x04 \x01(\x01R\x11convertedQuantity\x12\x16\n" +
+	"\x06factor\x18\x05 \x01(\x01R\x06factor\"~\n" +
+	"\x17ConvertQuantityResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.costing.v1.BaseResponseR\x04base\x125\n" +
+	"\x04data\x18\x02 \x01(\v2!.costing.v1.ConvertQuantityResultR\x04data\"N\n" +
+	"\x16ListConversionsRequest\x12'\n" +
+	"\buom_code\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x18\x14H\x00R\auomCode\x88\x01\x01B\v\n" +
+	"\t_uom_code\"v\n" +
+	"\x17ListConversionsResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.costing.v1.BaseResponseR\x04base\x12-\n" +
+	"\x04data\x18\x02 \x03(\v2\x19.costing.v1.UOMConversionR\x04data\"\xdc\x01\n" +
+	"\x17CreateConversionRequest\x12-\n" +
+	"\rfrom_uom_code\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18\x14R\vfromUomCode\x12)\n" +
+	"\vto_uom_code\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18\x14R\ttoUomCode\x12&\n" +
+	"\x06factor\x18\x03 \x01(\x01B\x0e\xbaH\v\x12\t!\x00\x00\x00\x00\x00\x00\x00\x00R\x06factor\x12/\n" +
+	"\vdescription\x18\x04 \x01(\tB\b\xbaH\x05r\x03\x18\xe8\aH\x00R\vdescription\x88\x01\x01B\x0e\n" +
+	"\f_description\"w\n" +
+	"\x18CreateConversionResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.costing.v1.BaseResponseR\x04base\x12-\n" +
+	"\x04data\x18\x02 \x01(\v2\x19.costing.v1.UOMConversionR\x04data\"s\n" +
+	"\x17DeleteConversionRequest\x12-\n" +
+	"\rfrom_uom_code\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18\x14R\vfromUomCode\x12)\n" +
+	"\vto_uom_code\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18\x14R\ttoUomCode\"H\n" +
+	"\x18DeleteConversionResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.costing.v1.BaseResponseR\x04base\"\xd1\x02\n" +
+	"\rUpsertUOMItem\x127\n" +
+	"\buom_code\x18\x01 \x01(\tB\x1c\xbaH\x19r\x17\x10\x01\x18\x142\x11^[A-Z][A-Z0-9_]*$R\auomCode\x12$\n" +
+	"\buom_name\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18dR\auomName\x12F\n" +
+	"\fuom_category\x18\x03 \x01(\x0e2\x17.costing.v1.UOMCategoryB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\vuomCategory\x12\x1e\n" +
+	"\vis_base_uom\x18\x04 \x01(\bR\tisBaseUom\x12@\n" +
+	"\x11conversion_factor\x18\x05 \x01(\x01B\x0e\xbaH\v\x12\t!\x00\x00\x00\x00\x00\x00\x00\x00H\x00R\x10conversionFactor\x88\x01\x01\x12!\n" +
+	"\aversion\x18\x06 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\aversionB\x14\n" +
+	"\x12_conversion_factor\"t\n" +
+	"\x16BatchUpsertUOMsRequest\x12/\n" +
+	"\x05items\x18\x01 \x03(\v2\x19.costing.v1.UpsertUOMItemR\x05items\x12)\n" +
+	"\x04mode\x18\x02 \x01(\x0e2\x15.costing.v1.BatchModeR\x04mode\"\xca\x01\n" +
+	"\x0fUpsertUOMResult\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x19\n" +
+	"\buom_code\x18\x02 \x01(\tR\auomCode\x123\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x1b.costing.v1.BatchItemStatusR\x06status\x12,\n" +
+	"\x04base\x18\x04 \x01(\v2\x18.costing.v1.BaseResponseR\x04base\x12#\n" +
+	"\x04data\x18\x05 \x01(\v2\x0f.costing.v1.UOMR\x04data\"\xb2\x01\n" +
+	"\x17BatchUpsertUOMsResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.costing.v1.BaseResponseR\x04base\x125\n" +
+	"\aresults\x18\x02 \x03(\v2\x1b.costing.v1.UpsertUOMResultR\aresults\x122\n" +
+	"\asummary\x18\x03 \x01(\v2\x18.costing.v1.BatchSummaryR\asummary*\x91\x01\n" +
 	"\vUOMCategory\x12\x1c\n" +
 	"\x18UOM_CATEGORY_UNSPECIFIED\x10\x00\x12\x17\n" +
 	"\x13UOM_CATEGORY_WEIGHT\x10\x01\x12\x17\n" +
 	"\x13UOM_CATEGORY_VOLUME\x10\x02\x12\x19\n" +
 	"\x15UOM_CATEGORY_QUANTITY\x10\x03\x12\x17\n" +
-	"\x13UOM_CATEGORY_LENGTH\x10\x042\xf3\x03\n" +
+	"\x13UOM_CATEGORY_LENGTH\x10\x042\xc1\v\n" +
 	"\n" +
 	"UOMService\x12]\n" +
 	"\tCreateUOM\x12\x1c.costing.v1.CreateUOMRequest\x1a\x1d.costing.v1.CreateUOMResponse\"\x13\x82\xd3\xe4\x93\x02\r:\x01*\"\b/v1/uoms\x12\\\n" +
@@ -768,7 +2078,19 @@ const file_costing_v1_uom_proto_rawDesc = "" +
 	"\bListUOMs\x12\x1b.costing.v1.ListUOMsRequest\x1a\x1c.costing.v1.ListUOMsResponse\"\x10\x82\xd3\xe4\x93\x02\n" +
 	"\x12\b/v1/uoms\x12h\n" +
 	"\tUpdateUOM\x12\x1c.costing.v1.UpdateUOMRequest\x1a\x1d.costing.v1.UpdateUOMResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\x1a\x13/v1/uoms/{uom_code}\x12e\n" +
-	"\tDeleteUOM\x12\x1c.costing.v1.DeleteUOMRequest\x1a\x1d.costing.v1.DeleteUOMResponse\"\x1b\x82\xd3\xe4\x93\x02\x15*\x13/v1/uoms/{uom_code}B\xab\x01\n" +
+	"\tDeleteUOM\x12\x1c.costing.v1.DeleteUOMRequest\x1a\x1d.costing.v1.DeleteUOMResponse\"\x1b\x82\xd3\xe4\x93\x02\x15*\x13/v1/uoms/{uom_code}\x12s\n" +
+	"\n" +
+	"RestoreUOM\x12\x1d.costing.v1.RestoreUOMRequest\x1a\x1e.costing.v1.RestoreUOMResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/uoms/{uom_code}:restore\x12{\n" +
+	"\x0fBatchUpsertUOMs\x12\".costing.v1.BatchUpsertUOMsRequest\x1a#.costing.v1.BatchUpsertUOMsResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/uoms:batchUpsert\x12E\n" +
+	"\n" +
+	"ImportUOMs\x12\x19.costing.v1.ImportRequest\x1a\x1a.costing.v1.ImportResponse(\x01\x12F\n" +
+	"\n" +
+	"ExportUOMs\x12\x1d.costing.v1.ExportUOMsRequest\x1a\x17.costing.v1.ExportChunk0\x01\x12B\n" +
+	"\tWatchUOMs\x12\x1c.costing.v1.WatchUOMsRequest\x1a\x15.costing.v1.UOMChange0\x01\x12t\n" +
+	"\x0fConvertQuantity\x12\".costing.v1.ConvertQuantityRequest\x1a#.costing.v1.ConvertQuantityResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/uoms:convert\x12w\n" +
+	"\x0fListConversions\x12\".costing.v1.ListConversionsRequest\x1a#.costing.v1.ListConversionsResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/uom-conversions\x12}\n" +
+	"\x10CreateConversion\x12#.costing.v1.CreateConversionRequest\x1a$.costing.v1.CreateConversionResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/uom-conversions\x12\x98\x01\n" +
+	"\x10DeleteConversion\x12#.costing.v1.DeleteConversionRequest\x1a$.costing.v1.DeleteConversionResponse\"9\x82\xd3\xe4\x93\x023*1/v1/uom-conversions/{from_uom_code}/{to_uom_code}B\xab\x01\n" +
 	"\x0ecom.costing.v1B\bUomProtoP\x01ZFgithub.com/homindolenern/goapps-costing-v1/gen/go/costing/v1;costingv1\xa2\x02\x03CXX\xaa\x02\n" +
 	"Costing.V1\xca\x02\n" +
 	"Costing\\V1\xe2\x02\x16Costing\\V1\\GPBMetadata\xea\x02\vCosting::V1b\x06proto3"
@@ -786,55 +2108,123 @@ func file_costing_v1_uom_proto_rawDescGZIP() []byte {
 }
 
 var file_costing_v1_uom_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_costing_v1_uom_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_costing_v1_uom_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
 var file_costing_v1_uom_proto_goTypes = []any{
-	(UOMCategory)(0),          // 0: costing.v1.UOMCategory
-	(*UOM)(nil),               // 1: costing.v1.UOM
-	(*CreateUOMRequest)(nil),  // 2: costing.v1.CreateUOMRequest
-	(*CreateUOMResponse)(nil), // 3: costing.v1.CreateUOMResponse
-	(*GetUOMRequest)(nil),     // 4: costing.v1.GetUOMRequest
-	(*GetUOMResponse)(nil),    // 5: costing.v1.GetUOMResponse
-	(*ListUOMsRequest)(nil),   // 6: costing.v1.ListUOMsRequest
-	(*ListUOMsResponse)(nil),  // 7: costing.v1.ListUOMsResponse
-	(*UpdateUOMRequest)(nil),  // 8: costing.v1.UpdateUOMRequest
-	(*UpdateUOMResponse)(nil), // 9: costing.v1.UpdateUOMResponse
-	(*DeleteUOMRequest)(nil),  // 10: costing.v1.DeleteUOMRequest
-	(*DeleteUOMResponse)(nil), // 11: costing.v1.DeleteUOMResponse
-	(*AuditInfo)(nil),         // 12: costing.v1.AuditInfo
-	(*BaseResponse)(nil),      // 13: costing.v1.BaseResponse
-	(*PaginationMeta)(nil),    // 14: costing.v1.PaginationMeta
+	(UOMCategory)(0),                 // 0: costing.v1.UOMCategory
+	(*UOM)(nil),                      // 1: costing.v1.UOM
+	(*UOMConversion)(nil),            // 2: costing.v1.UOMConversion
+	(*CreateUOMRequest)(nil),         // 3: costing.v1.CreateUOMRequest
+	(*CreateUOMResponse)(nil),        // 4: costing.v1.CreateUOMResponse
+	(*GetUOMRequest)(nil),            // 5: costing.v1.GetUOMRequest
+	(*GetUOMResponse)(nil),           // 6: costing.v1.GetUOMResponse
+	(*ListUOMsRequest)(nil),          // 7: costing.v1.ListUOMsRequest
+	(*ListUOMsResponse)(nil),         // 8: costing.v1.ListUOMsResponse
+	(*ExportUOMsRequest)(nil),        // 9: costing.v1.ExportUOMsRequest
+	(*WatchUOMsRequest)(nil),         // 10: costing.v1.WatchUOMsRequest
+	(*UOMChange)(nil),                // 11: costing.v1.UOMChange
+	(*UpdateUOMRequest)(nil),         // 12: costing.v1.UpdateUOMRequest
+	(*UpdateUOMResponse)(nil),        // 13: costing.v1.UpdateUOMResponse
+	(*DeleteUOMRequest)(nil),         // 14: costing.v1.DeleteUOMRequest
+	(*DeleteUOMResponse)(nil),        // 15: costing.v1.DeleteUOMResponse
+	(*RestoreUOMRequest)(nil),        // 16: costing.v1.RestoreUOMRequest
+	(*RestoreUOMResponse)(nil),       // 17: costing.v1.RestoreUOMResponse
+	(*ConvertQuantityRequest)(nil),   // 18: costing.v1.ConvertQuantityRequest
+	(*ConvertQuantityResult)(nil),    // 19: costing.v1.ConvertQuantityResult
+	(*ConvertQuantityResponse)(nil),  // 20: costing.v1.ConvertQuantityResponse
+	(*ListConversionsRequest)(nil),   // 21: costing.v1.ListConversionsRequest
+	(*ListConversionsResponse)(nil),  // 22: costing.v1.ListConversionsResponse
+	(*CreateConversionRequest)(nil),  // 23: costing.v1.CreateConversionRequest
+	(*CreateConversionResponse)(nil), // 24: costing.v1.CreateConversionResponse
+	(*DeleteConversionRequest)(nil),  // 25: costing.v1.DeleteConversionRequest
+	(*DeleteConversionResponse)(nil), // 26: costing.v1.DeleteConversionResponse
+	(*UpsertUOMItem)(nil),            // 27: costing.v1.UpsertUOMItem
+	(*BatchUpsertUOMsRequest)(nil),   // 28: costing.v1.BatchUpsertUOMsRequest
+	(*UpsertUOMResult)(nil),          // 29: costing.v1.UpsertUOMResult
+	(*BatchUpsertUOMsResponse)(nil),  // 30: costing.v1.BatchUpsertUOMsResponse
+	(*AuditInfo)(nil),                // 31: costing.v1.AuditInfo
+	(*BaseResponse)(nil),             // 32: costing.v1.BaseResponse
+	(*PaginationMeta)(nil),           // 33: costing.v1.PaginationMeta
+	(FileFormat)(0),                  // 34: costing.v1.FileFormat
+	(ChangeType)(0),                  // 35: costing.v1.ChangeType
+	(BatchMode)(0),                   // 36: costing.v1.BatchMode
+	(BatchItemStatus)(0),             // 37: costing.v1.BatchItemStatus
+	(*BatchSummary)(nil),             // 38: costing.v1.BatchSummary
+	(*ImportRequest)(nil),            // 39: costing.v1.ImportRequest
+	(*ImportResponse)(nil),           // 40: costing.v1.ImportResponse
+	(*ExportChunk)(nil),              // 41: costing.v1.ExportChunk
 }
 var file_costing_v1_uom_proto_depIdxs = []int32{
 	0,  // 0: costing.v1.UOM.uom_category:type_name -> costing.v1.UOMCategory
-	12, // 1: costing.v1.UOM.audit:type_name -> costing.v1.AuditInfo
-	0,  // 2: costing.v1.CreateUOMRequest.uom_category:type_name -> costing.v1.UOMCategory
-	13, // 3: costing.v1.CreateUOMResponse.base:type_name -> costing.v1.BaseResponse
-	1,  // 4: costing.v1.CreateUOMResponse.data:type_name -> costing.v1.UOM
-	13, // 5: costing.v1.GetUOMResponse.base:type_name -> costing.v1.BaseResponse
-	1,  // 6: costing.v1.GetUOMResponse.data:type_name -> costing.v1.UOM
-	0,  // 7: costing.v1.ListUOMsRequest.category:type_name -> costing.v1.UOMCategory
-	13, // 8: costing.v1.ListUOMsResponse.base:type_name -> costing.v1.BaseResponse
-	1,  // 9: costing.v1.ListUOMsResponse.data:type_name -> costing.v1.UOM
-	14, // 10: costing.v1.ListUOMsResponse.pagination:type_name -> costing.v1.PaginationMeta
-	0,  // 11: costing.v1.UpdateUOMRequest.uom_category:type_name -> costing.v1.UOMCategory
-	13, // 12: costing.v1.UpdateUOMResponse.base:type_name -> costing.v1.BaseResponse
-	1,  // 13: costing.v1.UpdateUOMResponse.data:type_name -> costing.v1.UOM
-	13, // 14: costing.v1.DeleteUOMResponse.base:type_name -> costing.v1.BaseResponse
-	2,  // 15: costing.v1.UOMService.CreateUOM:input_type -> costing.v1.CreateUOMRequest
-	4,  // 16: costing.v1.UOMService.GetUOM:input_type -> costing.v1.GetUOMRequest
-	6,  // 17: costing.v1.UOMService.ListUOMs:input_type -> costing.v1.ListUOMsRequest
-	8,  // 18: costing.v1.UOMService.UpdateUOM:input_type -> costing.v1.UpdateUOMRequest
-	10, // 19: costing.v1.UOMService.DeleteUOM:input_type -> costing.v1.DeleteUOMRequest
-	3,  // 20: costing.v1.UOMService.CreateUOM:output_type -> costing.v1.CreateUOMResponse
-	5,  // 21: costing.v1.UOMService.GetUOM:output_type -> costing.v1.GetUOMResponse
-	7,  // 22: costing.v1.UOMService.ListUOMs:output_type -> costing.v1.ListUOMsResponse
-	9,  // 23: costing.v1.UOMService.UpdateUOM:output_type -> costing.v1.UpdateUOMResponse
-	11, // 24: costing.v1.UOMService.DeleteUOM:output_type -> costing.v1.DeleteUOMResponse
-	20, // [20:25] is the sub-list for method output_type
-	15, // [15:20] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	31, // 1: costing.v1.UOM.audit:type_name -> costing.v1.AuditInfo
+	31, // 2: costing.v1.UOMConversion.audit:type_name -> costing.v1.AuditInfo
+	0,  // 3: costing.v1.CreateUOMRequest.uom_category:type_name -> costing.v1.UOMCategory
+	32, // 4: costing.v1.CreateUOMResponse.base:type_name -> costing.v1.BaseResponse
+	1,  // 5: costing.v1.CreateUOMResponse.data:type_name -> costing.v1.UOM
+	32, // 6: costing.v1.GetUOMResponse.base:type_name -> costing.v1.BaseResponse
+	1,  // 7: costing.v1.GetUOMResponse.data:type_name -> costing.v1.UOM
+	0,  // 8: costing.v1.ListUOMsRequest.category:type_name -> costing.v1.UOMCategory
+	32, // 9: costing.v1.ListUOMsResponse.base:type_name -> costing.v1.BaseResponse
+	1,  // 10: costing.v1.ListUOMsResponse.data:type_name -> costing.v1.UOM
+	33, // 11: costing.v1.ListUOMsResponse.pagination:type_name -> costing.v1.PaginationMeta
+	34, // 12: costing.v1.ExportUOMsRequest.format:type_name -> costing.v1.FileFormat
+	0,  // 13: costing.v1.ExportUOMsRequest.category:type_name -> costing.v1.UOMCategory
+	35, // 14: costing.v1.UOMChange.change_type:type_name -> costing.v1.ChangeType
+	1,  // 15: costing.v1.UOMChange.uom:type_name -> costing.v1.UOM
+	0,  // 16: costing.v1.UpdateUOMRequest.uom_category:type_name -> costing.v1.UOMCategory
+	32, // 17: costing.v1.UpdateUOMResponse.base:type_name -> costing.v1.BaseResponse
+	1,  // 18: costing.v1.UpdateUOMResponse.data:type_name -> costing.v1.UOM
+	32, // 19: costing.v1.DeleteUOMResponse.base:type_name -> costing.v1.BaseResponse
+	32, // 20: costing.v1.RestoreUOMResponse.base:type_name -> costing.v1.BaseResponse
+	1,  // 21: costing.v1.RestoreUOMResponse.data:type_name -> costing.v1.UOM
+	32, // 22: costing.v1.ConvertQuantityResponse.base:type_name -> costing.v1.BaseResponse
+	19, // 23: costing.v1.ConvertQuantityResponse.data:type_name -> costing.v1.ConvertQuantityResult
+	32, // 24: costing.v1.ListConversionsResponse.base:type_name -> costing.v1.BaseResponse
+	2,  // 25: costing.v1.ListConversionsResponse.data:type_name -> costing.v1.UOMConversion
+	32, // 26: costing.v1.CreateConversionResponse.base:type_name -> costing.v1.BaseResponse
+	2,  // 27: costing.v1.CreateConversionResponse.data:type_name -> costing.v1.UOMConversion
+	32, // 28: costing.v1.DeleteConversionResponse.base:type_name -> costing.v1.BaseResponse
+	0,  // 29: costing.v1.UpsertUOMItem.uom_category:type_name -> costing.v1.UOMCategory
+	27, // 30: costing.v1.BatchUpsertUOMsRequest.items:type_name -> costing.v1.UpsertUOMItem
+	36, // 31: costing.v1.BatchUpsertUOMsRequest.mode:type_name -> costing.v1.BatchMode
+	37, // 32: costing.v1.UpsertUOMResult.status:type_name -> costing.v1.BatchItemStatus
+	32, // 33: costing.v1.UpsertUOMResult.base:type_name -> costing.v1.BaseResponse
+	1,  // 34: costing.v1.UpsertUOMResult.data:type_name -> costing.v1.UOM
+	32, // 35: costing.v1.BatchUpsertUOMsResponse.base:type_name -> costing.v1.BaseResponse
+	29, // 36: costing.v1.BatchUpsertUOMsResponse.results:type_name -> costing.v1.UpsertUOMResult
+	38, // 37: costing.v1.BatchUpsertUOMsResponse.summary:type_name -> costing.v1.BatchSummary
+	3,  // 38: costing.v1.UOMService.CreateUOM:input_type -> costing.v1.CreateUOMRequest
+	5,  // 39: costing.v1.UOMService.GetUOM:input_type -> costing.v1.GetUOMRequest
+	7,  // 40: costing.v1.UOMService.ListUOMs:input_type -> costing.v1.ListUOMsRequest
+	12, // 41: costing.v1.UOMService.UpdateUOM:input_type -> costing.v1.UpdateUOMRequest
+	14, // 42: costing.v1.UOMService.DeleteUOM:input_type -> costing.v1.DeleteUOMRequest
+	16, // 43: costing.v1.UOMService.RestoreUOM:input_type -> costing.v1.RestoreUOMRequest
+	28, // 44: costing.v1.UOMService.BatchUpsertUOMs:input_type -> costing.v1.BatchUpsertUOMsRequest
+	39, // 45: costing.v1.UOMService.ImportUOMs:input_type -> costing.v1.ImportRequest
+	9,  // 46: costing.v1.UOMService.ExportUOMs:input_type -> costing.v1.ExportUOMsRequest
+	10, // 47: costing.v1.UOMService.WatchUOMs:input_type -> costing.v1.WatchUOMsRequest
+	18, // 48: costing.v1.UOMService.ConvertQuantity:input_type -> costing.v1.ConvertQuantityRequest
+	21, // 49: costing.v1.UOMService.ListConversions:input_type -> costing.v1.ListConversionsRequest
+	23, // 50: costing.v1.UOMService.CreateConversion:input_type -> costing.v1.CreateConversionRequest
+	25, // 51: costing.v1.UOMService.DeleteConversion:input_type -> costing.v1.DeleteConversionRequest
+	4,  // 52: costing.v1.UOMService.CreateUOM:output_type -> costing.v1.CreateUOMResponse
+	6,  // 53: costing.v1.UOMService.GetUOM:output_type -> costing.v1.GetUOMResponse
+	8,  // 54: costing.v1.UOMService.ListUOMs:output_type -> costing.v1.ListUOMsResponse
+	13, // 55: costing.v1.UOMService.UpdateUOM:output_type -> costing.v1.UpdateUOMResponse
+	15, // 56: costing.v1.UOMService.DeleteUOM:output_type -> costing.v1.DeleteUOMResponse
+	17, // 57: costing.v1.UOMService.RestoreUOM:output_type -> costing.v1.RestoreUOMResponse
+	30, // 58: costing.v1.UOMService.BatchUpsertUOMs:output_type -> costing.v1.BatchUpsertUOMsResponse
+	40, // 59: costing.v1.UOMService.ImportUOMs:output_type -> costing.v1.ImportResponse
+	41, // 60: costing.v1.UOMService.ExportUOMs:output_type -> costing.v1.ExportChunk
+	11, // 61: costing.v1.UOMService.WatchUOMs:output_type -> costing.v1.UOMChange
+	20, // 62: costing.v1.UOMService.ConvertQuantity:output_type -> costing.v1.ConvertQuantityResponse
+	22, // 63: costing.v1.UOMService.ListConversions:output_type -> costing.v1.ListConversionsResponse
+	24, // 64: costing.v1.UOMService.CreateConversion:output_type -> costing.v1.CreateConversionResponse
+	26, // 65: costing.v1.UOMService.DeleteConversion:output_type -> costing.v1.DeleteConversionResponse
+	52, // [52:66] is the sub-list for method output_type
+	38, // [38:52] is the sub-list for method input_type
+	38, // [38:38] is the sub-list for extension type_name
+	38, // [38:38] is the sub-list for extension extendee
+	0,  // [0:38] is the sub-list for field type_name
 }
 
 func init() { file_costing_v1_uom_proto_init() }
@@ -843,14 +2233,21 @@ func file_costing_v1_uom_proto_init() {
 		return
 	}
 	file_costing_v1_common_proto_init()
-	file_costing_v1_uom_proto_msgTypes[5].OneofWrappers = []any{}
+	file_costing_v1_uom_proto_msgTypes[1].OneofWrappers = []any{}
+	file_costing_v1_uom_proto_msgTypes[2].OneofWrappers = []any{}
+	file_costing_v1_uom_proto_msgTypes[6].OneofWrappers = []any{}
+	file_costing_v1_uom_proto_msgTypes[8].OneofWrappers = []any{}
+	file_costing_v1_uom_proto_msgTypes[11].OneofWrappers = []any{}
+	file_costing_v1_uom_proto_msgTypes[20].OneofWrappers = []any{}
+	file_costing_v1_uom_proto_msgTypes[22].OneofWrappers = []any{}
+	file_costing_v1_uom_proto_msgTypes[26].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_costing_v1_uom_proto_rawDesc), len(file_costing_v1_uom_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   11,
+			NumMessages:   30,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
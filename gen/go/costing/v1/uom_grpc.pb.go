@@ -19,11 +19,20 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UOMService_CreateUOM_FullMethodName = "/costing.v1.UOMService/CreateUOM"
-	UOMService_GetUOM_FullMethodName    = "/costing.v1.UOMService/GetUOM"
-	UOMService_ListUOMs_FullMethodName  = "/costing.v1.UOMService/ListUOMs"
-	UOMService_UpdateUOM_FullMethodName = "/costing.v1.UOMService/UpdateUOM"
-	UOMService_DeleteUOM_FullMethodName = "/costing.v1.UOMService/DeleteUOM"
+	UOMService_CreateUOM_FullMethodName        = "/costing.v1.UOMService/CreateUOM"
+	UOMService_GetUOM_FullMethodName           = "/costing.v1.UOMService/GetUOM"
+	UOMService_ListUOMs_FullMethodName         = "/costing.v1.UOMService/ListUOMs"
+	UOMService_UpdateUOM_FullMethodName        = "/costing.v1.UOMService/UpdateUOM"
+	UOMService_DeleteUOM_FullMethodName        = "/costing.v1.UOMService/DeleteUOM"
+	UOMService_RestoreUOM_FullMethodName       = "/costing.v1.UOMService/RestoreUOM"
+	UOMService_BatchUpsertUOMs_FullMethodName  = "/costing.v1.UOMService/BatchUpsertUOMs"
+	UOMService_ImportUOMs_FullMethodName       = "/costing.v1.UOMService/ImportUOMs"
+	UOMService_ExportUOMs_FullMethodName       = "/costing.v1.UOMService/ExportUOMs"
+	UOMService_WatchUOMs_FullMethodName        = "/costing.v1.UOMService/WatchUOMs"
+	UOMService_ConvertQuantity_FullMethodName  = "/costing.v1.UOMService/ConvertQuantity"
+	UOMService_ListConversions_FullMethodName  = "/costing.v1.UOMService/ListConversions"
+	UOMService_CreateConversion_FullMethodName = "/costing.v1.UOMService/CreateConversion"
+	UOMService_DeleteConversion_FullMethodName = "/costing.v1.UOMService/DeleteConversion"
 )
 
 // UOMServiceClient is the client API for UOMService service.
@@ -40,8 +49,30 @@ type UOMServiceClient interface {
 	ListUOMs(ctx context.Context, in *ListUOMsRequest, opts ...grpc.CallOption) (*ListUOMsResponse, error)
 	// UpdateUOM updates an existing Unit of Measure
 	UpdateUOM(ctx context.Context, in *UpdateUOMRequest, opts ...grpc.CallOption) (*UpdateUOMResponse, error)
-	// DeleteUOM deletes a Unit of Measure by code
+	// DeleteUOM soft-deletes a Unit of Measure by code
 	DeleteUOM(ctx context.Context, in *DeleteUOMRequest, opts ...grpc.CallOption) (*DeleteUOMResponse, error)
+	// RestoreUOM restores a soft-deleted Unit of Measure
+	RestoreUOM(ctx context.Context, in *RestoreUOMRequest, opts ...grpc.CallOption) (*RestoreUOMResponse, error)
+	// BatchUpsertUOMs creates or updates many Units of Measure in one transaction
+	BatchUpsertUOMs(ctx context.Context, in *BatchUpsertUOMsRequest, opts ...grpc.CallOption) (*BatchUpsertUOMsResponse, error)
+	// ImportUOMs creates Units of Measure from a streamed CSV or XLSX file.
+	// Over HTTP, the file is uploaded to POST /v1/uoms:import.
+	ImportUOMs(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportRequest, ImportResponse], error)
+	// ExportUOMs streams every Unit of Measure matching the List filters as a CSV,
+	// XLSX or JSON file. Over HTTP, the file is downloaded from GET /v1/uoms:export.
+	ExportUOMs(ctx context.Context, in *ExportUOMsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportChunk], error)
+	// WatchUOMs streams every committed change of a Unit of Measure after
+	// resume_token, or from now when it is empty. Over HTTP, the
+	// changes are sent as Server-Sent Events from GET /v1/uoms:watch.
+	WatchUOMs(ctx context.Context, in *WatchUOMsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[UOMChange], error)
+	// ConvertQuantity converts a quantity from one Unit of Measure to another
+	ConvertQuantity(ctx context.Context, in *ConvertQuantityRequest, opts ...grpc.CallOption) (*ConvertQuantityResponse, error)
+	// ListConversions lists explicit conversions between Units of Measure
+	ListConversions(ctx context.Context, in *ListConversionsRequest, opts ...grpc.CallOption) (*ListConversionsResponse, error)
+	// CreateConversion creates an explicit conversion between two categories
+	CreateConversion(ctx context.Context, in *CreateConversionRequest, opts ...grpc.CallOption) (*CreateConversionResponse, error)
+	// DeleteConversion deletes an explicit conversion
+	DeleteConversion(ctx context.Context, in *DeleteConversionRequest, opts ...grpc.CallOption) (*DeleteConversionResponse, error)
 }
 
 type uOMServiceClient struct {
@@ -102,6 +133,117 @@ func (c *uOMServiceClient) DeleteUOM(ctx context.Context, in *DeleteUOMRequest,
 	return out, nil
 }
 
+func (c *uOMServiceClient) RestoreUOM(ctx context.Context, in *RestoreUOMRequest, opts ...grpc.CallOption) (*RestoreUOMResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreUOMResponse)
+	err := c.cc.Invoke(ctx, UOMService_RestoreUOM_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uOMServiceClient) BatchUpsertUOMs(ctx context.Context, in *BatchUpsertUOMsRequest, opts ...grpc.CallOption) (*BatchUpsertUOMsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchUpsertUOMsResponse)
+	err := c.cc.Invoke(ctx, UOMService_BatchUpsertUOMs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uOMServiceClient) ImportUOMs(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportRequest, ImportResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &UOMService_ServiceDesc.Streams[0], UOMService_ImportUOMs_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportRequest, ImportResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UOMService_ImportUOMsClient = grpc.ClientStreamingClient[ImportRequest, ImportResponse]
+
+func (c *uOMServiceClient) ExportUOMs(ctx context.Context, in *ExportUOMsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &UOMService_ServiceDesc.Streams[1], UOMService_ExportUOMs_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportUOMsRequest, ExportChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UOMService_ExportUOMsClient = grpc.ServerStreamingClient[ExportChunk]
+
+func (c *uOMServiceClient) WatchUOMs(ctx context.Context, in *WatchUOMsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[UOMChange], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &UOMService_ServiceDesc.Streams[2], UOMService_WatchUOMs_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchUOMsRequest, UOMChange]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UOMService_WatchUOMsClient = grpc.ServerStreamingClient[UOMChange]
+
+func (c *uOMServiceClient) ConvertQuantity(ctx context.Context, in *ConvertQuantityRequest, opts ...grpc.CallOption) (*ConvertQuantityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConvertQuantityResponse)
+	err := c.cc.Invoke(ctx, UOMService_ConvertQuantity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uOMServiceClient) ListConversions(ctx context.Context, in *ListConversionsRequest, opts ...grpc.CallOption) (*ListConversionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListConversionsResponse)
+	err := c.cc.Invoke(ctx, UOMService_ListConversions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uOMServiceClient) CreateConversion(ctx context.Context, in *CreateConversionRequest, opts ...grpc.CallOption) (*CreateConversionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateConversionResponse)
+	err := c.cc.Invoke(ctx, UOMService_CreateConversion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uOMServiceClient) DeleteConversion(ctx context.Context, in *DeleteConversionRequest, opts ...grpc.CallOption) (*DeleteConversionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteConversionResponse)
+	err := c.cc.Invoke(ctx, UOMService_DeleteConversion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UOMServiceServer is the server API for UOMService service.
 // All implementations must embed UnimplementedUOMServiceServer.
 // for forward compatibility.
@@ -116,8 +258,30 @@ type UOMServiceServer interface {
 	ListUOMs(context.Context, *ListUOMsRequest) (*ListUOMsResponse, error)
 	// UpdateUOM updates an existing Unit of Measure
 	UpdateUOM(context.Context, *UpdateUOMRequest) (*UpdateUOMResponse, error)
-	// DeleteUOM deletes a Unit of Measure by code
+	// DeleteUOM soft-deletes a Unit of Measure by code
 	DeleteUOM(context.Context, *DeleteUOMRequest) (*DeleteUOMResponse, error)
+	// RestoreUOM restores a soft-deleted Unit of Measure
+	RestoreUOM(context.Context, *RestoreUOMRequest) (*RestoreUOMResponse, error)
+	// BatchUpsertUOMs creates or updates many Units of Measure in one transaction
+	BatchUpsertUOMs(context.Context, *BatchUpsertUOMsRequest) (*BatchUpsertUOMsResponse, error)
+	// ImportUOMs creates Units of Measure from a streamed CSV or XLSX file.
+	// Over HTTP, the file is uploaded to POST /v1/uoms:import.
+	ImportUOMs(grpc.ClientStreamingServer[ImportRequest, ImportResponse]) error
+	// ExportUOMs streams every Unit of Measure matching the List filters as a CSV,
+	// XLSX or JSON file. Over HTTP, the file is downloaded from GET /v1/uoms:export.
+	ExportUOMs(*ExportUOMsRequest, grpc.ServerStreamingServer[ExportChunk]) error
+	// WatchUOMs streams every committed change of a Unit of Measure after
+	// resume_token, or from now when it is empty. Over HTTP, the
+	// changes are sent as Server-Sent Events from GET /v1/uoms:watch.
+	WatchUOMs(*WatchUOMsRequest, grpc.ServerStreamingServer[UOMChange]) error
+	// ConvertQuantity converts a quantity from one Unit of Measure to another
+	ConvertQuantity(context.Context, *ConvertQuantityRequest) (*ConvertQuantityResponse, error)
+	// ListConversions lists explicit conversions between Units of Measure
+	ListConversions(context.Context, *ListConversionsRequest) (*ListConversionsResponse, error)
+	// CreateConversion creates an explicit conversion between two categories
+	CreateConversion(context.Context, *CreateConversionRequest) (*CreateConversionResponse, error)
+	// DeleteConversion deletes an explicit conversion
+	DeleteConversion(context.Context, *DeleteConversionRequest) (*DeleteConversionResponse, error)
 	mustEmbedUnimplementedUOMServiceServer()
 }
 
@@ -143,6 +307,33 @@ func (UnimplementedUOMServiceServer) UpdateUOM(context.Context, *UpdateUOMReques
 func (UnimplementedUOMServiceServer) DeleteUOM(context.Context, *DeleteUOMRequest) (*DeleteUOMResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteUOM not implemented")
 }
+func (UnimplementedUOMServiceServer) RestoreUOM(context.Context, *RestoreUOMRequest) (*RestoreUOMResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreUOM not implemented")
+}
+func (UnimplementedUOMServiceServer) BatchUpsertUOMs(context.Context, *BatchUpsertUOMsRequest) (*BatchUpsertUOMsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchUpsertUOMs not implemented")
+}
+func (UnimplementedUOMServiceServer) ImportUOMs(grpc.ClientStreamingServer[ImportRequest, ImportResponse]) error {
+	return status.Error(codes.Unimplemented, "method ImportUOMs not implemented")
+}
+func (UnimplementedUOMServiceServer) ExportUOMs(*ExportUOMsRequest, grpc.ServerStreamingServer[ExportChunk]) error {
+	return status.Error(codes.Unimplemented, "method ExportUOMs not implemented")
+}
+func (UnimplementedUOMServiceServer) WatchUOMs(*WatchUOMsRequest, grpc.ServerStreamingServer[UOMChange]) error {
+	return status.Error(codes.Unimplemented, "method WatchUOMs not implemented")
+}
+func (UnimplementedUOMServiceServer) ConvertQuantity(context.Context, *ConvertQuantityRequest) (*ConvertQuantityResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ConvertQuantity not implemented")
+}
+func (UnimplementedUOMServiceServer) ListConversions(context.Context, *ListConversionsRequest) (*ListConversionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListConversions not implemented")
+}
+func (UnimplementedUOMServiceServer) CreateConversion(context.Context, *CreateConversionRequest) (*CreateConversionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateConversion not implemented")
+}
+func (UnimplementedUOMServiceServer) DeleteConversion(context.Context, *DeleteConversionRequest) (*DeleteConversionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteConversion not implemented")
+}
 func (UnimplementedUOMServiceServer) mustEmbedUnimplementedUOMServiceServer() {}
 func (UnimplementedUOMServiceServer) testEmbeddedByValue()                    {}
 
@@ -254,6 +445,143 @@ func _UOMService_DeleteUOM_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UOMService_RestoreUOM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreUOMRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UOMServiceServer).RestoreUOM(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UOMService_RestoreUOM_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UOMServiceServer).RestoreUOM(ctx, req.(*RestoreUOMRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UOMService_BatchUpsertUOMs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpsertUOMsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UOMServiceServer).BatchUpsertUOMs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UOMService_BatchUpsertUOMs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UOMServiceServer).BatchUpsertUOMs(ctx, req.(*BatchUpsertUOMsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UOMService_ImportUOMs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(UOMServiceServer).ImportUOMs(&grpc.GenericServerStream[ImportRequest, ImportResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UOMService_ImportUOMsServer = grpc.ClientStreamingServer[ImportRequest, ImportResponse]
+
+func _UOMService_ExportUOMs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportUOMsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UOMServiceServer).ExportUOMs(m, &grpc.GenericServerStream[ExportUOMsRequest, ExportChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UOMService_ExportUOMsServer = grpc.ServerStreamingServer[ExportChunk]
+
+func _UOMService_WatchUOMs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchUOMsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UOMServiceServer).WatchUOMs(m, &grpc.GenericServerStream[WatchUOMsRequest, UOMChange]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UOMService_WatchUOMsServer = grpc.ServerStreamingServer[UOMChange]
+
+func _UOMService_ConvertQuantity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConvertQuantityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UOMServiceServer).ConvertQuantity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UOMService_ConvertQuantity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UOMServiceServer).ConvertQuantity(ctx, req.(*ConvertQuantityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UOMService_ListConversions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConversionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UOMServiceServer).ListConversions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UOMService_ListConversions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UOMServiceServer).ListConversions(ctx, req.(*ListConversionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UOMService_CreateConversion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateConversionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UOMServiceServer).CreateConversion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UOMService_CreateConversion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UOMServiceServer).CreateConversion(ctx, req.(*CreateConversionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UOMService_DeleteConversion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteConversionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UOMServiceServer).DeleteConversion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UOMService_DeleteConversion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UOMServiceServer).DeleteConversion(ctx, req.(*DeleteConversionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UOMService_ServiceDesc is the grpc.ServiceDesc for UOMService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -281,7 +609,47 @@ var UOMService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteUOM",
 			Handler:    _UOMService_DeleteUOM_Handler,
 		},
+		{
+			MethodName: "RestoreUOM",
+			Handler:    _UOMService_RestoreUOM_Handler,
+		},
+		{
+			MethodName: "BatchUpsertUOMs",
+			Handler:    _UOMService_BatchUpsertUOMs_Handler,
+		},
+		{
+			MethodName: "ConvertQuantity",
+			Handler:    _UOMService_ConvertQuantity_Handler,
+		},
+		{
+			MethodName: "ListConversions",
+			Handler:    _UOMService_ListConversions_Handler,
+		},
+		{
+			MethodName: "CreateConversion",
+			Handler:    _UOMService_CreateConversion_Handler,
+		},
+		{
+			MethodName: "DeleteConversion",
+			Handler:    _UOMService_DeleteConversion_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportUOMs",
+			Handler:       _UOMService_ImportUOMs_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportUOMs",
+			Handler:       _UOMService_ExportUOMs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchUOMs",
+			Handler:       _UOMService_WatchUOMs_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "costing/v1/uom.proto",
 }
@@ -0,0 +1,66 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/audit"
+)
+
+// Transactor runs fn in a transaction carried by the context passed to it,
+// so every repository called with that context commits or rolls back together.
+type Transactor interface {
+	InTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// Recorder appends audit events on behalf of the master-data command handlers.
+type Recorder struct {
+	repo audit.Repository
+	tx   Transactor
+}
+
+// NewRecorder creates a new audit recorder.
+func NewRecorder(repo audit.Repository, tx Transactor) *Recorder {
+	return &Recorder{repo: repo, tx: tx}
+}
+
+// InTx runs fn, which persists a change and records it, in one transaction:
+// a change is never kept without its audit event.
+func (r *Recorder) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return r.tx.InTx(ctx, fn)
+}
+
+// Created records the creation of an entity.
+func (r *Recorder) Created(ctx context.Context, entityType audit.EntityType, code string, after audit.Snapshot, by string) error {
+	return r.record(ctx, entityType, code, audit.ActionCreate, nil, after, by)
+}
+
+// Updated records a change of an entity from before to after.
+func (r *Recorder) Updated(ctx context.Context, entityType audit.EntityType, code string, before, after audit.Snapshot, by string) error {
+	return r.record(ctx, entityType, code, audit.ActionUpdate, before, after, by)
+}
+
+// Deleted records the deletion of an entity last seen as before.
+func (r *Recorder) Deleted(ctx context.Context, entityType audit.EntityType, code string, before audit.Snapshot, by string) error {
+	return r.record(ctx, entityType, code, audit.ActionDelete, before, nil, by)
+}
+
+// record appends the event. Called within InTx, a failure rolls back the
+// change it describes.
+func (r *Recorder) record(
+	ctx context.Context,
+	entityType audit.EntityType,
+	code string,
+	action audit.Action,
+	before, after audit.Snapshot,
+	by string,
+) error {
+	event, err := audit.NewEvent(entityType, code, action, before, after, by)
+	if err != nil {
+		return err
+	}
+	if err := r.repo.Append(ctx, event); err != nil {
+		return fmt.Errorf("failed to record audit event: %w", err)
+	}
+	return nil
+}
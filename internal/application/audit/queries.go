@@ -0,0 +1,89 @@
+package audit
+
+import (
+	"context"
+	"time"
+
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/audit"
+)
+
+// ListQuery represents the list audit events query.
+// From and To are RFC 3339 timestamps bounding occurred_at as [From, To).
+type ListQuery struct {
+	EntityType *string
+	EntityCode *string
+	ChangedBy  *string
+	From       *string
+	To         *string
+	Page       int
+	PageSize   int
+}
+
+// ListResult contains the list result with pagination.
+type ListResult struct {
+	Events []*audit.Event
+	Total  int64
+}
+
+// ListHandler handles the ListAuditEvents query.
+type ListHandler struct {
+	repo audit.Repository
+}
+
+// NewListHandler creates a new list handler.
+func NewListHandler(repo audit.Repository) *ListHandler {
+	return &ListHandler{repo: repo}
+}
+
+// Handle executes the list query.
+func (h *ListHandler) Handle(ctx context.Context, query ListQuery) (*ListResult, error) {
+	filter := audit.ListFilter{
+		EntityCode: query.EntityCode,
+		ChangedBy:  query.ChangedBy,
+		Page:       query.Page,
+		PageSize:   query.PageSize,
+	}
+
+	if query.EntityType != nil {
+		entityType, err := audit.NewEntityType(*query.EntityType)
+		if err != nil {
+			return nil, err
+		}
+		filter.EntityType = &entityType
+	}
+
+	from, err := parseTimestamp(query.From)
+	if err != nil {
+		return nil, err
+	}
+	to, err := parseTimestamp(query.To)
+	if err != nil {
+		return nil, err
+	}
+	if from != nil && to != nil && !from.Before(*to) {
+		return nil, audit.ErrInvalidTimeRange
+	}
+	filter.From, filter.To = from, to
+
+	events, total, err := h.repo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListResult{
+		Events: events,
+		Total:  total,
+	}, nil
+}
+
+// parseTimestamp parses an optional RFC 3339 timestamp.
+func parseTimestamp(s *string) (*time.Time, error) {
+	if s == nil || *s == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, *s)
+	if err != nil {
+		return nil, audit.ErrInvalidTimeRange
+	}
+	return &t, nil
+}
@@ -0,0 +1,46 @@
+// Package batch holds the types shared by the batch upsert use cases.
+package batch
+
+import "errors"
+
+// ErrDuplicateCode is returned for an item whose code already appeared earlier in the batch.
+var ErrDuplicateCode = errors.New("code appears more than once in the batch")
+
+// Status is the outcome of one batch item.
+type Status string
+
+const (
+	StatusCreated Status = "CREATED"
+	StatusUpdated Status = "UPDATED"
+	StatusFailed  Status = "FAILED"
+	// StatusSkipped marks a valid item that was not saved because another
+	// item of an atomic batch failed.
+	StatusSkipped Status = "SKIPPED"
+)
+
+// ItemResult is the outcome of one batch item. Entity is set for saved items
+// and Err for failed ones.
+type ItemResult[T any] struct {
+	Index  int
+	Code   string
+	Status Status
+	Entity T
+	Err    error
+}
+
+// Fail marks the result as failed with err.
+func (r *ItemResult[T]) Fail(err error) {
+	r.Status = StatusFailed
+	r.Err = err
+}
+
+// CountFailed returns the number of failed items.
+func CountFailed[T any](results []ItemResult[T]) int {
+	failed := 0
+	for _, result := range results {
+		if result.Status == StatusFailed {
+			failed++
+		}
+	}
+	return failed
+}
@@ -0,0 +1,193 @@
+package costing
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	appuom "github.com/homindolenern/goapps-costing-v1/internal/application/uom"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/costing"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/parameter"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/parametervalue"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/uom"
+)
+
+// MaterialInput is a raw material line of the CalculateCost query.
+type MaterialInput struct {
+	MaterialCode string
+	Quantity     float64
+	UOMCode      string
+	UnitPrice    float64
+	PriceUOMCode string
+	WastePercent float64
+}
+
+// StepParameterInput is a parameter value of a process step.
+type StepParameterInput struct {
+	ParameterCode string
+	Value         float64
+}
+
+// StepInput is a process step of the CalculateCost query.
+type StepInput struct {
+	StepCode            string
+	MachineCode         string
+	OutputUOMCode       string
+	Parameters          []StepParameterInput
+	LaborRatePerHour    float64
+	OverheadRatePerHour float64
+}
+
+// CalculateQuery represents the CalculateCost query.
+type CalculateQuery struct {
+	ProductCode     string
+	OutputQuantity  float64
+	OutputUOMCode   string
+	Materials       []MaterialInput
+	Steps           []StepInput
+	ElectricityRate float64
+	// AsOf, an RFC 3339 timestamp, validates step parameters against the
+	// definitions in force at that time; the current ones are used if nil.
+	AsOf *string
+}
+
+// CalculateHandler handles the CalculateCost query.
+type CalculateHandler struct {
+	uomRepo   uom.Repository
+	paramRepo parameter.Repository
+}
+
+// NewCalculateHandler creates a new calculate handler.
+func NewCalculateHandler(uomRepo uom.Repository, paramRepo parameter.Repository) *CalculateHandler {
+	return &CalculateHandler{uomRepo: uomRepo, paramRepo: paramRepo}
+}
+
+// Handle executes the calculate query.
+func (h *CalculateHandler) Handle(ctx context.Context, query CalculateQuery) (*costing.Breakdown, error) {
+	// 1. Create value objects, collecting every UOM the recipe uses
+	outputUOM, err := uom.NewUOMCode(query.OutputUOMCode)
+	if err != nil {
+		return nil, err
+	}
+	codes := []uom.Code{outputUOM}
+
+	var asOf *time.Time
+	if query.AsOf != nil {
+		t, err := parameter.NewAsOf(*query.AsOf)
+		if err != nil {
+			return nil, err
+		}
+		asOf = &t
+	}
+
+	recipe := costing.Recipe{
+		ProductCode:     query.ProductCode,
+		OutputQuantity:  query.OutputQuantity,
+		OutputUOM:       outputUOM,
+		ElectricityRate: query.ElectricityRate,
+		Materials:       make([]costing.MaterialLine, 0, len(query.Materials)),
+		Steps:           make([]costing.ProcessStep, 0, len(query.Steps)),
+	}
+
+	for _, m := range query.Materials {
+		qtyUOM, err := uom.NewUOMCode(m.UOMCode)
+		if err != nil {
+			return nil, fmt.Errorf("material %s: %w", m.MaterialCode, err)
+		}
+		priceUOM, err := uom.NewUOMCode(m.PriceUOMCode)
+		if err != nil {
+			return nil, fmt.Errorf("material %s: %w", m.MaterialCode, err)
+		}
+		codes = append(codes, qtyUOM, priceUOM)
+
+		recipe.Materials = append(recipe.Materials, costing.MaterialLine{
+			MaterialCode: m.MaterialCode,
+			Quantity:     m.Quantity,
+			UOM:          qtyUOM,
+			UnitPrice:    m.UnitPrice,
+			PriceUOM:     priceUOM,
+			WastePercent: m.WastePercent,
+		})
+	}
+
+	// 2. Check step parameters against their definitions
+	definitions := make(map[parameter.Code]*parameter.Parameter)
+	for _, s := range query.Steps {
+		stepUOM, err := uom.NewUOMCode(s.OutputUOMCode)
+		if err != nil {
+			return nil, fmt.Errorf("step %s: %w", s.StepCode, err)
+		}
+		codes = append(codes, stepUOM)
+
+		params := make(map[string]float64, len(s.Parameters))
+		for _, p := range s.Parameters {
+			if err := h.checkParameter(ctx, definitions, asOf, p); err != nil {
+				return nil, fmt.Errorf("step %s: parameter %s: %w", s.StepCode, p.ParameterCode, err)
+			}
+			if _, ok := params[p.ParameterCode]; ok {
+				return nil, fmt.Errorf("step %s: parameter %s: %w", s.StepCode, p.ParameterCode, costing.ErrDuplicateStepParameter)
+			}
+			params[p.ParameterCode] = p.Value
+		}
+
+		recipe.Steps = append(recipe.Steps, costing.ProcessStep{
+			StepCode:            s.StepCode,
+			MachineCode:         s.MachineCode,
+			OutputUOM:           stepUOM,
+			Parameters:          params,
+			LaborRatePerHour:    s.LaborRatePerHour,
+			OverheadRatePerHour: s.OverheadRatePerHour,
+		})
+	}
+
+	// 3. Load the units and conversions involved
+	converter, err := appuom.LoadConverter(ctx, h.uomRepo, codes...)
+	if err != nil {
+		return nil, err
+	}
+
+	// 4. Calculate
+	return costing.Calculate(recipe, converter)
+}
+
+// checkParameter validates a step parameter against its NUMERIC definition,
+// as of asOf when set, caching definitions already loaded.
+func (h *CalculateHandler) checkParameter(
+	ctx context.Context,
+	definitions map[parameter.Code]*parameter.Parameter,
+	asOf *time.Time,
+	input StepParameterInput,
+) error {
+	code, err := parameter.NewParameterCode(input.ParameterCode)
+	if err != nil {
+		return err
+	}
+
+	definition, ok := definitions[code]
+	if !ok {
+		definition, err = h.definition(ctx, code, asOf)
+		if err != nil {
+			return err
+		}
+		definitions[code] = definition
+	}
+
+	if definition.DataType() != parameter.DataTypeNumeric {
+		return costing.ErrParameterNotNumeric
+	}
+	_, _, err = parametervalue.ParseValue(definition, strconv.FormatFloat(input.Value, 'f', -1, 64))
+	return err
+}
+
+// definition loads the current definition of code, or the one in force at asOf.
+func (h *CalculateHandler) definition(ctx context.Context, code parameter.Code, asOf *time.Time) (*parameter.Parameter, error) {
+	if asOf == nil {
+		return h.paramRepo.GetByCode(ctx, code)
+	}
+	version, err := h.paramRepo.GetVersionAsOf(ctx, code, *asOf)
+	if err != nil {
+		return nil, err
+	}
+	return version.Parameter, nil
+}
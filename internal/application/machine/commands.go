@@ -0,0 +1,487 @@
+package machine
+
+import (
+	"context"
+	"fmt"
+
+	appaudit "github.com/homindolenern/goapps-costing-v1/internal/application/audit"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/audit"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/machine"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/parameter"
+)
+
+// TemplateParameterInput is a parameter declared by a machine type.
+type TemplateParameterInput struct {
+	ParameterCode string
+	IsMandatory   bool
+}
+
+// CreateTypeCommand represents the create MachineType command.
+type CreateTypeCommand struct {
+	TypeCode    string
+	TypeName    string
+	Description *string
+	Parameters  []TemplateParameterInput
+	CreatedBy   string
+}
+
+// CreateTypeHandler handles the CreateMachineType command.
+type CreateTypeHandler struct {
+	typeRepo  machine.TypeRepository
+	paramRepo parameter.Repository
+	recorder  *appaudit.Recorder
+}
+
+// NewCreateTypeHandler creates a new create machine type handler.
+func NewCreateTypeHandler(
+	typeRepo machine.TypeRepository,
+	paramRepo parameter.Repository,
+	recorder *appaudit.Recorder,
+) *CreateTypeHandler {
+	return &CreateTypeHandler{typeRepo: typeRepo, paramRepo: paramRepo, recorder: recorder}
+}
+
+// Handle executes the create machine type command.
+func (h *CreateTypeHandler) Handle(ctx context.Context, cmd CreateTypeCommand) (*machine.MachineType, error) {
+	// 1. Create value objects
+	code, err := machine.NewTypeCode(cmd.TypeCode)
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := buildTemplate(ctx, h.paramRepo, cmd.Parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Check for duplicates
+	exists, err := h.typeRepo.ExistsByCode(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, machine.ErrTypeAlreadyExists
+	}
+
+	// 3. Create domain entity
+	entity, err := machine.NewMachineType(code, cmd.TypeName, cmd.CreatedBy)
+	if err != nil {
+		return nil, err
+	}
+	entity.SetDescription(cmd.Description)
+	if err := entity.SetParameters(template); err != nil {
+		return nil, err
+	}
+
+	// 4. Persist with its audit event
+	if err := h.recorder.InTx(ctx, func(ctx context.Context) error {
+		if err := h.typeRepo.Create(ctx, entity); err != nil {
+			return err
+		}
+		return h.recorder.Created(ctx, audit.EntityMachineType, code.String(), typeSnapshot(entity), cmd.CreatedBy)
+	}); err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+// UpdateTypeCommand represents the update MachineType command.
+type UpdateTypeCommand struct {
+	TypeCode    string
+	TypeName    string
+	Description *string
+	Parameters  []TemplateParameterInput
+	IsActive    bool
+	UpdatedBy   string
+}
+
+// UpdateTypeHandler handles the UpdateMachineType command.
+type UpdateTypeHandler struct {
+	typeRepo  machine.TypeRepository
+	paramRepo parameter.Repository
+	recorder  *appaudit.Recorder
+}
+
+// NewUpdateTypeHandler creates a new update machine type handler.
+func NewUpdateTypeHandler(
+	typeRepo machine.TypeRepository,
+	paramRepo parameter.Repository,
+	recorder *appaudit.Recorder,
+) *UpdateTypeHandler {
+	return &UpdateTypeHandler{typeRepo: typeRepo, paramRepo: paramRepo, recorder: recorder}
+}
+
+// Handle executes the update machine type command. Machines keep their
+// values; a parameter still holding machine values cannot leave the template.
+func (h *UpdateTypeHandler) Handle(ctx context.Context, cmd UpdateTypeCommand) (*machine.MachineType, error) {
+	// 1. Create value objects
+	code, err := machine.NewTypeCode(cmd.TypeCode)
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := buildTemplate(ctx, h.paramRepo, cmd.Parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Get existing entity
+	entity, err := h.typeRepo.GetByCode(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+	before := typeSnapshot(entity)
+
+	// 3. Update entity
+	if err := entity.Update(cmd.TypeName, cmd.UpdatedBy); err != nil {
+		return nil, err
+	}
+	entity.SetDescription(cmd.Description)
+	if err := entity.SetParameters(template); err != nil {
+		return nil, err
+	}
+
+	if cmd.IsActive {
+		entity.Activate()
+	} else {
+		entity.Deactivate()
+	}
+
+	// 4. Persist with its audit event
+	if err := h.recorder.InTx(ctx, func(ctx context.Context) error {
+		if err := h.typeRepo.Update(ctx, entity); err != nil {
+			return err
+		}
+		return h.recorder.Updated(ctx, audit.EntityMachineType, code.String(), before, typeSnapshot(entity), cmd.UpdatedBy)
+	}); err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+// DeleteTypeCommand represents the delete MachineType command.
+type DeleteTypeCommand struct {
+	TypeCode  string
+	DeletedBy string
+}
+
+// DeleteTypeHandler handles the DeleteMachineType command.
+type DeleteTypeHandler struct {
+	typeRepo machine.TypeRepository
+	recorder *appaudit.Recorder
+}
+
+// NewDeleteTypeHandler creates a new delete machine type handler.
+func NewDeleteTypeHandler(typeRepo machine.TypeRepository, recorder *appaudit.Recorder) *DeleteTypeHandler {
+	return &DeleteTypeHandler{typeRepo: typeRepo, recorder: recorder}
+}
+
+// Handle executes the delete machine type command.
+func (h *DeleteTypeHandler) Handle(ctx context.Context, cmd DeleteTypeCommand) error {
+	code, err := machine.NewTypeCode(cmd.TypeCode)
+	if err != nil {
+		return err
+	}
+
+	// Keep the last state for the audit trail
+	entity, err := h.typeRepo.GetByCode(ctx, code)
+	if err != nil {
+		return err
+	}
+
+	if err := h.recorder.InTx(ctx, func(ctx context.Context) error {
+		if err := h.typeRepo.Delete(ctx, code); err != nil {
+			return err
+		}
+		return h.recorder.Deleted(ctx, audit.EntityMachineType, code.String(), typeSnapshot(entity), cmd.DeletedBy)
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ValueInput is a raw parameter value of a machine.
+type ValueInput struct {
+	ParameterCode string
+	Value         string
+}
+
+// CreateCommand represents the create Machine command.
+type CreateCommand struct {
+	MachineCode string
+	MachineName string
+	TypeCode    string
+	Description *string
+	Values      []ValueInput
+	CreatedBy   string
+}
+
+// CreateHandler handles the CreateMachine command.
+type CreateHandler struct {
+	repo      machine.Repository
+	typeRepo  machine.TypeRepository
+	paramRepo parameter.Repository
+	recorder  *appaudit.Recorder
+}
+
+// NewCreateHandler creates a new create handler.
+func NewCreateHandler(
+	repo machine.Repository,
+	typeRepo machine.TypeRepository,
+	paramRepo parameter.Repository,
+	recorder *appaudit.Recorder,
+) *CreateHandler {
+	return &CreateHandler{repo: repo, typeRepo: typeRepo, paramRepo: paramRepo, recorder: recorder}
+}
+
+// Handle executes the create command.
+func (h *CreateHandler) Handle(ctx context.Context, cmd CreateCommand) (*machine.Machine, error) {
+	// 1. Create value objects
+	code, err := machine.NewMachineCode(cmd.MachineCode)
+	if err != nil {
+		return nil, err
+	}
+
+	machineType, err := loadActiveType(ctx, h.typeRepo, cmd.TypeCode)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Check for duplicates
+	exists, err := h.repo.ExistsByCode(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, machine.ErrAlreadyExists
+	}
+
+	// 3. Validate values against the machine type template
+	values, err := resolveValues(ctx, h.paramRepo, machineType, cmd.Values)
+	if err != nil {
+		return nil, err
+	}
+
+	// 4. Create domain entity
+	entity, err := machine.NewMachine(code, cmd.MachineName, machineType.Code(), cmd.CreatedBy)
+	if err != nil {
+		return nil, err
+	}
+	entity.SetDescription(cmd.Description)
+	entity.SetValues(values)
+
+	// 5. Persist with its audit event
+	if err := h.recorder.InTx(ctx, func(ctx context.Context) error {
+		if err := h.repo.Create(ctx, entity); err != nil {
+			return err
+		}
+		return h.recorder.Created(ctx, audit.EntityMachine, code.String(), snapshot(entity), cmd.CreatedBy)
+	}); err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+// UpdateCommand represents the update Machine command.
+type UpdateCommand struct {
+	MachineCode string
+	MachineName string
+	TypeCode    string
+	Description *string
+	Values      []ValueInput
+	IsActive    bool
+	UpdatedBy   string
+}
+
+// UpdateHandler handles the UpdateMachine command.
+type UpdateHandler struct {
+	repo      machine.Repository
+	typeRepo  machine.TypeRepository
+	paramRepo parameter.Repository
+	recorder  *appaudit.Recorder
+}
+
+// NewUpdateHandler creates a new update handler.
+func NewUpdateHandler(
+	repo machine.Repository,
+	typeRepo machine.TypeRepository,
+	paramRepo parameter.Repository,
+	recorder *appaudit.Recorder,
+) *UpdateHandler {
+	return &UpdateHandler{repo: repo, typeRepo: typeRepo, paramRepo: paramRepo, recorder: recorder}
+}
+
+// Handle executes the update command. Values replace the stored set and
+// are validated against the current template of the (possibly new) type.
+func (h *UpdateHandler) Handle(ctx context.Context, cmd UpdateCommand) (*machine.Machine, error) {
+	// 1. Create value objects
+	code, err := machine.NewMachineCode(cmd.MachineCode)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Get existing entity and its target type
+	entity, err := h.repo.GetByCode(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+	before := snapshot(entity)
+
+	machineType, err := loadActiveType(ctx, h.typeRepo, cmd.TypeCode)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Validate values against the machine type template
+	values, err := resolveValues(ctx, h.paramRepo, machineType, cmd.Values)
+	if err != nil {
+		return nil, err
+	}
+
+	// 4. Update entity
+	if err := entity.Update(cmd.MachineName, machineType.Code(), cmd.UpdatedBy); err != nil {
+		return nil, err
+	}
+	entity.SetDescription(cmd.Description)
+	entity.SetValues(values)
+
+	if cmd.IsActive {
+		entity.Activate()
+	} else {
+		entity.Deactivate()
+	}
+
+	// 5. Persist with its audit event
+	if err := h.recorder.InTx(ctx, func(ctx context.Context) error {
+		if err := h.repo.Update(ctx, entity); err != nil {
+			return err
+		}
+		return h.recorder.Updated(ctx, audit.EntityMachine, code.String(), before, snapshot(entity), cmd.UpdatedBy)
+	}); err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+// DeleteCommand represents the delete Machine command.
+type DeleteCommand struct {
+	MachineCode string
+	DeletedBy   string
+}
+
+// DeleteHandler handles the DeleteMachine command.
+type DeleteHandler struct {
+	repo     machine.Repository
+	recorder *appaudit.Recorder
+}
+
+// NewDeleteHandler creates a new delete handler.
+func NewDeleteHandler(repo machine.Repository, recorder *appaudit.Recorder) *DeleteHandler {
+	return &DeleteHandler{repo: repo, recorder: recorder}
+}
+
+// Handle executes the delete command.
+func (h *DeleteHandler) Handle(ctx context.Context, cmd DeleteCommand) error {
+	code, err := machine.NewMachineCode(cmd.MachineCode)
+	if err != nil {
+		return err
+	}
+
+	// Keep the last state for the audit trail
+	entity, err := h.repo.GetByCode(ctx, code)
+	if err != nil {
+		return err
+	}
+
+	if err := h.recorder.InTx(ctx, func(ctx context.Context) error {
+		if err := h.repo.Delete(ctx, code); err != nil {
+			return err
+		}
+		return h.recorder.Deleted(ctx, audit.EntityMachine, code.String(), snapshot(entity), cmd.DeletedBy)
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// buildTemplate checks every template parameter exists in the MACHINE
+// category. A parameter whose definition is mandatory is always mandatory
+// in the template.
+func buildTemplate(
+	ctx context.Context,
+	paramRepo parameter.Repository,
+	inputs []TemplateParameterInput,
+) ([]machine.TemplateParameter, error) {
+	template := make([]machine.TemplateParameter, 0, len(inputs))
+	for _, in := range inputs {
+		code, err := parameter.NewParameterCode(in.ParameterCode)
+		if err != nil {
+			return nil, fmt.Errorf("parameter %s: %w", in.ParameterCode, err)
+		}
+
+		definition, err := paramRepo.GetByCode(ctx, code)
+		if err != nil {
+			return nil, fmt.Errorf("parameter %s: %w", in.ParameterCode, err)
+		}
+		if err := machine.CheckTemplateParameter(definition); err != nil {
+			return nil, fmt.Errorf("parameter %s: %w", in.ParameterCode, err)
+		}
+
+		template = append(template, machine.NewTemplateParameter(code, in.IsMandatory || definition.IsMandatory()))
+	}
+	return template, nil
+}
+
+// loadActiveType loads the machine type a machine is assigned to.
+func loadActiveType(ctx context.Context, typeRepo machine.TypeRepository, typeCode string) (*machine.MachineType, error) {
+	code, err := machine.NewTypeCode(typeCode)
+	if err != nil {
+		return nil, err
+	}
+
+	machineType, err := typeRepo.GetByCode(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+	if !machineType.IsActive() {
+		return nil, machine.ErrTypeInactive
+	}
+	return machineType, nil
+}
+
+// resolveValues loads the template parameter definitions and validates inputs against them.
+func resolveValues(
+	ctx context.Context,
+	paramRepo parameter.Repository,
+	machineType *machine.MachineType,
+	inputs []ValueInput,
+) ([]machine.Value, error) {
+	raw := make(map[parameter.Code]string, len(inputs))
+	for _, in := range inputs {
+		code, err := parameter.NewParameterCode(in.ParameterCode)
+		if err != nil {
+			return nil, fmt.Errorf("parameter %s: %w", in.ParameterCode, err)
+		}
+		if _, ok := raw[code]; ok {
+			return nil, fmt.Errorf("parameter %s: %w", in.ParameterCode, machine.ErrDuplicateParameter)
+		}
+		raw[code] = in.Value
+	}
+
+	definitions := make(map[parameter.Code]*parameter.Parameter, len(machineType.Parameters()))
+	for _, p := range machineType.Parameters() {
+		definition, err := paramRepo.GetByCode(ctx, p.ParameterCode())
+		if err != nil {
+			return nil, fmt.Errorf("parameter %s: %w", p.ParameterCode(), err)
+		}
+		definitions[p.ParameterCode()] = definition
+	}
+
+	return machine.ResolveValues(machineType, definitions, raw)
+}
@@ -0,0 +1,150 @@
+package machine
+
+import (
+	"context"
+
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/machine"
+)
+
+// GetTypeQuery represents the get MachineType query.
+type GetTypeQuery struct {
+	TypeCode string
+}
+
+// GetTypeHandler handles the GetMachineType query.
+type GetTypeHandler struct {
+	typeRepo machine.TypeRepository
+}
+
+// NewGetTypeHandler creates a new get machine type handler.
+func NewGetTypeHandler(typeRepo machine.TypeRepository) *GetTypeHandler {
+	return &GetTypeHandler{typeRepo: typeRepo}
+}
+
+// Handle executes the get machine type query.
+func (h *GetTypeHandler) Handle(ctx context.Context, query GetTypeQuery) (*machine.MachineType, error) {
+	code, err := machine.NewTypeCode(query.TypeCode)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.typeRepo.GetByCode(ctx, code)
+}
+
+// ListTypesQuery represents the list MachineTypes query.
+type ListTypesQuery struct {
+	IsActive *bool
+	Page     int
+	PageSize int
+}
+
+// ListTypesResult contains the machine type list result with pagination.
+type ListTypesResult struct {
+	MachineTypes []*machine.MachineType
+	Total        int64
+}
+
+// ListTypesHandler handles the ListMachineTypes query.
+type ListTypesHandler struct {
+	typeRepo machine.TypeRepository
+}
+
+// NewListTypesHandler creates a new list machine types handler.
+func NewListTypesHandler(typeRepo machine.TypeRepository) *ListTypesHandler {
+	return &ListTypesHandler{typeRepo: typeRepo}
+}
+
+// Handle executes the list machine types query.
+func (h *ListTypesHandler) Handle(ctx context.Context, query ListTypesQuery) (*ListTypesResult, error) {
+	filter := machine.TypeListFilter{
+		IsActive: query.IsActive,
+		Page:     query.Page,
+		PageSize: query.PageSize,
+	}
+
+	machineTypes, total, err := h.typeRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListTypesResult{
+		MachineTypes: machineTypes,
+		Total:        total,
+	}, nil
+}
+
+// GetQuery represents the get Machine query.
+type GetQuery struct {
+	MachineCode string
+}
+
+// GetHandler handles the GetMachine query.
+type GetHandler struct {
+	repo machine.Repository
+}
+
+// NewGetHandler creates a new get handler.
+func NewGetHandler(repo machine.Repository) *GetHandler {
+	return &GetHandler{repo: repo}
+}
+
+// Handle executes the get query.
+func (h *GetHandler) Handle(ctx context.Context, query GetQuery) (*machine.Machine, error) {
+	code, err := machine.NewMachineCode(query.MachineCode)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.repo.GetByCode(ctx, code)
+}
+
+// ListQuery represents the list Machines query.
+type ListQuery struct {
+	TypeCode *string
+	IsActive *bool
+	Page     int
+	PageSize int
+}
+
+// ListResult contains the list result with pagination.
+type ListResult struct {
+	Machines []*machine.Machine
+	Total    int64
+}
+
+// ListHandler handles the ListMachines query.
+type ListHandler struct {
+	repo machine.Repository
+}
+
+// NewListHandler creates a new list handler.
+func NewListHandler(repo machine.Repository) *ListHandler {
+	return &ListHandler{repo: repo}
+}
+
+// Handle executes the list query.
+func (h *ListHandler) Handle(ctx context.Context, query ListQuery) (*ListResult, error) {
+	filter := machine.ListFilter{
+		IsActive: query.IsActive,
+		Page:     query.Page,
+		PageSize: query.PageSize,
+	}
+
+	if query.TypeCode != nil {
+		typeCode, err := machine.NewTypeCode(*query.TypeCode)
+		if err != nil {
+			return nil, err
+		}
+		filter.MachineType = &typeCode
+	}
+
+	machines, total, err := h.repo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListResult{
+		Machines: machines,
+		Total:    total,
+	}, nil
+}
@@ -0,0 +1,40 @@
+package machine
+
+import (
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/audit"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/machine"
+)
+
+// typeSnapshot captures the audited fields of a MachineType, including its template.
+func typeSnapshot(entity *machine.MachineType) audit.Snapshot {
+	parameters := make([]map[string]interface{}, len(entity.Parameters()))
+	for i, p := range entity.Parameters() {
+		parameters[i] = map[string]interface{}{
+			"parameter_code": p.ParameterCode().String(),
+			"is_mandatory":   p.IsMandatory(),
+		}
+	}
+
+	return audit.Snapshot{
+		"machine_type_name": entity.Name(),
+		"description":       entity.Description(),
+		"parameters":        parameters,
+		"is_active":         entity.IsActive(),
+	}
+}
+
+// snapshot captures the audited fields of a Machine; values are keyed by parameter code.
+func snapshot(entity *machine.Machine) audit.Snapshot {
+	values := make(map[string]string, len(entity.Values()))
+	for _, v := range entity.Values() {
+		values[v.ParameterCode().String()] = v.Value()
+	}
+
+	return audit.Snapshot{
+		"machine_name":      entity.Name(),
+		"machine_type_code": entity.MachineType().String(),
+		"description":       entity.Description(),
+		"values":            values,
+		"is_active":         entity.IsActive(),
+	}
+}
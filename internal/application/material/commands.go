@@ -0,0 +1,236 @@
+package material
+
+import (
+	"context"
+	"errors"
+
+	appaudit "github.com/homindolenern/goapps-costing-v1/internal/application/audit"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/audit"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/material"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/uom"
+)
+
+// CreateCommand represents the create Material command.
+type CreateCommand struct {
+	MaterialCode    string
+	MaterialName    string
+	MaterialType    string
+	PurchaseUOMCode string
+	StandardPrice   float64
+	Currency        string
+	Description     *string
+	CreatedBy       string
+}
+
+// CreateHandler handles the CreateMaterial command.
+type CreateHandler struct {
+	repo     material.Repository
+	uomRepo  uom.Repository
+	recorder *appaudit.Recorder
+}
+
+// NewCreateHandler creates a new create handler.
+func NewCreateHandler(repo material.Repository, uomRepo uom.Repository, recorder *appaudit.Recorder) *CreateHandler {
+	return &CreateHandler{repo: repo, uomRepo: uomRepo, recorder: recorder}
+}
+
+// Handle executes the create command.
+func (h *CreateHandler) Handle(ctx context.Context, cmd CreateCommand) (*material.Material, error) {
+	// 1. Create and validate value objects
+	code, err := material.NewMaterialCode(cmd.MaterialCode)
+	if err != nil {
+		return nil, err
+	}
+
+	materialType, err := material.NewType(cmd.MaterialType)
+	if err != nil {
+		return nil, err
+	}
+
+	price, err := newPrice(cmd.StandardPrice, cmd.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	purchaseUOM, err := checkPurchaseUOM(ctx, h.uomRepo, cmd.PurchaseUOMCode)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Check for duplicates
+	exists, err := h.repo.ExistsByCode(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, material.ErrAlreadyExists
+	}
+
+	// 3. Create domain entity
+	entity, err := material.NewMaterial(code, cmd.MaterialName, materialType, purchaseUOM, price, cmd.CreatedBy)
+	if err != nil {
+		return nil, err
+	}
+	entity.SetDescription(cmd.Description)
+
+	// 4. Persist with its audit event
+	if err := h.recorder.InTx(ctx, func(ctx context.Context) error {
+		if err := h.repo.Create(ctx, entity); err != nil {
+			return err
+		}
+		return h.recorder.Created(ctx, audit.EntityMaterial, code.String(), snapshot(entity), cmd.CreatedBy)
+	}); err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+// UpdateCommand represents the update Material command.
+type UpdateCommand struct {
+	MaterialCode    string
+	MaterialName    string
+	MaterialType    string
+	PurchaseUOMCode string
+	StandardPrice   float64
+	Currency        string
+	Description     *string
+	IsActive        bool
+	UpdatedBy       string
+}
+
+// UpdateHandler handles the UpdateMaterial command.
+type UpdateHandler struct {
+	repo     material.Repository
+	uomRepo  uom.Repository
+	recorder *appaudit.Recorder
+}
+
+// NewUpdateHandler creates a new update handler.
+func NewUpdateHandler(repo material.Repository, uomRepo uom.Repository, recorder *appaudit.Recorder) *UpdateHandler {
+	return &UpdateHandler{repo: repo, uomRepo: uomRepo, recorder: recorder}
+}
+
+// Handle executes the update command.
+func (h *UpdateHandler) Handle(ctx context.Context, cmd UpdateCommand) (*material.Material, error) {
+	// 1. Create value objects
+	code, err := material.NewMaterialCode(cmd.MaterialCode)
+	if err != nil {
+		return nil, err
+	}
+
+	materialType, err := material.NewType(cmd.MaterialType)
+	if err != nil {
+		return nil, err
+	}
+
+	price, err := newPrice(cmd.StandardPrice, cmd.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	purchaseUOM, err := checkPurchaseUOM(ctx, h.uomRepo, cmd.PurchaseUOMCode)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Get existing entity
+	entity, err := h.repo.GetByCode(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+	before := snapshot(entity)
+
+	// 3. Update entity
+	if err := entity.Update(cmd.MaterialName, materialType, purchaseUOM, price, cmd.UpdatedBy); err != nil {
+		return nil, err
+	}
+	entity.SetDescription(cmd.Description)
+
+	if cmd.IsActive {
+		entity.Activate()
+	} else {
+		entity.Deactivate()
+	}
+
+	// 4. Persist with its audit event
+	if err := h.recorder.InTx(ctx, func(ctx context.Context) error {
+		if err := h.repo.Update(ctx, entity); err != nil {
+			return err
+		}
+		return h.recorder.Updated(ctx, audit.EntityMaterial, code.String(), before, snapshot(entity), cmd.UpdatedBy)
+	}); err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+// DeleteCommand represents the delete Material command.
+type DeleteCommand struct {
+	MaterialCode string
+	DeletedBy    string
+}
+
+// DeleteHandler handles the DeleteMaterial command.
+type DeleteHandler struct {
+	repo     material.Repository
+	recorder *appaudit.Recorder
+}
+
+// NewDeleteHandler creates a new delete handler.
+func NewDeleteHandler(repo material.Repository, recorder *appaudit.Recorder) *DeleteHandler {
+	return &DeleteHandler{repo: repo, recorder: recorder}
+}
+
+// Handle executes the delete command.
+func (h *DeleteHandler) Handle(ctx context.Context, cmd DeleteCommand) error {
+	code, err := material.NewMaterialCode(cmd.MaterialCode)
+	if err != nil {
+		return err
+	}
+
+	// Keep the last state for the audit trail
+	entity, err := h.repo.GetByCode(ctx, code)
+	if err != nil {
+		return err
+	}
+
+	if err := h.recorder.InTx(ctx, func(ctx context.Context) error {
+		if err := h.repo.Delete(ctx, code); err != nil {
+			return err
+		}
+		return h.recorder.Deleted(ctx, audit.EntityMaterial, code.String(), snapshot(entity), cmd.DeletedBy)
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// newPrice builds the standard price value object.
+func newPrice(amount float64, currency string) (material.Price, error) {
+	cur, err := material.NewCurrency(currency)
+	if err != nil {
+		return material.Price{}, err
+	}
+	return material.NewPrice(amount, cur)
+}
+
+// checkPurchaseUOM validates a purchase UOM code and checks it is a live UOM in the UOM master.
+func checkPurchaseUOM(ctx context.Context, uomRepo uom.Repository, code string) (uom.Code, error) {
+	uomCode, err := uom.NewUOMCode(code)
+	if err != nil {
+		return "", err
+	}
+
+	// Deleted UOMs still exist but cannot be used for new purchases
+	_, err = uomRepo.GetByCode(ctx, uomCode)
+	if errors.Is(err, uom.ErrNotFound) {
+		return "", material.ErrPurchaseUOMNotFound
+	}
+	if err != nil {
+		return "", err
+	}
+	return uomCode, nil
+}
@@ -0,0 +1,83 @@
+package material
+
+import (
+	"context"
+
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/material"
+)
+
+// GetQuery represents the get Material query.
+type GetQuery struct {
+	MaterialCode string
+}
+
+// GetHandler handles the GetMaterial query.
+type GetHandler struct {
+	repo material.Repository
+}
+
+// NewGetHandler creates a new get handler.
+func NewGetHandler(repo material.Repository) *GetHandler {
+	return &GetHandler{repo: repo}
+}
+
+// Handle executes the get query.
+func (h *GetHandler) Handle(ctx context.Context, query GetQuery) (*material.Material, error) {
+	code, err := material.NewMaterialCode(query.MaterialCode)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.repo.GetByCode(ctx, code)
+}
+
+// ListQuery represents the list Materials query.
+type ListQuery struct {
+	MaterialType *string
+	IsActive     *bool
+	Page         int
+	PageSize     int
+}
+
+// ListResult contains the list result with pagination.
+type ListResult struct {
+	Materials []*material.Material
+	Total     int64
+}
+
+// ListHandler handles the ListMaterials query.
+type ListHandler struct {
+	repo material.Repository
+}
+
+// NewListHandler creates a new list handler.
+func NewListHandler(repo material.Repository) *ListHandler {
+	return &ListHandler{repo: repo}
+}
+
+// Handle executes the list query.
+func (h *ListHandler) Handle(ctx context.Context, query ListQuery) (*ListResult, error) {
+	filter := material.ListFilter{
+		Page:     query.Page,
+		PageSize: query.PageSize,
+		IsActive: query.IsActive,
+	}
+
+	if query.MaterialType != nil {
+		materialType, err := material.NewType(*query.MaterialType)
+		if err != nil {
+			return nil, err
+		}
+		filter.Type = &materialType
+	}
+
+	materials, total, err := h.repo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListResult{
+		Materials: materials,
+		Total:     total,
+	}, nil
+}
@@ -0,0 +1,19 @@
+package material
+
+import (
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/audit"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/material"
+)
+
+// snapshot captures the audited fields of a Material.
+func snapshot(entity *material.Material) audit.Snapshot {
+	return audit.Snapshot{
+		"material_name":     entity.Name(),
+		"material_type":     entity.Type().String(),
+		"purchase_uom_code": entity.PurchaseUOM().String(),
+		"standard_price":    entity.StandardPrice().Amount(),
+		"currency":          entity.StandardPrice().Currency().String(),
+		"description":       entity.Description(),
+		"is_active":         entity.IsActive(),
+	}
+}
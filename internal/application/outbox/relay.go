@@ -0,0 +1,129 @@
+// Package outbox publishes the domain events saved to the transactional outbox.
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/event"
+)
+
+// Publisher delivers an outbox message to an external system. Delivery is
+// at least once: a message may be published again if marking it fails, so
+// consumers should deduplicate on EventID.
+type Publisher interface {
+	Publish(ctx context.Context, msg *event.Message) error
+}
+
+// multi publishes to several publishers in turn.
+type multi []Publisher
+
+// Multi returns a publisher that hands each message to every publisher in
+// order, failing at the first error. The message is then published again to
+// all of them, so publishers placed before a failing one should be
+// idempotent.
+func Multi(publishers ...Publisher) Publisher {
+	return multi(publishers)
+}
+
+// Publish hands msg to every publisher in order.
+func (m multi) Publish(ctx context.Context, msg *event.Message) error {
+	for _, p := range m {
+		if err := p.Publish(ctx, msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RelayConfig tunes the relay loop.
+type RelayConfig struct {
+	// Interval is the pause between polls when the outbox is drained.
+	Interval time.Duration
+	// BatchSize is the number of messages claimed per poll.
+	BatchSize int
+	// Lease is how long claimed messages are hidden from other relays.
+	Lease time.Duration
+}
+
+// Relay moves messages from the outbox to a publisher in sequence order.
+type Relay struct {
+	outbox    event.Outbox
+	publisher Publisher
+	cfg       RelayConfig
+}
+
+// NewRelay creates a new relay.
+func NewRelay(outbox event.Outbox, publisher Publisher, cfg RelayConfig) *Relay {
+	if cfg.Interval <= 0 {
+		cfg.Interval = time.Second
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = 100
+	}
+	if cfg.Lease <= 0 {
+		cfg.Lease = 30 * time.Second
+	}
+	return &Relay{outbox: outbox, publisher: publisher, cfg: cfg}
+}
+
+// Run relays messages until ctx is done. A full batch is followed at once
+// by the next poll; errors are logged and retried after the interval.
+func (r *Relay) Run(ctx context.Context) error {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-timer.C:
+		}
+
+		n, err := r.RelayOnce(ctx)
+		if err != nil && ctx.Err() == nil {
+			log.Error().Err(err).Msg("Outbox relay failed")
+		}
+
+		wait := r.cfg.Interval
+		if err == nil && n == r.cfg.BatchSize {
+			wait = 0
+		}
+		timer.Reset(wait)
+	}
+}
+
+// RelayOnce claims one batch and publishes it, returning the number of
+// messages published. Publishing stops at the first failure so consumers
+// see each aggregate's events in order; the failed message and the rest of
+// the batch are retried once their lease expires.
+func (r *Relay) RelayOnce(ctx context.Context) (int, error) {
+	msgs, err := r.outbox.Claim(ctx, r.cfg.BatchSize, r.cfg.Lease)
+	if err != nil {
+		return 0, err
+	}
+
+	published := make([]int64, 0, len(msgs))
+	var publishErr error
+	for _, msg := range msgs {
+		if publishErr = r.publisher.Publish(ctx, msg); publishErr != nil {
+			if err := r.outbox.MarkFailed(ctx, msg.Sequence, publishErr); err != nil {
+				log.Error().Err(err).Int64("sequence", msg.Sequence).Msg("Failed to record outbox failure")
+			}
+			log.Warn().Err(publishErr).
+				Int64("sequence", msg.Sequence).
+				Str("event_type", msg.Type.String()).
+				Int("attempts", msg.Attempts+1).
+				Msg("Failed to publish outbox event")
+			break
+		}
+		published = append(published, msg.Sequence)
+	}
+
+	if err := r.outbox.MarkPublished(ctx, published...); err != nil {
+		return 0, err
+	}
+	return len(published), publishErr
+}
@@ -0,0 +1,231 @@
+package parameter
+
+import (
+	"context"
+	"errors"
+
+	appaudit "github.com/homindolenern/goapps-costing-v1/internal/application/audit"
+	"github.com/homindolenern/goapps-costing-v1/internal/application/batch"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/audit"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/changerequest"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/parameter"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/uom"
+	pkgerrors "github.com/homindolenern/goapps-costing-v1/pkg/errors"
+)
+
+// BatchUpsertItem is one Parameter of a batch upsert. The Parameter is
+// created if its code is new and updated otherwise.
+type BatchUpsertItem struct {
+	ParameterCode string
+	ParameterName string
+	Category      string
+	DataType      string
+	UOM           *string
+	MinValue      *float64
+	MaxValue      *float64
+	AllowedValues []string
+	IsMandatory   bool
+	Description   *string
+	// IsActive is left unchanged when nil; new Parameters start active.
+	IsActive *bool
+	// Version is checked when set; 0 updates whatever revision is current.
+	Version int
+}
+
+// BatchUpsertCommand represents the batch upsert Parameters command.
+type BatchUpsertCommand struct {
+	Items []BatchUpsertItem
+	// Atomic saves nothing if any item fails; otherwise the valid items are saved.
+	Atomic bool
+	// CreateOnly fails items whose code already exists instead of updating them.
+	CreateOnly bool
+	// DryRun checks every item and reports what would be saved without saving.
+	DryRun     bool
+	UpsertedBy string
+}
+
+// BatchUpsertHandler handles the BatchUpsertParameters command. Updates of
+// Parameters the approval policy covers fail; they go through review with
+// UpdateParameter instead.
+type BatchUpsertHandler struct {
+	repo     parameter.Repository
+	uomRepo  uom.Repository
+	recorder *appaudit.Recorder
+	policy   changerequest.Policy
+}
+
+// NewBatchUpsertHandler creates a new batch upsert handler.
+func NewBatchUpsertHandler(
+	repo parameter.Repository,
+	uomRepo uom.Repository,
+	recorder *appaudit.Recorder,
+	policy changerequest.Policy,
+) *BatchUpsertHandler {
+	return &BatchUpsertHandler{repo: repo, uomRepo: uomRepo, recorder: recorder, policy: policy}
+}
+
+// Handle executes the batch upsert command and returns one result per item, in order.
+func (h *BatchUpsertHandler) Handle(ctx context.Context, cmd BatchUpsertCommand) ([]batch.ItemResult[*parameter.Parameter], error) {
+	results := make([]batch.ItemResult[*parameter.Parameter], len(cmd.Items))
+	entries := make([]parameter.BatchEntry, 0, len(cmd.Items))
+	befores := make([]audit.Snapshot, 0, len(cmd.Items))
+	positions := make([]int, 0, len(cmd.Items))
+	seen := make(map[string]bool, len(cmd.Items))
+
+	// 1. Apply every item to its entity, collecting domain rule failures
+	for i, item := range cmd.Items {
+		results[i] = batch.ItemResult[*parameter.Parameter]{Index: i, Code: item.ParameterCode}
+		if seen[item.ParameterCode] {
+			results[i].Fail(pkgerrors.NewFieldError("parameter_code", batch.ErrDuplicateCode))
+			continue
+		}
+		seen[item.ParameterCode] = true
+
+		entity, before, err := h.apply(ctx, item, cmd.UpsertedBy)
+		if err != nil {
+			results[i].Fail(err)
+			continue
+		}
+		if cmd.CreateOnly && before != nil {
+			results[i].Fail(parameter.ErrAlreadyExists)
+			continue
+		}
+		entries = append(entries, parameter.BatchEntry{Parameter: entity, IsNew: before == nil})
+		befores = append(befores, before)
+		positions = append(positions, i)
+	}
+
+	// 2. An atomic batch with failed items is rejected before touching the database
+	if cmd.Atomic && batch.CountFailed(results) > 0 {
+		for _, i := range positions {
+			results[i].Status = batch.StatusSkipped
+		}
+		return results, nil
+	}
+	if len(entries) == 0 {
+		return results, nil
+	}
+	if cmd.DryRun {
+		for j, i := range positions {
+			results[i].Status = batch.StatusCreated
+			if !entries[j].IsNew {
+				results[i].Status = batch.StatusUpdated
+			}
+			results[i].Entity = entries[j].Parameter
+		}
+		return results, nil
+	}
+
+	// 3. Persist in a single transaction with the audit events
+	err := h.recorder.InTx(ctx, func(ctx context.Context) error {
+		errs, err := h.repo.SaveBatch(ctx, entries, cmd.Atomic)
+		if err != nil {
+			return err
+		}
+		rejected := false
+		for j, i := range positions {
+			if errs[j] != nil {
+				results[i].Fail(errs[j])
+				rejected = cmd.Atomic
+			}
+		}
+
+		// 4. Report and audit the saved items
+		for j, i := range positions {
+			entity := entries[j].Parameter
+			switch {
+			case errs[j] != nil:
+				// Reported above
+			case rejected:
+				results[i].Status = batch.StatusSkipped
+			case entries[j].IsNew:
+				results[i].Status = batch.StatusCreated
+				results[i].Entity = entity
+				err = h.recorder.Created(ctx, audit.EntityParameter, entity.Code().String(), snapshot(entity), cmd.UpsertedBy)
+			default:
+				results[i].Status = batch.StatusUpdated
+				results[i].Entity = entity
+				err = h.recorder.Updated(ctx, audit.EntityParameter, entity.Code().String(), befores[j], snapshot(entity), cmd.UpsertedBy)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// apply builds the new or changed Parameter for an item. before is the
+// snapshot of an existing Parameter and nil for a new one.
+func (h *BatchUpsertHandler) apply(ctx context.Context, item BatchUpsertItem, by string) (*parameter.Parameter, audit.Snapshot, error) {
+	code, err := parameter.NewParameterCode(item.ParameterCode)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	category, err := parameter.NewCategory(item.Category)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dataType, err := parameter.NewDataType(item.DataType)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Deleted codes cannot be reused, so look them up too
+	var before audit.Snapshot
+	entity, err := h.repo.GetByCodeIncludingDeleted(ctx, code)
+	switch {
+	case errors.Is(err, parameter.ErrNotFound):
+		entity, err = parameter.NewParameter(code, item.ParameterName, category, dataType, by)
+		if err != nil {
+			return nil, nil, err
+		}
+	case err != nil:
+		return nil, nil, err
+	case entity.IsDeleted():
+		return nil, nil, parameter.ErrDeleted
+	default:
+		if item.Version != 0 {
+			if err := entity.CheckVersion(item.Version); err != nil {
+				return nil, nil, err
+			}
+		}
+		if h.policy.Requires(entity.Category(), entity.IsMandatory()) || h.policy.Requires(category, item.IsMandatory) {
+			return nil, nil, changerequest.ErrApprovalRequired
+		}
+		before = snapshot(entity)
+		if err := entity.Update(item.ParameterName, category, dataType, by); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if err := setUOM(ctx, h.uomRepo, entity, item.UOM); err != nil {
+		return nil, nil, err
+	}
+	entity.SetDescription(item.Description)
+	entity.SetMandatory(item.IsMandatory)
+
+	if err := entity.SetNumericConstraints(item.MinValue, item.MaxValue); err != nil {
+		return nil, nil, err
+	}
+	if err := entity.SetAllowedValues(item.AllowedValues); err != nil {
+		return nil, nil, err
+	}
+
+	if item.IsActive != nil {
+		if *item.IsActive {
+			entity.Activate()
+		} else {
+			entity.Deactivate()
+		}
+	}
+
+	return entity, before, nil
+}
@@ -0,0 +1,379 @@
+package parameter
+
+import (
+	"context"
+
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/audit"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/changerequest"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/parameter"
+)
+
+// ChangeResult is the outcome of an edit, delete or restore: the changed
+// Parameter, or the change request holding the change for review.
+type ChangeResult struct {
+	Parameter     *parameter.Parameter
+	ChangeRequest *changerequest.ChangeRequest
+}
+
+// EditHandler handles the UpdateParameter, DeleteParameter and
+// RestoreParameter commands under the approval policy. Changes of
+// Parameters the policy covers are held as pending change requests; all
+// others go straight to the UpdateHandler, DeleteHandler or RestoreHandler.
+type EditHandler struct {
+	repo       parameter.Repository
+	changes    changerequest.Repository
+	update     *UpdateHandler
+	softDelete *DeleteHandler
+	restore    *RestoreHandler
+	policy     changerequest.Policy
+}
+
+// NewEditHandler creates a new edit handler.
+func NewEditHandler(
+	repo parameter.Repository,
+	changes changerequest.Repository,
+	update *UpdateHandler,
+	softDelete *DeleteHandler,
+	restore *RestoreHandler,
+	policy changerequest.Policy,
+) *EditHandler {
+	return &EditHandler{
+		repo:       repo,
+		changes:    changes,
+		update:     update,
+		softDelete: softDelete,
+		restore:    restore,
+		policy:     policy,
+	}
+}
+
+// Handle executes the update command, or submits it for approval.
+func (h *EditHandler) Handle(ctx context.Context, cmd UpdateCommand) (*ChangeResult, error) {
+	// 1. Apply the edit without persisting it, rejecting invalid ones now
+	// rather than at review
+	entity, before, err := h.update.prepare(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Edits outside the policy, before and after, are applied at once
+	current, err := h.repo.GetByCode(ctx, entity.Code())
+	if err != nil {
+		return nil, err
+	}
+	if !h.policy.Requires(current.Category(), current.IsMandatory()) &&
+		!h.policy.Requires(entity.Category(), entity.IsMandatory()) {
+		if err := h.update.save(ctx, entity, before, cmd.UpdatedBy); err != nil {
+			return nil, err
+		}
+		return &ChangeResult{Parameter: entity}, nil
+	}
+
+	// 3. Hold the edit with its diff until reviewed
+	return h.submit(ctx, entity, before, changerequest.ActionUpdate, proposal(cmd), cmd.UpdatedBy, cmd.UpdatedBySubject)
+}
+
+// Delete executes the delete command, or submits it for approval.
+func (h *EditHandler) Delete(ctx context.Context, cmd DeleteCommand) (*ChangeResult, error) {
+	// 1. Delete without persisting, rejecting Parameters still in use now
+	entity, before, err := h.softDelete.prepare(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Deletes outside the policy are applied at once
+	if !h.policy.Requires(entity.Category(), entity.IsMandatory()) {
+		if err := h.softDelete.save(ctx, entity, before, cmd.DeletedBy); err != nil {
+			return nil, err
+		}
+		return &ChangeResult{Parameter: entity}, nil
+	}
+
+	// 3. Hold the delete until reviewed
+	return h.submit(ctx, entity, before, changerequest.ActionDelete, changerequest.Proposal{}, cmd.DeletedBy, cmd.DeletedBySubject)
+}
+
+// Restore executes the restore command, or submits it for approval.
+func (h *EditHandler) Restore(ctx context.Context, cmd RestoreCommand) (*ChangeResult, error) {
+	// 1. Restore without persisting, rejecting Parameters not deleted now
+	entity, before, err := h.restore.prepare(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Restores outside the policy are applied at once
+	if !h.policy.Requires(entity.Category(), entity.IsMandatory()) {
+		if err := h.restore.save(ctx, entity, before, cmd.RestoredBy); err != nil {
+			return nil, err
+		}
+		return &ChangeResult{Parameter: entity}, nil
+	}
+
+	// 3. Hold the restore until reviewed
+	return h.submit(ctx, entity, before, changerequest.ActionRestore, changerequest.Proposal{}, cmd.RestoredBy, cmd.RestoredBySubject)
+}
+
+// submit holds a prepared change of entity, with its diff against before,
+// as a pending change request of by, whose token subject is bySubject.
+func (h *EditHandler) submit(
+	ctx context.Context,
+	entity *parameter.Parameter,
+	before audit.Snapshot,
+	action changerequest.Action,
+	proposal changerequest.Proposal,
+	by string,
+	bySubject string,
+) (*ChangeResult, error) {
+	changes, err := audit.Compare(before, snapshot(entity))
+	if err != nil {
+		return nil, err
+	}
+	request, err := changerequest.NewChangeRequest(entity.Code(), entity.Version(), action, proposal, changes, by, bySubject)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.changes.Create(ctx, request); err != nil {
+		return nil, err
+	}
+
+	return &ChangeResult{ChangeRequest: request}, nil
+}
+
+// ReviewChangeCommand represents the ApproveChange and RejectChange commands.
+type ReviewChangeCommand struct {
+	ChangeRequestID   int64
+	Comment           *string
+	ReviewedBy        string
+	ReviewedBySubject string
+}
+
+// ApproveChangeHandler handles the ApproveChange command.
+type ApproveChangeHandler struct {
+	changes    changerequest.Repository
+	update     *UpdateHandler
+	softDelete *DeleteHandler
+	restore    *RestoreHandler
+}
+
+// NewApproveChangeHandler creates a new approve change handler.
+func NewApproveChangeHandler(
+	changes changerequest.Repository,
+	update *UpdateHandler,
+	softDelete *DeleteHandler,
+	restore *RestoreHandler,
+) *ApproveChangeHandler {
+	return &ApproveChangeHandler{changes: changes, update: update, softDelete: softDelete, restore: restore}
+}
+
+// Handle approves a pending change request and applies it through the
+// UpdateHandler, DeleteHandler or RestoreHandler, as its author and at the
+// version it was made against. A request whose Parameter changed since is
+// not applied and stays pending.
+func (h *ApproveChangeHandler) Handle(ctx context.Context, cmd ReviewChangeCommand) (*ChangeResult, error) {
+	// 1. Get the request and enforce four eyes
+	request, err := h.changes.GetByID(ctx, cmd.ChangeRequestID)
+	if err != nil {
+		return nil, err
+	}
+	if err := request.Approve(cmd.ReviewedBy, cmd.ReviewedBySubject, cmd.Comment); err != nil {
+		return nil, err
+	}
+
+	// 2. Claim the request and apply the change in one transaction: a
+	// concurrent review finds it no longer pending, and a failed apply
+	// leaves it pending
+	var entity *parameter.Parameter
+	if err := h.update.recorder.InTx(ctx, func(ctx context.Context) error {
+		if err := h.changes.Review(ctx, request); err != nil {
+			return err
+		}
+		entity, err = h.apply(ctx, request)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return &ChangeResult{Parameter: entity, ChangeRequest: request}, nil
+}
+
+// apply performs the change held by request.
+func (h *ApproveChangeHandler) apply(ctx context.Context, request *changerequest.ChangeRequest) (*parameter.Parameter, error) {
+	var (
+		entity *parameter.Parameter
+		before audit.Snapshot
+		err    error
+		save   func(ctx context.Context, entity *parameter.Parameter, before audit.Snapshot, by string) error
+	)
+	switch request.Action() {
+	case changerequest.ActionDelete:
+		entity, before, err = h.softDelete.prepare(ctx, DeleteCommand{
+			ParameterCode:    request.ParameterCode().String(),
+			DeletedBy:        request.RequestedBy(),
+			DeletedBySubject: request.RequestedBySubject(),
+		})
+		save = h.softDelete.save
+	case changerequest.ActionRestore:
+		entity, before, err = h.restore.prepare(ctx, RestoreCommand{
+			ParameterCode:     request.ParameterCode().String(),
+			RestoredBy:        request.RequestedBy(),
+			RestoredBySubject: request.RequestedBySubject(),
+		})
+		save = h.restore.save
+	default:
+		return h.update.Handle(ctx, updateCommand(request))
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := entity.CheckVersion(request.BaseVersion()); err != nil {
+		return nil, err
+	}
+	if err := save(ctx, entity, before, request.RequestedBy()); err != nil {
+		return nil, err
+	}
+	return entity, nil
+}
+
+// RejectChangeHandler handles the RejectChange command.
+type RejectChangeHandler struct {
+	changes changerequest.Repository
+}
+
+// NewRejectChangeHandler creates a new reject change handler.
+func NewRejectChangeHandler(changes changerequest.Repository) *RejectChangeHandler {
+	return &RejectChangeHandler{changes: changes}
+}
+
+// Handle rejects a pending change request, leaving its Parameter as is.
+func (h *RejectChangeHandler) Handle(ctx context.Context, cmd ReviewChangeCommand) (*changerequest.ChangeRequest, error) {
+	request, err := h.changes.GetByID(ctx, cmd.ChangeRequestID)
+	if err != nil {
+		return nil, err
+	}
+	if err := request.Reject(cmd.ReviewedBy, cmd.ReviewedBySubject, cmd.Comment); err != nil {
+		return nil, err
+	}
+
+	if err := h.changes.Review(ctx, request); err != nil {
+		return nil, err
+	}
+
+	return request, nil
+}
+
+// GetChangeQuery represents the get change request query.
+type GetChangeQuery struct {
+	ChangeRequestID int64
+}
+
+// GetChangeHandler handles the GetParameterChangeRequest query.
+type GetChangeHandler struct {
+	changes changerequest.Repository
+}
+
+// NewGetChangeHandler creates a new get change handler.
+func NewGetChangeHandler(changes changerequest.Repository) *GetChangeHandler {
+	return &GetChangeHandler{changes: changes}
+}
+
+// Handle executes the get change query.
+func (h *GetChangeHandler) Handle(ctx context.Context, query GetChangeQuery) (*changerequest.ChangeRequest, error) {
+	return h.changes.GetByID(ctx, query.ChangeRequestID)
+}
+
+// ListChangesQuery represents the list change requests query.
+type ListChangesQuery struct {
+	ParameterCode *string
+	Status        *string
+	RequestedBy   *string
+	Page          int
+	PageSize      int
+}
+
+// ListChangesResult contains the list result with pagination.
+type ListChangesResult struct {
+	ChangeRequests []*changerequest.ChangeRequest
+	Total          int64
+}
+
+// ListChangesHandler handles the ListParameterChangeRequests query.
+type ListChangesHandler struct {
+	changes changerequest.Repository
+}
+
+// NewListChangesHandler creates a new list changes handler.
+func NewListChangesHandler(changes changerequest.Repository) *ListChangesHandler {
+	return &ListChangesHandler{changes: changes}
+}
+
+// Handle executes the list changes query.
+func (h *ListChangesHandler) Handle(ctx context.Context, query ListChangesQuery) (*ListChangesResult, error) {
+	filter := changerequest.ListFilter{
+		RequestedBy: query.RequestedBy,
+		Page:        query.Page,
+		PageSize:    query.PageSize,
+	}
+
+	if query.ParameterCode != nil {
+		code, err := parameter.NewParameterCode(*query.ParameterCode)
+		if err != nil {
+			return nil, err
+		}
+		filter.ParameterCode = &code
+	}
+	if query.Status != nil {
+		status, err := changerequest.NewStatus(*query.Status)
+		if err != nil {
+			return nil, err
+		}
+		filter.Status = &status
+	}
+
+	requests, total, err := h.changes.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListChangesResult{
+		ChangeRequests: requests,
+		Total:          total,
+	}, nil
+}
+
+// proposal keeps the fields of cmd a change request applies later.
+func proposal(cmd UpdateCommand) changerequest.Proposal {
+	return changerequest.Proposal{
+		ParameterName: cmd.ParameterName,
+		Category:      cmd.Category,
+		DataType:      cmd.DataType,
+		UOM:           cmd.UOM,
+		MinValue:      cmd.MinValue,
+		MaxValue:      cmd.MaxValue,
+		AllowedValues: cmd.AllowedValues,
+		IsMandatory:   cmd.IsMandatory,
+		Description:   cmd.Description,
+		IsActive:      cmd.IsActive,
+	}
+}
+
+// updateCommand rebuilds the UpdateParameter command of request.
+func updateCommand(request *changerequest.ChangeRequest) UpdateCommand {
+	p := request.Proposal()
+	return UpdateCommand{
+		ParameterCode:    request.ParameterCode().String(),
+		ParameterName:    p.ParameterName,
+		Category:         p.Category,
+		DataType:         p.DataType,
+		UOM:              p.UOM,
+		MinValue:         p.MinValue,
+		MaxValue:         p.MaxValue,
+		AllowedValues:    p.AllowedValues,
+		IsMandatory:      p.IsMandatory,
+		Description:      p.Description,
+		IsActive:         p.IsActive,
+		Version:          request.BaseVersion(),
+		UpdatedBy:        request.RequestedBy(),
+		UpdatedBySubject: request.RequestedBySubject(),
+	}
+}
@@ -2,8 +2,13 @@ package parameter
 
 import (
 	"context"
+	"errors"
 
+	appaudit "github.com/homindolenern/goapps-costing-v1/internal/application/audit"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/audit"
 	"github.com/homindolenern/goapps-costing-v1/internal/domain/parameter"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/uom"
+	pkgerrors "github.com/homindolenern/goapps-costing-v1/pkg/errors"
 )
 
 // CreateCommand represents the create Parameter command.
@@ -23,12 +28,14 @@ type CreateCommand struct {
 
 // CreateHandler handles the CreateParameter command.
 type CreateHandler struct {
-	repo parameter.Repository
+	repo     parameter.Repository
+	uomRepo  uom.Repository
+	recorder *appaudit.Recorder
 }
 
 // NewCreateHandler creates a new create handler.
-func NewCreateHandler(repo parameter.Repository) *CreateHandler {
-	return &CreateHandler{repo: repo}
+func NewCreateHandler(repo parameter.Repository, uomRepo uom.Repository, recorder *appaudit.Recorder) *CreateHandler {
+	return &CreateHandler{repo: repo, uomRepo: uomRepo, recorder: recorder}
 }
 
 // Handle executes the create command.
@@ -65,7 +72,9 @@ func (h *CreateHandler) Handle(ctx context.Context, cmd CreateCommand) (*paramet
 	}
 
 	// 4. Set optional fields
-	entity.SetUOM(cmd.UOM)
+	if err := setUOM(ctx, h.uomRepo, entity, cmd.UOM); err != nil {
+		return nil, err
+	}
 	entity.SetDescription(cmd.Description)
 	entity.SetMandatory(cmd.IsMandatory)
 
@@ -76,8 +85,13 @@ func (h *CreateHandler) Handle(ctx context.Context, cmd CreateCommand) (*paramet
 		return nil, err
 	}
 
-	// 5. Persist
-	if err := h.repo.Create(ctx, entity); err != nil {
+	// 5. Persist with its audit event
+	if err := h.recorder.InTx(ctx, func(ctx context.Context) error {
+		if err := h.repo.Create(ctx, entity); err != nil {
+			return err
+		}
+		return h.recorder.Created(ctx, audit.EntityParameter, code.String(), snapshot(entity), cmd.CreatedBy)
+	}); err != nil {
 		return nil, err
 	}
 
@@ -86,68 +100,103 @@ func (h *CreateHandler) Handle(ctx context.Context, cmd CreateCommand) (*paramet
 
 // UpdateCommand represents the update Parameter command.
 type UpdateCommand struct {
-	ParameterCode string
-	ParameterName string
-	Category      string
-	DataType      string
-	UOM           *string
-	MinValue      *float64
-	MaxValue      *float64
-	AllowedValues []string
-	IsMandatory   bool
-	Description   *string
-	IsActive      bool
-	UpdatedBy     string
+	ParameterCode    string
+	ParameterName    string
+	Category         string
+	DataType         string
+	UOM              *string
+	MinValue         *float64
+	MaxValue         *float64
+	AllowedValues    []string
+	IsMandatory      bool
+	Description      *string
+	IsActive         bool
+	Version          int
+	UpdatedBy        string
+	UpdatedBySubject string
 }
 
 // UpdateHandler handles the UpdateParameter command.
 type UpdateHandler struct {
-	repo parameter.Repository
+	repo     parameter.Repository
+	uomRepo  uom.Repository
+	recorder *appaudit.Recorder
 }
 
 // NewUpdateHandler creates a new update handler.
-func NewUpdateHandler(repo parameter.Repository) *UpdateHandler {
-	return &UpdateHandler{repo: repo}
+func NewUpdateHandler(repo parameter.Repository, uomRepo uom.Repository, recorder *appaudit.Recorder) *UpdateHandler {
+	return &UpdateHandler{repo: repo, uomRepo: uomRepo, recorder: recorder}
 }
 
 // Handle executes the update command.
 func (h *UpdateHandler) Handle(ctx context.Context, cmd UpdateCommand) (*parameter.Parameter, error) {
+	entity, before, err := h.prepare(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.save(ctx, entity, before, cmd.UpdatedBy); err != nil {
+		return nil, err
+	}
+	return entity, nil
+}
+
+// save persists a prepared entity; the repository closes the version in
+// force and records the updated definition as the next one.
+func (h *UpdateHandler) save(ctx context.Context, entity *parameter.Parameter, before audit.Snapshot, by string) error {
+	// 4. Persist with its audit event
+	return h.recorder.InTx(ctx, func(ctx context.Context) error {
+		if err := h.repo.Update(ctx, entity); err != nil {
+			return err
+		}
+		return h.recorder.Updated(ctx, audit.EntityParameter, entity.Code().String(), before, snapshot(entity), by)
+	})
+}
+
+// prepare applies cmd to the stored Parameter without persisting it,
+// returning the updated entity and the snapshot taken before.
+func (h *UpdateHandler) prepare(ctx context.Context, cmd UpdateCommand) (*parameter.Parameter, audit.Snapshot, error) {
 	// 1. Create value objects
 	code, err := parameter.NewParameterCode(cmd.ParameterCode)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	category, err := parameter.NewCategory(cmd.Category)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	dataType, err := parameter.NewDataType(cmd.DataType)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	// 2. Get existing entity
+	// 2. Get existing entity and reject changes based on a stale read
 	entity, err := h.repo.GetByCode(ctx, code)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+	if err := entity.CheckVersion(cmd.Version); err != nil {
+		return nil, nil, err
 	}
+	before := snapshot(entity)
 
 	// 3. Update entity
 	if err := entity.Update(cmd.ParameterName, category, dataType, cmd.UpdatedBy); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	entity.SetUOM(cmd.UOM)
+	if err := setUOM(ctx, h.uomRepo, entity, cmd.UOM); err != nil {
+		return nil, nil, err
+	}
 	entity.SetDescription(cmd.Description)
 	entity.SetMandatory(cmd.IsMandatory)
 
 	if err := entity.SetNumericConstraints(cmd.MinValue, cmd.MaxValue); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	if err := entity.SetAllowedValues(cmd.AllowedValues); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	if cmd.IsActive {
@@ -156,35 +205,158 @@ func (h *UpdateHandler) Handle(ctx context.Context, cmd UpdateCommand) (*paramet
 		entity.Deactivate()
 	}
 
-	// 4. Persist
-	if err := h.repo.Update(ctx, entity); err != nil {
-		return nil, err
-	}
-
-	return entity, nil
+	return entity, before, nil
 }
 
 // DeleteCommand represents the delete Parameter command.
 type DeleteCommand struct {
-	ParameterCode string
+	ParameterCode    string
+	DeletedBy        string
+	DeletedBySubject string
 }
 
 // DeleteHandler handles the DeleteParameter command.
 type DeleteHandler struct {
-	repo parameter.Repository
+	repo     parameter.Repository
+	recorder *appaudit.Recorder
 }
 
 // NewDeleteHandler creates a new delete handler.
-func NewDeleteHandler(repo parameter.Repository) *DeleteHandler {
-	return &DeleteHandler{repo: repo}
+func NewDeleteHandler(repo parameter.Repository, recorder *appaudit.Recorder) *DeleteHandler {
+	return &DeleteHandler{repo: repo, recorder: recorder}
 }
 
-// Handle executes the delete command.
+// Handle executes the delete command. The parameter is soft-deleted.
 func (h *DeleteHandler) Handle(ctx context.Context, cmd DeleteCommand) error {
-	code, err := parameter.NewParameterCode(cmd.ParameterCode)
+	entity, before, err := h.prepare(ctx, cmd)
 	if err != nil {
 		return err
 	}
+	return h.save(ctx, entity, before, cmd.DeletedBy)
+}
+
+// prepare soft-deletes the stored Parameter without persisting it,
+// returning the deleted entity and the snapshot taken before.
+func (h *DeleteHandler) prepare(ctx context.Context, cmd DeleteCommand) (*parameter.Parameter, audit.Snapshot, error) {
+	code, err := parameter.NewParameterCode(cmd.ParameterCode)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Keep the last state for the audit trail
+	entity, err := h.repo.GetByCode(ctx, code)
+	if err != nil {
+		return nil, nil, err
+	}
+	before := snapshot(entity)
+
+	// Values and machine type templates must not point at a deleted parameter
+	inUse, err := h.repo.IsInUse(ctx, code)
+	if err != nil {
+		return nil, nil, err
+	}
+	if inUse {
+		return nil, nil, parameter.ErrInUse
+	}
+
+	entity.SoftDelete(cmd.DeletedBy)
+	return entity, before, nil
+}
+
+// save persists a prepared delete with its audit event.
+func (h *DeleteHandler) save(ctx context.Context, entity *parameter.Parameter, before audit.Snapshot, by string) error {
+	return h.recorder.InTx(ctx, func(ctx context.Context) error {
+		if err := h.repo.Update(ctx, entity); err != nil {
+			return err
+		}
+		return h.recorder.Deleted(ctx, audit.EntityParameter, entity.Code().String(), before, by)
+	})
+}
+
+// RestoreCommand represents the restore Parameter command.
+type RestoreCommand struct {
+	ParameterCode     string
+	RestoredBy        string
+	RestoredBySubject string
+}
+
+// RestoreHandler handles the RestoreParameter command.
+type RestoreHandler struct {
+	repo     parameter.Repository
+	recorder *appaudit.Recorder
+}
+
+// NewRestoreHandler creates a new restore handler.
+func NewRestoreHandler(repo parameter.Repository, recorder *appaudit.Recorder) *RestoreHandler {
+	return &RestoreHandler{repo: repo, recorder: recorder}
+}
+
+// Handle executes the restore command.
+func (h *RestoreHandler) Handle(ctx context.Context, cmd RestoreCommand) (*parameter.Parameter, error) {
+	entity, before, err := h.prepare(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.save(ctx, entity, before, cmd.RestoredBy); err != nil {
+		return nil, err
+	}
+	return entity, nil
+}
+
+// prepare restores the stored Parameter without persisting it, returning
+// the restored entity and the snapshot taken before.
+func (h *RestoreHandler) prepare(ctx context.Context, cmd RestoreCommand) (*parameter.Parameter, audit.Snapshot, error) {
+	code, err := parameter.NewParameterCode(cmd.ParameterCode)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	entity, err := h.repo.GetByCodeIncludingDeleted(ctx, code)
+	if err != nil {
+		return nil, nil, err
+	}
+	before := snapshot(entity)
+
+	if err := entity.Restore(cmd.RestoredBy); err != nil {
+		return nil, nil, err
+	}
+	return entity, before, nil
+}
+
+// save persists a prepared restore with its audit event.
+func (h *RestoreHandler) save(ctx context.Context, entity *parameter.Parameter, before audit.Snapshot, by string) error {
+	return h.recorder.InTx(ctx, func(ctx context.Context) error {
+		if err := h.repo.Update(ctx, entity); err != nil {
+			return err
+		}
+		return h.recorder.Updated(ctx, audit.EntityParameter, entity.Code().String(), before, snapshot(entity), by)
+	})
+}
 
-	return h.repo.Delete(ctx, code)
+// setUOM sets a parameter UOM after checking it is a live UOM in the UOM master.
+// An unchanged UOM is kept even if it was deleted since. Failures are reported
+// as a validation error on the uom field.
+func setUOM(ctx context.Context, uomRepo uom.Repository, entity *parameter.Parameter, code *string) error {
+	if code != nil && *code == "" {
+		code = nil
+	}
+	unchanged := code != nil && entity.UOM() != nil && *entity.UOM() == *code
+	if err := entity.SetUOM(code); err != nil {
+		return pkgerrors.NewFieldError("uom", err)
+	}
+	if code == nil || unchanged {
+		return nil
+	}
+
+	uomCode, err := uom.NewUOMCode(*code)
+	if err != nil {
+		return pkgerrors.NewFieldError("uom", err)
+	}
+
+	// Deleted UOMs still exist but cannot be used for new references
+	_, err = uomRepo.GetByCode(ctx, uomCode)
+	if errors.Is(err, uom.ErrNotFound) {
+		return pkgerrors.NewFieldError("uom", parameter.ErrUOMNotFound)
+	}
+	return err
 }
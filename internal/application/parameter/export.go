@@ -0,0 +1,34 @@
+package parameter
+
+import (
+	"context"
+
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/parameter"
+)
+
+// ExportQuery represents the export Parameters query. It takes the filters of
+// ListQuery and covers every matching Parameter rather than one page.
+type ExportQuery struct {
+	Filter
+}
+
+// ExportHandler handles the ExportParameters query.
+type ExportHandler struct {
+	repo parameter.Repository
+}
+
+// NewExportHandler creates a new export handler.
+func NewExportHandler(repo parameter.Repository) *ExportHandler {
+	return &ExportHandler{repo: repo}
+}
+
+// Handle calls fn for every matching Parameter, in the requested order,
+// without loading them all at once.
+func (h *ExportHandler) Handle(ctx context.Context, query ExportQuery, fn func(*parameter.Parameter) error) error {
+	filter, err := query.Filter.toListFilter()
+	if err != nil {
+		return err
+	}
+
+	return h.repo.ForEach(ctx, filter, fn)
+}
@@ -2,13 +2,19 @@ package parameter
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/homindolenern/goapps-costing-v1/internal/domain/parameter"
+	"github.com/homindolenern/goapps-costing-v1/pkg/pagetoken"
 )
 
-// GetQuery represents the get Parameter query.
+// GetQuery represents the get Parameter query. AsOf, an RFC 3339 timestamp,
+// selects the definition in force at that time instead of the current one.
 type GetQuery struct {
 	ParameterCode string
+	AsOf          *string
 }
 
 // GetHandler handles the GetParameter query.
@@ -28,47 +34,84 @@ func (h *GetHandler) Handle(ctx context.Context, query GetQuery) (*parameter.Par
 		return nil, err
 	}
 
-	return h.repo.GetByCode(ctx, code)
+	if query.AsOf == nil {
+		return h.repo.GetByCode(ctx, code)
+	}
+
+	asOf, err := parameter.NewAsOf(*query.AsOf)
+	if err != nil {
+		return nil, err
+	}
+	version, err := h.repo.GetVersionAsOf(ctx, code, asOf)
+	if err != nil {
+		return nil, err
+	}
+	return version.Parameter, nil
+}
+
+// Filter holds the filters shared by ListQuery and ExportQuery. The date
+// ranges take RFC 3339 timestamps and are half-open: [From, To).
+type Filter struct {
+	Category       *string
+	IsActive       *bool
+	IncludeDeleted bool
+	Search         string
+	DataType       *string
+	UOM            *string
+	IsMandatory    *bool
+	CreatedBy      *string
+	CreatedFrom    *string
+	CreatedTo      *string
+	UpdatedFrom    *string
+	UpdatedTo      *string
+	SortBy         string
+	SortDesc       bool
 }
 
-// ListQuery represents the list Parameters query.
+// ListQuery represents the list Parameters query. PageToken, taken from a
+// previous result with the same Filter, selects the following page in place
+// of Page.
 type ListQuery struct {
-	Category *string
-	IsActive *bool
-	Page     int
-	PageSize int
+	Filter
+	Page      int
+	PageSize  int
+	PageToken string
 }
 
 // ListResult contains the list result with pagination.
 type ListResult struct {
-	Parameters []*parameter.Parameter
-	Total      int64
+	Parameters    []*parameter.Parameter
+	Total         int64
+	Page          int
+	NextPageToken string // empty on the last page
 }
 
 // ListHandler handles the ListParameters query.
 type ListHandler struct {
-	repo parameter.Repository
+	repo   parameter.Repository
+	tokens *pagetoken.Codec
 }
 
 // NewListHandler creates a new list handler.
-func NewListHandler(repo parameter.Repository) *ListHandler {
-	return &ListHandler{repo: repo}
+func NewListHandler(repo parameter.Repository, tokens *pagetoken.Codec) *ListHandler {
+	return &ListHandler{repo: repo, tokens: tokens}
 }
 
 // Handle executes the list query.
 func (h *ListHandler) Handle(ctx context.Context, query ListQuery) (*ListResult, error) {
-	filter := parameter.ListFilter{
-		Page:     query.Page,
-		PageSize: query.PageSize,
-		IsActive: query.IsActive,
+	filter, err := query.Filter.toListFilter()
+	if err != nil {
+		return nil, err
 	}
+	filter.Page, filter.PageSize = query.Page, query.PageSize
 
-	if query.Category != nil {
-		cat, err := parameter.NewCategory(*query.Category)
+	if query.PageToken != "" {
+		cursor, err := h.tokens.Decode(query.PageToken, query.Filter)
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("%w: %w", parameter.ErrInvalidPageToken, err)
 		}
-		filter.Category = &cat
+		filter.Page = cursor.Page
+		filter.After = &parameter.Cursor{SortValue: cursor.SortValue, Code: parameter.Code(cursor.Key)}
 	}
 
 	params, total, err := h.repo.List(ctx, filter)
@@ -76,8 +119,82 @@ func (h *ListHandler) Handle(ctx context.Context, query ListQuery) (*ListResult,
 		return nil, err
 	}
 
-	return &ListResult{
+	result := &ListResult{
 		Parameters: params,
 		Total:      total,
-	}, nil
+		Page:       max(filter.Page, 1),
+	}
+	if filter.HasNext(len(params), total) {
+		last := params[len(params)-1]
+		result.NextPageToken, err = h.tokens.Encode(pagetoken.Cursor{
+			SortValue: filter.SortBy.Value(last),
+			Key:       last.Code().String(),
+			Page:      result.Page + 1,
+		}, query.Filter)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// toListFilter validates the filter and converts it for the repository.
+func (f Filter) toListFilter() (parameter.ListFilter, error) {
+	filter := parameter.ListFilter{
+		IsActive:       f.IsActive,
+		IncludeDeleted: f.IncludeDeleted,
+		Search:         strings.TrimSpace(f.Search),
+		UOM:            f.UOM,
+		IsMandatory:    f.IsMandatory,
+		CreatedBy:      f.CreatedBy,
+		SortDesc:       f.SortDesc,
+	}
+
+	if f.Category != nil {
+		cat, err := parameter.NewCategory(*f.Category)
+		if err != nil {
+			return parameter.ListFilter{}, err
+		}
+		filter.Category = &cat
+	}
+	if f.DataType != nil {
+		dataType, err := parameter.NewDataType(*f.DataType)
+		if err != nil {
+			return parameter.ListFilter{}, err
+		}
+		filter.DataType = &dataType
+	}
+
+	sortBy, err := parameter.NewSortField(f.SortBy)
+	if err != nil {
+		return parameter.ListFilter{}, err
+	}
+	filter.SortBy = sortBy
+
+	if filter.CreatedFrom, filter.CreatedTo, err = parseTimeRange(f.CreatedFrom, f.CreatedTo); err != nil {
+		return parameter.ListFilter{}, err
+	}
+	if filter.UpdatedFrom, filter.UpdatedTo, err = parseTimeRange(f.UpdatedFrom, f.UpdatedTo); err != nil {
+		return parameter.ListFilter{}, err
+	}
+	return filter, nil
+}
+
+// parseTimeRange parses optional RFC 3339 bounds; from must be before to.
+func parseTimeRange(from, to *string) (*time.Time, *time.Time, error) {
+	var bounds [2]*time.Time
+	for i, s := range []*string{from, to} {
+		if s == nil || *s == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, *s)
+		if err != nil {
+			return nil, nil, parameter.ErrInvalidTimeRange
+		}
+		bounds[i] = &t
+	}
+	if bounds[0] != nil && bounds[1] != nil && !bounds[0].Before(*bounds[1]) {
+		return nil, nil, parameter.ErrInvalidTimeRange
+	}
+	return bounds[0], bounds[1], nil
 }
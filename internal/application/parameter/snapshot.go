@@ -0,0 +1,23 @@
+package parameter
+
+import (
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/audit"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/parameter"
+)
+
+// snapshot captures the audited fields of a Parameter.
+func snapshot(entity *parameter.Parameter) audit.Snapshot {
+	return audit.Snapshot{
+		"parameter_name": entity.Name(),
+		"category":       entity.Category().String(),
+		"data_type":      entity.DataType().String(),
+		"uom":            entity.UOM(),
+		"min_value":      entity.MinValue(),
+		"max_value":      entity.MaxValue(),
+		"allowed_values": entity.AllowedValues(),
+		"is_mandatory":   entity.IsMandatory(),
+		"description":    entity.Description(),
+		"is_active":      entity.IsActive(),
+		"is_deleted":     entity.IsDeleted(),
+	}
+}
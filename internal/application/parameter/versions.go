@@ -0,0 +1,56 @@
+package parameter
+
+import (
+	"context"
+
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/parameter"
+)
+
+// ListVersionsQuery represents the list Parameter versions query.
+type ListVersionsQuery struct {
+	ParameterCode string
+	Page          int
+	PageSize      int
+}
+
+// ListVersionsResult contains the list result with pagination.
+type ListVersionsResult struct {
+	Versions []*parameter.Version
+	Total    int64
+}
+
+// ListVersionsHandler handles the ListParameterVersions query.
+type ListVersionsHandler struct {
+	repo parameter.Repository
+}
+
+// NewListVersionsHandler creates a new list versions handler.
+func NewListVersionsHandler(repo parameter.Repository) *ListVersionsHandler {
+	return &ListVersionsHandler{repo: repo}
+}
+
+// Handle executes the list versions query. Deleted Parameters keep their
+// history; a Parameter without versions does not exist.
+func (h *ListVersionsHandler) Handle(ctx context.Context, query ListVersionsQuery) (*ListVersionsResult, error) {
+	code, err := parameter.NewParameterCode(query.ParameterCode)
+	if err != nil {
+		return nil, err
+	}
+
+	versions, total, err := h.repo.ListVersions(ctx, parameter.VersionFilter{
+		Code:     code,
+		Page:     query.Page,
+		PageSize: query.PageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if total == 0 {
+		return nil, parameter.ErrNotFound
+	}
+
+	return &ListVersionsResult{
+		Versions: versions,
+		Total:    total,
+	}, nil
+}
@@ -0,0 +1,251 @@
+package parametervalue
+
+import (
+	"context"
+	"time"
+
+	appaudit "github.com/homindolenern/goapps-costing-v1/internal/application/audit"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/audit"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/parameter"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/parametervalue"
+)
+
+// CreateCommand represents the create ParameterValue command.
+type CreateCommand struct {
+	SubjectType   string
+	SubjectCode   string
+	ParameterCode string
+	Value         string
+	EffectiveFrom string
+	EffectiveTo   *string
+	Remarks       *string
+	CreatedBy     string
+}
+
+// CreateHandler handles the CreateParameterValue command.
+type CreateHandler struct {
+	repo      parametervalue.Repository
+	paramRepo parameter.Repository
+	recorder  *appaudit.Recorder
+}
+
+// NewCreateHandler creates a new create handler.
+func NewCreateHandler(
+	repo parametervalue.Repository,
+	paramRepo parameter.Repository,
+	recorder *appaudit.Recorder,
+) *CreateHandler {
+	return &CreateHandler{repo: repo, paramRepo: paramRepo, recorder: recorder}
+}
+
+// Handle executes the create command.
+func (h *CreateHandler) Handle(ctx context.Context, cmd CreateCommand) (*parametervalue.ParameterValue, error) {
+	// 1. Create and validate value objects
+	subjectType, err := parametervalue.NewSubjectType(cmd.SubjectType)
+	if err != nil {
+		return nil, err
+	}
+
+	subjectCode, err := parametervalue.NewSubjectCode(cmd.SubjectCode)
+	if err != nil {
+		return nil, err
+	}
+
+	paramCode, err := parameter.NewParameterCode(cmd.ParameterCode)
+	if err != nil {
+		return nil, err
+	}
+
+	period, err := newPeriod(cmd.EffectiveFrom, cmd.EffectiveTo)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Load the parameter definition the value is validated against
+	definition, err := h.paramRepo.GetByCode(ctx, paramCode)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Create domain entity
+	entity, err := parametervalue.NewParameterValue(definition, subjectType, subjectCode, cmd.Value, period, cmd.CreatedBy)
+	if err != nil {
+		return nil, err
+	}
+	entity.SetRemarks(cmd.Remarks)
+
+	if err := h.recorder.InTx(ctx, func(ctx context.Context) error {
+		// 4. Place it among the existing periods, closing the current value
+		// if superseded, with the periods locked until the write commits
+		if err := h.repo.LockSubjectParameter(ctx, subjectType, subjectCode, paramCode); err != nil {
+			return err
+		}
+		existing, err := h.repo.ListBySubjectParameter(ctx, subjectType, subjectCode, paramCode)
+		if err != nil {
+			return err
+		}
+		before := make(map[int64]audit.Snapshot, len(existing))
+		for _, e := range existing {
+			before[e.ID()] = snapshot(e)
+		}
+		superseded, err := parametervalue.Schedule(entity, existing, cmd.CreatedBy)
+		if err != nil {
+			return err
+		}
+
+		// 5. Persist with the audit events
+		if superseded == nil {
+			if err := h.repo.Create(ctx, entity); err != nil {
+				return err
+			}
+			return h.recorder.Created(ctx, audit.EntityParameterValue, auditCode(entity), snapshot(entity), cmd.CreatedBy)
+		}
+
+		if err := h.repo.Supersede(ctx, superseded, entity); err != nil {
+			return err
+		}
+		if err := h.recorder.Updated(ctx, audit.EntityParameterValue, auditCode(superseded),
+			before[superseded.ID()], snapshot(superseded), cmd.CreatedBy); err != nil {
+			return err
+		}
+		return h.recorder.Created(ctx, audit.EntityParameterValue, auditCode(entity), snapshot(entity), cmd.CreatedBy)
+	}); err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+// UpdateCommand represents the update ParameterValue command.
+type UpdateCommand struct {
+	ID            int64
+	Value         string
+	EffectiveFrom string
+	EffectiveTo   *string
+	Remarks       *string
+	UpdatedBy     string
+}
+
+// UpdateHandler handles the UpdateParameterValue command.
+type UpdateHandler struct {
+	repo      parametervalue.Repository
+	paramRepo parameter.Repository
+	recorder  *appaudit.Recorder
+}
+
+// NewUpdateHandler creates a new update handler.
+func NewUpdateHandler(
+	repo parametervalue.Repository,
+	paramRepo parameter.Repository,
+	recorder *appaudit.Recorder,
+) *UpdateHandler {
+	return &UpdateHandler{repo: repo, paramRepo: paramRepo, recorder: recorder}
+}
+
+// Handle executes the update command.
+func (h *UpdateHandler) Handle(ctx context.Context, cmd UpdateCommand) (*parametervalue.ParameterValue, error) {
+	// 1. Create value objects
+	period, err := newPeriod(cmd.EffectiveFrom, cmd.EffectiveTo)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Get existing entity and its parameter definition
+	entity, err := h.repo.GetByID(ctx, cmd.ID)
+	if err != nil {
+		return nil, err
+	}
+	before := snapshot(entity)
+
+	definition, err := h.paramRepo.GetByCode(ctx, entity.ParameterCode())
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Update entity
+	if err := entity.Update(definition, cmd.Value, period, cmd.UpdatedBy); err != nil {
+		return nil, err
+	}
+	entity.SetRemarks(cmd.Remarks)
+
+	if err := h.recorder.InTx(ctx, func(ctx context.Context) error {
+		// 4. The new period must not overlap the other periods, which stay
+		// locked until the write commits
+		if err := h.repo.LockSubjectParameter(ctx, entity.SubjectType(), entity.SubjectCode(), entity.ParameterCode()); err != nil {
+			return err
+		}
+		existing, err := h.repo.ListBySubjectParameter(ctx, entity.SubjectType(), entity.SubjectCode(), entity.ParameterCode())
+		if err != nil {
+			return err
+		}
+		if _, err := parametervalue.Schedule(entity, existing, cmd.UpdatedBy); err != nil {
+			return err
+		}
+
+		// 5. Persist with its audit event
+		if err := h.repo.Update(ctx, entity); err != nil {
+			return err
+		}
+		return h.recorder.Updated(ctx, audit.EntityParameterValue, auditCode(entity), before, snapshot(entity), cmd.UpdatedBy)
+	}); err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+// DeleteCommand represents the delete ParameterValue command.
+type DeleteCommand struct {
+	ID        int64
+	DeletedBy string
+}
+
+// DeleteHandler handles the DeleteParameterValue command.
+type DeleteHandler struct {
+	repo     parametervalue.Repository
+	recorder *appaudit.Recorder
+}
+
+// NewDeleteHandler creates a new delete handler.
+func NewDeleteHandler(repo parametervalue.Repository, recorder *appaudit.Recorder) *DeleteHandler {
+	return &DeleteHandler{repo: repo, recorder: recorder}
+}
+
+// Handle executes the delete command.
+func (h *DeleteHandler) Handle(ctx context.Context, cmd DeleteCommand) error {
+	// Keep the last state for the audit trail
+	entity, err := h.repo.GetByID(ctx, cmd.ID)
+	if err != nil {
+		return err
+	}
+
+	if err := h.recorder.InTx(ctx, func(ctx context.Context) error {
+		if err := h.repo.Delete(ctx, cmd.ID); err != nil {
+			return err
+		}
+		return h.recorder.Deleted(ctx, audit.EntityParameterValue, auditCode(entity), snapshot(entity), cmd.DeletedBy)
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// newPeriod parses effective dates into a period.
+func newPeriod(from string, to *string) (parametervalue.Period, error) {
+	start, err := parametervalue.NewDate(from)
+	if err != nil {
+		return parametervalue.Period{}, err
+	}
+
+	var end *time.Time
+	if to != nil && *to != "" {
+		t, err := parametervalue.NewDate(*to)
+		if err != nil {
+			return parametervalue.Period{}, err
+		}
+		end = &t
+	}
+
+	return parametervalue.NewPeriod(start, end)
+}
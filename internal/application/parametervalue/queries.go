@@ -0,0 +1,101 @@
+package parametervalue
+
+import (
+	"context"
+
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/parameter"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/parametervalue"
+)
+
+// GetQuery represents the get ParameterValue query.
+type GetQuery struct {
+	ID int64
+}
+
+// GetHandler handles the GetParameterValue query.
+type GetHandler struct {
+	repo parametervalue.Repository
+}
+
+// NewGetHandler creates a new get handler.
+func NewGetHandler(repo parametervalue.Repository) *GetHandler {
+	return &GetHandler{repo: repo}
+}
+
+// Handle executes the get query.
+func (h *GetHandler) Handle(ctx context.Context, query GetQuery) (*parametervalue.ParameterValue, error) {
+	return h.repo.GetByID(ctx, query.ID)
+}
+
+// ListQuery represents the list ParameterValues query.
+type ListQuery struct {
+	SubjectType   *string
+	SubjectCode   *string
+	ParameterCode *string
+	AsOf          *string
+	Page          int
+	PageSize      int
+}
+
+// ListResult contains the list result with pagination.
+type ListResult struct {
+	Values []*parametervalue.ParameterValue
+	Total  int64
+}
+
+// ListHandler handles the ListParameterValues query.
+type ListHandler struct {
+	repo parametervalue.Repository
+}
+
+// NewListHandler creates a new list handler.
+func NewListHandler(repo parametervalue.Repository) *ListHandler {
+	return &ListHandler{repo: repo}
+}
+
+// Handle executes the list query.
+func (h *ListHandler) Handle(ctx context.Context, query ListQuery) (*ListResult, error) {
+	filter := parametervalue.ListFilter{
+		Page:     query.Page,
+		PageSize: query.PageSize,
+	}
+
+	if query.SubjectType != nil {
+		subjectType, err := parametervalue.NewSubjectType(*query.SubjectType)
+		if err != nil {
+			return nil, err
+		}
+		filter.SubjectType = &subjectType
+	}
+	if query.SubjectCode != nil {
+		subjectCode, err := parametervalue.NewSubjectCode(*query.SubjectCode)
+		if err != nil {
+			return nil, err
+		}
+		filter.SubjectCode = &subjectCode
+	}
+	if query.ParameterCode != nil {
+		paramCode, err := parameter.NewParameterCode(*query.ParameterCode)
+		if err != nil {
+			return nil, err
+		}
+		filter.ParameterCode = &paramCode
+	}
+	if query.AsOf != nil {
+		asOf, err := parametervalue.NewDate(*query.AsOf)
+		if err != nil {
+			return nil, err
+		}
+		filter.AsOf = &asOf
+	}
+
+	values, total, err := h.repo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListResult{
+		Values: values,
+		Total:  total,
+	}, nil
+}
@@ -0,0 +1,33 @@
+package parametervalue
+
+import (
+	"strconv"
+
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/audit"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/parametervalue"
+)
+
+// snapshot captures the audited fields of a ParameterValue.
+// Dates are kept in DateLayout so the diff reads like the API.
+func snapshot(entity *parametervalue.ParameterValue) audit.Snapshot {
+	var effectiveTo *string
+	if to := entity.Period().To(); to != nil {
+		s := to.Format(parametervalue.DateLayout)
+		effectiveTo = &s
+	}
+
+	return audit.Snapshot{
+		"subject_type":   entity.SubjectType().String(),
+		"subject_code":   entity.SubjectCode().String(),
+		"parameter_code": entity.ParameterCode().String(),
+		"value":          entity.Value(),
+		"effective_from": entity.Period().From().Format(parametervalue.DateLayout),
+		"effective_to":   effectiveTo,
+		"remarks":        entity.Remarks(),
+	}
+}
+
+// auditCode identifies a parameter value in the audit trail by its ID.
+func auditCode(entity *parametervalue.ParameterValue) string {
+	return strconv.FormatInt(entity.ID(), 10)
+}
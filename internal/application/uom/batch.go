@@ -0,0 +1,217 @@
+package uom
+
+import (
+	"context"
+	"errors"
+
+	appaudit "github.com/homindolenern/goapps-costing-v1/internal/application/audit"
+	"github.com/homindolenern/goapps-costing-v1/internal/application/batch"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/audit"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/uom"
+	pkgerrors "github.com/homindolenern/goapps-costing-v1/pkg/errors"
+)
+
+// ErrBaseChangeNotBatched is returned for items that promote or demote a
+// base UOM. That rescales the whole category and must go through UpdateUOM.
+var ErrBaseChangeNotBatched = errors.New("base uom changes are not supported in a batch, use UpdateUOM")
+
+// BatchUpsertItem is one UOM of a batch upsert. The UOM is created if its
+// code is new and updated otherwise.
+type BatchUpsertItem struct {
+	UOMCode          string
+	UOMName          string
+	Category         string
+	IsBaseUOM        bool
+	ConversionFactor *float64
+	// Version is checked when set; 0 updates whatever revision is current.
+	Version int
+}
+
+// BatchUpsertCommand represents the batch upsert UOMs command.
+type BatchUpsertCommand struct {
+	Items []BatchUpsertItem
+	// Atomic saves nothing if any item fails; otherwise the valid items are saved.
+	Atomic bool
+	// CreateOnly fails items whose code already exists instead of updating them.
+	CreateOnly bool
+	// DryRun checks every item and reports what would be saved without saving.
+	DryRun     bool
+	UpsertedBy string
+}
+
+// BatchUpsertHandler handles the BatchUpsertUOMs command.
+type BatchUpsertHandler struct {
+	repo       uom.Repository
+	recorder   *appaudit.Recorder
+	authorizer Authorizer
+}
+
+// NewBatchUpsertHandler creates a new batch upsert handler.
+func NewBatchUpsertHandler(repo uom.Repository, recorder *appaudit.Recorder, authorizer Authorizer) *BatchUpsertHandler {
+	return &BatchUpsertHandler{repo: repo, recorder: recorder, authorizer: authorizer}
+}
+
+// Handle executes the batch upsert command and returns one result per item, in order.
+func (h *BatchUpsertHandler) Handle(ctx context.Context, cmd BatchUpsertCommand) ([]batch.ItemResult[*uom.UOM], error) {
+	results := make([]batch.ItemResult[*uom.UOM], len(cmd.Items))
+	entries := make([]uom.BatchEntry, 0, len(cmd.Items))
+	befores := make([]audit.Snapshot, 0, len(cmd.Items))
+	positions := make([]int, 0, len(cmd.Items))
+	seen := make(map[string]bool, len(cmd.Items))
+
+	// 1. Apply every item to its entity, collecting domain rule failures
+	for i, item := range cmd.Items {
+		results[i] = batch.ItemResult[*uom.UOM]{Index: i, Code: item.UOMCode}
+		if seen[item.UOMCode] {
+			results[i].Fail(pkgerrors.NewFieldError("uom_code", batch.ErrDuplicateCode))
+			continue
+		}
+		seen[item.UOMCode] = true
+
+		entity, before, err := h.apply(ctx, item, cmd.UpsertedBy)
+		if err != nil {
+			results[i].Fail(err)
+			continue
+		}
+		if cmd.CreateOnly && before != nil {
+			results[i].Fail(uom.ErrAlreadyExists)
+			continue
+		}
+		entries = append(entries, uom.BatchEntry{UOM: entity, IsNew: before == nil})
+		befores = append(befores, before)
+		positions = append(positions, i)
+	}
+
+	// 2. An atomic batch with failed items is rejected before touching the database
+	if cmd.Atomic && batch.CountFailed(results) > 0 {
+		for _, i := range positions {
+			results[i].Status = batch.StatusSkipped
+		}
+		return results, nil
+	}
+	if len(entries) == 0 {
+		return results, nil
+	}
+	if cmd.DryRun {
+		for j, i := range positions {
+			results[i].Status = batch.StatusCreated
+			if !entries[j].IsNew {
+				results[i].Status = batch.StatusUpdated
+			}
+			results[i].Entity = entries[j].UOM
+		}
+		return results, nil
+	}
+
+	// 3. Persist in a single transaction with the audit events; two new base
+	// units of one category are caught here by the one-base-per-category index
+	err := h.recorder.InTx(ctx, func(ctx context.Context) error {
+		errs, err := h.repo.SaveBatch(ctx, entries, cmd.Atomic)
+		if err != nil {
+			return err
+		}
+		rejected := false
+		for j, i := range positions {
+			if errs[j] != nil {
+				results[i].Fail(errs[j])
+				rejected = cmd.Atomic
+			}
+		}
+
+		// 4. Report and audit the saved items
+		for j, i := range positions {
+			entity := entries[j].UOM
+			switch {
+			case errs[j] != nil:
+				// Reported above
+			case rejected:
+				results[i].Status = batch.StatusSkipped
+			case entries[j].IsNew:
+				results[i].Status = batch.StatusCreated
+				results[i].Entity = entity
+				err = h.recorder.Created(ctx, audit.EntityUOM, entity.Code().String(), snapshot(entity), cmd.UpsertedBy)
+			default:
+				results[i].Status = batch.StatusUpdated
+				results[i].Entity = entity
+				err = h.recorder.Updated(ctx, audit.EntityUOM, entity.Code().String(), befores[j], snapshot(entity), cmd.UpsertedBy)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// apply builds the new or changed UOM for an item. before is the snapshot of
+// an existing UOM and nil for a new one.
+func (h *BatchUpsertHandler) apply(ctx context.Context, item BatchUpsertItem, by string) (*uom.UOM, audit.Snapshot, error) {
+	code, err := uom.NewUOMCode(item.UOMCode)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	category, err := uom.NewCategory(item.Category)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Deleted codes cannot be reused, so look them up too
+	var before audit.Snapshot
+	entity, err := h.repo.GetByCodeIncludingDeleted(ctx, code)
+	switch {
+	case errors.Is(err, uom.ErrNotFound):
+		if item.IsBaseUOM {
+			if err := h.authorizer.Authorize(ctx, PermissionSetBaseUOM); err != nil {
+				return nil, nil, err
+			}
+			_, err := h.repo.GetBaseByCategory(ctx, category)
+			if err == nil {
+				return nil, nil, uom.ErrBaseUOMExists
+			}
+			if !errors.Is(err, uom.ErrNotFound) {
+				return nil, nil, err
+			}
+		}
+		entity, err = uom.NewUOM(code, item.UOMName, category, by)
+		if err != nil {
+			return nil, nil, err
+		}
+		if item.IsBaseUOM {
+			entity.SetAsBaseUOM()
+		}
+	case err != nil:
+		return nil, nil, err
+	case entity.IsDeleted():
+		return nil, nil, uom.ErrDeleted
+	default:
+		if item.Version != 0 {
+			if err := entity.CheckVersion(item.Version); err != nil {
+				return nil, nil, err
+			}
+		}
+		if item.IsBaseUOM != entity.IsBaseUOM() || (entity.IsBaseUOM() && category != entity.Category()) {
+			return nil, nil, pkgerrors.NewFieldError("is_base_uom", ErrBaseChangeNotBatched)
+		}
+		if err := checkCategoryMove(ctx, h.repo, entity, category, false, item.ConversionFactor); err != nil {
+			return nil, nil, err
+		}
+		before = snapshot(entity)
+		if err := entity.Update(item.UOMName, category, item.IsBaseUOM, by); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if item.ConversionFactor != nil {
+		if err := entity.SetConversionFactor(*item.ConversionFactor); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return entity, before, nil
+}
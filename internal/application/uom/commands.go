@@ -2,27 +2,45 @@ package uom
 
 import (
 	"context"
+	"errors"
 
+	appaudit "github.com/homindolenern/goapps-costing-v1/internal/application/audit"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/audit"
 	"github.com/homindolenern/goapps-costing-v1/internal/domain/uom"
 )
 
+// PermissionSetBaseUOM is required on top of CreateUOM/UpdateUOM to make a
+// UOM its category's base unit, because that re-bases every conversion
+// factor in the category. Edits of a unit that already is the base do not
+// need it.
+const PermissionSetBaseUOM = "/costing.v1.UOMService/SetBaseUOM"
+
+// Authorizer checks a permission of the caller that depends on the stored
+// data rather than on the method called.
+type Authorizer interface {
+	Authorize(ctx context.Context, permission string) error
+}
+
 // CreateCommand represents the create UOM command.
 type CreateCommand struct {
-	UOMCode   string
-	UOMName   string
-	Category  string
-	IsBaseUOM bool
-	CreatedBy string
+	UOMCode          string
+	UOMName          string
+	Category         string
+	IsBaseUOM        bool
+	ConversionFactor *float64
+	CreatedBy        string
 }
 
 // CreateHandler handles the CreateUOM command.
 type CreateHandler struct {
-	repo uom.Repository
+	repo       uom.Repository
+	recorder   *appaudit.Recorder
+	authorizer Authorizer
 }
 
 // NewCreateHandler creates a new create handler.
-func NewCreateHandler(repo uom.Repository) *CreateHandler {
-	return &CreateHandler{repo: repo}
+func NewCreateHandler(repo uom.Repository, recorder *appaudit.Recorder, authorizer Authorizer) *CreateHandler {
+	return &CreateHandler{repo: repo, recorder: recorder, authorizer: authorizer}
 }
 
 // Handle executes the create command.
@@ -47,6 +65,20 @@ func (h *CreateHandler) Handle(ctx context.Context, cmd CreateCommand) (*uom.UOM
 		return nil, uom.ErrAlreadyExists
 	}
 
+	// A category has a single base UOM; replacing it is a promotion (update)
+	if cmd.IsBaseUOM {
+		if err := h.authorizer.Authorize(ctx, PermissionSetBaseUOM); err != nil {
+			return nil, err
+		}
+		_, err := h.repo.GetBaseByCategory(ctx, category)
+		if err == nil {
+			return nil, uom.ErrBaseUOMExists
+		}
+		if !errors.Is(err, uom.ErrNotFound) {
+			return nil, err
+		}
+	}
+
 	// 3. Create domain entity
 	entity, err := uom.NewUOM(code, cmd.UOMName, category, cmd.CreatedBy)
 	if err != nil {
@@ -56,9 +88,19 @@ func (h *CreateHandler) Handle(ctx context.Context, cmd CreateCommand) (*uom.UOM
 	if cmd.IsBaseUOM {
 		entity.SetAsBaseUOM()
 	}
+	if cmd.ConversionFactor != nil {
+		if err := entity.SetConversionFactor(*cmd.ConversionFactor); err != nil {
+			return nil, err
+		}
+	}
 
-	// 4. Persist
-	if err := h.repo.Create(ctx, entity); err != nil {
+	// 4. Persist with its audit event
+	if err := h.recorder.InTx(ctx, func(ctx context.Context) error {
+		if err := h.repo.Create(ctx, entity); err != nil {
+			return err
+		}
+		return h.recorder.Created(ctx, audit.EntityUOM, code.String(), snapshot(entity), cmd.CreatedBy)
+	}); err != nil {
 		return nil, err
 	}
 
@@ -67,21 +109,25 @@ func (h *CreateHandler) Handle(ctx context.Context, cmd CreateCommand) (*uom.UOM
 
 // UpdateCommand represents the update UOM command.
 type UpdateCommand struct {
-	UOMCode   string
-	UOMName   string
-	Category  string
-	IsBaseUOM bool
-	UpdatedBy string
+	UOMCode          string
+	UOMName          string
+	Category         string
+	IsBaseUOM        bool
+	ConversionFactor *float64
+	Version          int
+	UpdatedBy        string
 }
 
 // UpdateHandler handles the UpdateUOM command.
 type UpdateHandler struct {
-	repo uom.Repository
+	repo       uom.Repository
+	recorder   *appaudit.Recorder
+	authorizer Authorizer
 }
 
 // NewUpdateHandler creates a new update handler.
-func NewUpdateHandler(repo uom.Repository) *UpdateHandler {
-	return &UpdateHandler{repo: repo}
+func NewUpdateHandler(repo uom.Repository, recorder *appaudit.Recorder, authorizer Authorizer) *UpdateHandler {
+	return &UpdateHandler{repo: repo, recorder: recorder, authorizer: authorizer}
 }
 
 // Handle executes the update command.
@@ -97,46 +143,375 @@ func (h *UpdateHandler) Handle(ctx context.Context, cmd UpdateCommand) (*uom.UOM
 		return nil, err
 	}
 
-	// 2. Get existing entity
+	// 2. Get existing entity and reject changes based on a stale read
 	entity, err := h.repo.GetByCode(ctx, code)
 	if err != nil {
 		return nil, err
 	}
+	if err := entity.CheckVersion(cmd.Version); err != nil {
+		return nil, err
+	}
+	before := snapshot(entity)
+
+	// 3. Keep exactly one base UOM per category
+	wasBase := entity.IsBaseUOM()
+	promote := cmd.IsBaseUOM && !wasBase
+	switch {
+	case wasBase && (!cmd.IsBaseUOM || category != entity.Category()):
+		siblings, err := h.repo.ListByCategory(ctx, entity.Category())
+		if err != nil {
+			return nil, err
+		}
+		if err := uom.EnsureBaseRetained(entity, siblings); err != nil {
+			return nil, err
+		}
+	case promote && category != entity.Category():
+		return nil, uom.ErrBasePromotionCategory
+	case promote:
+		if err := h.authorizer.Authorize(ctx, PermissionSetBaseUOM); err != nil {
+			return nil, err
+		}
+	}
+	if err := checkCategoryMove(ctx, h.repo, entity, category, cmd.IsBaseUOM && wasBase, cmd.ConversionFactor); err != nil {
+		return nil, err
+	}
 
-	// 3. Update entity
-	if err := entity.Update(cmd.UOMName, category, cmd.IsBaseUOM, cmd.UpdatedBy); err != nil {
+	// 4. Update entity; a promotion is applied by the domain service below so
+	// the factor given here is still relative to the current base
+	if err := entity.Update(cmd.UOMName, category, cmd.IsBaseUOM && wasBase, cmd.UpdatedBy); err != nil {
 		return nil, err
 	}
+	if cmd.ConversionFactor != nil {
+		if err := entity.SetConversionFactor(*cmd.ConversionFactor); err != nil {
+			return nil, err
+		}
+	}
+
+	// 5. Persist with the audit events
+	if promote {
+		siblings, err := h.repo.ListByCategory(ctx, category)
+		if err != nil {
+			return nil, err
+		}
+		siblingsBefore := make(map[uom.Code]audit.Snapshot, len(siblings))
+		for _, sibling := range siblings {
+			siblingsBefore[sibling.Code()] = snapshot(sibling)
+		}
+		changed, err := uom.PromoteToBase(entity, siblings, cmd.UpdatedBy)
+		if err != nil {
+			return nil, err
+		}
+		if err := h.recorder.InTx(ctx, func(ctx context.Context) error {
+			if err := h.repo.UpdateBase(ctx, entity, changed); err != nil {
+				return err
+			}
+			if err := h.recorder.Updated(ctx, audit.EntityUOM, code.String(), before, snapshot(entity), cmd.UpdatedBy); err != nil {
+				return err
+			}
+			for _, sibling := range changed {
+				if err := h.recorder.Updated(ctx, audit.EntityUOM, sibling.Code().String(),
+					siblingsBefore[sibling.Code()], snapshot(sibling), cmd.UpdatedBy); err != nil {
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+		return entity, nil
+	}
 
-	// 4. Persist
-	if err := h.repo.Update(ctx, entity); err != nil {
+	if err := h.recorder.InTx(ctx, func(ctx context.Context) error {
+		if err := h.repo.Update(ctx, entity); err != nil {
+			return err
+		}
+		return h.recorder.Updated(ctx, audit.EntityUOM, code.String(), before, snapshot(entity), cmd.UpdatedBy)
+	}); err != nil {
 		return nil, err
 	}
 
 	return entity, nil
 }
 
+// checkCategoryMove verifies that entity can move to category. The factor of
+// a unit is relative to the base of its category, so a unit that is not the
+// base keeps a meaningless one unless a new factor comes with the move. Its
+// explicit conversions must still link two different categories afterwards.
+func checkCategoryMove(ctx context.Context, repo uom.Repository, entity *uom.UOM, category uom.Category, isBase bool, factor *float64) error {
+	if category == entity.Category() {
+		return nil
+	}
+	if !isBase && factor == nil {
+		return uom.ErrCategoryChangeFactor
+	}
+
+	code := entity.Code()
+	conversions, err := repo.ListConversions(ctx, uom.ConversionFilter{UOMCode: &code})
+	if err != nil {
+		return err
+	}
+	for _, conversion := range conversions {
+		other := conversion.ToUOM()
+		if other == code {
+			other = conversion.FromUOM()
+		}
+		otherEntity, err := repo.GetByCodeIncludingDeleted(ctx, other)
+		if errors.Is(err, uom.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if otherEntity.Category() == category {
+			return uom.ErrConversionSameCategory
+		}
+	}
+	return nil
+}
+
 // DeleteCommand represents the delete UOM command.
 type DeleteCommand struct {
 	UOMCode string
+	// Force deletes the UOM even if active parameters still use it.
+	Force     bool
+	DeletedBy string
 }
 
 // DeleteHandler handles the DeleteUOM command.
 type DeleteHandler struct {
-	repo uom.Repository
+	repo     uom.Repository
+	recorder *appaudit.Recorder
 }
 
 // NewDeleteHandler creates a new delete handler.
-func NewDeleteHandler(repo uom.Repository) *DeleteHandler {
-	return &DeleteHandler{repo: repo}
+func NewDeleteHandler(repo uom.Repository, recorder *appaudit.Recorder) *DeleteHandler {
+	return &DeleteHandler{repo: repo, recorder: recorder}
 }
 
-// Handle executes the delete command.
+// Handle executes the delete command. The UOM is soft-deleted so parameters
+// and materials referencing it keep their unit.
 func (h *DeleteHandler) Handle(ctx context.Context, cmd DeleteCommand) error {
 	code, err := uom.NewUOMCode(cmd.UOMCode)
 	if err != nil {
 		return err
 	}
 
-	return h.repo.Delete(ctx, code)
+	entity, err := h.repo.GetByCode(ctx, code)
+	if err != nil {
+		return err
+	}
+	before := snapshot(entity)
+
+	// Active parameters would be left measured in a deleted unit
+	if !cmd.Force {
+		inUse, err := h.repo.HasActiveParameters(ctx, code)
+		if err != nil {
+			return err
+		}
+		if inUse {
+			return uom.ErrInUse
+		}
+	}
+
+	// The base UOM can only go once it is the last unit of its category
+	if entity.IsBaseUOM() {
+		siblings, err := h.repo.ListByCategory(ctx, entity.Category())
+		if err != nil {
+			return err
+		}
+		if err := uom.EnsureBaseRetained(entity, siblings); err != nil {
+			return err
+		}
+	}
+
+	entity.SoftDelete(cmd.DeletedBy)
+	if err := h.recorder.InTx(ctx, func(ctx context.Context) error {
+		if err := h.repo.Update(ctx, entity); err != nil {
+			return err
+		}
+		return h.recorder.Deleted(ctx, audit.EntityUOM, code.String(), before, cmd.DeletedBy)
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RestoreCommand represents the restore UOM command.
+type RestoreCommand struct {
+	UOMCode    string
+	RestoredBy string
+}
+
+// RestoreHandler handles the RestoreUOM command.
+type RestoreHandler struct {
+	repo     uom.Repository
+	recorder *appaudit.Recorder
+}
+
+// NewRestoreHandler creates a new restore handler.
+func NewRestoreHandler(repo uom.Repository, recorder *appaudit.Recorder) *RestoreHandler {
+	return &RestoreHandler{repo: repo, recorder: recorder}
+}
+
+// Handle executes the restore command.
+func (h *RestoreHandler) Handle(ctx context.Context, cmd RestoreCommand) (*uom.UOM, error) {
+	code, err := uom.NewUOMCode(cmd.UOMCode)
+	if err != nil {
+		return nil, err
+	}
+
+	entity, err := h.repo.GetByCodeIncludingDeleted(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+	before := snapshot(entity)
+
+	// A restored base UOM fails with ErrBaseUOMExists if the category has
+	// gained another base in the meantime
+	if err := entity.Restore(cmd.RestoredBy); err != nil {
+		return nil, err
+	}
+	if err := h.recorder.InTx(ctx, func(ctx context.Context) error {
+		if err := h.repo.Update(ctx, entity); err != nil {
+			return err
+		}
+		return h.recorder.Updated(ctx, audit.EntityUOM, code.String(), before, snapshot(entity), cmd.RestoredBy)
+	}); err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+// CreateConversionCommand represents the create UOM conversion command.
+type CreateConversionCommand struct {
+	FromUOMCode string
+	ToUOMCode   string
+	Factor      float64
+	Description *string
+	CreatedBy   string
+}
+
+// CreateConversionHandler handles the CreateConversion command.
+type CreateConversionHandler struct {
+	repo     uom.Repository
+	recorder *appaudit.Recorder
+}
+
+// NewCreateConversionHandler creates a new create conversion handler.
+func NewCreateConversionHandler(repo uom.Repository, recorder *appaudit.Recorder) *CreateConversionHandler {
+	return &CreateConversionHandler{repo: repo, recorder: recorder}
+}
+
+// Handle executes the create conversion command.
+func (h *CreateConversionHandler) Handle(ctx context.Context, cmd CreateConversionCommand) (*uom.Conversion, error) {
+	// 1. Create and validate value objects
+	from, err := uom.NewUOMCode(cmd.FromUOMCode)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := uom.NewUOMCode(cmd.ToUOMCode)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Both units must exist and belong to different categories
+	fromEntity, err := h.repo.GetByCode(ctx, from)
+	if err != nil {
+		return nil, err
+	}
+	toEntity, err := h.repo.GetByCode(ctx, to)
+	if err != nil {
+		return nil, err
+	}
+	if fromEntity.Category() == toEntity.Category() {
+		return nil, uom.ErrConversionSameCategory
+	}
+
+	// 3. Check for duplicates (either direction)
+	exists, err := h.repo.ExistsConversion(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, uom.ErrConversionExists
+	}
+
+	// 4. Create domain entity
+	conversion, err := uom.NewConversion(from, to, cmd.Factor, cmd.CreatedBy)
+	if err != nil {
+		return nil, err
+	}
+	conversion.SetDescription(cmd.Description)
+
+	// 5. Persist with its audit event
+	if err := h.recorder.InTx(ctx, func(ctx context.Context) error {
+		if err := h.repo.CreateConversion(ctx, conversion); err != nil {
+			return err
+		}
+		return h.recorder.Created(ctx, audit.EntityUOMConversion, conversionCode(from, to), conversionSnapshot(conversion), cmd.CreatedBy)
+	}); err != nil {
+		return nil, err
+	}
+
+	return conversion, nil
+}
+
+// DeleteConversionCommand represents the delete UOM conversion command.
+type DeleteConversionCommand struct {
+	FromUOMCode string
+	ToUOMCode   string
+	DeletedBy   string
+}
+
+// DeleteConversionHandler handles the DeleteConversion command.
+type DeleteConversionHandler struct {
+	repo     uom.Repository
+	recorder *appaudit.Recorder
+}
+
+// NewDeleteConversionHandler creates a new delete conversion handler.
+func NewDeleteConversionHandler(repo uom.Repository, recorder *appaudit.Recorder) *DeleteConversionHandler {
+	return &DeleteConversionHandler{repo: repo, recorder: recorder}
+}
+
+// Handle executes the delete conversion command.
+func (h *DeleteConversionHandler) Handle(ctx context.Context, cmd DeleteConversionCommand) error {
+	from, err := uom.NewUOMCode(cmd.FromUOMCode)
+	if err != nil {
+		return err
+	}
+
+	to, err := uom.NewUOMCode(cmd.ToUOMCode)
+	if err != nil {
+		return err
+	}
+
+	// Keep the last state for the audit trail
+	conversions, err := h.repo.ListConversions(ctx, uom.ConversionFilter{UOMCode: &from})
+	if err != nil {
+		return err
+	}
+	var before audit.Snapshot
+	for _, c := range conversions {
+		if c.FromUOM() == from && c.ToUOM() == to {
+			before = conversionSnapshot(c)
+		}
+	}
+	if before == nil {
+		return uom.ErrConversionNotFound
+	}
+
+	if err := h.recorder.InTx(ctx, func(ctx context.Context) error {
+		if err := h.repo.DeleteConversion(ctx, from, to); err != nil {
+			return err
+		}
+		return h.recorder.Deleted(ctx, audit.EntityUOMConversion, conversionCode(from, to), before, cmd.DeletedBy)
+	}); err != nil {
+		return err
+	}
+
+	return nil
 }
@@ -0,0 +1,40 @@
+package uom
+
+import (
+	"context"
+
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/uom"
+)
+
+// ExportQuery represents the export UOMs query. It takes the filters of
+// ListQuery and covers every matching UOM rather than one page.
+type ExportQuery struct {
+	Category       *string
+	IncludeDeleted bool
+}
+
+// ExportHandler handles the ExportUOMs query.
+type ExportHandler struct {
+	repo uom.Repository
+}
+
+// NewExportHandler creates a new export handler.
+func NewExportHandler(repo uom.Repository) *ExportHandler {
+	return &ExportHandler{repo: repo}
+}
+
+// Handle calls fn for every matching UOM, in code order, without loading
+// them all at once.
+func (h *ExportHandler) Handle(ctx context.Context, query ExportQuery, fn func(*uom.UOM) error) error {
+	filter := uom.ListFilter{IncludeDeleted: query.IncludeDeleted}
+
+	if query.Category != nil {
+		cat, err := uom.NewCategory(*query.Category)
+		if err != nil {
+			return err
+		}
+		filter.Category = &cat
+	}
+
+	return h.repo.ForEach(ctx, filter, fn)
+}
@@ -2,8 +2,10 @@ package uom
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/homindolenern/goapps-costing-v1/internal/domain/uom"
+	"github.com/homindolenern/goapps-costing-v1/pkg/pagetoken"
 )
 
 // GetQuery represents the get UOM query.
@@ -31,34 +33,50 @@ func (h *GetHandler) Handle(ctx context.Context, query GetQuery) (*uom.UOM, erro
 	return h.repo.GetByCode(ctx, code)
 }
 
-// ListQuery represents the list UOMs query.
+// ListQuery represents the list UOMs query. PageToken, taken from a
+// previous result with the same filters, selects the following page in
+// place of Page.
 type ListQuery struct {
-	Category *string
-	Page     int
-	PageSize int
+	Category       *string
+	IncludeDeleted bool
+	Page           int
+	PageSize       int
+	PageToken      string
+}
+
+// tokenFilter returns the filters a page token is bound to.
+func (q ListQuery) tokenFilter() any {
+	return struct {
+		Category       *string
+		IncludeDeleted bool
+	}{q.Category, q.IncludeDeleted}
 }
 
 // ListResult contains the list result with pagination.
 type ListResult struct {
-	UOMs  []*uom.UOM
-	Total int64
+	UOMs          []*uom.UOM
+	Total         int64
+	Page          int
+	NextPageToken string // empty on the last page
 }
 
 // ListHandler handles the ListUOMs query.
 type ListHandler struct {
-	repo uom.Repository
+	repo   uom.Repository
+	tokens *pagetoken.Codec
 }
 
 // NewListHandler creates a new list handler.
-func NewListHandler(repo uom.Repository) *ListHandler {
-	return &ListHandler{repo: repo}
+func NewListHandler(repo uom.Repository, tokens *pagetoken.Codec) *ListHandler {
+	return &ListHandler{repo: repo, tokens: tokens}
 }
 
 // Handle executes the list query.
 func (h *ListHandler) Handle(ctx context.Context, query ListQuery) (*ListResult, error) {
 	filter := uom.ListFilter{
-		Page:     query.Page,
-		PageSize: query.PageSize,
+		Page:           query.Page,
+		PageSize:       query.PageSize,
+		IncludeDeleted: query.IncludeDeleted,
 	}
 
 	if query.Category != nil {
@@ -69,13 +87,166 @@ func (h *ListHandler) Handle(ctx context.Context, query ListQuery) (*ListResult,
 		filter.Category = &cat
 	}
 
+	if query.PageToken != "" {
+		cursor, err := h.tokens.Decode(query.PageToken, query.tokenFilter())
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", uom.ErrInvalidPageToken, err)
+		}
+		after := uom.Code(cursor.Key)
+		filter.Page, filter.After = cursor.Page, &after
+	}
+
 	uoms, total, err := h.repo.List(ctx, filter)
 	if err != nil {
 		return nil, err
 	}
 
-	return &ListResult{
+	result := &ListResult{
 		UOMs:  uoms,
 		Total: total,
+		Page:  max(filter.Page, 1),
+	}
+	if filter.HasNext(len(uoms), total) {
+		result.NextPageToken, err = h.tokens.Encode(pagetoken.Cursor{
+			Key:  uoms[len(uoms)-1].Code().String(),
+			Page: result.Page + 1,
+		}, query.tokenFilter())
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// ConvertQuery represents the convert quantity query.
+type ConvertQuery struct {
+	Quantity    float64
+	FromUOMCode string
+	ToUOMCode   string
+}
+
+// ConvertResult contains the converted quantity.
+type ConvertResult struct {
+	Quantity          float64
+	FromUOMCode       string
+	ToUOMCode         string
+	ConvertedQuantity float64
+	Factor            float64
+}
+
+// ConvertHandler handles the ConvertQuantity query.
+type ConvertHandler struct {
+	repo uom.Repository
+}
+
+// NewConvertHandler creates a new convert handler.
+func NewConvertHandler(repo uom.Repository) *ConvertHandler {
+	return &ConvertHandler{repo: repo}
+}
+
+// Handle executes the convert query.
+func (h *ConvertHandler) Handle(ctx context.Context, query ConvertQuery) (*ConvertResult, error) {
+	from, err := uom.NewUOMCode(query.FromUOMCode)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := uom.NewUOMCode(query.ToUOMCode)
+	if err != nil {
+		return nil, err
+	}
+
+	converter, err := LoadConverter(ctx, h.repo, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	factor, err := converter.Factor(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ConvertResult{
+		Quantity:          query.Quantity,
+		FromUOMCode:       from.String(),
+		ToUOMCode:         to.String(),
+		ConvertedQuantity: query.Quantity * factor,
+		Factor:            factor,
 	}, nil
 }
+
+// LoadConverter builds a converter able to convert between the given units.
+// Explicit conversions, and the units they reference, are only loaded when
+// the units span more than one category.
+func LoadConverter(ctx context.Context, repo uom.Repository, codes ...uom.Code) (*uom.Converter, error) {
+	units := make(map[uom.Code]*uom.UOM, len(codes))
+	categories := make(map[uom.Category]bool)
+	for _, code := range codes {
+		if _, ok := units[code]; ok {
+			continue
+		}
+		entity, err := repo.GetByCode(ctx, code)
+		if err != nil {
+			return nil, err
+		}
+		units[code] = entity
+		categories[entity.Category()] = true
+	}
+
+	var conversions []*uom.Conversion
+	if len(categories) > 1 {
+		var err error
+		conversions, err = repo.ListConversions(ctx, uom.ConversionFilter{})
+		if err != nil {
+			return nil, err
+		}
+		for _, conv := range conversions {
+			for _, code := range []uom.Code{conv.FromUOM(), conv.ToUOM()} {
+				if _, ok := units[code]; ok {
+					continue
+				}
+				entity, err := repo.GetByCode(ctx, code)
+				if err != nil {
+					return nil, err
+				}
+				units[code] = entity
+			}
+		}
+	}
+
+	list := make([]*uom.UOM, 0, len(units))
+	for _, entity := range units {
+		list = append(list, entity)
+	}
+	return uom.NewConverter(list, conversions), nil
+}
+
+// ListConversionsQuery represents the list UOM conversions query.
+type ListConversionsQuery struct {
+	UOMCode *string
+}
+
+// ListConversionsHandler handles the ListConversions query.
+type ListConversionsHandler struct {
+	repo uom.Repository
+}
+
+// NewListConversionsHandler creates a new list conversions handler.
+func NewListConversionsHandler(repo uom.Repository) *ListConversionsHandler {
+	return &ListConversionsHandler{repo: repo}
+}
+
+// Handle executes the list conversions query.
+func (h *ListConversionsHandler) Handle(ctx context.Context, query ListConversionsQuery) ([]*uom.Conversion, error) {
+	filter := uom.ConversionFilter{}
+
+	if query.UOMCode != nil {
+		code, err := uom.NewUOMCode(*query.UOMCode)
+		if err != nil {
+			return nil, err
+		}
+		filter.UOMCode = &code
+	}
+
+	return h.repo.ListConversions(ctx, filter)
+}
@@ -0,0 +1,32 @@
+package uom
+
+import (
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/audit"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/uom"
+)
+
+// snapshot captures the audited fields of a UOM.
+func snapshot(entity *uom.UOM) audit.Snapshot {
+	return audit.Snapshot{
+		"uom_name":          entity.Name(),
+		"uom_category":      entity.Category().String(),
+		"is_base_uom":       entity.IsBaseUOM(),
+		"conversion_factor": entity.ConversionFactor(),
+		"is_deleted":        entity.IsDeleted(),
+	}
+}
+
+// conversionSnapshot captures the audited fields of an explicit conversion.
+func conversionSnapshot(conversion *uom.Conversion) audit.Snapshot {
+	return audit.Snapshot{
+		"from_uom_code": conversion.FromUOM().String(),
+		"to_uom_code":   conversion.ToUOM().String(),
+		"factor":        conversion.Factor(),
+		"description":   conversion.Description(),
+	}
+}
+
+// conversionCode identifies a conversion in the audit trail, e.g. "KG:M".
+func conversionCode(from, to uom.Code) string {
+	return from.String() + ":" + to.String()
+}
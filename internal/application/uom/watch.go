@@ -0,0 +1,57 @@
+package uom
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/homindolenern/goapps-costing-v1/internal/application/watch"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/event"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/uom"
+)
+
+// WatchQuery represents the watch UOMs query. Without a ResumeToken the
+// watch starts at the latest change.
+type WatchQuery struct {
+	ResumeToken string
+}
+
+// WatchHandler handles the WatchUOMs query.
+type WatchHandler struct {
+	feed     uom.ChangeFeed
+	follower *watch.Follower
+}
+
+// NewWatchHandler creates a new watch handler.
+func NewWatchHandler(feed uom.ChangeFeed, follower *watch.Follower) *WatchHandler {
+	return &WatchHandler{feed: feed, follower: follower}
+}
+
+// Handle calls send for every UOM change after the resume token until ctx
+// is done. started, if set, is called first with the position the watch
+// starts from. Each change's position, as a string, resumes after it.
+func (h *WatchHandler) Handle(
+	ctx context.Context,
+	query WatchQuery,
+	started func(from event.Position) error,
+	send func(*uom.Change) error,
+) error {
+	from, err := event.ParsePosition(query.ResumeToken)
+	if err != nil {
+		return fmt.Errorf("%w: %w", uom.ErrInvalidResumeToken, err)
+	}
+	if query.ResumeToken == "" {
+		if from, err = h.feed.Head(ctx); err != nil {
+			return err
+		}
+	}
+	if started != nil {
+		if err := started(from); err != nil {
+			return err
+		}
+	}
+
+	return watch.Follow(ctx, h.follower, from, h.feed.UOMChangesAfter,
+		func(change *uom.Change) event.Position { return change.Position },
+		send,
+	)
+}
@@ -0,0 +1,88 @@
+// Package watch follows the change log for the watch use cases.
+package watch
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/event"
+)
+
+// ErrStopped is returned by Follow when the follower is stopped, e.g. on
+// shutdown. Clients resume from the last position they received.
+var ErrStopped = errors.New("watch stopped")
+
+// readLimit is the number of changes read per query.
+const readLimit = 100
+
+// Follower waits for changes on behalf of every watch stream. It wakes on
+// notifications and polls as a fallback.
+type Follower struct {
+	notifier event.Notifier
+	poll     time.Duration
+
+	stopOnce sync.Once
+	stopped  chan struct{}
+}
+
+// NewFollower creates a follower. notifier may be nil, leaving polling only.
+func NewFollower(notifier event.Notifier, poll time.Duration) *Follower {
+	if poll <= 0 {
+		poll = 5 * time.Second
+	}
+	return &Follower{notifier: notifier, poll: poll, stopped: make(chan struct{})}
+}
+
+// Stop ends every Follow call with ErrStopped.
+func (f *Follower) Stop() {
+	f.stopOnce.Do(func() { close(f.stopped) })
+}
+
+// Follow sends the changes read after from, in order, then waits for more
+// until ctx is done, the follower is stopped or send fails.
+func Follow[T any](
+	ctx context.Context,
+	f *Follower,
+	from event.Position,
+	read func(ctx context.Context, after event.Position, limit int) ([]T, error),
+	position func(T) event.Position,
+	send func(T) error,
+) error {
+	var wake <-chan struct{}
+	if f.notifier != nil {
+		signal, unsubscribe := f.notifier.Subscribe()
+		defer unsubscribe()
+		wake = signal
+	}
+	timer := time.NewTimer(f.poll)
+	defer timer.Stop()
+
+	after := from
+	for {
+		changes, err := read(ctx, after, readLimit)
+		if err != nil {
+			return err
+		}
+		for _, change := range changes {
+			if err := send(change); err != nil {
+				return err
+			}
+			after = position(change)
+		}
+		if len(changes) == readLimit {
+			continue
+		}
+
+		timer.Reset(f.poll)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-f.stopped:
+			return ErrStopped
+		case <-wake:
+		case <-timer.C:
+		}
+	}
+}
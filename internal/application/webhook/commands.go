@@ -0,0 +1,114 @@
+package webhook
+
+import (
+	"context"
+	"time"
+
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/event"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/webhook"
+)
+
+// CreateCommand represents the create webhook subscription command.
+type CreateCommand struct {
+	URL         string
+	EventTypes  []string
+	Description *string
+	CreatedBy   string
+}
+
+// CreateHandler handles the CreateWebhookSubscription command.
+type CreateHandler struct {
+	repo webhook.Repository
+}
+
+// NewCreateHandler creates a new create handler.
+func NewCreateHandler(repo webhook.Repository) *CreateHandler {
+	return &CreateHandler{repo: repo}
+}
+
+// Handle executes the create command. The returned subscription carries the
+// generated signing secret.
+func (h *CreateHandler) Handle(ctx context.Context, cmd CreateCommand) (*webhook.Subscription, error) {
+	// 1. Create and validate value objects
+	url, err := webhook.NewEndpointURL(cmd.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	eventTypes := make([]event.Type, 0, len(cmd.EventTypes))
+	for _, t := range cmd.EventTypes {
+		eventType, err := event.NewType(t)
+		if err != nil {
+			return nil, err
+		}
+		eventTypes = append(eventTypes, eventType)
+	}
+
+	// 2. Create domain entity
+	sub, err := webhook.NewSubscription(url, eventTypes, cmd.Description, cmd.CreatedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Persist
+	if err := h.repo.CreateSubscription(ctx, sub); err != nil {
+		return nil, err
+	}
+
+	return sub, nil
+}
+
+// DeleteCommand represents the delete webhook subscription command.
+type DeleteCommand struct {
+	ID int64
+}
+
+// DeleteHandler handles the DeleteWebhookSubscription command.
+type DeleteHandler struct {
+	repo webhook.Repository
+}
+
+// NewDeleteHandler creates a new delete handler.
+func NewDeleteHandler(repo webhook.Repository) *DeleteHandler {
+	return &DeleteHandler{repo: repo}
+}
+
+// Handle executes the delete command. Pending deliveries are dropped with
+// the subscription.
+func (h *DeleteHandler) Handle(ctx context.Context, cmd DeleteCommand) error {
+	return h.repo.DeleteSubscription(ctx, cmd.ID)
+}
+
+// ReplayCommand represents the replay webhook delivery command.
+type ReplayCommand struct {
+	ID int64
+}
+
+// ReplayHandler handles the ReplayWebhookDelivery command.
+type ReplayHandler struct {
+	repo webhook.Repository
+}
+
+// NewReplayHandler creates a new replay handler.
+func NewReplayHandler(repo webhook.Repository) *ReplayHandler {
+	return &ReplayHandler{repo: repo}
+}
+
+// Handle executes the replay command. The delivery is queued for the
+// dispatcher rather than sent in the request.
+func (h *ReplayHandler) Handle(ctx context.Context, cmd ReplayCommand) (*webhook.Delivery, error) {
+	delivery, err := h.repo.GetDelivery(ctx, cmd.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := delivery.Replay(time.Now()); err != nil {
+		return nil, err
+	}
+
+	if err := h.repo.UpdateDelivery(ctx, delivery); err != nil {
+		return nil, err
+	}
+
+	return delivery, nil
+}
@@ -0,0 +1,170 @@
+// Package webhook delivers domain events to the HTTPS endpoints subscribed
+// to them.
+package webhook
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/homindolenern/goapps-costing-v1/internal/application/outbox"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/event"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/webhook"
+)
+
+// Fanout is the outbox publisher recording one delivery of each message per
+// subscription to its event type. Recording is idempotent, so the relay may
+// hand a message over again.
+type Fanout struct {
+	repo webhook.Repository
+}
+
+// Verify interface implementation at compile time.
+var _ outbox.Publisher = (*Fanout)(nil)
+
+// NewFanout creates a new fan-out publisher.
+func NewFanout(repo webhook.Repository) *Fanout {
+	return &Fanout{repo: repo}
+}
+
+// Publish records the deliveries of msg.
+func (f *Fanout) Publish(ctx context.Context, msg *event.Message) error {
+	subs, err := f.repo.SubscriptionsFor(ctx, msg.Type)
+	if err != nil {
+		return err
+	}
+
+	deliveries := make([]*webhook.Delivery, 0, len(subs))
+	for _, sub := range subs {
+		d, err := webhook.NewDelivery(sub, msg)
+		if err != nil {
+			return err
+		}
+		deliveries = append(deliveries, d)
+	}
+	return f.repo.CreateDeliveries(ctx, deliveries...)
+}
+
+// Sender posts a delivery to its subscription's endpoint. It returns the
+// HTTP status code received, zero if none was, and an error unless the
+// endpoint acknowledged the delivery.
+type Sender interface {
+	Send(ctx context.Context, sub *webhook.Subscription, delivery *webhook.Delivery) (int, error)
+}
+
+// DispatcherConfig tunes the dispatch loop.
+type DispatcherConfig struct {
+	// Interval is the pause between polls when no delivery is due.
+	Interval time.Duration
+	// BatchSize is the number of deliveries claimed per poll.
+	BatchSize int
+	// Lease is how long claimed deliveries are hidden from other
+	// dispatchers. It should exceed BatchSize times the send timeout.
+	Lease time.Duration
+	// Retry decides when failed deliveries are attempted again.
+	Retry webhook.RetryPolicy
+}
+
+// Dispatcher sends the due deliveries, recording each outcome. Deliveries
+// are independent, so one failing endpoint does not hold back the others,
+// and events may reach an endpoint out of order.
+type Dispatcher struct {
+	repo   webhook.Repository
+	sender Sender
+	cfg    DispatcherConfig
+}
+
+// NewDispatcher creates a new dispatcher.
+func NewDispatcher(repo webhook.Repository, sender Sender, cfg DispatcherConfig) *Dispatcher {
+	if cfg.Interval <= 0 {
+		cfg.Interval = time.Second
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = 20
+	}
+	if cfg.Lease <= 0 {
+		cfg.Lease = 5 * time.Minute
+	}
+	if cfg.Retry.MaxAttempts <= 0 {
+		cfg.Retry = webhook.DefaultRetryPolicy
+	}
+	return &Dispatcher{repo: repo, sender: sender, cfg: cfg}
+}
+
+// Run dispatches deliveries until ctx is done. A full batch is followed at
+// once by the next poll; errors are logged and retried after the interval.
+func (d *Dispatcher) Run(ctx context.Context) error {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-timer.C:
+		}
+
+		n, err := d.DispatchOnce(ctx)
+		if err != nil && ctx.Err() == nil {
+			log.Error().Err(err).Msg("Webhook dispatch failed")
+		}
+
+		wait := d.cfg.Interval
+		if err == nil && n == d.cfg.BatchSize {
+			wait = 0
+		}
+		timer.Reset(wait)
+	}
+}
+
+// DispatchOnce claims one batch of due deliveries and attempts each,
+// returning the number attempted.
+func (d *Dispatcher) DispatchOnce(ctx context.Context) (int, error) {
+	deliveries, err := d.repo.ClaimDueDeliveries(ctx, d.cfg.BatchSize, d.cfg.Lease)
+	if err != nil {
+		return 0, err
+	}
+
+	subs := make(map[int64]*webhook.Subscription)
+	for i, delivery := range deliveries {
+		sub, ok := subs[delivery.SubscriptionID()]
+		if !ok {
+			if sub, err = d.repo.GetSubscription(ctx, delivery.SubscriptionID()); err != nil {
+				return i, err
+			}
+			subs[sub.ID()] = sub
+		}
+		if err := d.attempt(ctx, sub, delivery); err != nil {
+			return i, err
+		}
+	}
+	return len(deliveries), nil
+}
+
+// attempt sends delivery once and records the outcome.
+func (d *Dispatcher) attempt(ctx context.Context, sub *webhook.Subscription, delivery *webhook.Delivery) error {
+	statusCode, sendErr := d.sender.Send(ctx, sub, delivery)
+	if sendErr != nil && ctx.Err() != nil {
+		// Shutting down; the delivery is claimed again once its lease expires
+		return ctx.Err()
+	}
+	if sendErr == nil {
+		delivery.Succeeded(statusCode, time.Now())
+	} else {
+		delivery.Failed(sendErr, statusCode, d.cfg.Retry, time.Now())
+		logEvent := log.Warn()
+		if delivery.Status() == webhook.StatusDead {
+			logEvent = log.Error()
+		}
+		logEvent.Err(sendErr).
+			Int64("delivery_id", delivery.ID()).
+			Int64("subscription_id", sub.ID()).
+			Str("event_type", delivery.EventType().String()).
+			Int("attempts", delivery.Attempts()).
+			Str("status", delivery.Status().String()).
+			Msg("Failed to deliver webhook")
+	}
+
+	return d.repo.UpdateDelivery(ctx, delivery)
+}
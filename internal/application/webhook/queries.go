@@ -0,0 +1,76 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/webhook"
+)
+
+// ListHandler handles the ListWebhookSubscriptions query.
+type ListHandler struct {
+	repo webhook.Repository
+}
+
+// NewListHandler creates a new list handler.
+func NewListHandler(repo webhook.Repository) *ListHandler {
+	return &ListHandler{repo: repo}
+}
+
+// Handle executes the list query.
+func (h *ListHandler) Handle(ctx context.Context) ([]*webhook.Subscription, error) {
+	return h.repo.ListSubscriptions(ctx)
+}
+
+// ListDeliveriesQuery represents the list webhook deliveries query.
+type ListDeliveriesQuery struct {
+	SubscriptionID *int64
+	Status         *string
+	EventID        *string
+	Page           int
+	PageSize       int
+}
+
+// ListDeliveriesResult contains the list result with pagination.
+type ListDeliveriesResult struct {
+	Deliveries []*webhook.Delivery
+	Total      int64
+}
+
+// ListDeliveriesHandler handles the ListWebhookDeliveries query. Listing the
+// DEAD status gives the dead letters.
+type ListDeliveriesHandler struct {
+	repo webhook.Repository
+}
+
+// NewListDeliveriesHandler creates a new list deliveries handler.
+func NewListDeliveriesHandler(repo webhook.Repository) *ListDeliveriesHandler {
+	return &ListDeliveriesHandler{repo: repo}
+}
+
+// Handle executes the list deliveries query.
+func (h *ListDeliveriesHandler) Handle(ctx context.Context, query ListDeliveriesQuery) (*ListDeliveriesResult, error) {
+	filter := webhook.DeliveryFilter{
+		SubscriptionID: query.SubscriptionID,
+		EventID:        query.EventID,
+		Page:           query.Page,
+		PageSize:       query.PageSize,
+	}
+
+	if query.Status != nil {
+		status, err := webhook.NewStatus(*query.Status)
+		if err != nil {
+			return nil, err
+		}
+		filter.Status = &status
+	}
+
+	deliveries, total, err := h.repo.ListDeliveries(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListDeliveriesResult{
+		Deliveries: deliveries,
+		Total:      total,
+	}, nil
+}
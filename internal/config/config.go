@@ -11,10 +11,18 @@ import (
 
 // Config holds all application configuration.
 type Config struct {
-	Server   ServerConfig
-	Database DatabaseConfig
-	Redis    RedisConfig
-	Jaeger   JaegerConfig
+	Server     ServerConfig
+	Database   DatabaseConfig
+	Redis      RedisConfig
+	Jaeger     JaegerConfig
+	Auth       AuthConfig
+	RBAC       RBACConfig       `mapstructure:"rbac"`
+	RateLimit  RateLimitConfig  `mapstructure:"rate_limit"`
+	Pagination PaginationConfig `mapstructure:"pagination"`
+	Outbox     OutboxConfig     `mapstructure:"outbox"`
+	Watch      WatchConfig      `mapstructure:"watch"`
+	Webhook    WebhookConfig    `mapstructure:"webhook"`
+	Approval   ApprovalConfig   `mapstructure:"approval"`
 }
 
 // ServerConfig holds gRPC and HTTP server configuration.
@@ -39,10 +47,11 @@ type DatabaseConfig struct {
 
 // RedisConfig holds Redis cache configuration.
 type RedisConfig struct {
-	Host     string `mapstructure:"host"`
-	Port     int    `mapstructure:"port"`
-	Password string `mapstructure:"password"`
-	DB       int    `mapstructure:"db"`
+	Host     string        `mapstructure:"host"`
+	Port     int           `mapstructure:"port"`
+	Password string        `mapstructure:"password"`
+	DB       int           `mapstructure:"db"`
+	CacheTTL time.Duration `mapstructure:"cache_ttl"`
 }
 
 // JaegerConfig holds Jaeger tracing configuration.
@@ -51,6 +60,110 @@ type JaegerConfig struct {
 	Endpoint string `mapstructure:"endpoint"`
 }
 
+// AuthConfig holds bearer-token authentication configuration.
+// Tokens are verified against the JWKS endpoint when JWKSURL is set,
+// otherwise against the static PEM public key or the HMAC secret.
+type AuthConfig struct {
+	Enabled             bool          `mapstructure:"enabled"`
+	Issuer              string        `mapstructure:"issuer"`
+	Audience            string        `mapstructure:"audience"`
+	JWKSURL             string        `mapstructure:"jwks_url"`
+	JWKSRefreshInterval time.Duration `mapstructure:"jwks_refresh_interval"`
+	PublicKeyFile       string        `mapstructure:"public_key_file"`
+	HMACSecret          string        `mapstructure:"hmac_secret"`
+	RolesClaim          string        `mapstructure:"roles_claim"`
+	Leeway              time.Duration `mapstructure:"leeway"`
+}
+
+// RBACConfig holds role-based authorization configuration.
+// It only takes effect when authentication is enabled.
+type RBACConfig struct {
+	Enabled bool         `mapstructure:"enabled"`
+	Roles   []RoleConfig `mapstructure:"roles"`
+}
+
+// RoleConfig maps a token role to permissions, given as full gRPC method
+// names or patterns such as "/costing.v1.*/Get*".
+type RoleConfig struct {
+	Name        string   `mapstructure:"name"`
+	Inherits    []string `mapstructure:"inherits"`
+	Permissions []string `mapstructure:"permissions"`
+}
+
+// RateLimitConfig holds per-caller rate limiting configuration.
+type RateLimitConfig struct {
+	Enabled           bool                `mapstructure:"enabled"`
+	Distributed       bool                `mapstructure:"distributed"` // share buckets across replicas via Redis
+	RequestsPerSecond float64             `mapstructure:"requests_per_second"`
+	Burst             int                 `mapstructure:"burst"`
+	TrustedProxies    int                 `mapstructure:"trusted_proxies"`
+	GatewayNetworks   []string            `mapstructure:"gateway_networks"` // CIDRs of gateways besides loopback
+	Methods           []MethodLimitConfig `mapstructure:"methods"`
+}
+
+// MethodLimitConfig overrides the rate limit of one full gRPC method name.
+type MethodLimitConfig struct {
+	Method            string  `mapstructure:"method"`
+	RequestsPerSecond float64 `mapstructure:"requests_per_second"`
+	Burst             int     `mapstructure:"burst"`
+}
+
+// PaginationConfig holds list pagination configuration.
+type PaginationConfig struct {
+	// TokenSecret signs page tokens; replicas must share it. When empty a
+	// random secret is generated at startup.
+	TokenSecret string `mapstructure:"token_secret"`
+}
+
+// OutboxConfig holds the domain event relay configuration.
+type OutboxConfig struct {
+	Enabled   bool                `mapstructure:"enabled"`
+	Publisher string              `mapstructure:"publisher"` // log, webhook or nats
+	Interval  time.Duration       `mapstructure:"interval"`
+	BatchSize int                 `mapstructure:"batch_size"`
+	Lease     time.Duration       `mapstructure:"lease"`
+	Timeout   time.Duration       `mapstructure:"timeout"` // per publish
+	Webhook   OutboxWebhookConfig `mapstructure:"webhook"`
+	NATS      OutboxNATSConfig    `mapstructure:"nats"`
+}
+
+// OutboxWebhookConfig holds the webhook publisher configuration.
+type OutboxWebhookConfig struct {
+	URL string `mapstructure:"url"`
+}
+
+// OutboxNATSConfig holds the NATS publisher configuration.
+type OutboxNATSConfig struct {
+	URL           string `mapstructure:"url"`
+	SubjectPrefix string `mapstructure:"subject_prefix"`
+}
+
+// WatchConfig holds the change feed configuration of the Watch RPCs.
+type WatchConfig struct {
+	// PollInterval bounds the delay of a change when a notification is missed.
+	PollInterval time.Duration `mapstructure:"poll_interval"`
+}
+
+// WebhookConfig holds the webhook subscription dispatcher configuration.
+type WebhookConfig struct {
+	Enabled        bool          `mapstructure:"enabled"`
+	Interval       time.Duration `mapstructure:"interval"`
+	BatchSize      int           `mapstructure:"batch_size"`
+	Lease          time.Duration `mapstructure:"lease"`
+	Timeout        time.Duration `mapstructure:"timeout"` // per delivery attempt
+	MaxAttempts    int           `mapstructure:"max_attempts"`
+	InitialBackoff time.Duration `mapstructure:"initial_backoff"`
+	MaxBackoff     time.Duration `mapstructure:"max_backoff"`
+}
+
+// ApprovalConfig holds the four-eyes review policy of parameter changes.
+// Edits of parameters it covers, before or after the edit, are held as
+// change requests until someone other than their author approves them.
+type ApprovalConfig struct {
+	Categories []string `mapstructure:"categories"` // parameter categories under review
+	Mandatory  bool     `mapstructure:"mandatory"`  // review mandatory parameters of any category
+}
+
 // Load loads configuration from file and environment variables.
 func Load() (*Config, error) {
 	viper.SetConfigName("config")
@@ -104,10 +217,120 @@ func setDefaults() {
 	viper.SetDefault("redis.port", 6379)
 	viper.SetDefault("redis.password", "")
 	viper.SetDefault("redis.db", 0)
+	viper.SetDefault("redis.cache_ttl", 5*time.Minute)
 
 	// Jaeger defaults
 	viper.SetDefault("jaeger.enabled", false)
 	viper.SetDefault("jaeger.endpoint", "http://localhost:14268/api/traces")
+
+	// Auth defaults
+	viper.SetDefault("auth.enabled", false)
+	viper.SetDefault("auth.issuer", "")
+	viper.SetDefault("auth.audience", "")
+	viper.SetDefault("auth.jwks_url", "")
+	viper.SetDefault("auth.jwks_refresh_interval", 15*time.Minute)
+	viper.SetDefault("auth.public_key_file", "")
+	viper.SetDefault("auth.hmac_secret", "")
+	viper.SetDefault("auth.roles_claim", "roles")
+	viper.SetDefault("auth.leeway", 30*time.Second)
+
+	// Rate limit defaults
+	viper.SetDefault("rate_limit.enabled", true)
+	viper.SetDefault("rate_limit.distributed", true)
+	viper.SetDefault("rate_limit.requests_per_second", 20)
+	viper.SetDefault("rate_limit.burst", 40)
+	viper.SetDefault("rate_limit.trusted_proxies", 0)
+	viper.SetDefault("rate_limit.gateway_networks", []string{})
+	viper.SetDefault("rate_limit.methods", []map[string]interface{}{
+		{"method": "/costing.v1.CostingService/CalculateCost", "requests_per_second": 5, "burst": 10},
+		{"method": "/costing.v1.UOMService/BatchUpsertUOMs", "requests_per_second": 1, "burst": 2},
+		{"method": "/costing.v1.ParameterService/BatchUpsertParameters", "requests_per_second": 1, "burst": 2},
+		{"method": "/costing.v1.UOMService/ImportUOMs", "requests_per_second": 1, "burst": 2},
+		{"method": "/costing.v1.ParameterService/ImportParameters", "requests_per_second": 1, "burst": 2},
+		{"method": "/costing.v1.UOMService/ExportUOMs", "requests_per_second": 1, "burst": 2},
+		{"method": "/costing.v1.ParameterService/ExportParameters", "requests_per_second": 1, "burst": 2},
+	})
+
+	// Pagination defaults
+	viper.SetDefault("pagination.token_secret", "")
+
+	// Outbox defaults
+	viper.SetDefault("outbox.enabled", true)
+	viper.SetDefault("outbox.publisher", "log")
+	viper.SetDefault("outbox.interval", time.Second)
+	viper.SetDefault("outbox.batch_size", 100)
+	viper.SetDefault("outbox.lease", 30*time.Second)
+	viper.SetDefault("outbox.timeout", 10*time.Second)
+	viper.SetDefault("outbox.webhook.url", "")
+	viper.SetDefault("outbox.nats.url", "nats://localhost:4222")
+	viper.SetDefault("outbox.nats.subject_prefix", "costing")
+
+	// Watch defaults
+	viper.SetDefault("watch.poll_interval", 5*time.Second)
+
+	// Webhook defaults
+	viper.SetDefault("webhook.enabled", true)
+	viper.SetDefault("webhook.interval", time.Second)
+	viper.SetDefault("webhook.batch_size", 20)
+	viper.SetDefault("webhook.lease", 5*time.Minute)
+	viper.SetDefault("webhook.timeout", 10*time.Second)
+	viper.SetDefault("webhook.max_attempts", 8)
+	viper.SetDefault("webhook.initial_backoff", time.Minute)
+	viper.SetDefault("webhook.max_backoff", time.Hour)
+
+	// Approval defaults
+	viper.SetDefault("approval.categories", []string{"MACHINE", "PROCESS"})
+	viper.SetDefault("approval.mandatory", true)
+
+	// RBAC defaults
+	viper.SetDefault("rbac.enabled", false)
+	viper.SetDefault("rbac.roles", []map[string]interface{}{
+		{
+			"name": "viewer",
+			"permissions": []string{
+				"/costing.v1.*/Get*",
+				"/costing.v1.*/List*",
+				"/costing.v1.*/Export*",
+				"/costing.v1.*/Watch*",
+				"/costing.v1.UOMService/ConvertQuantity",
+				"/costing.v1.CostingService/CalculateCost",
+			},
+		},
+		{
+			"name":     "costing_engineer",
+			"inherits": []string{"viewer"},
+			"permissions": []string{
+				"/costing.v1.ParameterService/*",
+				"/costing.v1.ParameterValueService/*",
+				"/costing.v1.MaterialService/*",
+				"/costing.v1.MachineService/*",
+				"/costing.v1.UOMService/CreateUOM",
+				"/costing.v1.UOMService/UpdateUOM",
+				"/costing.v1.UOMService/BatchUpsertUOMs",
+				"/costing.v1.UOMService/ImportUOMs",
+				"/costing.v1.UOMService/CreateConversion",
+			},
+		},
+		{
+			"name":        "parameter_approver",
+			"inherits":    []string{"viewer"},
+			"permissions": []string{"/costing.v1.ParameterChangeService/*"},
+		},
+		{
+			"name":        "admin",
+			"permissions": []string{"/*/*"},
+		},
+	})
+}
+
+// EffectiveApproval returns the approval policy to enforce. Without
+// authentication every caller is the system actor, who could never approve
+// a request they made, so no edit is held for review.
+func (c *Config) EffectiveApproval() ApprovalConfig {
+	if !c.Auth.Enabled {
+		return ApprovalConfig{}
+	}
+	return c.Approval
 }
 
 // DSN returns the PostgreSQL connection string.
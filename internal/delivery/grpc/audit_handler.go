@@ -0,0 +1,208 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/protobuf/types/known/structpb"
+
+	pb "github.com/homindolenern/goapps-costing-v1/gen/go/costing/v1"
+	appaudit "github.com/homindolenern/goapps-costing-v1/internal/application/audit"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/audit"
+)
+
+// AuditHandler implements the gRPC AuditService.
+type AuditHandler struct {
+	pb.UnimplementedAuditServiceServer
+	listHandler *appaudit.ListHandler
+	validator   *ValidationHelper
+}
+
+// NewAuditHandler creates a new Audit handler.
+func NewAuditHandler(listHandler *appaudit.ListHandler, validator *ValidationHelper) *AuditHandler {
+	return &AuditHandler{
+		listHandler: listHandler,
+		validator:   validator,
+	}
+}
+
+// ListAuditEvents retrieves a paginated list of audit events.
+func (h *AuditHandler) ListAuditEvents(ctx context.Context, req *pb.ListAuditEventsRequest) (*pb.ListAuditEventsResponse, error) {
+	// Validate request
+	if validationResp := h.validator.Validate(ctx, req); validationResp != nil {
+		return &pb.ListAuditEventsResponse{Base: validationResp}, nil
+	}
+
+	query := appaudit.ListQuery{
+		EntityCode: req.EntityCode,
+		ChangedBy:  req.ChangedBy,
+		From:       req.From,
+		To:         req.To,
+		Page:       int(req.Page),
+		PageSize:   int(req.PageSize),
+	}
+
+	if req.EntityType != nil && *req.EntityType != pb.AuditEntityType_AUDIT_ENTITY_TYPE_UNSPECIFIED {
+		entityType := pbAuditEntityTypeToString(*req.EntityType)
+		query.EntityType = &entityType
+	}
+
+	result, err := h.listHandler.Handle(ctx, query)
+	if err != nil {
+		return &pb.ListAuditEventsResponse{
+			Base: auditErrorToBaseResponse(err),
+		}, nil
+	}
+
+	data := make([]*pb.AuditEvent, 0, len(result.Events))
+	for _, event := range result.Events {
+		msg, err := auditEventToProto(event)
+		if err != nil {
+			return &pb.ListAuditEventsResponse{
+				Base: auditErrorToBaseResponse(err),
+			}, nil
+		}
+		data = append(data, msg)
+	}
+
+	totalPages := int32(result.Total) / req.PageSize
+	if int32(result.Total)%req.PageSize > 0 {
+		totalPages++
+	}
+
+	return &pb.ListAuditEventsResponse{
+		Base: successResponse("Audit events retrieved successfully"),
+		Data: data,
+		Pagination: &pb.PaginationMeta{
+			CurrentPage: req.Page,
+			PageSize:    req.PageSize,
+			TotalItems:  result.Total,
+			TotalPages:  totalPages,
+		},
+	}, nil
+}
+
+// Helper functions.
+
+func pbAuditEntityTypeToString(t pb.AuditEntityType) string {
+	switch t {
+	case pb.AuditEntityType_AUDIT_ENTITY_TYPE_UOM:
+		return "UOM"
+	case pb.AuditEntityType_AUDIT_ENTITY_TYPE_UOM_CONVERSION:
+		return "UOM_CONVERSION"
+	case pb.AuditEntityType_AUDIT_ENTITY_TYPE_PARAMETER:
+		return "PARAMETER"
+	case pb.AuditEntityType_AUDIT_ENTITY_TYPE_PARAMETER_VALUE:
+		return "PARAMETER_VALUE"
+	case pb.AuditEntityType_AUDIT_ENTITY_TYPE_MATERIAL:
+		return "MATERIAL"
+	case pb.AuditEntityType_AUDIT_ENTITY_TYPE_MACHINE_TYPE:
+		return "MACHINE_TYPE"
+	case pb.AuditEntityType_AUDIT_ENTITY_TYPE_MACHINE:
+		return "MACHINE"
+	case pb.AuditEntityType_AUDIT_ENTITY_TYPE_UNSPECIFIED:
+		return ""
+	}
+	return ""
+}
+
+func stringToPbAuditEntityType(t string) pb.AuditEntityType {
+	switch t {
+	case "UOM":
+		return pb.AuditEntityType_AUDIT_ENTITY_TYPE_UOM
+	case "UOM_CONVERSION":
+		return pb.AuditEntityType_AUDIT_ENTITY_TYPE_UOM_CONVERSION
+	case "PARAMETER":
+		return pb.AuditEntityType_AUDIT_ENTITY_TYPE_PARAMETER
+	case "PARAMETER_VALUE":
+		return pb.AuditEntityType_AUDIT_ENTITY_TYPE_PARAMETER_VALUE
+	case "MATERIAL":
+		return pb.AuditEntityType_AUDIT_ENTITY_TYPE_MATERIAL
+	case "MACHINE_TYPE":
+		return pb.AuditEntityType_AUDIT_ENTITY_TYPE_MACHINE_TYPE
+	case "MACHINE":
+		return pb.AuditEntityType_AUDIT_ENTITY_TYPE_MACHINE
+	default:
+		return pb.AuditEntityType_AUDIT_ENTITY_TYPE_UNSPECIFIED
+	}
+}
+
+func stringToPbAuditAction(a string) pb.AuditAction {
+	switch a {
+	case "CREATE":
+		return pb.AuditAction_AUDIT_ACTION_CREATE
+	case "UPDATE":
+		return pb.AuditAction_AUDIT_ACTION_UPDATE
+	case "DELETE":
+		return pb.AuditAction_AUDIT_ACTION_DELETE
+	default:
+		return pb.AuditAction_AUDIT_ACTION_UNSPECIFIED
+	}
+}
+
+func auditEventToProto(event *audit.Event) (*pb.AuditEvent, error) {
+	msg := &pb.AuditEvent{
+		Id:         event.ID(),
+		EntityType: stringToPbAuditEntityType(event.EntityType().String()),
+		EntityCode: event.EntityCode(),
+		Action:     stringToPbAuditAction(event.Action().String()),
+		ChangedBy:  event.ChangedBy(),
+		OccurredAt: event.OccurredAt().Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	var err error
+	if event.Before() != nil {
+		if msg.Before, err = structpb.NewStruct(event.Before()); err != nil {
+			return nil, err
+		}
+	}
+	if event.After() != nil {
+		if msg.After, err = structpb.NewStruct(event.After()); err != nil {
+			return nil, err
+		}
+	}
+
+	if msg.Changes, err = fieldChangesToProto(event.Changes()); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+func fieldChangesToProto(changes []audit.Change) ([]*pb.FieldChange, error) {
+	result := make([]*pb.FieldChange, 0, len(changes))
+	for _, c := range changes {
+		before, err := structpb.NewValue(c.Before)
+		if err != nil {
+			return nil, err
+		}
+		after, err := structpb.NewValue(c.After)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, &pb.FieldChange{
+			Field:  c.Field,
+			Before: before,
+			After:  after,
+		})
+	}
+	return result, nil
+}
+
+func auditErrorToBaseResponse(err error) *pb.BaseResponse {
+	statusCode := "500"
+	message := "Internal server error"
+
+	switch {
+	case errors.Is(err, audit.ErrInvalidEntityType),
+		errors.Is(err, audit.ErrInvalidTimeRange):
+		statusCode = "400"
+		message = err.Error()
+	}
+
+	return &pb.BaseResponse{
+		StatusCode: statusCode,
+		IsSuccess:  false,
+		Message:    message,
+	}
+}
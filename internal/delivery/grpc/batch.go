@@ -0,0 +1,98 @@
+package grpc
+
+import (
+	"fmt"
+
+	pb "github.com/homindolenern/goapps-costing-v1/gen/go/costing/v1"
+	"github.com/homindolenern/goapps-costing-v1/internal/application/batch"
+)
+
+// maxBatchItems caps the number of items in a batch upsert.
+const maxBatchItems = 500
+
+// batchSizeResponse rejects an empty or oversized batch. It returns nil if the size is fine.
+func batchSizeResponse(size int) *pb.BaseResponse {
+	if size >= 1 && size <= maxBatchItems {
+		return nil
+	}
+	return &pb.BaseResponse{
+		StatusCode: "400",
+		IsSuccess:  false,
+		Message:    "Validation failed",
+		ValidationErrors: []*pb.ValidationError{
+			{Field: "items", Message: fmt.Sprintf("must contain 1 to %d items", maxBatchItems)},
+		},
+	}
+}
+
+func batchStatusToProto(status batch.Status) pb.BatchItemStatus {
+	switch status {
+	case batch.StatusCreated:
+		return pb.BatchItemStatus_BATCH_ITEM_STATUS_CREATED
+	case batch.StatusUpdated:
+		return pb.BatchItemStatus_BATCH_ITEM_STATUS_UPDATED
+	case batch.StatusFailed:
+		return pb.BatchItemStatus_BATCH_ITEM_STATUS_FAILED
+	case batch.StatusSkipped:
+		return pb.BatchItemStatus_BATCH_ITEM_STATUS_SKIPPED
+	default:
+		return pb.BatchItemStatus_BATCH_ITEM_STATUS_UNSPECIFIED
+	}
+}
+
+// batchItemBase describes the outcome of one item. errorToBase maps the
+// item's error for failed items.
+func batchItemBase(status batch.Status, err error, entityName string, errorToBase func(error) *pb.BaseResponse) *pb.BaseResponse {
+	switch status {
+	case batch.StatusCreated:
+		return &pb.BaseResponse{StatusCode: "200", IsSuccess: true, Message: entityName + " created successfully"}
+	case batch.StatusUpdated:
+		return &pb.BaseResponse{StatusCode: "200", IsSuccess: true, Message: entityName + " updated successfully"}
+	case batch.StatusSkipped:
+		return &pb.BaseResponse{StatusCode: "424", IsSuccess: false, Message: "Not saved because another item failed"}
+	default:
+		return errorToBase(err)
+	}
+}
+
+// newBatchSummary counts the item outcomes.
+func newBatchSummary(statuses []pb.BatchItemStatus) *pb.BatchSummary {
+	summary := &pb.BatchSummary{Total: int32(len(statuses))}
+	for _, status := range statuses {
+		switch status {
+		case pb.BatchItemStatus_BATCH_ITEM_STATUS_CREATED:
+			summary.Created++
+		case pb.BatchItemStatus_BATCH_ITEM_STATUS_UPDATED:
+			summary.Updated++
+		case pb.BatchItemStatus_BATCH_ITEM_STATUS_FAILED:
+			summary.Failed++
+		case pb.BatchItemStatus_BATCH_ITEM_STATUS_SKIPPED:
+			summary.Skipped++
+		}
+	}
+	return summary
+}
+
+// batchResponseBase describes the outcome of the whole batch.
+func batchResponseBase(summary *pb.BatchSummary, atomic bool) *pb.BaseResponse {
+	switch {
+	case summary.Failed == 0:
+		return &pb.BaseResponse{
+			StatusCode: "200",
+			IsSuccess:  true,
+			Message:    fmt.Sprintf("Batch saved: %d created, %d updated", summary.Created, summary.Updated),
+		}
+	case atomic:
+		return &pb.BaseResponse{
+			StatusCode: "400",
+			IsSuccess:  false,
+			Message:    fmt.Sprintf("Batch rejected, nothing was saved: %d of %d items failed", summary.Failed, summary.Total),
+		}
+	default:
+		return &pb.BaseResponse{
+			StatusCode: "207",
+			IsSuccess:  false,
+			Message:    fmt.Sprintf("Batch partially saved: %d of %d items failed", summary.Failed, summary.Total),
+		}
+	}
+}
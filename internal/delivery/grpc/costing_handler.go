@@ -0,0 +1,185 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/homindolenern/goapps-costing-v1/gen/go/costing/v1"
+	appcosting "github.com/homindolenern/goapps-costing-v1/internal/application/costing"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/costing"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/parameter"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/parametervalue"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/uom"
+)
+
+// CostingHandler implements the gRPC CostingService.
+type CostingHandler struct {
+	pb.UnimplementedCostingServiceServer
+	calculateHandler *appcosting.CalculateHandler
+	validator        *ValidationHelper
+}
+
+// NewCostingHandler creates a new Costing handler.
+func NewCostingHandler(
+	calculateHandler *appcosting.CalculateHandler,
+	validator *ValidationHelper,
+) *CostingHandler {
+	return &CostingHandler{
+		calculateHandler: calculateHandler,
+		validator:        validator,
+	}
+}
+
+// CalculateCost returns the cost breakdown of a product recipe.
+func (h *CostingHandler) CalculateCost(ctx context.Context, req *pb.CalculateCostRequest) (*pb.CalculateCostResponse, error) {
+	// Validate request
+	if validationResp := h.validator.Validate(ctx, req); validationResp != nil {
+		return &pb.CalculateCostResponse{Base: validationResp}, nil
+	}
+
+	query := appcosting.CalculateQuery{
+		ProductCode:     req.ProductCode,
+		OutputQuantity:  req.OutputQuantity,
+		OutputUOMCode:   req.OutputUomCode,
+		ElectricityRate: req.ElectricityRate,
+		AsOf:            req.AsOf,
+		Materials:       make([]appcosting.MaterialInput, len(req.Materials)),
+		Steps:           make([]appcosting.StepInput, len(req.Steps)),
+	}
+	for i, m := range req.Materials {
+		query.Materials[i] = appcosting.MaterialInput{
+			MaterialCode: m.MaterialCode,
+			Quantity:     m.Quantity,
+			UOMCode:      m.UomCode,
+			UnitPrice:    m.UnitPrice,
+			PriceUOMCode: m.PriceUomCode,
+			WastePercent: m.WastePercent,
+		}
+	}
+	for i, s := range req.Steps {
+		params := make([]appcosting.StepParameterInput, len(s.Parameters))
+		for j, p := range s.Parameters {
+			params[j] = appcosting.StepParameterInput{ParameterCode: p.ParameterCode, Value: p.Value}
+		}
+		query.Steps[i] = appcosting.StepInput{
+			StepCode:            s.StepCode,
+			MachineCode:         s.MachineCode,
+			OutputUOMCode:       s.OutputUomCode,
+			Parameters:          params,
+			LaborRatePerHour:    s.LaborRatePerHour,
+			OverheadRatePerHour: s.OverheadRatePerHour,
+		}
+	}
+
+	breakdown, err := h.calculateHandler.Handle(ctx, query)
+	if err != nil {
+		return &pb.CalculateCostResponse{
+			Base: costingErrorToBaseResponse(err),
+		}, nil
+	}
+
+	return &pb.CalculateCostResponse{
+		Base: successResponse("Cost calculated successfully"),
+		Data: breakdownToProto(breakdown),
+	}, nil
+}
+
+// Helper functions.
+
+func stringToPbComponentType(t costing.ComponentType) pb.CostComponentType {
+	switch t {
+	case costing.ComponentTypeMaterial:
+		return pb.CostComponentType_COST_COMPONENT_TYPE_MATERIAL
+	case costing.ComponentTypeEnergy:
+		return pb.CostComponentType_COST_COMPONENT_TYPE_ENERGY
+	case costing.ComponentTypeLabor:
+		return pb.CostComponentType_COST_COMPONENT_TYPE_LABOR
+	case costing.ComponentTypeOverhead:
+		return pb.CostComponentType_COST_COMPONENT_TYPE_OVERHEAD
+	default:
+		return pb.CostComponentType_COST_COMPONENT_TYPE_UNSPECIFIED
+	}
+}
+
+func breakdownToProto(b *costing.Breakdown) *pb.CostBreakdown {
+	components := make([]*pb.CostComponent, len(b.Components))
+	for i, c := range b.Components {
+		components[i] = &pb.CostComponent{
+			Type:        stringToPbComponentType(c.Type),
+			Reference:   c.Reference,
+			Description: c.Description,
+			Quantity:    c.Quantity,
+			UomCode:     c.UOM,
+			UnitCost:    c.UnitCost,
+			Amount:      c.Amount,
+		}
+	}
+
+	steps := make([]*pb.StepResult, len(b.Steps))
+	for i, s := range b.Steps {
+		steps[i] = &pb.StepResult{
+			StepCode:       s.StepCode,
+			MachineCode:    s.MachineCode,
+			Output:         s.Output,
+			OutputUomCode:  s.OutputUOM.String(),
+			ProductionRate: s.ProductionRate,
+			Hours:          s.Hours,
+			EnergyKwh:      s.EnergyKWh,
+		}
+	}
+
+	return &pb.CostBreakdown{
+		ProductCode:    b.ProductCode,
+		OutputQuantity: b.OutputQuantity,
+		OutputUomCode:  b.OutputUOM.String(),
+		Components:     components,
+		Steps:          steps,
+		MaterialCost:   b.MaterialCost,
+		ProcessCost:    b.ProcessCost,
+		TotalCost:      b.TotalCost,
+		UnitCost:       b.UnitCost,
+	}
+}
+
+func costingErrorToBaseResponse(err error) *pb.BaseResponse {
+	statusCode := "500"
+	message := "Internal server error"
+
+	switch {
+	case errors.Is(err, uom.ErrNotFound),
+		errors.Is(err, parameter.ErrNotFound):
+		statusCode = "404"
+		message = err.Error()
+	case errors.Is(err, costing.ErrEmptyProductCode),
+		errors.Is(err, costing.ErrInvalidOutputQuantity),
+		errors.Is(err, costing.ErrEmptyRecipe),
+		errors.Is(err, costing.ErrEmptyMaterialCode),
+		errors.Is(err, costing.ErrInvalidMaterialQty),
+		errors.Is(err, costing.ErrNegativePrice),
+		errors.Is(err, costing.ErrInvalidWaste),
+		errors.Is(err, costing.ErrEmptyStepCode),
+		errors.Is(err, costing.ErrDuplicateStep),
+		errors.Is(err, costing.ErrNegativeRate),
+		errors.Is(err, costing.ErrMissingProductionRate),
+		errors.Is(err, costing.ErrInvalidProductionRate),
+		errors.Is(err, costing.ErrInvalidEfficiency),
+		errors.Is(err, costing.ErrParameterNotNumeric),
+		errors.Is(err, costing.ErrDuplicateStepParameter),
+		errors.Is(err, uom.ErrInvalidUOMCode),
+		errors.Is(err, uom.ErrConversionNotFound),
+		errors.Is(err, uom.ErrAmbiguousConversion),
+		errors.Is(err, parameter.ErrInvalidCode),
+		errors.Is(err, parameter.ErrInvalidAsOf),
+		errors.Is(err, parametervalue.ErrParameterInactive),
+		errors.Is(err, parametervalue.ErrValueBelowMin),
+		errors.Is(err, parametervalue.ErrValueAboveMax):
+		statusCode = "400"
+		message = err.Error()
+	}
+
+	return &pb.BaseResponse{
+		StatusCode: statusCode,
+		IsSuccess:  false,
+		Message:    message,
+	}
+}
@@ -0,0 +1,42 @@
+package grpc
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Metadata keys carrying entity versions. The HTTP gateway maps them to the
+// ETag response header and the If-Match request header.
+const (
+	ETagKey    = "etag"
+	IfMatchKey = "if-match"
+)
+
+// setETag returns the entity version to the caller as an ETag.
+func setETag(ctx context.Context, version int) {
+	_ = grpc.SetHeader(ctx, metadata.Pairs(ETagKey, strconv.Quote(strconv.Itoa(version))))
+}
+
+// versionFromIfMatch reads the expected version from an If-Match header such
+// as "3" or W/"3". It returns 0 when the header is missing or malformed.
+func versionFromIfMatch(ctx context.Context) int32 {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return 0
+	}
+	values := md.Get(IfMatchKey)
+	if len(values) == 0 {
+		return 0
+	}
+
+	tag := strings.TrimPrefix(strings.TrimSpace(values[0]), "W/")
+	version, err := strconv.ParseInt(strings.Trim(tag, `"`), 10, 32)
+	if err != nil {
+		return 0
+	}
+	return int32(version)
+}
@@ -0,0 +1,174 @@
+package grpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	pb "github.com/homindolenern/goapps-costing-v1/gen/go/costing/v1"
+	"github.com/homindolenern/goapps-costing-v1/pkg/spreadsheet"
+)
+
+// exportChunkSize is the size of the chunks an export is streamed in.
+const exportChunkSize = 32 << 10
+
+// exportStream is the server side of an export RPC.
+type exportStream interface {
+	Send(*pb.ExportChunk) error
+}
+
+// chunkWriter buffers an export and sends it in chunks.
+type chunkWriter struct {
+	stream exportStream
+	buf    []byte
+}
+
+func (c *chunkWriter) Write(p []byte) (int, error) {
+	c.buf = append(c.buf, p...)
+	if len(c.buf) >= exportChunkSize {
+		return len(p), c.Flush()
+	}
+	return len(p), nil
+}
+
+// Flush sends the buffered bytes. Send marshals the chunk before it
+// returns, so the buffer can be reused.
+func (c *chunkWriter) Flush() error {
+	if len(c.buf) == 0 {
+		return nil
+	}
+	err := c.stream.Send(&pb.ExportChunk{Data: c.buf})
+	c.buf = c.buf[:0]
+	return err
+}
+
+// exportWriter writes exported records in the requested format. CSV and
+// XLSX files have one column per field of the Create request, named and
+// formatted as the import expects, so an export can be edited and imported
+// again. JSON files are an array of the records as returned by List.
+type exportWriter[T proto.Message] struct {
+	chunks  *chunkWriter
+	rows    spreadsheet.Writer // nil for JSON
+	columns []protoreflect.Name
+	count   int
+}
+
+func newExportWriter[T proto.Message](stream exportStream, format pb.FileFormat, createReq proto.Message) (*exportWriter[T], error) {
+	w := &exportWriter[T]{chunks: &chunkWriter{stream: stream}}
+	if format == pb.FileFormat_FILE_FORMAT_JSON {
+		_, err := w.chunks.Write([]byte("["))
+		return w, err
+	}
+
+	sheetFormat, err := importFormat(format)
+	if err != nil {
+		return nil, err
+	}
+	if w.rows, err = spreadsheet.NewWriter(sheetFormat, w.chunks); err != nil {
+		return nil, err
+	}
+
+	fields := createReq.ProtoReflect().Descriptor().Fields()
+	header := make([]string, 0, fields.Len())
+	for i := 0; i < fields.Len(); i++ {
+		if fd := fields.Get(i); importableField(fd) {
+			w.columns = append(w.columns, fd.Name())
+			header = append(header, string(fd.Name()))
+		}
+	}
+	return w, w.rows.Write(header)
+}
+
+// Write adds a record to the file.
+func (w *exportWriter[T]) Write(record T) error {
+	w.count++
+	if w.rows == nil {
+		data, err := protojson.MarshalOptions{EmitUnpopulated: true}.Marshal(record)
+		if err != nil {
+			return err
+		}
+		// protojson varies its whitespace; compact it to one record per line
+		var buf bytes.Buffer
+		if w.count > 1 {
+			buf.WriteString(",")
+		}
+		buf.WriteString("\n")
+		if err := json.Compact(&buf, data); err != nil {
+			return err
+		}
+		_, err = w.chunks.Write(buf.Bytes())
+		return err
+	}
+
+	msg := record.ProtoReflect()
+	fields := msg.Descriptor().Fields()
+	row := make([]string, len(w.columns))
+	for i, name := range w.columns {
+		// Unset optional fields are empty cells; others are written even if zero
+		if fd := fields.ByName(name); fd != nil && (msg.Has(fd) || !fd.HasPresence()) {
+			row[i] = exportCell(msg.Get(fd), fd)
+		}
+	}
+	return w.rows.Write(row)
+}
+
+// Close completes the file and sends the rest of it.
+func (w *exportWriter[T]) Close() error {
+	var err error
+	if w.rows == nil {
+		_, err = w.chunks.Write([]byte("\n]\n"))
+	} else {
+		err = w.rows.Close()
+	}
+	if err != nil {
+		return err
+	}
+	return w.chunks.Flush()
+}
+
+// exportCell formats a field as setImportField parses it.
+func exportCell(value protoreflect.Value, fd protoreflect.FieldDescriptor) string {
+	switch {
+	case fd.IsList():
+		list := value.List()
+		items := make([]string, list.Len())
+		for i := range items {
+			items[i] = list.Get(i).String()
+		}
+		return strings.Join(items, importListSeparator)
+	case fd.Kind() == protoreflect.BoolKind:
+		return strconv.FormatBool(value.Bool())
+	case fd.Kind() == protoreflect.DoubleKind:
+		return strconv.FormatFloat(value.Float(), 'g', -1, 64)
+	case fd.Kind() == protoreflect.EnumKind:
+		// Drop the type prefix shared with the UNSPECIFIED value, e.g. UOM_CATEGORY_
+		values := fd.Enum().Values()
+		prefix := strings.TrimSuffix(string(values.ByNumber(0).Name()), "UNSPECIFIED")
+		if v := values.ByNumber(value.Enum()); v != nil {
+			return strings.TrimPrefix(string(v.Name()), prefix)
+		}
+		return strconv.Itoa(int(value.Enum()))
+	default:
+		return value.String()
+	}
+}
+
+// exportError turns a BaseResponse into the status an export or watch
+// stream ends with. Validation failures keep the JSON form the gateway renders as a 400.
+func exportError(base *pb.BaseResponse) error {
+	if base.StatusCode != "400" {
+		return status.Error(codes.Internal, base.Message)
+	}
+	details, err := json.Marshal(base)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, base.Message)
+	}
+	return status.Error(codes.InvalidArgument, string(details))
+}
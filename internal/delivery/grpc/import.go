@@ -0,0 +1,341 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	pb "github.com/homindolenern/goapps-costing-v1/gen/go/costing/v1"
+	"github.com/homindolenern/goapps-costing-v1/pkg/spreadsheet"
+)
+
+const (
+	// maxImportBytes caps the size of an imported file.
+	maxImportBytes = 10 << 20
+	// maxImportRows caps the data rows of an import, which are saved in one transaction.
+	maxImportRows = 5000
+	// importListSeparator separates the values of a list cell, e.g. "S|Z".
+	importListSeparator = "|"
+)
+
+// importStream is the server side of an import RPC.
+type importStream interface {
+	Recv() (*pb.ImportRequest, error)
+}
+
+// receiveImport reads the options and the file of an import stream. A
+// non-nil BaseResponse rejects the import.
+func receiveImport(stream importStream) (*pb.ImportOptions, []byte, *pb.BaseResponse, error) {
+	first, err := stream.Recv()
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, nil, nil, err
+	}
+	opts := first.GetOptions()
+	if opts == nil {
+		return nil, nil, importErrorBase("options", "the first message must carry the import options"), nil
+	}
+	if _, err := importFormat(opts.Format); err != nil {
+		return nil, nil, importErrorBase("format", err.Error()), nil
+	}
+
+	var data []byte
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		if len(data)+len(req.GetChunk()) > maxImportBytes {
+			return nil, nil, importErrorBase("file", fmt.Sprintf("must not exceed %d MiB", maxImportBytes>>20)), nil
+		}
+		data = append(data, req.GetChunk()...)
+	}
+	if len(data) == 0 {
+		return nil, nil, importErrorBase("file", "must not be empty"), nil
+	}
+	return opts, data, nil, nil
+}
+
+func importFormat(format pb.FileFormat) (spreadsheet.Format, error) {
+	switch format {
+	case pb.FileFormat_FILE_FORMAT_CSV:
+		return spreadsheet.FormatCSV, nil
+	case pb.FileFormat_FILE_FORMAT_XLSX:
+		return spreadsheet.FormatXLSX, nil
+	default:
+		return "", spreadsheet.ErrUnsupportedFormat
+	}
+}
+
+func importErrorBase(field, message string) *pb.BaseResponse {
+	return &pb.BaseResponse{
+		StatusCode:       "400",
+		IsSuccess:        false,
+		Message:          "Validation failed",
+		ValidationErrors: []*pb.ValidationError{{Field: field, Message: message}},
+	}
+}
+
+// importRow is a data row decoded into a request message.
+type importRow[T proto.Message] struct {
+	line int             // row number in the file
+	req  T               // the row's cells
+	set  map[string]bool // fields with a non-empty cell
+}
+
+// importSheet is a decoded import file. Cells are mapped to the request
+// fields named by the header row, so a "Parameter Code" column fills
+// parameter_code. Rows that cannot be decoded or fail validation are
+// recorded in errors and left out of rows.
+type importSheet[T proto.Message] struct {
+	rows    []importRow[T]
+	errors  []*pb.ImportRowError
+	failed  map[int]bool      // lines with errors
+	headers map[string]string // field name to column header
+}
+
+// readImportSheet decodes and validates the rows of an import file. A
+// non-nil BaseResponse rejects the whole file.
+func readImportSheet[T proto.Message](
+	ctx context.Context,
+	v *ValidationHelper,
+	opts *pb.ImportOptions,
+	data []byte,
+	newReq func() T,
+) (*importSheet[T], *pb.BaseResponse) {
+	format, _ := importFormat(opts.Format)
+	// Stop reading past the header and one row over the limit
+	rows, err := spreadsheet.ReadRows(format, data, maxImportRows+1)
+	if errors.Is(err, spreadsheet.ErrTooManyRows) {
+		return nil, importErrorBase("file", fmt.Sprintf("must not have more than %d rows", maxImportRows))
+	}
+	if err != nil {
+		return nil, importErrorBase("file", err.Error())
+	}
+	if len(rows) == 0 {
+		return nil, importErrorBase("file", "must have a header row")
+	}
+
+	sheet := &importSheet[T]{failed: map[int]bool{}, headers: map[string]string{}}
+	fields := newReq().ProtoReflect().Descriptor().Fields()
+	columns := make([]protoreflect.FieldDescriptor, len(rows[0]))
+	for i, header := range rows[0] {
+		name := strings.NewReplacer(" ", "_", "-", "_").Replace(strings.ToLower(strings.TrimSpace(header)))
+		if name == "" {
+			continue
+		}
+		fd := fields.ByName(protoreflect.Name(name))
+		switch {
+		case fd == nil || !importableField(fd):
+			sheet.fail(1, header, "unknown column")
+		case sheet.headers[name] != "":
+			sheet.fail(1, header, "duplicate column")
+		default:
+			columns[i] = fd
+			sheet.headers[name] = header
+		}
+	}
+	if len(sheet.errors) > 0 {
+		return sheet, nil
+	}
+
+	count := 0
+	for i, cells := range rows[1:] {
+		line := i + 2
+		if blankRow(cells) {
+			continue
+		}
+		if count++; count > maxImportRows {
+			return nil, importErrorBase("file", fmt.Sprintf("must not have more than %d rows", maxImportRows))
+		}
+
+		row := importRow[T]{line: line, req: newReq(), set: map[string]bool{}}
+		msg := row.req.ProtoReflect()
+		for j, cell := range cells {
+			cell = strings.TrimSpace(cell)
+			if j >= len(columns) || columns[j] == nil {
+				if cell != "" {
+					sheet.fail(line, "", fmt.Sprintf("value %q in column %d has no header", cell, j+1))
+				}
+				continue
+			}
+			if cell == "" {
+				continue
+			}
+			fd := columns[j]
+			if err := setImportField(msg, fd, cell); err != nil {
+				sheet.fail(line, rows[0][j], err.Error())
+				continue
+			}
+			row.set[string(fd.Name())] = true
+		}
+		if sheet.failed[line] {
+			continue
+		}
+		if base := v.Validate(ctx, row.req); base != nil {
+			sheet.failBase(line, base)
+			continue
+		}
+		sheet.rows = append(sheet.rows, row)
+	}
+	if count == 0 && len(sheet.errors) == 0 {
+		return nil, importErrorBase("file", "has no data rows")
+	}
+	return sheet, nil
+}
+
+// importableField reports whether a field can be filled from a cell.
+func importableField(fd protoreflect.FieldDescriptor) bool {
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		return true
+	case protoreflect.BoolKind, protoreflect.DoubleKind, protoreflect.EnumKind:
+		return !fd.IsList()
+	default:
+		return false
+	}
+}
+
+// setImportField parses a cell into a field. Lists are separated by "|",
+// booleans accept yes/no, and enums accept their short name, e.g. MACHINE.
+func setImportField(msg protoreflect.Message, fd protoreflect.FieldDescriptor, cell string) error {
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		if !fd.IsList() {
+			msg.Set(fd, protoreflect.ValueOfString(cell))
+			return nil
+		}
+		list := msg.Mutable(fd).List()
+		for _, item := range strings.Split(cell, importListSeparator) {
+			if item = strings.TrimSpace(item); item != "" {
+				list.Append(protoreflect.ValueOfString(item))
+			}
+		}
+	case protoreflect.BoolKind:
+		switch strings.ToLower(cell) {
+		case "true", "yes", "y", "1":
+			msg.Set(fd, protoreflect.ValueOfBool(true))
+		case "false", "no", "n", "0":
+			msg.Set(fd, protoreflect.ValueOfBool(false))
+		default:
+			return fmt.Errorf("%q is not a boolean, use true or false", cell)
+		}
+	case protoreflect.DoubleKind:
+		f, err := strconv.ParseFloat(cell, 64)
+		if err != nil {
+			return fmt.Errorf("%q is not a number", cell)
+		}
+		msg.Set(fd, protoreflect.ValueOfFloat64(f))
+	case protoreflect.EnumKind:
+		name := strings.ToUpper(strings.ReplaceAll(cell, " ", "_"))
+		values := fd.Enum().Values()
+		for i := 0; i < values.Len(); i++ {
+			value := values.Get(i)
+			full := string(value.Name())
+			if value.Number() != 0 && (full == name || strings.HasSuffix(full, "_"+name)) {
+				msg.Set(fd, protoreflect.ValueOfEnum(value.Number()))
+				return nil
+			}
+		}
+		return fmt.Errorf("%q is not a valid value", cell)
+	}
+	return nil
+}
+
+func blankRow(cells []string) bool {
+	for _, cell := range cells {
+		if strings.TrimSpace(cell) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// fail records an error for a row. column is the header as written in the file.
+func (s *importSheet[T]) fail(line int, column, message string) {
+	s.failed[line] = true
+	s.errors = append(s.errors, &pb.ImportRowError{Row: int32(line), Column: column, Message: message})
+}
+
+// failBase records the validation errors of a row's BaseResponse, mapping
+// field names back to the column headers.
+func (s *importSheet[T]) failBase(line int, base *pb.BaseResponse) {
+	if len(base.ValidationErrors) == 0 {
+		s.fail(line, "", base.Message)
+		return
+	}
+	for _, ve := range base.ValidationErrors {
+		column := s.headers[ve.Field]
+		if column == "" {
+			column = ve.Field
+		}
+		s.fail(line, column, ve.Message)
+	}
+}
+
+// dryRun reports whether the rows must not be saved: on request, or because
+// some rows already failed and the import is all or nothing.
+func (s *importSheet[T]) dryRun(opts *pb.ImportOptions) bool {
+	return opts.DryRun || len(s.errors) > 0
+}
+
+// response describes the outcome of an import. statuses holds the result of
+// each decoded row, in order.
+func (s *importSheet[T]) response(opts *pb.ImportOptions, statuses []pb.BatchItemStatus) *pb.ImportResponse {
+	for i := range statuses {
+		// Rows that would have been saved are skipped when others failed
+		if len(s.errors) > 0 && statuses[i] != pb.BatchItemStatus_BATCH_ITEM_STATUS_FAILED {
+			statuses[i] = pb.BatchItemStatus_BATCH_ITEM_STATUS_SKIPPED
+		}
+	}
+	// Rows that failed before reaching the application are not in statuses
+	failed := 0
+	for line := range s.failed {
+		if line > 1 {
+			failed++
+		}
+	}
+	summary := newBatchSummary(statuses)
+	summary.Total += int32(failed) - summary.Failed
+	summary.Failed = int32(failed)
+
+	sort.SliceStable(s.errors, func(i, j int) bool { return s.errors[i].Row < s.errors[j].Row })
+
+	var base *pb.BaseResponse
+	switch {
+	case len(s.errors) > 0:
+		base = &pb.BaseResponse{
+			StatusCode: "400",
+			IsSuccess:  false,
+			Message:    fmt.Sprintf("Import rejected, nothing was saved: %d of %d rows have errors", summary.Failed, summary.Total),
+		}
+	case opts.DryRun:
+		base = &pb.BaseResponse{
+			StatusCode: "200",
+			IsSuccess:  true,
+			Message:    fmt.Sprintf("Import checked, nothing was saved: %d would be created, %d updated", summary.Created, summary.Updated),
+		}
+	default:
+		base = &pb.BaseResponse{
+			StatusCode: "200",
+			IsSuccess:  true,
+			Message:    fmt.Sprintf("Import saved: %d created, %d updated", summary.Created, summary.Updated),
+		}
+	}
+
+	return &pb.ImportResponse{
+		Base:    base,
+		DryRun:  opts.DryRun,
+		Summary: summary,
+		Errors:  s.errors,
+	}
+}
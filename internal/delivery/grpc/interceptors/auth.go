@@ -0,0 +1,96 @@
+package interceptors
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/homindolenern/goapps-costing-v1/pkg/auth"
+)
+
+// Auth returns a unary server interceptor that verifies the bearer token in
+// the "authorization" metadata and stores the principal in the context.
+// Methods whose full name starts with one of publicPrefixes skip authentication.
+// The gRPC-gateway forwards the HTTP Authorization header as that metadata.
+func Auth(verifier auth.Verifier, publicPrefixes ...string) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		for _, prefix := range publicPrefixes {
+			if strings.HasPrefix(info.FullMethod, prefix) {
+				return handler(ctx, req)
+			}
+		}
+
+		ctx, err := authenticate(ctx, verifier)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamAuth is the streaming counterpart of Auth.
+func StreamAuth(verifier auth.Verifier, publicPrefixes ...string) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		for _, prefix := range publicPrefixes {
+			if strings.HasPrefix(info.FullMethod, prefix) {
+				return handler(srv, ss)
+			}
+		}
+
+		ctx, err := authenticate(ss.Context(), verifier)
+		if err != nil {
+			return err
+		}
+		return handler(srv, withContext(ss, ctx))
+	}
+}
+
+// authenticate verifies the bearer token and returns ctx with the principal.
+func authenticate(ctx context.Context, verifier auth.Verifier) (context.Context, error) {
+	token, err := bearerToken(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	principal, err := verifier.Verify(ctx, token)
+	if err != nil {
+		if errors.Is(err, auth.ErrInvalidToken) {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+		return nil, status.Error(codes.Unavailable, "unable to verify token")
+	}
+
+	return auth.WithPrincipal(ctx, principal), nil
+}
+
+// bearerToken extracts the token from "authorization: Bearer <token>".
+func bearerToken(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", auth.ErrMissingToken
+	}
+
+	for _, value := range md.Get("authorization") {
+		scheme, token, found := strings.Cut(value, " ")
+		if found && strings.EqualFold(scheme, "Bearer") && strings.TrimSpace(token) != "" {
+			return strings.TrimSpace(token), nil
+		}
+	}
+
+	return "", auth.ErrMissingToken
+}
@@ -0,0 +1,71 @@
+package interceptors
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/homindolenern/goapps-costing-v1/pkg/auth"
+)
+
+// Authorization returns a unary server interceptor that checks the principal's
+// roles against the policy using the full method name as the permission.
+// It must run after Auth. Methods matching publicPrefixes are not checked.
+// Permissions that depend on the stored data, such as setting a base UOM,
+// are checked by the application with auth.Policy.Authorize.
+func Authorization(policy *auth.Policy, publicPrefixes ...string) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		for _, prefix := range publicPrefixes {
+			if strings.HasPrefix(info.FullMethod, prefix) {
+				return handler(ctx, req)
+			}
+		}
+
+		if err := authorize(ctx, policy, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamAuthorization is the streaming counterpart of Authorization.
+func StreamAuthorization(policy *auth.Policy, publicPrefixes ...string) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		for _, prefix := range publicPrefixes {
+			if strings.HasPrefix(info.FullMethod, prefix) {
+				return handler(srv, ss)
+			}
+		}
+
+		if err := authorize(ss.Context(), policy, info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+// authorize checks that the principal in ctx holds the permission.
+func authorize(ctx context.Context, policy *auth.Policy, permission string) error {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, auth.ErrMissingToken.Error())
+	}
+
+	if !policy.Allows(principal.Roles, permission) {
+		return status.Errorf(codes.PermissionDenied, "permission denied: %s", permission)
+	}
+	return nil
+}
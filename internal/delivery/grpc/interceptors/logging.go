@@ -23,30 +23,51 @@ func Logging() grpc.UnaryServerInterceptor {
 		// Call the handler
 		resp, err := handler(ctx, req)
 
-		// Get status code
-		code := codes.OK
-		if err != nil {
-			if st, ok := status.FromError(err); ok {
-				code = st.Code()
-			} else {
-				code = codes.Unknown
-			}
-		}
+		logCall(info.FullMethod, start, err)
+		return resp, err
+	}
+}
+
+// StreamLogging returns a stream server interceptor for logging.
+func StreamLogging() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		start := time.Now()
 
-		// Log the request
-		duration := time.Since(start)
-		logger := log.With().
-			Str("method", info.FullMethod).
-			Dur("duration", duration).
-			Str("code", code.String()).
-			Logger()
+		err := handler(srv, ss)
 
-		if err != nil {
-			logger.Error().Err(err).Msg("gRPC request failed")
+		logCall(info.FullMethod, start, err)
+		return err
+	}
+}
+
+// logCall logs a finished call with its duration and status code.
+func logCall(method string, start time.Time, err error) {
+	// Get status code
+	code := codes.OK
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			code = st.Code()
 		} else {
-			logger.Info().Msg("gRPC request completed")
+			code = codes.Unknown
 		}
+	}
 
-		return resp, err
+	// Log the request
+	duration := time.Since(start)
+	logger := log.With().
+		Str("method", method).
+		Dur("duration", duration).
+		Str("code", code.String()).
+		Logger()
+
+	if err != nil {
+		logger.Error().Err(err).Msg("gRPC request failed")
+	} else {
+		logger.Info().Msg("gRPC request completed")
 	}
 }
@@ -33,3 +33,27 @@ func Recovery() grpc.UnaryServerInterceptor {
 		return handler(ctx, req)
 	}
 }
+
+// StreamRecovery returns a stream server interceptor for panic recovery.
+func StreamRecovery() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				log.Error().
+					Interface("panic", r).
+					Str("method", info.FullMethod).
+					Str("stack", string(debug.Stack())).
+					Msg("Panic recovered in gRPC stream handler")
+
+				err = status.Errorf(codes.Internal, "internal server error")
+			}
+		}()
+
+		return handler(srv, ss)
+	}
+}
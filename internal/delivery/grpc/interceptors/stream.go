@@ -0,0 +1,23 @@
+package interceptors
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+// contextStream is a ServerStream whose context was replaced by an interceptor.
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context returns the replaced context.
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
+
+// withContext returns ss with its context replaced by ctx.
+func withContext(ss grpc.ServerStream, ctx context.Context) grpc.ServerStream {
+	return &contextStream{ServerStream: ss, ctx: ctx}
+}
@@ -0,0 +1,462 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/homindolenern/goapps-costing-v1/gen/go/costing/v1"
+	appmachine "github.com/homindolenern/goapps-costing-v1/internal/application/machine"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/machine"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/parameter"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/parametervalue"
+)
+
+// MachineHandler implements the gRPC MachineService.
+type MachineHandler struct {
+	pb.UnimplementedMachineServiceServer
+	createTypeHandler *appmachine.CreateTypeHandler
+	updateTypeHandler *appmachine.UpdateTypeHandler
+	deleteTypeHandler *appmachine.DeleteTypeHandler
+	getTypeHandler    *appmachine.GetTypeHandler
+	listTypesHandler  *appmachine.ListTypesHandler
+	createHandler     *appmachine.CreateHandler
+	updateHandler     *appmachine.UpdateHandler
+	deleteHandler     *appmachine.DeleteHandler
+	getHandler        *appmachine.GetHandler
+	listHandler       *appmachine.ListHandler
+	validator         *ValidationHelper
+}
+
+// NewMachineHandler creates a new Machine handler.
+func NewMachineHandler(
+	createTypeHandler *appmachine.CreateTypeHandler,
+	updateTypeHandler *appmachine.UpdateTypeHandler,
+	deleteTypeHandler *appmachine.DeleteTypeHandler,
+	getTypeHandler *appmachine.GetTypeHandler,
+	listTypesHandler *appmachine.ListTypesHandler,
+	createHandler *appmachine.CreateHandler,
+	updateHandler *appmachine.UpdateHandler,
+	deleteHandler *appmachine.DeleteHandler,
+	getHandler *appmachine.GetHandler,
+	listHandler *appmachine.ListHandler,
+	validator *ValidationHelper,
+) *MachineHandler {
+	return &MachineHandler{
+		createTypeHandler: createTypeHandler,
+		updateTypeHandler: updateTypeHandler,
+		deleteTypeHandler: deleteTypeHandler,
+		getTypeHandler:    getTypeHandler,
+		listTypesHandler:  listTypesHandler,
+		createHandler:     createHandler,
+		updateHandler:     updateHandler,
+		deleteHandler:     deleteHandler,
+		getHandler:        getHandler,
+		listHandler:       listHandler,
+		validator:         validator,
+	}
+}
+
+// CreateMachineType creates a new machine type.
+func (h *MachineHandler) CreateMachineType(ctx context.Context, req *pb.CreateMachineTypeRequest) (*pb.CreateMachineTypeResponse, error) {
+	// Validate request
+	if validationResp := h.validator.Validate(ctx, req); validationResp != nil {
+		return &pb.CreateMachineTypeResponse{Base: validationResp}, nil
+	}
+
+	cmd := appmachine.CreateTypeCommand{
+		TypeCode:    req.MachineTypeCode,
+		TypeName:    req.MachineTypeName,
+		Description: req.Description,
+		Parameters:  templateParametersFromProto(req.Parameters),
+		CreatedBy:   actorFromContext(ctx),
+	}
+
+	entity, err := h.createTypeHandler.Handle(ctx, cmd)
+	if err != nil {
+		return &pb.CreateMachineTypeResponse{
+			Base: machineErrorToBaseResponse(err),
+		}, nil
+	}
+
+	return &pb.CreateMachineTypeResponse{
+		Base: successResponse("Machine type created successfully"),
+		Data: machineTypeEntityToProto(entity),
+	}, nil
+}
+
+// GetMachineType retrieves a machine type by code.
+func (h *MachineHandler) GetMachineType(ctx context.Context, req *pb.GetMachineTypeRequest) (*pb.GetMachineTypeResponse, error) {
+	query := appmachine.GetTypeQuery{TypeCode: req.MachineTypeCode}
+
+	entity, err := h.getTypeHandler.Handle(ctx, query)
+	if err != nil {
+		return &pb.GetMachineTypeResponse{
+			Base: machineErrorToBaseResponse(err),
+		}, nil
+	}
+
+	return &pb.GetMachineTypeResponse{
+		Base: successResponse("Machine type retrieved successfully"),
+		Data: machineTypeEntityToProto(entity),
+	}, nil
+}
+
+// ListMachineTypes retrieves a paginated list of machine types.
+func (h *MachineHandler) ListMachineTypes(ctx context.Context, req *pb.ListMachineTypesRequest) (*pb.ListMachineTypesResponse, error) {
+	// Validate request
+	if validationResp := h.validator.Validate(ctx, req); validationResp != nil {
+		return &pb.ListMachineTypesResponse{Base: validationResp}, nil
+	}
+
+	query := appmachine.ListTypesQuery{
+		Page:     int(req.Page),
+		PageSize: int(req.PageSize),
+		IsActive: req.IsActive,
+	}
+
+	result, err := h.listTypesHandler.Handle(ctx, query)
+	if err != nil {
+		return &pb.ListMachineTypesResponse{
+			Base: machineErrorToBaseResponse(err),
+		}, nil
+	}
+
+	data := make([]*pb.MachineType, len(result.MachineTypes))
+	for i, entity := range result.MachineTypes {
+		data[i] = machineTypeEntityToProto(entity)
+	}
+
+	totalPages := int32(result.Total) / req.PageSize
+	if int32(result.Total)%req.PageSize > 0 {
+		totalPages++
+	}
+
+	return &pb.ListMachineTypesResponse{
+		Base: successResponse("Machine types retrieved successfully"),
+		Data: data,
+		Pagination: &pb.PaginationMeta{
+			CurrentPage: req.Page,
+			PageSize:    req.PageSize,
+			TotalItems:  result.Total,
+			TotalPages:  totalPages,
+		},
+	}, nil
+}
+
+// UpdateMachineType updates an existing machine type.
+func (h *MachineHandler) UpdateMachineType(ctx context.Context, req *pb.UpdateMachineTypeRequest) (*pb.UpdateMachineTypeResponse, error) {
+	// Validate request
+	if validationResp := h.validator.Validate(ctx, req); validationResp != nil {
+		return &pb.UpdateMachineTypeResponse{Base: validationResp}, nil
+	}
+
+	cmd := appmachine.UpdateTypeCommand{
+		TypeCode:    req.MachineTypeCode,
+		TypeName:    req.MachineTypeName,
+		Description: req.Description,
+		Parameters:  templateParametersFromProto(req.Parameters),
+		IsActive:    req.IsActive,
+		UpdatedBy:   actorFromContext(ctx),
+	}
+
+	entity, err := h.updateTypeHandler.Handle(ctx, cmd)
+	if err != nil {
+		return &pb.UpdateMachineTypeResponse{
+			Base: machineErrorToBaseResponse(err),
+		}, nil
+	}
+
+	return &pb.UpdateMachineTypeResponse{
+		Base: successResponse("Machine type updated successfully"),
+		Data: machineTypeEntityToProto(entity),
+	}, nil
+}
+
+// DeleteMachineType deletes a machine type by code.
+func (h *MachineHandler) DeleteMachineType(ctx context.Context, req *pb.DeleteMachineTypeRequest) (*pb.DeleteMachineTypeResponse, error) {
+	cmd := appmachine.DeleteTypeCommand{
+		TypeCode:  req.MachineTypeCode,
+		DeletedBy: actorFromContext(ctx),
+	}
+
+	err := h.deleteTypeHandler.Handle(ctx, cmd)
+	if err != nil {
+		return &pb.DeleteMachineTypeResponse{
+			Base: machineErrorToBaseResponse(err),
+		}, nil
+	}
+
+	return &pb.DeleteMachineTypeResponse{
+		Base: successResponse("Machine type deleted successfully"),
+	}, nil
+}
+
+// CreateMachine creates a new machine.
+func (h *MachineHandler) CreateMachine(ctx context.Context, req *pb.CreateMachineRequest) (*pb.CreateMachineResponse, error) {
+	// Validate request
+	if validationResp := h.validator.Validate(ctx, req); validationResp != nil {
+		return &pb.CreateMachineResponse{Base: validationResp}, nil
+	}
+
+	cmd := appmachine.CreateCommand{
+		MachineCode: req.MachineCode,
+		MachineName: req.MachineName,
+		TypeCode:    req.MachineTypeCode,
+		Description: req.Description,
+		Values:      machineValuesFromProto(req.Values),
+		CreatedBy:   actorFromContext(ctx),
+	}
+
+	entity, err := h.createHandler.Handle(ctx, cmd)
+	if err != nil {
+		return &pb.CreateMachineResponse{
+			Base: machineErrorToBaseResponse(err),
+		}, nil
+	}
+
+	return &pb.CreateMachineResponse{
+		Base: successResponse("Machine created successfully"),
+		Data: machineEntityToProto(entity),
+	}, nil
+}
+
+// GetMachine retrieves a machine by code.
+func (h *MachineHandler) GetMachine(ctx context.Context, req *pb.GetMachineRequest) (*pb.GetMachineResponse, error) {
+	query := appmachine.GetQuery{MachineCode: req.MachineCode}
+
+	entity, err := h.getHandler.Handle(ctx, query)
+	if err != nil {
+		return &pb.GetMachineResponse{
+			Base: machineErrorToBaseResponse(err),
+		}, nil
+	}
+
+	return &pb.GetMachineResponse{
+		Base: successResponse("Machine retrieved successfully"),
+		Data: machineEntityToProto(entity),
+	}, nil
+}
+
+// ListMachines retrieves a paginated list of machines.
+func (h *MachineHandler) ListMachines(ctx context.Context, req *pb.ListMachinesRequest) (*pb.ListMachinesResponse, error) {
+	// Validate request
+	if validationResp := h.validator.Validate(ctx, req); validationResp != nil {
+		return &pb.ListMachinesResponse{Base: validationResp}, nil
+	}
+
+	query := appmachine.ListQuery{
+		Page:     int(req.Page),
+		PageSize: int(req.PageSize),
+		IsActive: req.IsActive,
+	}
+
+	if req.MachineTypeCode != nil && *req.MachineTypeCode != "" {
+		query.TypeCode = req.MachineTypeCode
+	}
+
+	result, err := h.listHandler.Handle(ctx, query)
+	if err != nil {
+		return &pb.ListMachinesResponse{
+			Base: machineErrorToBaseResponse(err),
+		}, nil
+	}
+
+	data := make([]*pb.Machine, len(result.Machines))
+	for i, entity := range result.Machines {
+		data[i] = machineEntityToProto(entity)
+	}
+
+	totalPages := int32(result.Total) / req.PageSize
+	if int32(result.Total)%req.PageSize > 0 {
+		totalPages++
+	}
+
+	return &pb.ListMachinesResponse{
+		Base: successResponse("Machines retrieved successfully"),
+		Data: data,
+		Pagination: &pb.PaginationMeta{
+			CurrentPage: req.Page,
+			PageSize:    req.PageSize,
+			TotalItems:  result.Total,
+			TotalPages:  totalPages,
+		},
+	}, nil
+}
+
+// UpdateMachine updates an existing machine.
+func (h *MachineHandler) UpdateMachine(ctx context.Context, req *pb.UpdateMachineRequest) (*pb.UpdateMachineResponse, error) {
+	// Validate request
+	if validationResp := h.validator.Validate(ctx, req); validationResp != nil {
+		return &pb.UpdateMachineResponse{Base: validationResp}, nil
+	}
+
+	cmd := appmachine.UpdateCommand{
+		MachineCode: req.MachineCode,
+		MachineName: req.MachineName,
+		TypeCode:    req.MachineTypeCode,
+		Description: req.Description,
+		Values:      machineValuesFromProto(req.Values),
+		IsActive:    req.IsActive,
+		UpdatedBy:   actorFromContext(ctx),
+	}
+
+	entity, err := h.updateHandler.Handle(ctx, cmd)
+	if err != nil {
+		return &pb.UpdateMachineResponse{
+			Base: machineErrorToBaseResponse(err),
+		}, nil
+	}
+
+	return &pb.UpdateMachineResponse{
+		Base: successResponse("Machine updated successfully"),
+		Data: machineEntityToProto(entity),
+	}, nil
+}
+
+// DeleteMachine deletes a machine by code.
+func (h *MachineHandler) DeleteMachine(ctx context.Context, req *pb.DeleteMachineRequest) (*pb.DeleteMachineResponse, error) {
+	cmd := appmachine.DeleteCommand{
+		MachineCode: req.MachineCode,
+		DeletedBy:   actorFromContext(ctx),
+	}
+
+	err := h.deleteHandler.Handle(ctx, cmd)
+	if err != nil {
+		return &pb.DeleteMachineResponse{
+			Base: machineErrorToBaseResponse(err),
+		}, nil
+	}
+
+	return &pb.DeleteMachineResponse{
+		Base: successResponse("Machine deleted successfully"),
+	}, nil
+}
+
+// Helper functions.
+
+func templateParametersFromProto(params []*pb.TemplateParameter) []appmachine.TemplateParameterInput {
+	inputs := make([]appmachine.TemplateParameterInput, len(params))
+	for i, p := range params {
+		inputs[i] = appmachine.TemplateParameterInput{
+			ParameterCode: p.ParameterCode,
+			IsMandatory:   p.IsMandatory,
+		}
+	}
+	return inputs
+}
+
+func machineValuesFromProto(values []*pb.MachineParameterValue) []appmachine.ValueInput {
+	inputs := make([]appmachine.ValueInput, len(values))
+	for i, v := range values {
+		inputs[i] = appmachine.ValueInput{
+			ParameterCode: v.ParameterCode,
+			Value:         v.Value,
+		}
+	}
+	return inputs
+}
+
+func machineTypeEntityToProto(entity *machine.MachineType) *pb.MachineType {
+	audit := &pb.AuditInfo{
+		CreatedAt: entity.CreatedAt().Format("2006-01-02T15:04:05Z07:00"),
+		CreatedBy: entity.CreatedBy(),
+	}
+	if entity.UpdatedAt() != nil {
+		updatedAt := entity.UpdatedAt().Format("2006-01-02T15:04:05Z07:00")
+		audit.UpdatedAt = &updatedAt
+	}
+	if entity.UpdatedBy() != nil {
+		audit.UpdatedBy = entity.UpdatedBy()
+	}
+
+	params := make([]*pb.TemplateParameter, len(entity.Parameters()))
+	for i, p := range entity.Parameters() {
+		params[i] = &pb.TemplateParameter{
+			ParameterCode: p.ParameterCode().String(),
+			IsMandatory:   p.IsMandatory(),
+		}
+	}
+
+	return &pb.MachineType{
+		MachineTypeCode: entity.Code().String(),
+		MachineTypeName: entity.Name(),
+		Description:     entity.Description(),
+		Parameters:      params,
+		IsActive:        entity.IsActive(),
+		Audit:           audit,
+	}
+}
+
+func machineEntityToProto(entity *machine.Machine) *pb.Machine {
+	audit := &pb.AuditInfo{
+		CreatedAt: entity.CreatedAt().Format("2006-01-02T15:04:05Z07:00"),
+		CreatedBy: entity.CreatedBy(),
+	}
+	if entity.UpdatedAt() != nil {
+		updatedAt := entity.UpdatedAt().Format("2006-01-02T15:04:05Z07:00")
+		audit.UpdatedAt = &updatedAt
+	}
+	if entity.UpdatedBy() != nil {
+		audit.UpdatedBy = entity.UpdatedBy()
+	}
+
+	values := make([]*pb.MachineParameterValue, len(entity.Values()))
+	for i, v := range entity.Values() {
+		values[i] = &pb.MachineParameterValue{
+			ParameterCode: v.ParameterCode().String(),
+			Value:         v.Value(),
+		}
+	}
+
+	return &pb.Machine{
+		MachineCode:     entity.Code().String(),
+		MachineName:     entity.Name(),
+		MachineTypeCode: entity.MachineType().String(),
+		Description:     entity.Description(),
+		Values:          values,
+		IsActive:        entity.IsActive(),
+		Audit:           audit,
+	}
+}
+
+func machineErrorToBaseResponse(err error) *pb.BaseResponse {
+	statusCode := "500"
+	message := "Internal server error"
+
+	switch {
+	case errors.Is(err, machine.ErrNotFound),
+		errors.Is(err, machine.ErrTypeNotFound),
+		errors.Is(err, parameter.ErrNotFound):
+		statusCode = "404"
+		message = err.Error()
+	case errors.Is(err, machine.ErrAlreadyExists),
+		errors.Is(err, machine.ErrTypeAlreadyExists),
+		errors.Is(err, machine.ErrTypeInUse),
+		errors.Is(err, machine.ErrTemplateParameterInUse):
+		statusCode = "409"
+		message = err.Error()
+	case errors.Is(err, machine.ErrInvalidCode),
+		errors.Is(err, machine.ErrInvalidTypeCode),
+		errors.Is(err, machine.ErrEmptyName),
+		errors.Is(err, machine.ErrTypeInactive),
+		errors.Is(err, machine.ErrDuplicateParameter),
+		errors.Is(err, machine.ErrParameterNotMachine),
+		errors.Is(err, machine.ErrParameterNotInTemplate),
+		errors.Is(err, machine.ErrMandatoryParameterMissing),
+		errors.Is(err, parameter.ErrInvalidCode),
+		errors.Is(err, parametervalue.ErrParameterInactive),
+		errors.Is(err, parametervalue.ErrValueNotNumeric),
+		errors.Is(err, parametervalue.ErrValueBelowMin),
+		errors.Is(err, parametervalue.ErrValueAboveMax),
+		errors.Is(err, parametervalue.ErrValueNotBoolean),
+		errors.Is(err, parametervalue.ErrValueNotAllowed):
+		statusCode = "400"
+		message = err.Error()
+	}
+
+	return &pb.BaseResponse{
+		StatusCode: statusCode,
+		IsSuccess:  false,
+		Message:    message,
+	}
+}
@@ -0,0 +1,275 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/homindolenern/goapps-costing-v1/gen/go/costing/v1"
+	appmaterial "github.com/homindolenern/goapps-costing-v1/internal/application/material"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/material"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/uom"
+)
+
+// MaterialHandler implements the gRPC MaterialService.
+type MaterialHandler struct {
+	pb.UnimplementedMaterialServiceServer
+	createHandler *appmaterial.CreateHandler
+	updateHandler *appmaterial.UpdateHandler
+	deleteHandler *appmaterial.DeleteHandler
+	getHandler    *appmaterial.GetHandler
+	listHandler   *appmaterial.ListHandler
+	validator     *ValidationHelper
+}
+
+// NewMaterialHandler creates a new Material handler.
+func NewMaterialHandler(
+	createHandler *appmaterial.CreateHandler,
+	updateHandler *appmaterial.UpdateHandler,
+	deleteHandler *appmaterial.DeleteHandler,
+	getHandler *appmaterial.GetHandler,
+	listHandler *appmaterial.ListHandler,
+	validator *ValidationHelper,
+) *MaterialHandler {
+	return &MaterialHandler{
+		createHandler: createHandler,
+		updateHandler: updateHandler,
+		deleteHandler: deleteHandler,
+		getHandler:    getHandler,
+		listHandler:   listHandler,
+		validator:     validator,
+	}
+}
+
+// CreateMaterial creates a new Material.
+func (h *MaterialHandler) CreateMaterial(ctx context.Context, req *pb.CreateMaterialRequest) (*pb.CreateMaterialResponse, error) {
+	// Validate request
+	if validationResp := h.validator.Validate(ctx, req); validationResp != nil {
+		return &pb.CreateMaterialResponse{Base: validationResp}, nil
+	}
+
+	cmd := appmaterial.CreateCommand{
+		MaterialCode:    req.MaterialCode,
+		MaterialName:    req.MaterialName,
+		MaterialType:    pbMaterialTypeToString(req.MaterialType),
+		PurchaseUOMCode: req.PurchaseUomCode,
+		StandardPrice:   req.StandardPrice,
+		Currency:        req.Currency,
+		Description:     req.Description,
+		CreatedBy:       actorFromContext(ctx),
+	}
+
+	entity, err := h.createHandler.Handle(ctx, cmd)
+	if err != nil {
+		return &pb.CreateMaterialResponse{
+			Base: materialErrorToBaseResponse(err),
+		}, nil
+	}
+
+	return &pb.CreateMaterialResponse{
+		Base: successResponse("Material created successfully"),
+		Data: materialEntityToProto(entity),
+	}, nil
+}
+
+// GetMaterial retrieves a Material by code.
+func (h *MaterialHandler) GetMaterial(ctx context.Context, req *pb.GetMaterialRequest) (*pb.GetMaterialResponse, error) {
+	query := appmaterial.GetQuery{MaterialCode: req.MaterialCode}
+
+	entity, err := h.getHandler.Handle(ctx, query)
+	if err != nil {
+		return &pb.GetMaterialResponse{
+			Base: materialErrorToBaseResponse(err),
+		}, nil
+	}
+
+	return &pb.GetMaterialResponse{
+		Base: successResponse("Material retrieved successfully"),
+		Data: materialEntityToProto(entity),
+	}, nil
+}
+
+// ListMaterials retrieves a paginated list of Materials.
+func (h *MaterialHandler) ListMaterials(ctx context.Context, req *pb.ListMaterialsRequest) (*pb.ListMaterialsResponse, error) {
+	// Validate request
+	if validationResp := h.validator.Validate(ctx, req); validationResp != nil {
+		return &pb.ListMaterialsResponse{Base: validationResp}, nil
+	}
+
+	query := appmaterial.ListQuery{
+		Page:     int(req.Page),
+		PageSize: int(req.PageSize),
+		IsActive: req.IsActive,
+	}
+
+	if req.MaterialType != nil && *req.MaterialType != pb.MaterialType_MATERIAL_TYPE_UNSPECIFIED {
+		materialType := pbMaterialTypeToString(*req.MaterialType)
+		query.MaterialType = &materialType
+	}
+
+	result, err := h.listHandler.Handle(ctx, query)
+	if err != nil {
+		return &pb.ListMaterialsResponse{
+			Base: materialErrorToBaseResponse(err),
+		}, nil
+	}
+
+	data := make([]*pb.Material, len(result.Materials))
+	for i, entity := range result.Materials {
+		data[i] = materialEntityToProto(entity)
+	}
+
+	totalPages := int32(result.Total) / req.PageSize
+	if int32(result.Total)%req.PageSize > 0 {
+		totalPages++
+	}
+
+	return &pb.ListMaterialsResponse{
+		Base: successResponse("Materials retrieved successfully"),
+		Data: data,
+		Pagination: &pb.PaginationMeta{
+			CurrentPage: req.Page,
+			PageSize:    req.PageSize,
+			TotalItems:  result.Total,
+			TotalPages:  totalPages,
+		},
+	}, nil
+}
+
+// UpdateMaterial updates an existing Material.
+func (h *MaterialHandler) UpdateMaterial(ctx context.Context, req *pb.UpdateMaterialRequest) (*pb.UpdateMaterialResponse, error) {
+	// Validate request
+	if validationResp := h.validator.Validate(ctx, req); validationResp != nil {
+		return &pb.UpdateMaterialResponse{Base: validationResp}, nil
+	}
+
+	cmd := appmaterial.UpdateCommand{
+		MaterialCode:    req.MaterialCode,
+		MaterialName:    req.MaterialName,
+		MaterialType:    pbMaterialTypeToString(req.MaterialType),
+		PurchaseUOMCode: req.PurchaseUomCode,
+		StandardPrice:   req.StandardPrice,
+		Currency:        req.Currency,
+		Description:     req.Description,
+		IsActive:        req.IsActive,
+		UpdatedBy:       actorFromContext(ctx),
+	}
+
+	entity, err := h.updateHandler.Handle(ctx, cmd)
+	if err != nil {
+		return &pb.UpdateMaterialResponse{
+			Base: materialErrorToBaseResponse(err),
+		}, nil
+	}
+
+	return &pb.UpdateMaterialResponse{
+		Base: successResponse("Material updated successfully"),
+		Data: materialEntityToProto(entity),
+	}, nil
+}
+
+// DeleteMaterial deletes a Material by code.
+func (h *MaterialHandler) DeleteMaterial(ctx context.Context, req *pb.DeleteMaterialRequest) (*pb.DeleteMaterialResponse, error) {
+	cmd := appmaterial.DeleteCommand{
+		MaterialCode: req.MaterialCode,
+		DeletedBy:    actorFromContext(ctx),
+	}
+
+	err := h.deleteHandler.Handle(ctx, cmd)
+	if err != nil {
+		return &pb.DeleteMaterialResponse{
+			Base: materialErrorToBaseResponse(err),
+		}, nil
+	}
+
+	return &pb.DeleteMaterialResponse{
+		Base: successResponse("Material deleted successfully"),
+	}, nil
+}
+
+// Helper functions.
+
+func pbMaterialTypeToString(t pb.MaterialType) string {
+	switch t {
+	case pb.MaterialType_MATERIAL_TYPE_FIBRE:
+		return "FIBRE"
+	case pb.MaterialType_MATERIAL_TYPE_YARN:
+		return "YARN"
+	case pb.MaterialType_MATERIAL_TYPE_CHEMICAL:
+		return "CHEMICAL"
+	case pb.MaterialType_MATERIAL_TYPE_PACKAGING:
+		return "PACKAGING"
+	case pb.MaterialType_MATERIAL_TYPE_UNSPECIFIED:
+		return ""
+	}
+	return ""
+}
+
+func stringToPbMaterialType(t string) pb.MaterialType {
+	switch t {
+	case "FIBRE":
+		return pb.MaterialType_MATERIAL_TYPE_FIBRE
+	case "YARN":
+		return pb.MaterialType_MATERIAL_TYPE_YARN
+	case "CHEMICAL":
+		return pb.MaterialType_MATERIAL_TYPE_CHEMICAL
+	case "PACKAGING":
+		return pb.MaterialType_MATERIAL_TYPE_PACKAGING
+	default:
+		return pb.MaterialType_MATERIAL_TYPE_UNSPECIFIED
+	}
+}
+
+func materialEntityToProto(entity *material.Material) *pb.Material {
+	audit := &pb.AuditInfo{
+		CreatedAt: entity.CreatedAt().Format("2006-01-02T15:04:05Z07:00"),
+		CreatedBy: entity.CreatedBy(),
+	}
+	if entity.UpdatedAt() != nil {
+		updatedAt := entity.UpdatedAt().Format("2006-01-02T15:04:05Z07:00")
+		audit.UpdatedAt = &updatedAt
+	}
+	if entity.UpdatedBy() != nil {
+		audit.UpdatedBy = entity.UpdatedBy()
+	}
+
+	return &pb.Material{
+		MaterialCode:    entity.Code().String(),
+		MaterialName:    entity.Name(),
+		MaterialType:    stringToPbMaterialType(entity.Type().String()),
+		PurchaseUomCode: entity.PurchaseUOM().String(),
+		StandardPrice:   entity.StandardPrice().Amount(),
+		Currency:        entity.StandardPrice().Currency().String(),
+		Description:     entity.Description(),
+		IsActive:        entity.IsActive(),
+		Audit:           audit,
+	}
+}
+
+func materialErrorToBaseResponse(err error) *pb.BaseResponse {
+	statusCode := "500"
+	message := "Internal server error"
+
+	switch {
+	case errors.Is(err, material.ErrNotFound):
+		statusCode = "404"
+		message = err.Error()
+	case errors.Is(err, material.ErrAlreadyExists):
+		statusCode = "409"
+		message = err.Error()
+	case errors.Is(err, material.ErrInvalidCode),
+		errors.Is(err, material.ErrInvalidType),
+		errors.Is(err, material.ErrInvalidCurrency),
+		errors.Is(err, material.ErrNegativePrice),
+		errors.Is(err, material.ErrEmptyName),
+		errors.Is(err, material.ErrPurchaseUOMNotFound),
+		errors.Is(err, uom.ErrInvalidUOMCode):
+		statusCode = "400"
+		message = err.Error()
+	}
+
+	return &pb.BaseResponse{
+		StatusCode: statusCode,
+		IsSuccess:  false,
+		Message:    message,
+	}
+}
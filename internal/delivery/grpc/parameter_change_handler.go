@@ -0,0 +1,290 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/homindolenern/goapps-costing-v1/gen/go/costing/v1"
+	appparam "github.com/homindolenern/goapps-costing-v1/internal/application/parameter"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/changerequest"
+)
+
+// ParameterChangeHandler implements the gRPC ParameterChangeService.
+type ParameterChangeHandler struct {
+	pb.UnimplementedParameterChangeServiceServer
+	approveHandler *appparam.ApproveChangeHandler
+	rejectHandler  *appparam.RejectChangeHandler
+	getHandler     *appparam.GetChangeHandler
+	listHandler    *appparam.ListChangesHandler
+	validator      *ValidationHelper
+}
+
+// NewParameterChangeHandler creates a new ParameterChange handler.
+func NewParameterChangeHandler(
+	approveHandler *appparam.ApproveChangeHandler,
+	rejectHandler *appparam.RejectChangeHandler,
+	getHandler *appparam.GetChangeHandler,
+	listHandler *appparam.ListChangesHandler,
+	validator *ValidationHelper,
+) *ParameterChangeHandler {
+	return &ParameterChangeHandler{
+		approveHandler: approveHandler,
+		rejectHandler:  rejectHandler,
+		getHandler:     getHandler,
+		listHandler:    listHandler,
+		validator:      validator,
+	}
+}
+
+// ListParameterChangeRequests retrieves a paginated list of change requests.
+func (h *ParameterChangeHandler) ListParameterChangeRequests(
+	ctx context.Context,
+	req *pb.ListParameterChangeRequestsRequest,
+) (*pb.ListParameterChangeRequestsResponse, error) {
+	// Validate request
+	if validationResp := h.validator.Validate(ctx, req); validationResp != nil {
+		return &pb.ListParameterChangeRequestsResponse{Base: validationResp}, nil
+	}
+
+	query := appparam.ListChangesQuery{
+		ParameterCode: req.ParameterCode,
+		RequestedBy:   req.RequestedBy,
+		Page:          int(req.Page),
+		PageSize:      int(req.PageSize),
+	}
+
+	if req.Status != nil && *req.Status != pb.ParameterChangeStatus_PARAMETER_CHANGE_STATUS_UNSPECIFIED {
+		status := pbChangeStatusToString(*req.Status)
+		query.Status = &status
+	}
+
+	result, err := h.listHandler.Handle(ctx, query)
+	if err != nil {
+		return &pb.ListParameterChangeRequestsResponse{
+			Base: changeErrorToBaseResponse(err),
+		}, nil
+	}
+
+	data := make([]*pb.ParameterChangeRequest, 0, len(result.ChangeRequests))
+	for _, request := range result.ChangeRequests {
+		msg, err := changeRequestToProto(request)
+		if err != nil {
+			return &pb.ListParameterChangeRequestsResponse{
+				Base: changeErrorToBaseResponse(err),
+			}, nil
+		}
+		data = append(data, msg)
+	}
+
+	totalPages := int32(result.Total) / req.PageSize
+	if int32(result.Total)%req.PageSize > 0 {
+		totalPages++
+	}
+
+	return &pb.ListParameterChangeRequestsResponse{
+		Base: successResponse("Parameter change requests retrieved successfully"),
+		Data: data,
+		Pagination: &pb.PaginationMeta{
+			CurrentPage: req.Page,
+			PageSize:    req.PageSize,
+			TotalItems:  result.Total,
+			TotalPages:  totalPages,
+		},
+	}, nil
+}
+
+// GetParameterChangeRequest retrieves a change request by ID.
+func (h *ParameterChangeHandler) GetParameterChangeRequest(
+	ctx context.Context,
+	req *pb.GetParameterChangeRequestRequest,
+) (*pb.GetParameterChangeRequestResponse, error) {
+	// Validate request
+	if validationResp := h.validator.Validate(ctx, req); validationResp != nil {
+		return &pb.GetParameterChangeRequestResponse{Base: validationResp}, nil
+	}
+
+	request, err := h.getHandler.Handle(ctx, appparam.GetChangeQuery{ChangeRequestID: req.ChangeRequestId})
+	if err != nil {
+		return &pb.GetParameterChangeRequestResponse{
+			Base: changeErrorToBaseResponse(err),
+		}, nil
+	}
+
+	msg, err := changeRequestToProto(request)
+	if err != nil {
+		return &pb.GetParameterChangeRequestResponse{
+			Base: changeErrorToBaseResponse(err),
+		}, nil
+	}
+
+	return &pb.GetParameterChangeRequestResponse{
+		Base: successResponse("Parameter change request retrieved successfully"),
+		Data: msg,
+	}, nil
+}
+
+// ApproveChange approves a pending change request and applies it.
+func (h *ParameterChangeHandler) ApproveChange(
+	ctx context.Context,
+	req *pb.ApproveChangeRequest,
+) (*pb.ApproveChangeResponse, error) {
+	// Validate request
+	if validationResp := h.validator.Validate(ctx, req); validationResp != nil {
+		return &pb.ApproveChangeResponse{Base: validationResp}, nil
+	}
+
+	cmd := appparam.ReviewChangeCommand{
+		ChangeRequestID:   req.ChangeRequestId,
+		Comment:           req.Comment,
+		ReviewedBy:        actorFromContext(ctx),
+		ReviewedBySubject: subjectFromContext(ctx),
+	}
+
+	result, err := h.approveHandler.Handle(ctx, cmd)
+	if err != nil {
+		return &pb.ApproveChangeResponse{
+			Base: changeErrorToBaseResponse(err),
+		}, nil
+	}
+
+	msg, err := changeRequestToProto(result.ChangeRequest)
+	if err != nil {
+		return &pb.ApproveChangeResponse{
+			Base: changeErrorToBaseResponse(err),
+		}, nil
+	}
+
+	return &pb.ApproveChangeResponse{
+		Base:             successResponse("Parameter change approved and applied"),
+		Data:             msg,
+		ParameterVersion: int32(result.Parameter.Version()),
+	}, nil
+}
+
+// RejectChange rejects a pending change request.
+func (h *ParameterChangeHandler) RejectChange(
+	ctx context.Context,
+	req *pb.RejectChangeRequest,
+) (*pb.RejectChangeResponse, error) {
+	// Validate request
+	if validationResp := h.validator.Validate(ctx, req); validationResp != nil {
+		return &pb.RejectChangeResponse{Base: validationResp}, nil
+	}
+
+	cmd := appparam.ReviewChangeCommand{
+		ChangeRequestID:   req.ChangeRequestId,
+		Comment:           req.Comment,
+		ReviewedBy:        actorFromContext(ctx),
+		ReviewedBySubject: subjectFromContext(ctx),
+	}
+
+	request, err := h.rejectHandler.Handle(ctx, cmd)
+	if err != nil {
+		return &pb.RejectChangeResponse{
+			Base: changeErrorToBaseResponse(err),
+		}, nil
+	}
+
+	msg, err := changeRequestToProto(request)
+	if err != nil {
+		return &pb.RejectChangeResponse{
+			Base: changeErrorToBaseResponse(err),
+		}, nil
+	}
+
+	return &pb.RejectChangeResponse{
+		Base: successResponse("Parameter change rejected"),
+		Data: msg,
+	}, nil
+}
+
+// Helper functions.
+
+func pbChangeStatusToString(s pb.ParameterChangeStatus) string {
+	switch s {
+	case pb.ParameterChangeStatus_PARAMETER_CHANGE_STATUS_PENDING:
+		return "PENDING"
+	case pb.ParameterChangeStatus_PARAMETER_CHANGE_STATUS_APPROVED:
+		return "APPROVED"
+	case pb.ParameterChangeStatus_PARAMETER_CHANGE_STATUS_REJECTED:
+		return "REJECTED"
+	case pb.ParameterChangeStatus_PARAMETER_CHANGE_STATUS_UNSPECIFIED:
+		return ""
+	}
+	return ""
+}
+
+func stringToPbChangeStatus(s string) pb.ParameterChangeStatus {
+	switch s {
+	case "PENDING":
+		return pb.ParameterChangeStatus_PARAMETER_CHANGE_STATUS_PENDING
+	case "APPROVED":
+		return pb.ParameterChangeStatus_PARAMETER_CHANGE_STATUS_APPROVED
+	case "REJECTED":
+		return pb.ParameterChangeStatus_PARAMETER_CHANGE_STATUS_REJECTED
+	default:
+		return pb.ParameterChangeStatus_PARAMETER_CHANGE_STATUS_UNSPECIFIED
+	}
+}
+
+func stringToPbChangeAction(s string) pb.ParameterChangeAction {
+	switch s {
+	case "UPDATE":
+		return pb.ParameterChangeAction_PARAMETER_CHANGE_ACTION_UPDATE
+	case "DELETE":
+		return pb.ParameterChangeAction_PARAMETER_CHANGE_ACTION_DELETE
+	case "RESTORE":
+		return pb.ParameterChangeAction_PARAMETER_CHANGE_ACTION_RESTORE
+	default:
+		return pb.ParameterChangeAction_PARAMETER_CHANGE_ACTION_UNSPECIFIED
+	}
+}
+
+func changeRequestToProto(request *changerequest.ChangeRequest) (*pb.ParameterChangeRequest, error) {
+	changes, err := fieldChangesToProto(request.Changes())
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &pb.ParameterChangeRequest{
+		ChangeRequestId: request.ID(),
+		ParameterCode:   request.ParameterCode().String(),
+		BaseVersion:     int32(request.BaseVersion()),
+		Action:          stringToPbChangeAction(request.Action().String()),
+		Status:          stringToPbChangeStatus(request.Status().String()),
+		Changes:         changes,
+		RequestedBy:     request.RequestedBy(),
+		RequestedAt:     request.RequestedAt().Format("2006-01-02T15:04:05Z07:00"),
+		ReviewedBy:      request.ReviewedBy(),
+		ReviewComment:   request.ReviewComment(),
+	}
+	if at := request.ReviewedAt(); at != nil {
+		s := at.Format("2006-01-02T15:04:05Z07:00")
+		msg.ReviewedAt = &s
+	}
+	return msg, nil
+}
+
+// changeErrorToBaseResponse maps change request errors, and the Parameter
+// errors of applying one.
+func changeErrorToBaseResponse(err error) *pb.BaseResponse {
+	statusCode := ""
+	switch {
+	case errors.Is(err, changerequest.ErrNotFound):
+		statusCode = "404"
+	case errors.Is(err, changerequest.ErrNotPending):
+		statusCode = "409"
+	case errors.Is(err, changerequest.ErrSelfApproval):
+		statusCode = "403"
+	case errors.Is(err, changerequest.ErrInvalidStatus):
+		statusCode = "400"
+	default:
+		return paramErrorToBaseResponse(err)
+	}
+
+	return &pb.BaseResponse{
+		StatusCode: statusCode,
+		IsSuccess:  false,
+		Message:    err.Error(),
+	}
+}
@@ -4,38 +4,51 @@ import (
 	"context"
 	"errors"
 
+	"google.golang.org/protobuf/reflect/protoreflect"
+
 	pb "github.com/homindolenern/goapps-costing-v1/gen/go/costing/v1"
+	"github.com/homindolenern/goapps-costing-v1/internal/application/batch"
 	appparam "github.com/homindolenern/goapps-costing-v1/internal/application/parameter"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/changerequest"
 	"github.com/homindolenern/goapps-costing-v1/internal/domain/parameter"
 )
 
 // ParameterHandler implements the gRPC ParameterService.
 type ParameterHandler struct {
 	pb.UnimplementedParameterServiceServer
-	createHandler *appparam.CreateHandler
-	updateHandler *appparam.UpdateHandler
-	deleteHandler *appparam.DeleteHandler
-	getHandler    *appparam.GetHandler
-	listHandler   *appparam.ListHandler
-	validator     *ValidationHelper
+	createHandler      *appparam.CreateHandler
+	editHandler        *appparam.EditHandler
+	batchUpsertHandler *appparam.BatchUpsertHandler
+	getHandler         *appparam.GetHandler
+	listHandler        *appparam.ListHandler
+	versionsHandler    *appparam.ListVersionsHandler
+	exportHandler      *appparam.ExportHandler
+	watchHandler       *appparam.WatchHandler
+	validator          *ValidationHelper
 }
 
 // NewParameterHandler creates a new Parameter handler.
 func NewParameterHandler(
 	createHandler *appparam.CreateHandler,
-	updateHandler *appparam.UpdateHandler,
-	deleteHandler *appparam.DeleteHandler,
+	editHandler *appparam.EditHandler,
+	batchUpsertHandler *appparam.BatchUpsertHandler,
 	getHandler *appparam.GetHandler,
 	listHandler *appparam.ListHandler,
+	versionsHandler *appparam.ListVersionsHandler,
+	exportHandler *appparam.ExportHandler,
+	watchHandler *appparam.WatchHandler,
 	validator *ValidationHelper,
 ) *ParameterHandler {
 	return &ParameterHandler{
-		createHandler: createHandler,
-		updateHandler: updateHandler,
-		deleteHandler: deleteHandler,
-		getHandler:    getHandler,
-		listHandler:   listHandler,
-		validator:     validator,
+		createHandler:      createHandler,
+		editHandler:        editHandler,
+		batchUpsertHandler: batchUpsertHandler,
+		getHandler:         getHandler,
+		listHandler:        listHandler,
+		versionsHandler:    versionsHandler,
+		exportHandler:      exportHandler,
+		watchHandler:       watchHandler,
+		validator:          validator,
 	}
 }
 
@@ -57,7 +70,7 @@ func (h *ParameterHandler) CreateParameter(ctx context.Context, req *pb.CreatePa
 		AllowedValues: req.AllowedValues,
 		IsMandatory:   req.IsMandatory,
 		Description:   req.Description,
-		CreatedBy:     "system", // TODO: Extract from context/auth
+		CreatedBy:     actorFromContext(ctx),
 	}
 
 	entity, err := h.createHandler.Handle(ctx, cmd)
@@ -67,6 +80,7 @@ func (h *ParameterHandler) CreateParameter(ctx context.Context, req *pb.CreatePa
 		}, nil
 	}
 
+	setETag(ctx, entity.Version())
 	return &pb.CreateParameterResponse{
 		Base: paramSuccessResponse("Parameter created successfully"),
 		Data: paramEntityToProto(entity),
@@ -75,7 +89,7 @@ func (h *ParameterHandler) CreateParameter(ctx context.Context, req *pb.CreatePa
 
 // GetParameter retrieves a Parameter by code.
 func (h *ParameterHandler) GetParameter(ctx context.Context, req *pb.GetParameterRequest) (*pb.GetParameterResponse, error) {
-	query := appparam.GetQuery{ParameterCode: req.ParameterCode}
+	query := appparam.GetQuery{ParameterCode: req.ParameterCode, AsOf: req.AsOf}
 
 	entity, err := h.getHandler.Handle(ctx, query)
 	if err != nil {
@@ -84,6 +98,10 @@ func (h *ParameterHandler) GetParameter(ctx context.Context, req *pb.GetParamete
 		}, nil
 	}
 
+	// A past definition is not a version an update can be based on
+	if req.AsOf == nil {
+		setETag(ctx, entity.Version())
+	}
 	return &pb.GetParameterResponse{
 		Base: paramSuccessResponse("Parameter retrieved successfully"),
 		Data: paramEntityToProto(entity),
@@ -92,18 +110,17 @@ func (h *ParameterHandler) GetParameter(ctx context.Context, req *pb.GetParamete
 
 // ListParameters retrieves a paginated list of Parameters.
 func (h *ParameterHandler) ListParameters(ctx context.Context, req *pb.ListParametersRequest) (*pb.ListParametersResponse, error) {
-	query := appparam.ListQuery{
-		Page:     int(req.Page),
-		PageSize: int(req.PageSize),
-	}
-
-	if req.Category != nil && *req.Category != pb.ParameterCategory_PARAMETER_CATEGORY_UNSPECIFIED {
-		cat := pbParamCategoryToString(*req.Category)
-		query.Category = &cat
+	if validationResp := h.validator.Validate(ctx, req); validationResp != nil {
+		return &pb.ListParametersResponse{
+			Base: validationResp,
+		}, nil
 	}
 
-	if req.IsActive != nil {
-		query.IsActive = req.IsActive
+	query := appparam.ListQuery{
+		Filter:    paramFilterFromProto(req),
+		Page:      int(req.Page),
+		PageSize:  int(req.PageSize),
+		PageToken: req.PageToken,
 	}
 
 	result, err := h.listHandler.Handle(ctx, query)
@@ -126,6 +143,53 @@ func (h *ParameterHandler) ListParameters(ctx context.Context, req *pb.ListParam
 	return &pb.ListParametersResponse{
 		Base: paramSuccessResponse("Parameters retrieved successfully"),
 		Data: data,
+		Pagination: &pb.PaginationMeta{
+			CurrentPage: int32(result.Page),
+			PageSize:    req.PageSize,
+			TotalItems:  result.Total,
+			TotalPages:  totalPages,
+		},
+		NextPageToken: result.NextPageToken,
+	}, nil
+}
+
+// ListParameterVersions retrieves the definitions a Parameter has had.
+func (h *ParameterHandler) ListParameterVersions(
+	ctx context.Context,
+	req *pb.ListParameterVersionsRequest,
+) (*pb.ListParameterVersionsResponse, error) {
+	if validationResp := h.validator.Validate(ctx, req); validationResp != nil {
+		return &pb.ListParameterVersionsResponse{
+			Base: validationResp,
+		}, nil
+	}
+
+	query := appparam.ListVersionsQuery{
+		ParameterCode: req.ParameterCode,
+		Page:          int(req.Page),
+		PageSize:      int(req.PageSize),
+	}
+
+	result, err := h.versionsHandler.Handle(ctx, query)
+	if err != nil {
+		return &pb.ListParameterVersionsResponse{
+			Base: paramErrorToBaseResponse(err),
+		}, nil
+	}
+
+	data := make([]*pb.ParameterVersion, len(result.Versions))
+	for i, version := range result.Versions {
+		data[i] = paramVersionToProto(version)
+	}
+
+	totalPages := int32(result.Total) / req.PageSize
+	if int32(result.Total)%req.PageSize > 0 {
+		totalPages++
+	}
+
+	return &pb.ListParameterVersionsResponse{
+		Base: paramSuccessResponse("Parameter versions retrieved successfully"),
+		Data: data,
 		Pagination: &pb.PaginationMeta{
 			CurrentPage: req.Page,
 			PageSize:    req.PageSize,
@@ -137,52 +201,390 @@ func (h *ParameterHandler) ListParameters(ctx context.Context, req *pb.ListParam
 
 // UpdateParameter updates an existing Parameter.
 func (h *ParameterHandler) UpdateParameter(ctx context.Context, req *pb.UpdateParameterRequest) (*pb.UpdateParameterResponse, error) {
+	// Over HTTP the version may come from If-Match instead of the body
+	if req.Version == 0 {
+		req.Version = versionFromIfMatch(ctx)
+	}
+
+	// Validate request
+	if validationResp := h.validator.Validate(ctx, req); validationResp != nil {
+		return &pb.UpdateParameterResponse{Base: validationResp}, nil
+	}
+
 	cmd := appparam.UpdateCommand{
-		ParameterCode: req.ParameterCode,
-		ParameterName: req.ParameterName,
-		Category:      pbParamCategoryToString(req.ParameterCategory),
-		DataType:      pbDataTypeToString(req.DataType),
-		UOM:           req.Uom,
-		MinValue:      req.MinValue,
-		MaxValue:      req.MaxValue,
-		AllowedValues: req.AllowedValues,
-		IsMandatory:   req.IsMandatory,
-		Description:   req.Description,
-		IsActive:      req.IsActive,
-		UpdatedBy:     "system", // TODO: Extract from context/auth
+		ParameterCode:    req.ParameterCode,
+		ParameterName:    req.ParameterName,
+		Category:         pbParamCategoryToString(req.ParameterCategory),
+		DataType:         pbDataTypeToString(req.DataType),
+		UOM:              req.Uom,
+		MinValue:         req.MinValue,
+		MaxValue:         req.MaxValue,
+		AllowedValues:    req.AllowedValues,
+		IsMandatory:      req.IsMandatory,
+		Description:      req.Description,
+		IsActive:         req.IsActive,
+		Version:          int(req.Version),
+		UpdatedBy:        actorFromContext(ctx),
+		UpdatedBySubject: subjectFromContext(ctx),
 	}
 
-	entity, err := h.updateHandler.Handle(ctx, cmd)
+	result, err := h.editHandler.Handle(ctx, cmd)
 	if err != nil {
 		return &pb.UpdateParameterResponse{
 			Base: paramErrorToBaseResponse(err),
 		}, nil
 	}
 
+	if result.ChangeRequest != nil {
+		msg, err := changeRequestToProto(result.ChangeRequest)
+		if err != nil {
+			return &pb.UpdateParameterResponse{
+				Base: paramErrorToBaseResponse(err),
+			}, nil
+		}
+		return &pb.UpdateParameterResponse{
+			Base: &pb.BaseResponse{
+				StatusCode: "202",
+				IsSuccess:  true,
+				Message:    "Parameter change submitted for approval",
+			},
+			ChangeRequest: msg,
+		}, nil
+	}
+
+	setETag(ctx, result.Parameter.Version())
 	return &pb.UpdateParameterResponse{
 		Base: paramSuccessResponse("Parameter updated successfully"),
-		Data: paramEntityToProto(entity),
+		Data: paramEntityToProto(result.Parameter),
 	}, nil
 }
 
-// DeleteParameter deletes a Parameter by code.
+// DeleteParameter soft-deletes a Parameter by code.
 func (h *ParameterHandler) DeleteParameter(ctx context.Context, req *pb.DeleteParameterRequest) (*pb.DeleteParameterResponse, error) {
-	cmd := appparam.DeleteCommand{ParameterCode: req.ParameterCode}
+	cmd := appparam.DeleteCommand{
+		ParameterCode:    req.ParameterCode,
+		DeletedBy:        actorFromContext(ctx),
+		DeletedBySubject: subjectFromContext(ctx),
+	}
 
-	err := h.deleteHandler.Handle(ctx, cmd)
+	result, err := h.editHandler.Delete(ctx, cmd)
 	if err != nil {
 		return &pb.DeleteParameterResponse{
 			Base: paramErrorToBaseResponse(err),
 		}, nil
 	}
 
+	if result.ChangeRequest != nil {
+		msg, err := changeRequestToProto(result.ChangeRequest)
+		if err != nil {
+			return &pb.DeleteParameterResponse{
+				Base: paramErrorToBaseResponse(err),
+			}, nil
+		}
+		return &pb.DeleteParameterResponse{
+			Base: &pb.BaseResponse{
+				StatusCode: "202",
+				IsSuccess:  true,
+				Message:    "Parameter delete submitted for approval",
+			},
+			ChangeRequest: msg,
+		}, nil
+	}
+
 	return &pb.DeleteParameterResponse{
 		Base: paramSuccessResponse("Parameter deleted successfully"),
 	}, nil
 }
 
+// RestoreParameter restores a soft-deleted Parameter.
+func (h *ParameterHandler) RestoreParameter(ctx context.Context, req *pb.RestoreParameterRequest) (*pb.RestoreParameterResponse, error) {
+	cmd := appparam.RestoreCommand{
+		ParameterCode:     req.ParameterCode,
+		RestoredBy:        actorFromContext(ctx),
+		RestoredBySubject: subjectFromContext(ctx),
+	}
+
+	result, err := h.editHandler.Restore(ctx, cmd)
+	if err != nil {
+		return &pb.RestoreParameterResponse{
+			Base: paramErrorToBaseResponse(err),
+		}, nil
+	}
+
+	if result.ChangeRequest != nil {
+		msg, err := changeRequestToProto(result.ChangeRequest)
+		if err != nil {
+			return &pb.RestoreParameterResponse{
+				Base: paramErrorToBaseResponse(err),
+			}, nil
+		}
+		return &pb.RestoreParameterResponse{
+			Base: &pb.BaseResponse{
+				StatusCode: "202",
+				IsSuccess:  true,
+				Message:    "Parameter restore submitted for approval",
+			},
+			ChangeRequest: msg,
+		}, nil
+	}
+
+	setETag(ctx, result.Parameter.Version())
+	return &pb.RestoreParameterResponse{
+		Base: paramSuccessResponse("Parameter restored successfully"),
+		Data: paramEntityToProto(result.Parameter),
+	}, nil
+}
+
+// BatchUpsertParameters creates or updates many Parameters in one transaction.
+func (h *ParameterHandler) BatchUpsertParameters(ctx context.Context, req *pb.BatchUpsertParametersRequest) (*pb.BatchUpsertParametersResponse, error) {
+	if sizeResp := batchSizeResponse(len(req.Items)); sizeResp != nil {
+		return &pb.BatchUpsertParametersResponse{Base: sizeResp}, nil
+	}
+	atomic := req.Mode != pb.BatchMode_BATCH_MODE_BEST_EFFORT
+
+	// Validate every item on its own so failures are reported per item
+	results := make([]*pb.UpsertParameterResult, len(req.Items))
+	positions := make([]int, 0, len(req.Items))
+	cmd := appparam.BatchUpsertCommand{Atomic: atomic, UpsertedBy: actorFromContext(ctx)}
+	for i, item := range req.Items {
+		if validationResp := h.validator.Validate(ctx, item); validationResp != nil {
+			results[i] = &pb.UpsertParameterResult{
+				Index:         int32(i),
+				ParameterCode: item.ParameterCode,
+				Status:        pb.BatchItemStatus_BATCH_ITEM_STATUS_FAILED,
+				Base:          validationResp,
+			}
+			continue
+		}
+		cmd.Items = append(cmd.Items, appparam.BatchUpsertItem{
+			ParameterCode: item.ParameterCode,
+			ParameterName: item.ParameterName,
+			Category:      pbParamCategoryToString(item.ParameterCategory),
+			DataType:      pbDataTypeToString(item.DataType),
+			UOM:           item.Uom,
+			MinValue:      item.MinValue,
+			MaxValue:      item.MaxValue,
+			AllowedValues: item.AllowedValues,
+			IsMandatory:   item.IsMandatory,
+			Description:   item.Description,
+			IsActive:      item.IsActive,
+			Version:       int(item.Version),
+		})
+		positions = append(positions, i)
+	}
+
+	// An atomic batch with invalid items is rejected as a whole
+	if atomic && len(positions) < len(req.Items) {
+		for _, i := range positions {
+			results[i] = &pb.UpsertParameterResult{
+				Index:         int32(i),
+				ParameterCode: req.Items[i].ParameterCode,
+				Status:        pb.BatchItemStatus_BATCH_ITEM_STATUS_SKIPPED,
+				Base:          batchItemBase(batch.StatusSkipped, nil, "Parameter", paramErrorToBaseResponse),
+			}
+		}
+	} else if len(cmd.Items) > 0 {
+		itemResults, err := h.batchUpsertHandler.Handle(ctx, cmd)
+		if err != nil {
+			return &pb.BatchUpsertParametersResponse{
+				Base: paramErrorToBaseResponse(err),
+			}, nil
+		}
+		for j, itemResult := range itemResults {
+			i := positions[j]
+			results[i] = &pb.UpsertParameterResult{
+				Index:         int32(i),
+				ParameterCode: itemResult.Code,
+				Status:        batchStatusToProto(itemResult.Status),
+				Base:          batchItemBase(itemResult.Status, itemResult.Err, "Parameter", paramErrorToBaseResponse),
+			}
+			if itemResult.Entity != nil {
+				results[i].Data = paramEntityToProto(itemResult.Entity)
+			}
+		}
+	}
+
+	statuses := make([]pb.BatchItemStatus, len(results))
+	for i, result := range results {
+		statuses[i] = result.Status
+	}
+	summary := newBatchSummary(statuses)
+
+	return &pb.BatchUpsertParametersResponse{
+		Base:    batchResponseBase(summary, atomic),
+		Results: results,
+		Summary: summary,
+	}, nil
+}
+
+// ImportParameters creates, or with update_existing also updates, the
+// Parameters of a CSV or XLSX file in one transaction.
+func (h *ParameterHandler) ImportParameters(stream pb.ParameterService_ImportParametersServer) error {
+	ctx := stream.Context()
+	opts, data, base, err := receiveImport(stream)
+	if err != nil {
+		return err
+	}
+	if base != nil {
+		return stream.SendAndClose(&pb.ImportResponse{Base: base})
+	}
+
+	sheet, base := readImportSheet(ctx, h.validator, opts, data, func() *pb.CreateParameterRequest {
+		return &pb.CreateParameterRequest{}
+	})
+	if base != nil {
+		return stream.SendAndClose(&pb.ImportResponse{Base: base, DryRun: opts.DryRun})
+	}
+
+	dryRun := sheet.dryRun(opts)
+	cmd := appparam.BatchUpsertCommand{
+		Atomic:     !dryRun,
+		CreateOnly: !opts.UpdateExisting,
+		DryRun:     dryRun,
+		UpsertedBy: actorFromContext(ctx),
+	}
+	for _, row := range sheet.rows {
+		// An empty is_active cell keeps the current state of an existing Parameter
+		var isActive *bool
+		if row.set["is_active"] {
+			isActive = &row.req.IsActive
+		}
+		cmd.Items = append(cmd.Items, appparam.BatchUpsertItem{
+			ParameterCode: row.req.ParameterCode,
+			ParameterName: row.req.ParameterName,
+			Category:      pbParamCategoryToString(row.req.ParameterCategory),
+			DataType:      pbDataTypeToString(row.req.DataType),
+			UOM:           row.req.Uom,
+			MinValue:      row.req.MinValue,
+			MaxValue:      row.req.MaxValue,
+			AllowedValues: row.req.AllowedValues,
+			IsMandatory:   row.req.IsMandatory,
+			Description:   row.req.Description,
+			IsActive:      isActive,
+		})
+	}
+
+	itemResults, err := h.batchUpsertHandler.Handle(ctx, cmd)
+	if err != nil {
+		return stream.SendAndClose(&pb.ImportResponse{Base: paramErrorToBaseResponse(err), DryRun: opts.DryRun})
+	}
+	statuses := make([]pb.BatchItemStatus, len(itemResults))
+	for j, itemResult := range itemResults {
+		statuses[j] = batchStatusToProto(itemResult.Status)
+		if itemResult.Status == batch.StatusFailed {
+			sheet.failBase(sheet.rows[j].line, paramErrorToBaseResponse(itemResult.Err))
+		}
+	}
+
+	return stream.SendAndClose(sheet.response(opts, statuses))
+}
+
+// ExportParameters streams every Parameter matching the List filters as a file.
+func (h *ParameterHandler) ExportParameters(req *pb.ExportParametersRequest, stream pb.ParameterService_ExportParametersServer) error {
+	ctx := stream.Context()
+	if validationResp := h.validator.Validate(ctx, req); validationResp != nil {
+		return exportError(validationResp)
+	}
+
+	query := appparam.ExportQuery{Filter: paramFilterFromProto(listFiltersOf(req))}
+
+	w, err := newExportWriter[*pb.Parameter](stream, req.Format, &pb.CreateParameterRequest{})
+	if err != nil {
+		return err
+	}
+	err = h.exportHandler.Handle(ctx, query, func(entity *parameter.Parameter) error {
+		return w.Write(paramEntityToProto(entity))
+	})
+	if err != nil {
+		return exportError(paramErrorToBaseResponse(err))
+	}
+	return w.Close()
+}
+
+// WatchParameters streams every committed Parameter change after the resume token.
+func (h *ParameterHandler) WatchParameters(req *pb.WatchParametersRequest, stream pb.ParameterService_WatchParametersServer) error {
+	ctx := stream.Context()
+	if validationResp := h.validator.Validate(ctx, req); validationResp != nil {
+		return exportError(validationResp)
+	}
+
+	query := appparam.WatchQuery{ResumeToken: req.ResumeToken}
+	err := h.watchHandler.Handle(ctx, query, watchStarted(stream), func(change *parameter.Change) error {
+		return stream.Send(&pb.ParameterChange{
+			ChangeType:  changeTypeToProto(change.Type),
+			EventType:   change.Type.String(),
+			Parameter:   paramEntityToProto(change.Parameter),
+			OccurredAt:  change.OccurredAt.Format("2006-01-02T15:04:05Z07:00"),
+			ResumeToken: change.Position.String(),
+		})
+	})
+	return watchError(err, paramErrorToBaseResponse)
+}
+
 // Helper functions.
 
+// paramFilterFromProto converts the filters of a list request.
+func paramFilterFromProto(req *pb.ListParametersRequest) appparam.Filter {
+	filter := appparam.Filter{
+		IsActive:       req.IsActive,
+		IncludeDeleted: req.IncludeDeleted,
+		Search:         req.Search,
+		UOM:            req.Uom,
+		IsMandatory:    req.IsMandatory,
+		CreatedBy:      req.CreatedBy,
+		CreatedFrom:    req.CreatedFrom,
+		CreatedTo:      req.CreatedTo,
+		UpdatedFrom:    req.UpdatedFrom,
+		UpdatedTo:      req.UpdatedTo,
+		SortBy:         pbParamSortFieldToString(req.SortBy),
+		SortDesc:       req.SortDirection == pb.SortDirection_SORT_DIRECTION_DESC,
+	}
+	if req.Category != nil && *req.Category != pb.ParameterCategory_PARAMETER_CATEGORY_UNSPECIFIED {
+		cat := pbParamCategoryToString(*req.Category)
+		filter.Category = &cat
+	}
+	if req.DataType != nil && *req.DataType != pb.ParameterDataType_PARAMETER_DATA_TYPE_UNSPECIFIED {
+		dataType := pbDataTypeToString(*req.DataType)
+		filter.DataType = &dataType
+	}
+	return filter
+}
+
+// listFiltersOf copies the filters of an export request, which share their
+// names with the list request's, so both are converted the same way.
+func listFiltersOf(req *pb.ExportParametersRequest) *pb.ListParametersRequest {
+	list := &pb.ListParametersRequest{}
+	dst := list.ProtoReflect()
+	fields := dst.Descriptor().Fields()
+	req.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if target := fields.ByName(fd.Name()); target != nil && target.Kind() == fd.Kind() {
+			dst.Set(target, v)
+		}
+		return true
+	})
+	return list
+}
+
+func pbParamSortFieldToString(field pb.ParameterSortField) string {
+	switch field {
+	case pb.ParameterSortField_PARAMETER_SORT_FIELD_CODE:
+		return "CODE"
+	case pb.ParameterSortField_PARAMETER_SORT_FIELD_NAME:
+		return "NAME"
+	case pb.ParameterSortField_PARAMETER_SORT_FIELD_CATEGORY:
+		return "CATEGORY"
+	case pb.ParameterSortField_PARAMETER_SORT_FIELD_DATA_TYPE:
+		return "DATA_TYPE"
+	case pb.ParameterSortField_PARAMETER_SORT_FIELD_CREATED_AT:
+		return "CREATED_AT"
+	case pb.ParameterSortField_PARAMETER_SORT_FIELD_UPDATED_AT:
+		return "UPDATED_AT"
+	case pb.ParameterSortField_PARAMETER_SORT_FIELD_UNSPECIFIED:
+		return ""
+	}
+	return ""
+}
+
 func pbParamCategoryToString(cat pb.ParameterCategory) string {
 	switch cat {
 	case pb.ParameterCategory_PARAMETER_CATEGORY_MACHINE:
@@ -253,6 +655,7 @@ func paramEntityToProto(entity *parameter.Parameter) *pb.Parameter {
 	audit := &pb.AuditInfo{
 		CreatedAt: entity.CreatedAt().Format("2006-01-02T15:04:05Z07:00"),
 		CreatedBy: entity.CreatedBy(),
+		DeletedBy: entity.DeletedBy(),
 	}
 	if entity.UpdatedAt() != nil {
 		updatedAt := entity.UpdatedAt().Format("2006-01-02T15:04:05Z07:00")
@@ -261,6 +664,10 @@ func paramEntityToProto(entity *parameter.Parameter) *pb.Parameter {
 	if entity.UpdatedBy() != nil {
 		audit.UpdatedBy = entity.UpdatedBy()
 	}
+	if entity.DeletedAt() != nil {
+		deletedAt := entity.DeletedAt().Format("2006-01-02T15:04:05Z07:00")
+		audit.DeletedAt = &deletedAt
+	}
 
 	return &pb.Parameter{
 		ParameterCode:     entity.Code().String(),
@@ -275,7 +682,21 @@ func paramEntityToProto(entity *parameter.Parameter) *pb.Parameter {
 		Description:       entity.Description(),
 		IsActive:          entity.IsActive(),
 		Audit:             audit,
+		Version:           int32(entity.Version()),
+	}
+}
+
+func paramVersionToProto(version *parameter.Version) *pb.ParameterVersion {
+	msg := &pb.ParameterVersion{
+		Parameter:     paramEntityToProto(version.Parameter),
+		EffectiveFrom: version.EffectiveFrom.Format("2006-01-02T15:04:05Z07:00"),
+		RecordedBy:    version.RecordedBy,
 	}
+	if version.EffectiveTo != nil {
+		effectiveTo := version.EffectiveTo.Format("2006-01-02T15:04:05Z07:00")
+		msg.EffectiveTo = &effectiveTo
+	}
+	return msg
 }
 
 func paramSuccessResponse(message string) *pb.BaseResponse {
@@ -287,6 +708,10 @@ func paramSuccessResponse(message string) *pb.BaseResponse {
 }
 
 func paramErrorToBaseResponse(err error) *pb.BaseResponse {
+	if resp := fieldErrorResponse(err); resp != nil {
+		return resp
+	}
+
 	statusCode := "500"
 	message := "Internal server error"
 
@@ -294,15 +719,29 @@ func paramErrorToBaseResponse(err error) *pb.BaseResponse {
 	case errors.Is(err, parameter.ErrNotFound):
 		statusCode = "404"
 		message = err.Error()
-	case errors.Is(err, parameter.ErrAlreadyExists):
+	case errors.Is(err, parameter.ErrAlreadyExists),
+		errors.Is(err, parameter.ErrInUse),
+		errors.Is(err, parameter.ErrVersionConflict),
+		errors.Is(err, parameter.ErrNotDeleted),
+		errors.Is(err, parameter.ErrDeleted),
+		errors.Is(err, changerequest.ErrPendingExists):
 		statusCode = "409"
 		message = err.Error()
+	case errors.Is(err, changerequest.ErrApprovalRequired):
+		statusCode = "403"
+		message = err.Error()
 	case errors.Is(err, parameter.ErrInvalidCode),
 		errors.Is(err, parameter.ErrInvalidCategory),
 		errors.Is(err, parameter.ErrInvalidDataType),
 		errors.Is(err, parameter.ErrEmptyName),
 		errors.Is(err, parameter.ErrMinGreaterThanMax),
-		errors.Is(err, parameter.ErrDropdownNoOptions):
+		errors.Is(err, parameter.ErrDropdownNoOptions),
+		errors.Is(err, parameter.ErrInvalidSortField),
+		errors.Is(err, parameter.ErrInvalidTimeRange),
+		errors.Is(err, parameter.ErrInvalidPageToken),
+		errors.Is(err, parameter.ErrInvalidResumeToken),
+		errors.Is(err, parameter.ErrInvalidAsOf),
+		errors.Is(err, changerequest.ErrNoChanges):
 		statusCode = "400"
 		message = err.Error()
 	}
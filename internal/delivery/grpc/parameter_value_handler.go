@@ -0,0 +1,298 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/homindolenern/goapps-costing-v1/gen/go/costing/v1"
+	appvalue "github.com/homindolenern/goapps-costing-v1/internal/application/parametervalue"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/parameter"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/parametervalue"
+)
+
+// ParameterValueHandler implements the gRPC ParameterValueService.
+type ParameterValueHandler struct {
+	pb.UnimplementedParameterValueServiceServer
+	createHandler *appvalue.CreateHandler
+	updateHandler *appvalue.UpdateHandler
+	deleteHandler *appvalue.DeleteHandler
+	getHandler    *appvalue.GetHandler
+	listHandler   *appvalue.ListHandler
+	validator     *ValidationHelper
+}
+
+// NewParameterValueHandler creates a new ParameterValue handler.
+func NewParameterValueHandler(
+	createHandler *appvalue.CreateHandler,
+	updateHandler *appvalue.UpdateHandler,
+	deleteHandler *appvalue.DeleteHandler,
+	getHandler *appvalue.GetHandler,
+	listHandler *appvalue.ListHandler,
+	validator *ValidationHelper,
+) *ParameterValueHandler {
+	return &ParameterValueHandler{
+		createHandler: createHandler,
+		updateHandler: updateHandler,
+		deleteHandler: deleteHandler,
+		getHandler:    getHandler,
+		listHandler:   listHandler,
+		validator:     validator,
+	}
+}
+
+// CreateParameterValue records a new ParameterValue.
+func (h *ParameterValueHandler) CreateParameterValue(
+	ctx context.Context,
+	req *pb.CreateParameterValueRequest,
+) (*pb.CreateParameterValueResponse, error) {
+	// Validate request
+	if validationResp := h.validator.Validate(ctx, req); validationResp != nil {
+		return &pb.CreateParameterValueResponse{Base: validationResp}, nil
+	}
+
+	cmd := appvalue.CreateCommand{
+		SubjectType:   pbSubjectTypeToString(req.SubjectType),
+		SubjectCode:   req.SubjectCode,
+		ParameterCode: req.ParameterCode,
+		Value:         req.Value,
+		EffectiveFrom: req.EffectiveFrom,
+		EffectiveTo:   req.EffectiveTo,
+		Remarks:       req.Remarks,
+		CreatedBy:     actorFromContext(ctx),
+	}
+
+	entity, err := h.createHandler.Handle(ctx, cmd)
+	if err != nil {
+		return &pb.CreateParameterValueResponse{
+			Base: valueErrorToBaseResponse(err),
+		}, nil
+	}
+
+	return &pb.CreateParameterValueResponse{
+		Base: paramSuccessResponse("Parameter value created successfully"),
+		Data: valueEntityToProto(entity),
+	}, nil
+}
+
+// GetParameterValue retrieves a ParameterValue by ID.
+func (h *ParameterValueHandler) GetParameterValue(
+	ctx context.Context,
+	req *pb.GetParameterValueRequest,
+) (*pb.GetParameterValueResponse, error) {
+	entity, err := h.getHandler.Handle(ctx, appvalue.GetQuery{ID: req.Id})
+	if err != nil {
+		return &pb.GetParameterValueResponse{
+			Base: valueErrorToBaseResponse(err),
+		}, nil
+	}
+
+	return &pb.GetParameterValueResponse{
+		Base: paramSuccessResponse("Parameter value retrieved successfully"),
+		Data: valueEntityToProto(entity),
+	}, nil
+}
+
+// ListParameterValues retrieves a paginated list of ParameterValues.
+func (h *ParameterValueHandler) ListParameterValues(
+	ctx context.Context,
+	req *pb.ListParameterValuesRequest,
+) (*pb.ListParameterValuesResponse, error) {
+	// Validate request
+	if validationResp := h.validator.Validate(ctx, req); validationResp != nil {
+		return &pb.ListParameterValuesResponse{Base: validationResp}, nil
+	}
+
+	query := appvalue.ListQuery{
+		SubjectCode:   req.SubjectCode,
+		ParameterCode: req.ParameterCode,
+		AsOf:          req.AsOf,
+		Page:          int(req.Page),
+		PageSize:      int(req.PageSize),
+	}
+
+	if req.SubjectType != nil && *req.SubjectType != pb.SubjectType_SUBJECT_TYPE_UNSPECIFIED {
+		subjectType := pbSubjectTypeToString(*req.SubjectType)
+		query.SubjectType = &subjectType
+	}
+
+	result, err := h.listHandler.Handle(ctx, query)
+	if err != nil {
+		return &pb.ListParameterValuesResponse{
+			Base: valueErrorToBaseResponse(err),
+		}, nil
+	}
+
+	data := make([]*pb.ParameterValue, len(result.Values))
+	for i, entity := range result.Values {
+		data[i] = valueEntityToProto(entity)
+	}
+
+	totalPages := int32(result.Total) / req.PageSize
+	if int32(result.Total)%req.PageSize > 0 {
+		totalPages++
+	}
+
+	return &pb.ListParameterValuesResponse{
+		Base: paramSuccessResponse("Parameter values retrieved successfully"),
+		Data: data,
+		Pagination: &pb.PaginationMeta{
+			CurrentPage: req.Page,
+			PageSize:    req.PageSize,
+			TotalItems:  result.Total,
+			TotalPages:  totalPages,
+		},
+	}, nil
+}
+
+// UpdateParameterValue updates an existing ParameterValue.
+func (h *ParameterValueHandler) UpdateParameterValue(
+	ctx context.Context,
+	req *pb.UpdateParameterValueRequest,
+) (*pb.UpdateParameterValueResponse, error) {
+	// Validate request
+	if validationResp := h.validator.Validate(ctx, req); validationResp != nil {
+		return &pb.UpdateParameterValueResponse{Base: validationResp}, nil
+	}
+
+	cmd := appvalue.UpdateCommand{
+		ID:            req.Id,
+		Value:         req.Value,
+		EffectiveFrom: req.EffectiveFrom,
+		EffectiveTo:   req.EffectiveTo,
+		Remarks:       req.Remarks,
+		UpdatedBy:     actorFromContext(ctx),
+	}
+
+	entity, err := h.updateHandler.Handle(ctx, cmd)
+	if err != nil {
+		return &pb.UpdateParameterValueResponse{
+			Base: valueErrorToBaseResponse(err),
+		}, nil
+	}
+
+	return &pb.UpdateParameterValueResponse{
+		Base: paramSuccessResponse("Parameter value updated successfully"),
+		Data: valueEntityToProto(entity),
+	}, nil
+}
+
+// DeleteParameterValue deletes a ParameterValue by ID.
+func (h *ParameterValueHandler) DeleteParameterValue(
+	ctx context.Context,
+	req *pb.DeleteParameterValueRequest,
+) (*pb.DeleteParameterValueResponse, error) {
+	cmd := appvalue.DeleteCommand{
+		ID:        req.Id,
+		DeletedBy: actorFromContext(ctx),
+	}
+
+	err := h.deleteHandler.Handle(ctx, cmd)
+	if err != nil {
+		return &pb.DeleteParameterValueResponse{
+			Base: valueErrorToBaseResponse(err),
+		}, nil
+	}
+
+	return &pb.DeleteParameterValueResponse{
+		Base: paramSuccessResponse("Parameter value deleted successfully"),
+	}, nil
+}
+
+// Helper functions.
+
+func pbSubjectTypeToString(st pb.SubjectType) string {
+	switch st {
+	case pb.SubjectType_SUBJECT_TYPE_MACHINE:
+		return "MACHINE"
+	case pb.SubjectType_SUBJECT_TYPE_MATERIAL:
+		return "MATERIAL"
+	case pb.SubjectType_SUBJECT_TYPE_PRODUCT:
+		return "PRODUCT"
+	case pb.SubjectType_SUBJECT_TYPE_UNSPECIFIED:
+		return ""
+	}
+	return ""
+}
+
+func stringToPbSubjectType(st string) pb.SubjectType {
+	switch st {
+	case "MACHINE":
+		return pb.SubjectType_SUBJECT_TYPE_MACHINE
+	case "MATERIAL":
+		return pb.SubjectType_SUBJECT_TYPE_MATERIAL
+	case "PRODUCT":
+		return pb.SubjectType_SUBJECT_TYPE_PRODUCT
+	default:
+		return pb.SubjectType_SUBJECT_TYPE_UNSPECIFIED
+	}
+}
+
+func valueEntityToProto(entity *parametervalue.ParameterValue) *pb.ParameterValue {
+	audit := &pb.AuditInfo{
+		CreatedAt: entity.CreatedAt().Format("2006-01-02T15:04:05Z07:00"),
+		CreatedBy: entity.CreatedBy(),
+	}
+	if entity.UpdatedAt() != nil {
+		updatedAt := entity.UpdatedAt().Format("2006-01-02T15:04:05Z07:00")
+		audit.UpdatedAt = &updatedAt
+	}
+	if entity.UpdatedBy() != nil {
+		audit.UpdatedBy = entity.UpdatedBy()
+	}
+
+	var effectiveTo *string
+	if to := entity.Period().To(); to != nil {
+		s := to.Format(parametervalue.DateLayout)
+		effectiveTo = &s
+	}
+
+	return &pb.ParameterValue{
+		Id:            entity.ID(),
+		SubjectType:   stringToPbSubjectType(entity.SubjectType().String()),
+		SubjectCode:   entity.SubjectCode().String(),
+		ParameterCode: entity.ParameterCode().String(),
+		DataType:      stringToPbDataType(entity.DataType().String()),
+		Value:         entity.Value(),
+		NumericValue:  entity.NumericValue(),
+		EffectiveFrom: entity.Period().From().Format(parametervalue.DateLayout),
+		EffectiveTo:   effectiveTo,
+		Remarks:       entity.Remarks(),
+		Audit:         audit,
+	}
+}
+
+func valueErrorToBaseResponse(err error) *pb.BaseResponse {
+	statusCode := "500"
+	message := "Internal server error"
+
+	switch {
+	case errors.Is(err, parametervalue.ErrNotFound),
+		errors.Is(err, parameter.ErrNotFound):
+		statusCode = "404"
+		message = err.Error()
+	case errors.Is(err, parametervalue.ErrAlreadyExists),
+		errors.Is(err, parametervalue.ErrPeriodOverlap):
+		statusCode = "409"
+		message = err.Error()
+	case errors.Is(err, parametervalue.ErrInvalidSubjectType),
+		errors.Is(err, parametervalue.ErrInvalidSubjectCode),
+		errors.Is(err, parametervalue.ErrInvalidDate),
+		errors.Is(err, parametervalue.ErrInvalidPeriod),
+		errors.Is(err, parametervalue.ErrParameterInactive),
+		errors.Is(err, parametervalue.ErrEmptyValue),
+		errors.Is(err, parametervalue.ErrValueNotNumeric),
+		errors.Is(err, parametervalue.ErrValueBelowMin),
+		errors.Is(err, parametervalue.ErrValueAboveMax),
+		errors.Is(err, parametervalue.ErrValueNotBoolean),
+		errors.Is(err, parametervalue.ErrValueNotAllowed),
+		errors.Is(err, parameter.ErrInvalidCode):
+		statusCode = "400"
+		message = err.Error()
+	}
+
+	return &pb.BaseResponse{
+		StatusCode: statusCode,
+		IsSuccess:  false,
+		Message:    message,
+	}
+}
@@ -0,0 +1,28 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/homindolenern/goapps-costing-v1/pkg/auth"
+)
+
+// systemActor is recorded in audit fields when authentication is disabled.
+const systemActor = "system"
+
+// actorFromContext returns the authenticated caller recorded in
+// CreatedBy/UpdatedBy/DeletedBy.
+func actorFromContext(ctx context.Context) string {
+	if principal, ok := auth.PrincipalFromContext(ctx); ok {
+		return principal.Name()
+	}
+	return systemActor
+}
+
+// subjectFromContext returns the immutable token subject of the caller,
+// which tells people apart where display names may change or collide.
+func subjectFromContext(ctx context.Context) string {
+	if principal, ok := auth.PrincipalFromContext(ctx); ok {
+		return principal.Subject
+	}
+	return systemActor
+}
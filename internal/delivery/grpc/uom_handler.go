@@ -5,19 +5,29 @@ import (
 	"errors"
 
 	pb "github.com/homindolenern/goapps-costing-v1/gen/go/costing/v1"
+	"github.com/homindolenern/goapps-costing-v1/internal/application/batch"
 	appuom "github.com/homindolenern/goapps-costing-v1/internal/application/uom"
 	"github.com/homindolenern/goapps-costing-v1/internal/domain/uom"
+	"github.com/homindolenern/goapps-costing-v1/pkg/auth"
 )
 
 // UOMHandler implements the gRPC UOMService.
 type UOMHandler struct {
 	pb.UnimplementedUOMServiceServer
-	createHandler *appuom.CreateHandler
-	updateHandler *appuom.UpdateHandler
-	deleteHandler *appuom.DeleteHandler
-	getHandler    *appuom.GetHandler
-	listHandler   *appuom.ListHandler
-	validator     *ValidationHelper
+	createHandler           *appuom.CreateHandler
+	updateHandler           *appuom.UpdateHandler
+	deleteHandler           *appuom.DeleteHandler
+	restoreHandler          *appuom.RestoreHandler
+	batchUpsertHandler      *appuom.BatchUpsertHandler
+	getHandler              *appuom.GetHandler
+	listHandler             *appuom.ListHandler
+	exportHandler           *appuom.ExportHandler
+	watchHandler            *appuom.WatchHandler
+	convertHandler          *appuom.ConvertHandler
+	listConversionsHandler  *appuom.ListConversionsHandler
+	createConversionHandler *appuom.CreateConversionHandler
+	deleteConversionHandler *appuom.DeleteConversionHandler
+	validator               *ValidationHelper
 }
 
 // NewUOMHandler creates a new UOM handler.
@@ -25,17 +35,33 @@ func NewUOMHandler(
 	createHandler *appuom.CreateHandler,
 	updateHandler *appuom.UpdateHandler,
 	deleteHandler *appuom.DeleteHandler,
+	restoreHandler *appuom.RestoreHandler,
+	batchUpsertHandler *appuom.BatchUpsertHandler,
 	getHandler *appuom.GetHandler,
 	listHandler *appuom.ListHandler,
+	exportHandler *appuom.ExportHandler,
+	watchHandler *appuom.WatchHandler,
+	convertHandler *appuom.ConvertHandler,
+	listConversionsHandler *appuom.ListConversionsHandler,
+	createConversionHandler *appuom.CreateConversionHandler,
+	deleteConversionHandler *appuom.DeleteConversionHandler,
 	validator *ValidationHelper,
 ) *UOMHandler {
 	return &UOMHandler{
-		createHandler: createHandler,
-		updateHandler: updateHandler,
-		deleteHandler: deleteHandler,
-		getHandler:    getHandler,
-		listHandler:   listHandler,
-		validator:     validator,
+		createHandler:           createHandler,
+		updateHandler:           updateHandler,
+		deleteHandler:           deleteHandler,
+		restoreHandler:          restoreHandler,
+		batchUpsertHandler:      batchUpsertHandler,
+		getHandler:              getHandler,
+		listHandler:             listHandler,
+		exportHandler:           exportHandler,
+		watchHandler:            watchHandler,
+		convertHandler:          convertHandler,
+		listConversionsHandler:  listConversionsHandler,
+		createConversionHandler: createConversionHandler,
+		deleteConversionHandler: deleteConversionHandler,
+		validator:               validator,
 	}
 }
 
@@ -47,11 +73,12 @@ func (h *UOMHandler) CreateUOM(ctx context.Context, req *pb.CreateUOMRequest) (*
 	}
 
 	cmd := appuom.CreateCommand{
-		UOMCode:   req.UomCode,
-		UOMName:   req.UomName,
-		Category:  pbCategoryToString(req.UomCategory),
-		IsBaseUOM: req.IsBaseUom,
-		CreatedBy: "system", // TODO: Extract from context/auth
+		UOMCode:          req.UomCode,
+		UOMName:          req.UomName,
+		Category:         pbCategoryToString(req.UomCategory),
+		IsBaseUOM:        req.IsBaseUom,
+		ConversionFactor: req.ConversionFactor,
+		CreatedBy:        actorFromContext(ctx),
 	}
 
 	entity, err := h.createHandler.Handle(ctx, cmd)
@@ -61,6 +88,7 @@ func (h *UOMHandler) CreateUOM(ctx context.Context, req *pb.CreateUOMRequest) (*
 		}, nil
 	}
 
+	setETag(ctx, entity.Version())
 	return &pb.CreateUOMResponse{
 		Base: successResponse("UOM created successfully"),
 		Data: entityToProto(entity),
@@ -78,6 +106,7 @@ func (h *UOMHandler) GetUOM(ctx context.Context, req *pb.GetUOMRequest) (*pb.Get
 		}, nil
 	}
 
+	setETag(ctx, entity.Version())
 	return &pb.GetUOMResponse{
 		Base: successResponse("UOM retrieved successfully"),
 		Data: entityToProto(entity),
@@ -86,9 +115,17 @@ func (h *UOMHandler) GetUOM(ctx context.Context, req *pb.GetUOMRequest) (*pb.Get
 
 // ListUOMs retrieves a paginated list of Units of Measure.
 func (h *UOMHandler) ListUOMs(ctx context.Context, req *pb.ListUOMsRequest) (*pb.ListUOMsResponse, error) {
+	if validationResp := h.validator.Validate(ctx, req); validationResp != nil {
+		return &pb.ListUOMsResponse{
+			Base: validationResp,
+		}, nil
+	}
+
 	query := appuom.ListQuery{
-		Page:     int(req.Page),
-		PageSize: int(req.PageSize),
+		Page:           int(req.Page),
+		PageSize:       int(req.PageSize),
+		IncludeDeleted: req.IncludeDeleted,
+		PageToken:      req.PageToken,
 	}
 
 	if req.Category != nil && *req.Category != pb.UOMCategory_UOM_CATEGORY_UNSPECIFIED {
@@ -117,27 +154,35 @@ func (h *UOMHandler) ListUOMs(ctx context.Context, req *pb.ListUOMsRequest) (*pb
 		Base: successResponse("UOMs retrieved successfully"),
 		Data: data,
 		Pagination: &pb.PaginationMeta{
-			CurrentPage: req.Page,
+			CurrentPage: int32(result.Page),
 			PageSize:    req.PageSize,
 			TotalItems:  result.Total,
 			TotalPages:  totalPages,
 		},
+		NextPageToken: result.NextPageToken,
 	}, nil
 }
 
 // UpdateUOM updates an existing Unit of Measure.
 func (h *UOMHandler) UpdateUOM(ctx context.Context, req *pb.UpdateUOMRequest) (*pb.UpdateUOMResponse, error) {
+	// Over HTTP the version may come from If-Match instead of the body
+	if req.Version == 0 {
+		req.Version = versionFromIfMatch(ctx)
+	}
+
 	// Validate request
 	if validationResp := h.validator.Validate(ctx, req); validationResp != nil {
 		return &pb.UpdateUOMResponse{Base: validationResp}, nil
 	}
 
 	cmd := appuom.UpdateCommand{
-		UOMCode:   req.UomCode,
-		UOMName:   req.UomName,
-		Category:  pbCategoryToString(req.UomCategory),
-		IsBaseUOM: req.IsBaseUom,
-		UpdatedBy: "system", // TODO: Extract from context/auth
+		UOMCode:          req.UomCode,
+		UOMName:          req.UomName,
+		Category:         pbCategoryToString(req.UomCategory),
+		IsBaseUOM:        req.IsBaseUom,
+		ConversionFactor: req.ConversionFactor,
+		Version:          int(req.Version),
+		UpdatedBy:        actorFromContext(ctx),
 	}
 
 	entity, err := h.updateHandler.Handle(ctx, cmd)
@@ -147,15 +192,20 @@ func (h *UOMHandler) UpdateUOM(ctx context.Context, req *pb.UpdateUOMRequest) (*
 		}, nil
 	}
 
+	setETag(ctx, entity.Version())
 	return &pb.UpdateUOMResponse{
 		Base: successResponse("UOM updated successfully"),
 		Data: entityToProto(entity),
 	}, nil
 }
 
-// DeleteUOM deletes a Unit of Measure by code.
+// DeleteUOM soft-deletes a Unit of Measure by code.
 func (h *UOMHandler) DeleteUOM(ctx context.Context, req *pb.DeleteUOMRequest) (*pb.DeleteUOMResponse, error) {
-	cmd := appuom.DeleteCommand{UOMCode: req.UomCode}
+	cmd := appuom.DeleteCommand{
+		UOMCode:   req.UomCode,
+		Force:     req.Force,
+		DeletedBy: actorFromContext(ctx),
+	}
 
 	err := h.deleteHandler.Handle(ctx, cmd)
 	if err != nil {
@@ -169,6 +219,309 @@ func (h *UOMHandler) DeleteUOM(ctx context.Context, req *pb.DeleteUOMRequest) (*
 	}, nil
 }
 
+// RestoreUOM restores a soft-deleted Unit of Measure.
+func (h *UOMHandler) RestoreUOM(ctx context.Context, req *pb.RestoreUOMRequest) (*pb.RestoreUOMResponse, error) {
+	cmd := appuom.RestoreCommand{
+		UOMCode:    req.UomCode,
+		RestoredBy: actorFromContext(ctx),
+	}
+
+	entity, err := h.restoreHandler.Handle(ctx, cmd)
+	if err != nil {
+		return &pb.RestoreUOMResponse{
+			Base: errorToBaseResponse(err),
+		}, nil
+	}
+
+	setETag(ctx, entity.Version())
+	return &pb.RestoreUOMResponse{
+		Base: successResponse("UOM restored successfully"),
+		Data: entityToProto(entity),
+	}, nil
+}
+
+// BatchUpsertUOMs creates or updates many Units of Measure in one transaction.
+func (h *UOMHandler) BatchUpsertUOMs(ctx context.Context, req *pb.BatchUpsertUOMsRequest) (*pb.BatchUpsertUOMsResponse, error) {
+	if sizeResp := batchSizeResponse(len(req.Items)); sizeResp != nil {
+		return &pb.BatchUpsertUOMsResponse{Base: sizeResp}, nil
+	}
+	atomic := req.Mode != pb.BatchMode_BATCH_MODE_BEST_EFFORT
+
+	// Validate every item on its own so failures are reported per item
+	results := make([]*pb.UpsertUOMResult, len(req.Items))
+	positions := make([]int, 0, len(req.Items))
+	cmd := appuom.BatchUpsertCommand{Atomic: atomic, UpsertedBy: actorFromContext(ctx)}
+	for i, item := range req.Items {
+		if validationResp := h.validator.Validate(ctx, item); validationResp != nil {
+			results[i] = &pb.UpsertUOMResult{
+				Index:   int32(i),
+				UomCode: item.UomCode,
+				Status:  pb.BatchItemStatus_BATCH_ITEM_STATUS_FAILED,
+				Base:    validationResp,
+			}
+			continue
+		}
+		cmd.Items = append(cmd.Items, appuom.BatchUpsertItem{
+			UOMCode:          item.UomCode,
+			UOMName:          item.UomName,
+			Category:         pbCategoryToString(item.UomCategory),
+			IsBaseUOM:        item.IsBaseUom,
+			ConversionFactor: item.ConversionFactor,
+			Version:          int(item.Version),
+		})
+		positions = append(positions, i)
+	}
+
+	// An atomic batch with invalid items is rejected as a whole
+	if atomic && len(positions) < len(req.Items) {
+		for _, i := range positions {
+			results[i] = &pb.UpsertUOMResult{
+				Index:   int32(i),
+				UomCode: req.Items[i].UomCode,
+				Status:  pb.BatchItemStatus_BATCH_ITEM_STATUS_SKIPPED,
+				Base:    batchItemBase(batch.StatusSkipped, nil, "UOM", errorToBaseResponse),
+			}
+		}
+	} else if len(cmd.Items) > 0 {
+		itemResults, err := h.batchUpsertHandler.Handle(ctx, cmd)
+		if err != nil {
+			return &pb.BatchUpsertUOMsResponse{
+				Base: errorToBaseResponse(err),
+			}, nil
+		}
+		for j, itemResult := range itemResults {
+			i := positions[j]
+			results[i] = &pb.UpsertUOMResult{
+				Index:   int32(i),
+				UomCode: itemResult.Code,
+				Status:  batchStatusToProto(itemResult.Status),
+				Base:    batchItemBase(itemResult.Status, itemResult.Err, "UOM", errorToBaseResponse),
+			}
+			if itemResult.Entity != nil {
+				results[i].Data = entityToProto(itemResult.Entity)
+			}
+		}
+	}
+
+	statuses := make([]pb.BatchItemStatus, len(results))
+	for i, result := range results {
+		statuses[i] = result.Status
+	}
+	summary := newBatchSummary(statuses)
+
+	return &pb.BatchUpsertUOMsResponse{
+		Base:    batchResponseBase(summary, atomic),
+		Results: results,
+		Summary: summary,
+	}, nil
+}
+
+// ImportUOMs creates, or with update_existing also updates, the UOMs of a
+// CSV or XLSX file in one transaction. Base UOMs are not imported; creating
+// one needs the SetBaseUOM permission and is left to CreateUOM.
+func (h *UOMHandler) ImportUOMs(stream pb.UOMService_ImportUOMsServer) error {
+	ctx := stream.Context()
+	opts, data, base, err := receiveImport(stream)
+	if err != nil {
+		return err
+	}
+	if base != nil {
+		return stream.SendAndClose(&pb.ImportResponse{Base: base})
+	}
+
+	sheet, base := readImportSheet(ctx, h.validator, opts, data, func() *pb.CreateUOMRequest {
+		return &pb.CreateUOMRequest{}
+	})
+	if base != nil {
+		return stream.SendAndClose(&pb.ImportResponse{Base: base, DryRun: opts.DryRun})
+	}
+
+	var lines []int
+	var items []appuom.BatchUpsertItem
+	for _, row := range sheet.rows {
+		if row.req.IsBaseUom {
+			sheet.fail(row.line, sheet.headers["is_base_uom"], "base UOMs cannot be imported, use CreateUOM")
+			continue
+		}
+		lines = append(lines, row.line)
+		items = append(items, appuom.BatchUpsertItem{
+			UOMCode:          row.req.UomCode,
+			UOMName:          row.req.UomName,
+			Category:         pbCategoryToString(row.req.UomCategory),
+			ConversionFactor: row.req.ConversionFactor,
+		})
+	}
+
+	dryRun := sheet.dryRun(opts)
+	itemResults, err := h.batchUpsertHandler.Handle(ctx, appuom.BatchUpsertCommand{
+		Items:      items,
+		Atomic:     !dryRun,
+		CreateOnly: !opts.UpdateExisting,
+		DryRun:     dryRun,
+		UpsertedBy: actorFromContext(ctx),
+	})
+	if err != nil {
+		return stream.SendAndClose(&pb.ImportResponse{Base: errorToBaseResponse(err), DryRun: opts.DryRun})
+	}
+	statuses := make([]pb.BatchItemStatus, len(itemResults))
+	for j, itemResult := range itemResults {
+		statuses[j] = batchStatusToProto(itemResult.Status)
+		if itemResult.Status == batch.StatusFailed {
+			sheet.failBase(lines[j], errorToBaseResponse(itemResult.Err))
+		}
+	}
+
+	return stream.SendAndClose(sheet.response(opts, statuses))
+}
+
+// ExportUOMs streams every UOM matching the List filters as a file.
+func (h *UOMHandler) ExportUOMs(req *pb.ExportUOMsRequest, stream pb.UOMService_ExportUOMsServer) error {
+	ctx := stream.Context()
+	if validationResp := h.validator.Validate(ctx, req); validationResp != nil {
+		return exportError(validationResp)
+	}
+
+	query := appuom.ExportQuery{IncludeDeleted: req.IncludeDeleted}
+	if req.Category != nil && *req.Category != pb.UOMCategory_UOM_CATEGORY_UNSPECIFIED {
+		cat := pbCategoryToString(*req.Category)
+		query.Category = &cat
+	}
+
+	w, err := newExportWriter[*pb.UOM](stream, req.Format, &pb.CreateUOMRequest{})
+	if err != nil {
+		return err
+	}
+	err = h.exportHandler.Handle(ctx, query, func(entity *uom.UOM) error {
+		return w.Write(entityToProto(entity))
+	})
+	if err != nil {
+		return exportError(errorToBaseResponse(err))
+	}
+	return w.Close()
+}
+
+// WatchUOMs streams every committed UOM change after the resume token.
+func (h *UOMHandler) WatchUOMs(req *pb.WatchUOMsRequest, stream pb.UOMService_WatchUOMsServer) error {
+	ctx := stream.Context()
+	if validationResp := h.validator.Validate(ctx, req); validationResp != nil {
+		return exportError(validationResp)
+	}
+
+	query := appuom.WatchQuery{ResumeToken: req.ResumeToken}
+	err := h.watchHandler.Handle(ctx, query, watchStarted(stream), func(change *uom.Change) error {
+		return stream.Send(&pb.UOMChange{
+			ChangeType:  changeTypeToProto(change.Type),
+			EventType:   change.Type.String(),
+			Uom:         entityToProto(change.UOM),
+			OccurredAt:  change.OccurredAt.Format("2006-01-02T15:04:05Z07:00"),
+			ResumeToken: change.Position.String(),
+		})
+	})
+	return watchError(err, errorToBaseResponse)
+}
+
+// ConvertQuantity converts a quantity from one Unit of Measure to another.
+func (h *UOMHandler) ConvertQuantity(ctx context.Context, req *pb.ConvertQuantityRequest) (*pb.ConvertQuantityResponse, error) {
+	// Validate request
+	if validationResp := h.validator.Validate(ctx, req); validationResp != nil {
+		return &pb.ConvertQuantityResponse{Base: validationResp}, nil
+	}
+
+	query := appuom.ConvertQuery{
+		Quantity:    req.Quantity,
+		FromUOMCode: req.FromUomCode,
+		ToUOMCode:   req.ToUomCode,
+	}
+
+	result, err := h.convertHandler.Handle(ctx, query)
+	if err != nil {
+		return &pb.ConvertQuantityResponse{
+			Base: errorToBaseResponse(err),
+		}, nil
+	}
+
+	return &pb.ConvertQuantityResponse{
+		Base: successResponse("Quantity converted successfully"),
+		Data: &pb.ConvertQuantityResult{
+			Quantity:          result.Quantity,
+			FromUomCode:       result.FromUOMCode,
+			ToUomCode:         result.ToUOMCode,
+			ConvertedQuantity: result.ConvertedQuantity,
+			Factor:            result.Factor,
+		},
+	}, nil
+}
+
+// ListConversions lists explicit conversions between Units of Measure.
+func (h *UOMHandler) ListConversions(ctx context.Context, req *pb.ListConversionsRequest) (*pb.ListConversionsResponse, error) {
+	query := appuom.ListConversionsQuery{UOMCode: req.UomCode}
+
+	conversions, err := h.listConversionsHandler.Handle(ctx, query)
+	if err != nil {
+		return &pb.ListConversionsResponse{
+			Base: errorToBaseResponse(err),
+		}, nil
+	}
+
+	data := make([]*pb.UOMConversion, len(conversions))
+	for i, conversion := range conversions {
+		data[i] = conversionToProto(conversion)
+	}
+
+	return &pb.ListConversionsResponse{
+		Base: successResponse("UOM conversions retrieved successfully"),
+		Data: data,
+	}, nil
+}
+
+// CreateConversion creates an explicit conversion between two categories.
+func (h *UOMHandler) CreateConversion(ctx context.Context, req *pb.CreateConversionRequest) (*pb.CreateConversionResponse, error) {
+	// Validate request
+	if validationResp := h.validator.Validate(ctx, req); validationResp != nil {
+		return &pb.CreateConversionResponse{Base: validationResp}, nil
+	}
+
+	cmd := appuom.CreateConversionCommand{
+		FromUOMCode: req.FromUomCode,
+		ToUOMCode:   req.ToUomCode,
+		Factor:      req.Factor,
+		Description: req.Description,
+		CreatedBy:   actorFromContext(ctx),
+	}
+
+	conversion, err := h.createConversionHandler.Handle(ctx, cmd)
+	if err != nil {
+		return &pb.CreateConversionResponse{
+			Base: errorToBaseResponse(err),
+		}, nil
+	}
+
+	return &pb.CreateConversionResponse{
+		Base: successResponse("UOM conversion created successfully"),
+		Data: conversionToProto(conversion),
+	}, nil
+}
+
+// DeleteConversion deletes an explicit conversion.
+func (h *UOMHandler) DeleteConversion(ctx context.Context, req *pb.DeleteConversionRequest) (*pb.DeleteConversionResponse, error) {
+	cmd := appuom.DeleteConversionCommand{
+		FromUOMCode: req.FromUomCode,
+		ToUOMCode:   req.ToUomCode,
+		DeletedBy:   actorFromContext(ctx),
+	}
+
+	err := h.deleteConversionHandler.Handle(ctx, cmd)
+	if err != nil {
+		return &pb.DeleteConversionResponse{
+			Base: errorToBaseResponse(err),
+		}, nil
+	}
+
+	return &pb.DeleteConversionResponse{
+		Base: successResponse("UOM conversion deleted successfully"),
+	}, nil
+}
+
 // Helper functions.
 
 func pbCategoryToString(cat pb.UOMCategory) string {
@@ -206,6 +559,7 @@ func entityToProto(entity *uom.UOM) *pb.UOM {
 	audit := &pb.AuditInfo{
 		CreatedAt: entity.CreatedAt().Format("2006-01-02T15:04:05Z07:00"),
 		CreatedBy: entity.CreatedBy(),
+		DeletedBy: entity.DeletedBy(),
 	}
 	if entity.UpdatedAt() != nil {
 		updatedAt := entity.UpdatedAt().Format("2006-01-02T15:04:05Z07:00")
@@ -214,13 +568,32 @@ func entityToProto(entity *uom.UOM) *pb.UOM {
 	if entity.UpdatedBy() != nil {
 		audit.UpdatedBy = entity.UpdatedBy()
 	}
+	if entity.DeletedAt() != nil {
+		deletedAt := entity.DeletedAt().Format("2006-01-02T15:04:05Z07:00")
+		audit.DeletedAt = &deletedAt
+	}
 
 	return &pb.UOM{
-		UomCode:     entity.Code().String(),
-		UomName:     entity.Name(),
-		UomCategory: stringToPbCategory(entity.Category().String()),
-		IsBaseUom:   entity.IsBaseUOM(),
-		Audit:       audit,
+		UomCode:          entity.Code().String(),
+		UomName:          entity.Name(),
+		UomCategory:      stringToPbCategory(entity.Category().String()),
+		IsBaseUom:        entity.IsBaseUOM(),
+		Audit:            audit,
+		ConversionFactor: entity.ConversionFactor(),
+		Version:          int32(entity.Version()),
+	}
+}
+
+func conversionToProto(conversion *uom.Conversion) *pb.UOMConversion {
+	return &pb.UOMConversion{
+		FromUomCode: conversion.FromUOM().String(),
+		ToUomCode:   conversion.ToUOM().String(),
+		Factor:      conversion.Factor(),
+		Description: conversion.Description(),
+		Audit: &pb.AuditInfo{
+			CreatedAt: conversion.CreatedAt().Format("2006-01-02T15:04:05Z07:00"),
+			CreatedBy: conversion.CreatedBy(),
+		},
 	}
 }
 
@@ -233,19 +606,43 @@ func successResponse(message string) *pb.BaseResponse {
 }
 
 func errorToBaseResponse(err error) *pb.BaseResponse {
+	if resp := fieldErrorResponse(err); resp != nil {
+		return resp
+	}
+
 	statusCode := "500"
 	message := "Internal server error"
 
 	switch {
-	case errors.Is(err, uom.ErrNotFound):
+	case errors.Is(err, uom.ErrNotFound),
+		errors.Is(err, uom.ErrConversionNotFound):
 		statusCode = "404"
 		message = err.Error()
-	case errors.Is(err, uom.ErrAlreadyExists):
+	case errors.Is(err, auth.ErrPermissionDenied):
+		statusCode = "403"
+		message = err.Error()
+	case errors.Is(err, uom.ErrAlreadyExists),
+		errors.Is(err, uom.ErrConversionExists),
+		errors.Is(err, uom.ErrBaseUOMExists),
+		errors.Is(err, uom.ErrVersionConflict),
+		errors.Is(err, uom.ErrInUse),
+		errors.Is(err, uom.ErrNotDeleted),
+		errors.Is(err, uom.ErrDeleted):
 		statusCode = "409"
 		message = err.Error()
 	case errors.Is(err, uom.ErrInvalidUOMCode),
 		errors.Is(err, uom.ErrInvalidCategory),
-		errors.Is(err, uom.ErrEmptyName):
+		errors.Is(err, uom.ErrEmptyName),
+		errors.Is(err, uom.ErrInvalidConversionFactor),
+		errors.Is(err, uom.ErrBaseFactorNotOne),
+		errors.Is(err, uom.ErrConversionSameUOM),
+		errors.Is(err, uom.ErrConversionSameCategory),
+		errors.Is(err, uom.ErrAmbiguousConversion),
+		errors.Is(err, uom.ErrBaseUOMRequired),
+		errors.Is(err, uom.ErrBasePromotionCategory),
+		errors.Is(err, uom.ErrCategoryChangeFactor),
+		errors.Is(err, uom.ErrInvalidPageToken),
+		errors.Is(err, uom.ErrInvalidResumeToken):
 		statusCode = "400"
 		message = err.Error()
 	}
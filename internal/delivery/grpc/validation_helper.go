@@ -8,6 +8,8 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	pb "github.com/homindolenern/goapps-costing-v1/gen/go/costing/v1"
+	"github.com/homindolenern/goapps-costing-v1/internal/delivery/grpc/interceptors"
+	pkgerrors "github.com/homindolenern/goapps-costing-v1/pkg/errors"
 )
 
 // ValidationHelper provides validation utilities for handlers.
@@ -42,6 +44,22 @@ func (h *ValidationHelper) Validate(_ context.Context, msg proto.Message) *pb.Ba
 	}
 }
 
+// fieldErrorResponse converts a field validation error raised by an application
+// handler into a 400 BaseResponse. It returns nil for any other error.
+func fieldErrorResponse(err error) *pb.BaseResponse {
+	var appErr *pkgerrors.AppError
+	if !errors.As(err, &appErr) || appErr.Validation == nil {
+		return nil
+	}
+
+	return &pb.BaseResponse{
+		StatusCode:       "400",
+		IsSuccess:        false,
+		Message:          appErr.Message,
+		ValidationErrors: interceptors.ParseValidationErrors(appErr.Validation),
+	}
+}
+
 // parseValidationError parses protovalidate error into structured format.
 func (h *ValidationHelper) parseValidationError(err error) []*pb.ValidationError {
 	if err == nil {
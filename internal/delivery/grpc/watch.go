@@ -0,0 +1,55 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/homindolenern/goapps-costing-v1/gen/go/costing/v1"
+	"github.com/homindolenern/goapps-costing-v1/internal/application/watch"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/event"
+)
+
+// changeTypeToProto reports an event by the effect it has on a client's copy:
+// anything but creation and deletion updates the entity.
+func changeTypeToProto(eventType event.Type) pb.ChangeType {
+	switch eventType.Action() {
+	case "created":
+		return pb.ChangeType_CHANGE_TYPE_CREATED
+	case "deleted":
+		return pb.ChangeType_CHANGE_TYPE_DELETED
+	default:
+		return pb.ChangeType_CHANGE_TYPE_UPDATED
+	}
+}
+
+// WatchStartKey is the response header carrying the resume token a watch
+// starts from. It is sent once the watch is accepted, before any change.
+const WatchStartKey = "x-watch-start"
+
+// watchStarted returns the callback sending WatchStartKey on stream.
+func watchStarted(stream grpc.ServerStream) func(event.Position) error {
+	return func(from event.Position) error {
+		return stream.SendHeader(metadata.Pairs(WatchStartKey, from.String()))
+	}
+}
+
+// watchError turns the error a watch ended with into its stream status.
+// A stopped watch is Unavailable so clients resume elsewhere.
+func watchError(err error, toBase func(error) *pb.BaseResponse) error {
+	switch {
+	case errors.Is(err, watch.ErrStopped):
+		return status.Error(codes.Unavailable, err.Error())
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return status.FromContextError(err).Err()
+	}
+	if _, ok := status.FromError(err); ok {
+		// Sending failed; the stream is already broken
+		return err
+	}
+	return exportError(toBase(err))
+}
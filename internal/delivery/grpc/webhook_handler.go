@@ -0,0 +1,286 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/homindolenern/goapps-costing-v1/gen/go/costing/v1"
+	appwebhook "github.com/homindolenern/goapps-costing-v1/internal/application/webhook"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/event"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/webhook"
+)
+
+// WebhookHandler implements the gRPC WebhookService.
+type WebhookHandler struct {
+	pb.UnimplementedWebhookServiceServer
+	createHandler         *appwebhook.CreateHandler
+	deleteHandler         *appwebhook.DeleteHandler
+	replayHandler         *appwebhook.ReplayHandler
+	listHandler           *appwebhook.ListHandler
+	listDeliveriesHandler *appwebhook.ListDeliveriesHandler
+	validator             *ValidationHelper
+}
+
+// NewWebhookHandler creates a new Webhook handler.
+func NewWebhookHandler(
+	createHandler *appwebhook.CreateHandler,
+	deleteHandler *appwebhook.DeleteHandler,
+	replayHandler *appwebhook.ReplayHandler,
+	listHandler *appwebhook.ListHandler,
+	listDeliveriesHandler *appwebhook.ListDeliveriesHandler,
+	validator *ValidationHelper,
+) *WebhookHandler {
+	return &WebhookHandler{
+		createHandler:         createHandler,
+		deleteHandler:         deleteHandler,
+		replayHandler:         replayHandler,
+		listHandler:           listHandler,
+		listDeliveriesHandler: listDeliveriesHandler,
+		validator:             validator,
+	}
+}
+
+// CreateWebhookSubscription registers a new endpoint.
+func (h *WebhookHandler) CreateWebhookSubscription(
+	ctx context.Context,
+	req *pb.CreateWebhookSubscriptionRequest,
+) (*pb.CreateWebhookSubscriptionResponse, error) {
+	// Validate request
+	if validationResp := h.validator.Validate(ctx, req); validationResp != nil {
+		return &pb.CreateWebhookSubscriptionResponse{Base: validationResp}, nil
+	}
+
+	cmd := appwebhook.CreateCommand{
+		URL:         req.Url,
+		EventTypes:  req.EventTypes,
+		Description: req.Description,
+		CreatedBy:   actorFromContext(ctx),
+	}
+
+	sub, err := h.createHandler.Handle(ctx, cmd)
+	if err != nil {
+		return &pb.CreateWebhookSubscriptionResponse{
+			Base: webhookErrorToBaseResponse(err),
+		}, nil
+	}
+
+	return &pb.CreateWebhookSubscriptionResponse{
+		Base:   successResponse("Webhook subscription created successfully"),
+		Data:   subscriptionToProto(sub),
+		Secret: sub.Secret(),
+	}, nil
+}
+
+// ListWebhookSubscriptions retrieves every registered endpoint.
+func (h *WebhookHandler) ListWebhookSubscriptions(
+	ctx context.Context,
+	_ *pb.ListWebhookSubscriptionsRequest,
+) (*pb.ListWebhookSubscriptionsResponse, error) {
+	subs, err := h.listHandler.Handle(ctx)
+	if err != nil {
+		return &pb.ListWebhookSubscriptionsResponse{
+			Base: webhookErrorToBaseResponse(err),
+		}, nil
+	}
+
+	data := make([]*pb.WebhookSubscription, 0, len(subs))
+	for _, sub := range subs {
+		data = append(data, subscriptionToProto(sub))
+	}
+
+	return &pb.ListWebhookSubscriptionsResponse{
+		Base: successResponse("Webhook subscriptions retrieved successfully"),
+		Data: data,
+	}, nil
+}
+
+// DeleteWebhookSubscription removes an endpoint.
+func (h *WebhookHandler) DeleteWebhookSubscription(
+	ctx context.Context,
+	req *pb.DeleteWebhookSubscriptionRequest,
+) (*pb.DeleteWebhookSubscriptionResponse, error) {
+	// Validate request
+	if validationResp := h.validator.Validate(ctx, req); validationResp != nil {
+		return &pb.DeleteWebhookSubscriptionResponse{Base: validationResp}, nil
+	}
+
+	if err := h.deleteHandler.Handle(ctx, appwebhook.DeleteCommand{ID: req.SubscriptionId}); err != nil {
+		return &pb.DeleteWebhookSubscriptionResponse{
+			Base: webhookErrorToBaseResponse(err),
+		}, nil
+	}
+
+	return &pb.DeleteWebhookSubscriptionResponse{
+		Base: successResponse("Webhook subscription deleted successfully"),
+	}, nil
+}
+
+// ListWebhookDeliveries retrieves a paginated list of deliveries.
+func (h *WebhookHandler) ListWebhookDeliveries(
+	ctx context.Context,
+	req *pb.ListWebhookDeliveriesRequest,
+) (*pb.ListWebhookDeliveriesResponse, error) {
+	// Validate request
+	if validationResp := h.validator.Validate(ctx, req); validationResp != nil {
+		return &pb.ListWebhookDeliveriesResponse{Base: validationResp}, nil
+	}
+
+	query := appwebhook.ListDeliveriesQuery{
+		SubscriptionID: req.SubscriptionId,
+		EventID:        req.EventId,
+		Page:           int(req.Page),
+		PageSize:       int(req.PageSize),
+	}
+
+	if req.Status != nil && *req.Status != pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED {
+		status := pbDeliveryStatusToString(*req.Status)
+		query.Status = &status
+	}
+
+	result, err := h.listDeliveriesHandler.Handle(ctx, query)
+	if err != nil {
+		return &pb.ListWebhookDeliveriesResponse{
+			Base: webhookErrorToBaseResponse(err),
+		}, nil
+	}
+
+	data := make([]*pb.WebhookDelivery, 0, len(result.Deliveries))
+	for _, d := range result.Deliveries {
+		data = append(data, deliveryToProto(d))
+	}
+
+	totalPages := int32(result.Total) / req.PageSize
+	if int32(result.Total)%req.PageSize > 0 {
+		totalPages++
+	}
+
+	return &pb.ListWebhookDeliveriesResponse{
+		Base: successResponse("Webhook deliveries retrieved successfully"),
+		Data: data,
+		Pagination: &pb.PaginationMeta{
+			CurrentPage: req.Page,
+			PageSize:    req.PageSize,
+			TotalItems:  result.Total,
+			TotalPages:  totalPages,
+		},
+	}, nil
+}
+
+// ReplayWebhookDelivery queues a finished delivery to be sent again.
+func (h *WebhookHandler) ReplayWebhookDelivery(
+	ctx context.Context,
+	req *pb.ReplayWebhookDeliveryRequest,
+) (*pb.ReplayWebhookDeliveryResponse, error) {
+	// Validate request
+	if validationResp := h.validator.Validate(ctx, req); validationResp != nil {
+		return &pb.ReplayWebhookDeliveryResponse{Base: validationResp}, nil
+	}
+
+	d, err := h.replayHandler.Handle(ctx, appwebhook.ReplayCommand{ID: req.DeliveryId})
+	if err != nil {
+		return &pb.ReplayWebhookDeliveryResponse{
+			Base: webhookErrorToBaseResponse(err),
+		}, nil
+	}
+
+	return &pb.ReplayWebhookDeliveryResponse{
+		Base: successResponse("Webhook delivery queued for replay"),
+		Data: deliveryToProto(d),
+	}, nil
+}
+
+// Helper functions.
+
+func pbDeliveryStatusToString(s pb.WebhookDeliveryStatus) string {
+	switch s {
+	case pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING:
+		return "PENDING"
+	case pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_SUCCEEDED:
+		return "SUCCEEDED"
+	case pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DEAD:
+		return "DEAD"
+	case pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED:
+		return ""
+	}
+	return ""
+}
+
+func stringToPbDeliveryStatus(s string) pb.WebhookDeliveryStatus {
+	switch s {
+	case "PENDING":
+		return pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING
+	case "SUCCEEDED":
+		return pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_SUCCEEDED
+	case "DEAD":
+		return pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DEAD
+	default:
+		return pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED
+	}
+}
+
+// subscriptionToProto converts a subscription, leaving out its secret.
+func subscriptionToProto(sub *webhook.Subscription) *pb.WebhookSubscription {
+	eventTypes := make([]string, 0, len(sub.EventTypes()))
+	for _, t := range sub.EventTypes() {
+		eventTypes = append(eventTypes, t.String())
+	}
+
+	return &pb.WebhookSubscription{
+		SubscriptionId: sub.ID(),
+		Url:            sub.URL().String(),
+		EventTypes:     eventTypes,
+		Description:    sub.Description(),
+		CreatedAt:      sub.CreatedAt().Format("2006-01-02T15:04:05Z07:00"),
+		CreatedBy:      sub.CreatedBy(),
+	}
+}
+
+func deliveryToProto(d *webhook.Delivery) *pb.WebhookDelivery {
+	msg := &pb.WebhookDelivery{
+		DeliveryId:     d.ID(),
+		SubscriptionId: d.SubscriptionID(),
+		EventId:        d.EventID(),
+		EventType:      d.EventType().String(),
+		Status:         stringToPbDeliveryStatus(d.Status().String()),
+		Attempts:       int32(d.Attempts()),
+		NextAttemptAt:  d.NextAttemptAt().Format("2006-01-02T15:04:05Z07:00"),
+		LastError:      d.LastError(),
+		CreatedAt:      d.CreatedAt().Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if code := d.LastStatusCode(); code != nil {
+		c := int32(*code)
+		msg.LastStatusCode = &c
+	}
+	if at := d.DeliveredAt(); at != nil {
+		s := at.Format("2006-01-02T15:04:05Z07:00")
+		msg.DeliveredAt = &s
+	}
+	return msg
+}
+
+func webhookErrorToBaseResponse(err error) *pb.BaseResponse {
+	statusCode := "500"
+	message := "Internal server error"
+
+	switch {
+	case errors.Is(err, webhook.ErrSubscriptionNotFound),
+		errors.Is(err, webhook.ErrDeliveryNotFound):
+		statusCode = "404"
+		message = err.Error()
+	case errors.Is(err, webhook.ErrDeliveryPending):
+		statusCode = "409"
+		message = err.Error()
+	case errors.Is(err, webhook.ErrInvalidURL),
+		errors.Is(err, webhook.ErrNoEventTypes),
+		errors.Is(err, webhook.ErrInvalidStatus),
+		errors.Is(err, event.ErrInvalidType):
+		statusCode = "400"
+		message = err.Error()
+	}
+
+	return &pb.BaseResponse{
+		StatusCode: statusCode,
+		IsSuccess:  false,
+		Message:    message,
+	}
+}
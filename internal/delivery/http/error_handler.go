@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"net/textproto"
 	"strings"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
@@ -11,6 +12,8 @@ import (
 	"google.golang.org/grpc/status"
 
 	pb "github.com/homindolenern/goapps-costing-v1/gen/go/costing/v1"
+	grpcdelivery "github.com/homindolenern/goapps-costing-v1/internal/delivery/grpc"
+	"github.com/homindolenern/goapps-costing-v1/pkg/ratelimit"
 )
 
 // CustomErrorHandler handles gRPC errors and returns structured JSON responses.
@@ -27,31 +30,37 @@ func CustomErrorHandler(ctx context.Context, mux *runtime.ServeMux, marshaler ru
 		// This is our structured JSON error from validation interceptor
 		var baseResponse pb.BaseResponse
 		if jsonErr := json.Unmarshal([]byte(msg), &baseResponse); jsonErr == nil {
-			w.Header().Set("Content-Type", "application/json")
-			w.WriteHeader(http.StatusBadRequest)
-			_ = json.NewEncoder(w).Encode(map[string]interface{}{
-				"base": &baseResponse,
-			})
+			writeBaseResponse(w, http.StatusBadRequest, &baseResponse)
 			return
 		}
 	}
 
 	// Map gRPC codes to HTTP status and create response
+	// (401 Unauthenticated, 403 PermissionDenied, 429 ResourceExhausted, ...)
 	httpStatus := runtime.HTTPStatusFromCode(s.Code())
-	statusCode := httpStatusToString(httpStatus)
 
-	response := map[string]interface{}{
-		"base": &pb.BaseResponse{
-			StatusCode:       statusCode,
-			IsSuccess:        false,
-			Message:          s.Message(),
-			ValidationErrors: []*pb.ValidationError{},
-		},
+	// Surface the rate limiter's wait time as a standard Retry-After header
+	if md, ok := runtime.ServerMetadataFromContext(ctx); ok {
+		if values := md.HeaderMD.Get(ratelimit.RetryAfterKey); len(values) > 0 {
+			w.Header().Set("Retry-After", values[0])
+		}
 	}
 
+	writeBaseResponse(w, httpStatus, &pb.BaseResponse{
+		StatusCode:       httpStatusToString(httpStatus),
+		IsSuccess:        false,
+		Message:          s.Message(),
+		ValidationErrors: []*pb.ValidationError{},
+	})
+}
+
+// writeBaseResponse writes {"base": <BaseResponse>} with the given HTTP status.
+func writeBaseResponse(w http.ResponseWriter, httpStatus int, base *pb.BaseResponse) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(httpStatus)
-	_ = json.NewEncoder(w).Encode(response)
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"base": base,
+	})
 }
 
 func httpStatusToString(status int) string {
@@ -82,8 +91,30 @@ func httpStatusToString(status int) string {
 }
 
 // NewServeMux creates a new gRPC-Gateway ServeMux with custom error handling.
+// The gateway forwards the HTTP Authorization header to the gRPC server as
+// "authorization" metadata, which the Auth interceptor reads.
 func NewServeMux() *runtime.ServeMux {
 	return runtime.NewServeMux(
 		runtime.WithErrorHandler(CustomErrorHandler),
+		runtime.WithIncomingHeaderMatcher(IncomingHeaderMatcher),
+		runtime.WithOutgoingHeaderMatcher(OutgoingHeaderMatcher),
 	)
 }
+
+// IncomingHeaderMatcher forwards If-Match as plain "if-match" metadata so
+// update handlers can read the expected entity version.
+func IncomingHeaderMatcher(key string) (string, bool) {
+	if textproto.CanonicalMIMEHeaderKey(key) == "If-Match" {
+		return grpcdelivery.IfMatchKey, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// OutgoingHeaderMatcher returns the entity version as a standard ETag header.
+// Other header metadata keeps the gateway's Grpc-Metadata- prefix.
+func OutgoingHeaderMatcher(key string) (string, bool) {
+	if key == grpcdelivery.ETagKey {
+		return "ETag", true
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
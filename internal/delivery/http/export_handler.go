@@ -0,0 +1,113 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/homindolenern/goapps-costing-v1/gen/go/costing/v1"
+)
+
+// exportContentTypes maps the format query parameter to the download's content type.
+var exportContentTypes = map[string]string{
+	"csv":  "text/csv; charset=utf-8",
+	"xlsx": "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+	"json": "application/json",
+}
+
+// RegisterExportHandlers adds the file download endpoints to the gateway mux.
+// They take format=csv|xlsx|json (csv by default) and the query parameters of
+// the matching List endpoint, and stream the file from the export RPC over
+// conn. The generated gateway would add a delimiter after every chunk.
+func RegisterExportHandlers(mux *runtime.ServeMux, conn grpc.ClientConnInterface) error {
+	uoms := pb.NewUOMServiceClient(conn)
+	if err := mux.HandlePath(http.MethodGet, "/v1/uoms:export", exportHandler(
+		mux, pb.UOMService_ExportUOMs_FullMethodName, "/v1/uoms:export", "uoms",
+		func() *pb.ExportUOMsRequest { return &pb.ExportUOMsRequest{} }, uoms.ExportUOMs,
+	)); err != nil {
+		return err
+	}
+
+	params := pb.NewParameterServiceClient(conn)
+	return mux.HandlePath(http.MethodGet, "/v1/parameters:export", exportHandler(
+		mux, pb.ParameterService_ExportParameters_FullMethodName, "/v1/parameters:export", "parameters",
+		func() *pb.ExportParametersRequest { return &pb.ExportParametersRequest{} }, params.ExportParameters,
+	))
+}
+
+func exportHandler[Req proto.Message](
+	mux *runtime.ServeMux,
+	method, pattern, filename string,
+	newReq func() Req,
+	open func(context.Context, Req, ...grpc.CallOption) (grpc.ServerStreamingClient[pb.ExportChunk], error),
+) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		ctx, cancel := context.WithCancel(r.Context())
+		defer cancel()
+		_, outbound := runtime.MarshalerForRequest(mux, r)
+
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, r, method, runtime.WithHTTPPathPattern(pattern))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+		ctx = annotatedContext
+
+		// The List filters are parsed by the gateway; format also takes short names
+		query := r.URL.Query()
+		format := strings.ToLower(query.Get("format"))
+		if format == "" {
+			format = "csv"
+		}
+		contentType, ok := exportContentTypes[format]
+		if !ok {
+			runtime.HTTPError(ctx, mux, outbound, w, r, status.Error(codes.InvalidArgument, "format must be csv, xlsx or json"))
+			return
+		}
+		query.Set("format", "FILE_FORMAT_"+strings.ToUpper(format))
+
+		req := newReq()
+		if err := runtime.PopulateQueryParameters(req, query, utilities.NewDoubleArray(nil)); err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, status.Error(codes.InvalidArgument, err.Error()))
+			return
+		}
+
+		stream, err := open(ctx, req)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+
+		// Wait for the first chunk so a rejected export still gets an error response
+		chunk, err := stream.Recv()
+		if err != nil && !errors.Is(err, io.EOF) {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", contentType)
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename+"."+format))
+		rc := http.NewResponseController(w)
+		for err == nil {
+			if _, err := w.Write(chunk.GetData()); err != nil {
+				return
+			}
+			_ = rc.Flush()
+			chunk, err = stream.Recv()
+		}
+		if !errors.Is(err, io.EOF) {
+			// The status line is sent; abort so the client sees a truncated download
+			panic(http.ErrAbortHandler)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/homindolenern/goapps-costing-v1/gen/go/costing/v1"
+	"github.com/homindolenern/goapps-costing-v1/pkg/spreadsheet"
+)
+
+const (
+	// maxUploadBytes caps an import request body: the file plus multipart overhead.
+	maxUploadBytes = 11 << 20
+	// importChunkSize is the size of the file chunks streamed to the gRPC server.
+	importChunkSize = 64 << 10
+)
+
+// importStream is the client side of an import RPC.
+type importStream = grpc.ClientStreamingClient[pb.ImportRequest, pb.ImportResponse]
+
+// importOpener opens an import RPC, e.g. UOMServiceClient.ImportUOMs.
+type importOpener func(ctx context.Context, opts ...grpc.CallOption) (importStream, error)
+
+// RegisterImportHandlers adds the file upload endpoints, which the generated
+// gateway cannot express, to the gateway mux. An upload is a multipart form
+// with the file in a "file" part; the format is taken from the file name or
+// a "format" query parameter, and "dry_run" and "update_existing" are query
+// parameters. The file is streamed to the import RPC over conn.
+func RegisterImportHandlers(mux *runtime.ServeMux, conn grpc.ClientConnInterface) error {
+	uoms := pb.NewUOMServiceClient(conn)
+	if err := mux.HandlePath(http.MethodPost, "/v1/uoms:import", importHandler(
+		mux, pb.UOMService_ImportUOMs_FullMethodName, "/v1/uoms:import", uoms.ImportUOMs,
+	)); err != nil {
+		return err
+	}
+
+	params := pb.NewParameterServiceClient(conn)
+	return mux.HandlePath(http.MethodPost, "/v1/parameters:import", importHandler(
+		mux, pb.ParameterService_ImportParameters_FullMethodName, "/v1/parameters:import", params.ImportParameters,
+	))
+}
+
+func importHandler(mux *runtime.ServeMux, method, pattern string, open importOpener) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		ctx, cancel := context.WithCancel(r.Context())
+		defer cancel()
+		_, outbound := runtime.MarshalerForRequest(mux, r)
+
+		// Forward the authorization header and client address like the generated handlers
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, r, method, runtime.WithHTTPPathPattern(pattern))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+		ctx = annotatedContext
+
+		r.Body = http.MaxBytesReader(w, r.Body, maxUploadBytes)
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, status.Errorf(codes.InvalidArgument, "multipart field \"file\" is required: %v", err))
+			return
+		}
+		defer file.Close()
+
+		opts, err := importOptions(r, header.Filename)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, status.Error(codes.InvalidArgument, err.Error()))
+			return
+		}
+
+		var md runtime.ServerMetadata
+		resp, err := streamImport(ctx, open, opts, file, &md)
+		ctx = runtime.NewServerMetadataContext(ctx, md)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+		runtime.ForwardResponseMessage(ctx, mux, outbound, w, r, resp)
+	}
+}
+
+// importOptions reads the import options from the query string.
+func importOptions(r *http.Request, filename string) (*pb.ImportOptions, error) {
+	query := r.URL.Query()
+	opts := &pb.ImportOptions{}
+
+	name := query.Get("format")
+	format, err := spreadsheet.ParseFormat(name)
+	if name == "" {
+		format, err = spreadsheet.FormatFromFilename(filename)
+	}
+	if err != nil {
+		return nil, err
+	}
+	switch format {
+	case spreadsheet.FormatCSV:
+		opts.Format = pb.FileFormat_FILE_FORMAT_CSV
+	case spreadsheet.FormatXLSX:
+		opts.Format = pb.FileFormat_FILE_FORMAT_XLSX
+	}
+
+	for key, dst := range map[string]*bool{"dry_run": &opts.DryRun, "update_existing": &opts.UpdateExisting} {
+		if value := query.Get(key); value != "" {
+			if *dst, err = strconv.ParseBool(value); err != nil {
+				return nil, fmt.Errorf("%s must be true or false", key)
+			}
+		}
+	}
+	return opts, nil
+}
+
+// streamImport sends the options and the file to the import RPC and returns its response.
+func streamImport(ctx context.Context, open importOpener, opts *pb.ImportOptions, file io.Reader, md *runtime.ServerMetadata) (*pb.ImportResponse, error) {
+	stream, err := open(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = stream.Send(&pb.ImportRequest{Payload: &pb.ImportRequest_Options{Options: opts}})
+	buf := make([]byte, importChunkSize)
+	for err == nil {
+		var n int
+		n, err = file.Read(buf)
+		if n > 0 {
+			if sendErr := stream.Send(&pb.ImportRequest{Payload: &pb.ImportRequest_Chunk{Chunk: buf[:n]}}); sendErr != nil {
+				err = sendErr
+			}
+		}
+	}
+	// io.EOF from Send means the server already answered; CloseAndRecv returns the answer
+	if !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+
+	resp, err := stream.CloseAndRecv()
+	if header, headerErr := stream.Header(); headerErr == nil {
+		md.HeaderMD = header
+	}
+	md.TrailerMD = stream.Trailer()
+	return resp, err
+}
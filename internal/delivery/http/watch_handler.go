@@ -0,0 +1,174 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/homindolenern/goapps-costing-v1/gen/go/costing/v1"
+	grpcdelivery "github.com/homindolenern/goapps-costing-v1/internal/delivery/grpc"
+)
+
+// sseHeartbeat is how often an idle event stream sends a comment, so proxies
+// do not close it.
+const sseHeartbeat = 15 * time.Second
+
+// watchChange is a message of a watch stream.
+type watchChange interface {
+	proto.Message
+	GetResumeToken() string
+}
+
+// RegisterWatchHandlers adds the Server-Sent Events endpoints of the watch
+// RPCs to the gateway mux. Every change is a message whose id is its resume
+// token, so an EventSource that reconnects resumes through Last-Event-ID; the
+// resume_token query parameter starts elsewhere. The generated gateway would
+// wait for the stream to end before flushing it.
+func RegisterWatchHandlers(mux *runtime.ServeMux, conn grpc.ClientConnInterface) error {
+	uoms := pb.NewUOMServiceClient(conn)
+	if err := mux.HandlePath(http.MethodGet, "/v1/uoms:watch", watchHandler(
+		mux, pb.UOMService_WatchUOMs_FullMethodName, "/v1/uoms:watch",
+		func(token string) *pb.WatchUOMsRequest { return &pb.WatchUOMsRequest{ResumeToken: token} },
+		uoms.WatchUOMs,
+	)); err != nil {
+		return err
+	}
+
+	params := pb.NewParameterServiceClient(conn)
+	return mux.HandlePath(http.MethodGet, "/v1/parameters:watch", watchHandler(
+		mux, pb.ParameterService_WatchParameters_FullMethodName, "/v1/parameters:watch",
+		func(token string) *pb.WatchParametersRequest { return &pb.WatchParametersRequest{ResumeToken: token} },
+		params.WatchParameters,
+	))
+}
+
+func watchHandler[Req proto.Message, Change any, PChange interface {
+	*Change
+	watchChange
+}](
+	mux *runtime.ServeMux,
+	method, pattern string,
+	newReq func(resumeToken string) Req,
+	open func(context.Context, Req, ...grpc.CallOption) (grpc.ServerStreamingClient[Change], error),
+) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		ctx, cancel := context.WithCancel(r.Context())
+		defer cancel()
+		_, outbound := runtime.MarshalerForRequest(mux, r)
+
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, r, method, runtime.WithHTTPPathPattern(pattern))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+		ctx = annotatedContext
+
+		// A reconnecting EventSource knows a later position than its URL
+		resumeToken := r.Header.Get("Last-Event-ID")
+		if resumeToken == "" {
+			resumeToken = r.URL.Query().Get("resume_token")
+		}
+
+		stream, err := open(ctx, newReq(resumeToken))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+
+		// The watch sends headers once accepted; ending without them, it was rejected
+		header, _ := stream.Header()
+		if header == nil {
+			_, err := stream.Recv()
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("X-Accel-Buffering", "no")
+		if start := header.Get(grpcdelivery.WatchStartKey); len(start) > 0 {
+			w.Header().Set("X-Watch-Start", start[0])
+		}
+		w.WriteHeader(http.StatusOK)
+		rc := http.NewResponseController(w)
+		_ = rc.Flush()
+
+		// Receive in the background so heartbeats go out while the watch is idle
+		changes := make(chan PChange)
+		ended := make(chan error, 1)
+		go func() {
+			for {
+				change, err := stream.Recv()
+				if err != nil {
+					ended <- err
+					return
+				}
+				select {
+				case changes <- change:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+
+		heartbeat := time.NewTicker(sseHeartbeat)
+		defer heartbeat.Stop()
+		for {
+			var err error
+			select {
+			case change := <-changes:
+				err = writeChangeEvent(w, outbound, change)
+			case <-heartbeat.C:
+				_, err = fmt.Fprint(w, ": keepalive\n\n")
+			case cause := <-ended:
+				// Tell the client why; it reconnects from its last event id
+				writeErrorEvent(w, cause)
+				_ = rc.Flush()
+				return
+			case <-ctx.Done():
+				return
+			}
+			if err != nil {
+				return
+			}
+			_ = rc.Flush()
+		}
+	}
+}
+
+// writeChangeEvent writes change as a message event. Multi-line JSON is split
+// over several data fields, which the client joins again.
+func writeChangeEvent(w http.ResponseWriter, marshaler runtime.Marshaler, change watchChange) error {
+	data, err := marshaler.Marshal(change)
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "id: %s\n", change.GetResumeToken())
+	for _, line := range bytes.Split(data, []byte("\n")) {
+		fmt.Fprintf(&buf, "data: %s\n", line)
+	}
+	buf.WriteString("\n")
+
+	_, err = w.Write(buf.Bytes())
+	return err
+}
+
+// writeErrorEvent writes the status a watch ended with as an error event.
+func writeErrorEvent(w http.ResponseWriter, cause error) {
+	s := status.Convert(cause)
+	data, _ := json.Marshal(map[string]string{
+		"code":    s.Code().String(),
+		"message": s.Message(),
+	})
+	_, _ = fmt.Fprintf(w, "event: error\ndata: %s\n\n", data)
+}
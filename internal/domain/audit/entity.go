@@ -0,0 +1,124 @@
+package audit
+
+import (
+	"errors"
+	"time"
+)
+
+// Domain errors.
+var (
+	ErrEmptyEntityCode   = errors.New("entity code cannot be empty")
+	ErrEmptyChangedBy    = errors.New("changed_by cannot be empty")
+	ErrInvalidEntityType = errors.New("invalid audit entity type")
+	ErrInvalidAction     = errors.New("invalid audit action")
+	ErrInvalidTimeRange  = errors.New("invalid time range, expected RFC 3339 timestamps with from before to")
+	ErrSnapshotMismatch  = errors.New("snapshots do not match the action")
+)
+
+// Event is an append-only record of a change to master data.
+type Event struct {
+	id         int64
+	entityType EntityType
+	entityCode string
+	action     Action
+	before     Snapshot
+	after      Snapshot
+	changes    []Change
+	changedBy  string
+	occurredAt time.Time
+}
+
+// NewEvent creates an event and computes the field changes between before
+// and after. CREATE has no before snapshot and DELETE has no after snapshot.
+func NewEvent(
+	entityType EntityType,
+	entityCode string,
+	action Action,
+	before Snapshot,
+	after Snapshot,
+	changedBy string,
+) (*Event, error) {
+	if entityCode == "" {
+		return nil, ErrEmptyEntityCode
+	}
+	if changedBy == "" {
+		return nil, ErrEmptyChangedBy
+	}
+	switch action {
+	case ActionCreate:
+		if before != nil || after == nil {
+			return nil, ErrSnapshotMismatch
+		}
+	case ActionUpdate:
+		if before == nil || after == nil {
+			return nil, ErrSnapshotMismatch
+		}
+	case ActionDelete:
+		if before == nil || after != nil {
+			return nil, ErrSnapshotMismatch
+		}
+	default:
+		return nil, ErrInvalidAction
+	}
+
+	before, err := normalize(before)
+	if err != nil {
+		return nil, err
+	}
+	after, err = normalize(after)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Event{
+		entityType: entityType,
+		entityCode: entityCode,
+		action:     action,
+		before:     before,
+		after:      after,
+		changes:    Diff(before, after),
+		changedBy:  changedBy,
+		occurredAt: time.Now(),
+	}, nil
+}
+
+// Reconstitute creates an Event from persistence (no validation).
+func Reconstitute(
+	id int64,
+	entityType EntityType,
+	entityCode string,
+	action Action,
+	before Snapshot,
+	after Snapshot,
+	changes []Change,
+	changedBy string,
+	occurredAt time.Time,
+) *Event {
+	return &Event{
+		id:         id,
+		entityType: entityType,
+		entityCode: entityCode,
+		action:     action,
+		before:     before,
+		after:      after,
+		changes:    changes,
+		changedBy:  changedBy,
+		occurredAt: occurredAt,
+	}
+}
+
+// Getters.
+func (e *Event) ID() int64              { return e.id }
+func (e *Event) EntityType() EntityType { return e.entityType }
+func (e *Event) EntityCode() string     { return e.entityCode }
+func (e *Event) Action() Action         { return e.action }
+func (e *Event) Before() Snapshot       { return e.before }
+func (e *Event) After() Snapshot        { return e.after }
+func (e *Event) Changes() []Change      { return e.changes }
+func (e *Event) ChangedBy() string      { return e.changedBy }
+func (e *Event) OccurredAt() time.Time  { return e.occurredAt }
+
+// AssignID sets the identifier generated on persistence.
+func (e *Event) AssignID(id int64) {
+	e.id = id
+}
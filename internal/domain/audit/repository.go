@@ -0,0 +1,47 @@
+package audit
+
+import (
+	"context"
+	"time"
+)
+
+// Repository defines the interface for audit event persistence.
+// Events are append-only: there is no update or delete.
+type Repository interface {
+	// Append persists a new Event and assigns its ID.
+	Append(ctx context.Context, event *Event) error
+
+	// List retrieves Events, newest first, with optional filtering.
+	List(ctx context.Context, filter ListFilter) ([]*Event, int64, error)
+}
+
+// ListFilter contains filtering and pagination options.
+// The time range is half-open: From <= occurred_at < To.
+type ListFilter struct {
+	EntityType *EntityType
+	EntityCode *string
+	ChangedBy  *string
+	From       *time.Time
+	To         *time.Time
+	Page       int
+	PageSize   int
+}
+
+// Offset calculates the offset for pagination.
+func (f ListFilter) Offset() int {
+	if f.Page <= 0 {
+		f.Page = 1
+	}
+	return (f.Page - 1) * f.PageSize
+}
+
+// Limit returns the page size.
+func (f ListFilter) Limit() int {
+	if f.PageSize <= 0 {
+		return 10
+	}
+	if f.PageSize > 100 {
+		return 100
+	}
+	return f.PageSize
+}
@@ -0,0 +1,129 @@
+package audit
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+)
+
+// EntityType identifies the kind of master data an event is about.
+type EntityType string
+
+const (
+	EntityUOM            EntityType = "UOM"
+	EntityUOMConversion  EntityType = "UOM_CONVERSION"
+	EntityParameter      EntityType = "PARAMETER"
+	EntityParameterValue EntityType = "PARAMETER_VALUE"
+	EntityMaterial       EntityType = "MATERIAL"
+	EntityMachineType    EntityType = "MACHINE_TYPE"
+	EntityMachine        EntityType = "MACHINE"
+)
+
+// NewEntityType creates a validated entity type.
+func NewEntityType(entityType string) (EntityType, error) {
+	switch EntityType(entityType) {
+	case EntityUOM, EntityUOMConversion, EntityParameter, EntityParameterValue,
+		EntityMaterial, EntityMachineType, EntityMachine:
+		return EntityType(entityType), nil
+	default:
+		return "", ErrInvalidEntityType
+	}
+}
+
+// String returns the string representation.
+func (t EntityType) String() string {
+	return string(t)
+}
+
+// Action is the kind of change recorded.
+type Action string
+
+const (
+	ActionCreate Action = "CREATE"
+	ActionUpdate Action = "UPDATE"
+	ActionDelete Action = "DELETE"
+)
+
+// NewAction creates a validated action.
+func NewAction(action string) (Action, error) {
+	switch Action(action) {
+	case ActionCreate, ActionUpdate, ActionDelete:
+		return Action(action), nil
+	default:
+		return "", ErrInvalidAction
+	}
+}
+
+// String returns the string representation.
+func (a Action) String() string {
+	return string(a)
+}
+
+// Snapshot is the state of an entity as a JSON object, keyed by field name.
+type Snapshot map[string]interface{}
+
+// normalize round-trips s through JSON so pointers, numbers and slices
+// compare the same way as snapshots read back from storage.
+func normalize(s Snapshot) (Snapshot, error) {
+	if s == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	var out Snapshot
+	if err := json.Unmarshal(data, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Change is a field whose value differs between two snapshots.
+// A nil Before or After means the field was absent or null.
+type Change struct {
+	Field  string      `json:"field"`
+	Before interface{} `json:"before"`
+	After  interface{} `json:"after"`
+}
+
+// Compare normalizes before and after like stored snapshots and returns the
+// fields that differ, ordered by field name.
+func Compare(before, after Snapshot) ([]Change, error) {
+	before, err := normalize(before)
+	if err != nil {
+		return nil, err
+	}
+	after, err = normalize(after)
+	if err != nil {
+		return nil, err
+	}
+	return Diff(before, after), nil
+}
+
+// Diff returns the fields that differ between before and after, ordered by field name.
+func Diff(before, after Snapshot) []Change {
+	fields := make(map[string]bool, len(before)+len(after))
+	for k := range before {
+		fields[k] = true
+	}
+	for k := range after {
+		fields[k] = true
+	}
+
+	names := make([]string, 0, len(fields))
+	for k := range fields {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	changes := make([]Change, 0)
+	for _, name := range names {
+		b, a := before[name], after[name]
+		if reflect.DeepEqual(b, a) {
+			continue
+		}
+		changes = append(changes, Change{Field: name, Before: b, After: a})
+	}
+	return changes
+}
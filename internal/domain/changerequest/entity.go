@@ -0,0 +1,171 @@
+package changerequest
+
+import (
+	"errors"
+	"time"
+
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/audit"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/parameter"
+)
+
+// Domain errors.
+var (
+	ErrNotFound         = errors.New("parameter change request not found")
+	ErrInvalidStatus    = errors.New("invalid change request status")
+	ErrInvalidAction    = errors.New("invalid change request action")
+	ErrNotPending       = errors.New("change request has already been reviewed")
+	ErrPendingExists    = errors.New("parameter already has a pending change request")
+	ErrSelfApproval     = errors.New("change request cannot be approved by its author")
+	ErrNoChanges        = errors.New("change request does not change the parameter")
+	ErrApprovalRequired = errors.New("changes to this parameter require approval, submit them with UpdateParameter")
+	ErrEmptyRequestedBy = errors.New("requested_by cannot be empty")
+	ErrEmptyReviewedBy  = errors.New("reviewed_by cannot be empty")
+	ErrEmptySubject     = errors.New("subject of the requester or reviewer cannot be empty")
+)
+
+// ChangeRequest is an edit, delete or restore of a Parameter held until a
+// second person reviews it. Only an approved request is applied, at the
+// version it was made against. People are told apart by the immutable
+// subject of their token; requestedBy and reviewedBy are display names.
+type ChangeRequest struct {
+	id                 int64
+	parameterCode      parameter.Code
+	baseVersion        int
+	action             Action
+	proposal           Proposal
+	changes            []audit.Change
+	status             Status
+	requestedBy        string
+	requestedBySubject string
+	requestedAt        time.Time
+	reviewedBy         *string
+	reviewedBySubject  *string
+	reviewedAt         *time.Time
+	reviewComment      *string
+}
+
+// NewChangeRequest creates a pending ChangeRequest of the Parameter code at
+// baseVersion, with changes the fields action would change. proposal is
+// only used by ActionUpdate.
+func NewChangeRequest(
+	code parameter.Code,
+	baseVersion int,
+	action Action,
+	proposal Proposal,
+	changes []audit.Change,
+	requestedBy string,
+	requestedBySubject string,
+) (*ChangeRequest, error) {
+	if requestedBy == "" {
+		return nil, ErrEmptyRequestedBy
+	}
+	if requestedBySubject == "" {
+		return nil, ErrEmptySubject
+	}
+	if len(changes) == 0 {
+		return nil, ErrNoChanges
+	}
+
+	return &ChangeRequest{
+		parameterCode:      code,
+		baseVersion:        baseVersion,
+		action:             action,
+		proposal:           proposal,
+		changes:            changes,
+		status:             StatusPending,
+		requestedBy:        requestedBy,
+		requestedBySubject: requestedBySubject,
+		requestedAt:        time.Now(),
+	}, nil
+}
+
+// Reconstitute creates a ChangeRequest from persistence (no validation).
+func Reconstitute(
+	id int64,
+	code parameter.Code,
+	baseVersion int,
+	action Action,
+	proposal Proposal,
+	changes []audit.Change,
+	status Status,
+	requestedBy string,
+	requestedBySubject string,
+	requestedAt time.Time,
+	reviewedBy *string,
+	reviewedBySubject *string,
+	reviewedAt *time.Time,
+	reviewComment *string,
+) *ChangeRequest {
+	return &ChangeRequest{
+		id:                 id,
+		parameterCode:      code,
+		baseVersion:        baseVersion,
+		action:             action,
+		proposal:           proposal,
+		changes:            changes,
+		status:             status,
+		requestedBy:        requestedBy,
+		requestedBySubject: requestedBySubject,
+		requestedAt:        requestedAt,
+		reviewedBy:         reviewedBy,
+		reviewedBySubject:  reviewedBySubject,
+		reviewedAt:         reviewedAt,
+		reviewComment:      reviewComment,
+	}
+}
+
+// Getters.
+func (c *ChangeRequest) ID() int64                     { return c.id }
+func (c *ChangeRequest) ParameterCode() parameter.Code { return c.parameterCode }
+func (c *ChangeRequest) BaseVersion() int              { return c.baseVersion }
+func (c *ChangeRequest) Action() Action                { return c.action }
+func (c *ChangeRequest) Proposal() Proposal            { return c.proposal }
+func (c *ChangeRequest) Changes() []audit.Change       { return c.changes }
+func (c *ChangeRequest) Status() Status                { return c.status }
+func (c *ChangeRequest) RequestedBy() string           { return c.requestedBy }
+func (c *ChangeRequest) RequestedBySubject() string    { return c.requestedBySubject }
+func (c *ChangeRequest) RequestedAt() time.Time        { return c.requestedAt }
+func (c *ChangeRequest) ReviewedBy() *string           { return c.reviewedBy }
+func (c *ChangeRequest) ReviewedBySubject() *string    { return c.reviewedBySubject }
+func (c *ChangeRequest) ReviewedAt() *time.Time        { return c.reviewedAt }
+func (c *ChangeRequest) ReviewComment() *string        { return c.reviewComment }
+
+// AssignID sets the ID generated by persistence.
+func (c *ChangeRequest) AssignID(id int64) {
+	c.id = id
+}
+
+// Approve marks a pending request approved by someone other than its
+// author, comparing subjects as display names may change or collide.
+func (c *ChangeRequest) Approve(by, bySubject string, comment *string) error {
+	if bySubject == c.requestedBySubject {
+		return ErrSelfApproval
+	}
+	return c.review(StatusApproved, by, bySubject, comment)
+}
+
+// Reject marks a pending request rejected. Authors may reject, that is
+// withdraw, their own requests.
+func (c *ChangeRequest) Reject(by, bySubject string, comment *string) error {
+	return c.review(StatusRejected, by, bySubject, comment)
+}
+
+func (c *ChangeRequest) review(status Status, by, bySubject string, comment *string) error {
+	if by == "" {
+		return ErrEmptyReviewedBy
+	}
+	if bySubject == "" {
+		return ErrEmptySubject
+	}
+	if c.status != StatusPending {
+		return ErrNotPending
+	}
+
+	now := time.Now()
+	c.status = status
+	c.reviewedBy = &by
+	c.reviewedBySubject = &bySubject
+	c.reviewedAt = &now
+	c.reviewComment = comment
+	return nil
+}
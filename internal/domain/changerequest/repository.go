@@ -0,0 +1,52 @@
+package changerequest
+
+import (
+	"context"
+
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/parameter"
+)
+
+// Repository defines the interface for ChangeRequest persistence.
+type Repository interface {
+	// Create persists a new pending ChangeRequest and assigns its ID. It
+	// returns ErrPendingExists when the Parameter already has one.
+	Create(ctx context.Context, request *ChangeRequest) error
+
+	// GetByID retrieves a ChangeRequest by its ID.
+	GetByID(ctx context.Context, id int64) (*ChangeRequest, error)
+
+	// List retrieves ChangeRequests with optional filtering, newest first.
+	List(ctx context.Context, filter ListFilter) ([]*ChangeRequest, int64, error)
+
+	// Review persists the review of a ChangeRequest. It returns
+	// ErrNotPending when the request was reviewed in the meantime.
+	Review(ctx context.Context, request *ChangeRequest) error
+}
+
+// ListFilter contains filtering and pagination options.
+type ListFilter struct {
+	ParameterCode *parameter.Code
+	Status        *Status
+	RequestedBy   *string
+	Page          int
+	PageSize      int
+}
+
+// Offset calculates the offset for pagination.
+func (f ListFilter) Offset() int {
+	if f.Page <= 0 {
+		f.Page = 1
+	}
+	return (f.Page - 1) * f.PageSize
+}
+
+// Limit returns the page size.
+func (f ListFilter) Limit() int {
+	if f.PageSize <= 0 {
+		return 10
+	}
+	if f.PageSize > 100 {
+		return 100
+	}
+	return f.PageSize
+}
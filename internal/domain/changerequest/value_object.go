@@ -0,0 +1,103 @@
+package changerequest
+
+import (
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/parameter"
+)
+
+// Status is the review state of a change request.
+type Status string
+
+const (
+	// StatusPending change requests wait for a reviewer.
+	StatusPending Status = "PENDING"
+	// StatusApproved change requests were applied to their Parameter.
+	StatusApproved Status = "APPROVED"
+	// StatusRejected change requests were turned down and never applied.
+	StatusRejected Status = "REJECTED"
+)
+
+// NewStatus creates a validated status.
+func NewStatus(status string) (Status, error) {
+	switch Status(status) {
+	case StatusPending, StatusApproved, StatusRejected:
+		return Status(status), nil
+	default:
+		return "", ErrInvalidStatus
+	}
+}
+
+// String returns the string representation.
+func (s Status) String() string {
+	return string(s)
+}
+
+// Action is what a change request does to its Parameter.
+type Action string
+
+const (
+	// ActionUpdate change requests apply their Proposal.
+	ActionUpdate Action = "UPDATE"
+	// ActionDelete change requests soft-delete the Parameter.
+	ActionDelete Action = "DELETE"
+	// ActionRestore change requests restore a soft-deleted Parameter.
+	ActionRestore Action = "RESTORE"
+)
+
+// NewAction creates a validated action.
+func NewAction(action string) (Action, error) {
+	switch Action(action) {
+	case ActionUpdate, ActionDelete, ActionRestore:
+		return Action(action), nil
+	default:
+		return "", ErrInvalidAction
+	}
+}
+
+// String returns the string representation.
+func (a Action) String() string {
+	return string(a)
+}
+
+// Proposal is the edit held for review, in the fields of the
+// UpdateParameter command it came from. It is empty for deletes and
+// restores.
+type Proposal struct {
+	ParameterName string   `json:"parameter_name"`
+	Category      string   `json:"category"`
+	DataType      string   `json:"data_type"`
+	UOM           *string  `json:"uom,omitempty"`
+	MinValue      *float64 `json:"min_value,omitempty"`
+	MaxValue      *float64 `json:"max_value,omitempty"`
+	AllowedValues []string `json:"allowed_values,omitempty"`
+	IsMandatory   bool     `json:"is_mandatory"`
+	Description   *string  `json:"description,omitempty"`
+	IsActive      bool     `json:"is_active"`
+}
+
+// Policy decides which Parameters are only changed after approval. The
+// zero Policy lets every change through.
+type Policy struct {
+	categories map[parameter.Category]bool
+	mandatory  bool
+}
+
+// NewPolicy creates a policy requiring approval for Parameters of the
+// listed categories and, when mandatory is set, for mandatory Parameters.
+func NewPolicy(categories []string, mandatory bool) (Policy, error) {
+	p := Policy{categories: make(map[parameter.Category]bool, len(categories)), mandatory: mandatory}
+	for _, c := range categories {
+		category, err := parameter.NewCategory(c)
+		if err != nil {
+			return Policy{}, err
+		}
+		p.categories[category] = true
+	}
+	return p, nil
+}
+
+// Requires reports whether a Parameter of category, mandatory or not,
+// needs approval to change. Edits are checked against both the current and
+// the proposed definition, so a Parameter cannot be moved out of review.
+func (p Policy) Requires(category parameter.Category, mandatory bool) bool {
+	return p.categories[category] || p.mandatory && mandatory
+}
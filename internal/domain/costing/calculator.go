@@ -0,0 +1,209 @@
+package costing
+
+import (
+	"fmt"
+
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/uom"
+)
+
+// Calculate computes the cost breakdown of a recipe.
+//
+// The calculation is deterministic: components follow the recipe order
+// (materials first, then for each step energy, labor and overhead), every
+// amount is rounded to Precision decimals and totals are sums of the rounded
+// amounts.
+//
+// Material cost is the gross quantity, net / (1 - waste%), converted to the
+// price UOM times the unit price. Each process step handles the full recipe
+// output converted to the step output UOM; its running hours are that output
+// divided by the production rate (PRODUCTION_RATE, or RPM * 60 *
+// OUTPUT_PER_REV) times EFFICIENCY / 100. Energy is hours * POWER_KWH *
+// electricity rate, labor and overhead are hours times their hourly rates.
+func Calculate(recipe Recipe, converter *uom.Converter) (*Breakdown, error) {
+	if err := validateRecipe(recipe); err != nil {
+		return nil, err
+	}
+
+	breakdown := &Breakdown{
+		ProductCode:    recipe.ProductCode,
+		OutputQuantity: recipe.OutputQuantity,
+		OutputUOM:      recipe.OutputUOM,
+	}
+
+	for _, line := range recipe.Materials {
+		component, err := materialCost(line, converter)
+		if err != nil {
+			return nil, fmt.Errorf("material %s: %w", line.MaterialCode, err)
+		}
+		breakdown.Components = append(breakdown.Components, component)
+		breakdown.MaterialCost += component.Amount
+	}
+
+	for _, step := range recipe.Steps {
+		result, components, err := processCost(step, recipe, converter)
+		if err != nil {
+			return nil, fmt.Errorf("step %s: %w", step.StepCode, err)
+		}
+		breakdown.Steps = append(breakdown.Steps, result)
+		for _, component := range components {
+			breakdown.Components = append(breakdown.Components, component)
+			breakdown.ProcessCost += component.Amount
+		}
+	}
+
+	breakdown.MaterialCost = round(breakdown.MaterialCost)
+	breakdown.ProcessCost = round(breakdown.ProcessCost)
+	breakdown.TotalCost = round(breakdown.MaterialCost + breakdown.ProcessCost)
+	breakdown.UnitCost = round(breakdown.TotalCost / recipe.OutputQuantity)
+	return breakdown, nil
+}
+
+// validateRecipe checks the recipe header and step codes.
+func validateRecipe(recipe Recipe) error {
+	if recipe.ProductCode == "" {
+		return ErrEmptyProductCode
+	}
+	if recipe.OutputQuantity <= 0 {
+		return ErrInvalidOutputQuantity
+	}
+	if len(recipe.Materials) == 0 && len(recipe.Steps) == 0 {
+		return ErrEmptyRecipe
+	}
+	if recipe.ElectricityRate < 0 {
+		return ErrNegativeRate
+	}
+
+	seen := make(map[string]bool, len(recipe.Steps))
+	for _, step := range recipe.Steps {
+		if step.StepCode == "" {
+			return ErrEmptyStepCode
+		}
+		if seen[step.StepCode] {
+			return fmt.Errorf("step %s: %w", step.StepCode, ErrDuplicateStep)
+		}
+		seen[step.StepCode] = true
+	}
+	return nil
+}
+
+// materialCost prices a material line.
+func materialCost(line MaterialLine, converter *uom.Converter) (Component, error) {
+	switch {
+	case line.MaterialCode == "":
+		return Component{}, ErrEmptyMaterialCode
+	case line.Quantity <= 0:
+		return Component{}, ErrInvalidMaterialQty
+	case line.UnitPrice < 0:
+		return Component{}, ErrNegativePrice
+	case line.WastePercent < 0 || line.WastePercent >= 100:
+		return Component{}, ErrInvalidWaste
+	}
+
+	gross := line.Quantity / (1 - line.WastePercent/100)
+	quantity, err := converter.Convert(gross, line.UOM, line.PriceUOM)
+	if err != nil {
+		return Component{}, err
+	}
+
+	return Component{
+		Type:        ComponentTypeMaterial,
+		Reference:   line.MaterialCode,
+		Description: "Raw material",
+		Quantity:    quantity,
+		UOM:         line.PriceUOM.String(),
+		UnitCost:    line.UnitPrice,
+		Amount:      round(quantity * line.UnitPrice),
+	}, nil
+}
+
+// processCost costs a process step. Components with a zero rate are omitted.
+func processCost(step ProcessStep, recipe Recipe, converter *uom.Converter) (StepResult, []Component, error) {
+	if step.LaborRatePerHour < 0 || step.OverheadRatePerHour < 0 || step.Parameters[ParamPowerKWh] < 0 {
+		return StepResult{}, nil, ErrNegativeRate
+	}
+
+	rate, err := productionRate(step.Parameters)
+	if err != nil {
+		return StepResult{}, nil, err
+	}
+
+	output, err := converter.Convert(recipe.OutputQuantity, recipe.OutputUOM, step.OutputUOM)
+	if err != nil {
+		return StepResult{}, nil, err
+	}
+
+	hours := output / rate
+	result := StepResult{
+		StepCode:       step.StepCode,
+		MachineCode:    step.MachineCode,
+		Output:         output,
+		OutputUOM:      step.OutputUOM,
+		ProductionRate: rate,
+		Hours:          hours,
+		EnergyKWh:      hours * step.Parameters[ParamPowerKWh],
+	}
+
+	var components []Component
+	if result.EnergyKWh > 0 && recipe.ElectricityRate > 0 {
+		components = append(components, Component{
+			Type:        ComponentTypeEnergy,
+			Reference:   step.StepCode,
+			Description: "Electricity",
+			Quantity:    result.EnergyKWh,
+			UOM:         "KWH",
+			UnitCost:    recipe.ElectricityRate,
+			Amount:      round(result.EnergyKWh * recipe.ElectricityRate),
+		})
+	}
+	if step.LaborRatePerHour > 0 {
+		components = append(components, Component{
+			Type:        ComponentTypeLabor,
+			Reference:   step.StepCode,
+			Description: "Labor",
+			Quantity:    hours,
+			UOM:         "HOUR",
+			UnitCost:    step.LaborRatePerHour,
+			Amount:      round(hours * step.LaborRatePerHour),
+		})
+	}
+	if step.OverheadRatePerHour > 0 {
+		components = append(components, Component{
+			Type:        ComponentTypeOverhead,
+			Reference:   step.StepCode,
+			Description: "Machine overhead",
+			Quantity:    hours,
+			UOM:         "HOUR",
+			UnitCost:    step.OverheadRatePerHour,
+			Amount:      round(hours * step.OverheadRatePerHour),
+		})
+	}
+
+	return result, components, nil
+}
+
+// productionRate returns the effective output per hour of a step.
+func productionRate(params map[string]float64) (float64, error) {
+	rate, ok := params[ParamProductionRate]
+	if !ok {
+		rpm, hasRPM := params[ParamRPM]
+		perRev, hasPerRev := params[ParamOutputPerRev]
+		if !hasRPM || !hasPerRev {
+			return 0, ErrMissingProductionRate
+		}
+		rate = rpm * 60 * perRev
+	}
+
+	efficiency := 100.0
+	if eff, ok := params[ParamEfficiency]; ok {
+		efficiency = eff
+	}
+	if efficiency <= 0 || efficiency > 100 {
+		return 0, ErrInvalidEfficiency
+	}
+
+	rate *= efficiency / 100
+	if rate <= 0 {
+		return 0, ErrInvalidProductionRate
+	}
+	return rate, nil
+}
@@ -0,0 +1,102 @@
+package costing
+
+import (
+	"errors"
+
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/uom"
+)
+
+// Domain errors.
+var (
+	ErrEmptyProductCode       = errors.New("product code cannot be empty")
+	ErrInvalidOutputQuantity  = errors.New("output quantity must be greater than zero")
+	ErrEmptyRecipe            = errors.New("recipe needs at least one material or process step")
+	ErrEmptyMaterialCode      = errors.New("material code cannot be empty")
+	ErrInvalidMaterialQty     = errors.New("material quantity must be greater than zero")
+	ErrNegativePrice          = errors.New("unit price cannot be negative")
+	ErrInvalidWaste           = errors.New("waste percent must be at least 0 and below 100")
+	ErrEmptyStepCode          = errors.New("process step code cannot be empty")
+	ErrDuplicateStep          = errors.New("duplicate process step code")
+	ErrNegativeRate           = errors.New("rates cannot be negative")
+	ErrMissingProductionRate  = errors.New("process step needs PRODUCTION_RATE, or RPM and OUTPUT_PER_REV")
+	ErrInvalidProductionRate  = errors.New("production rate must be greater than zero")
+	ErrInvalidEfficiency      = errors.New("efficiency must be greater than 0 and at most 100")
+	ErrParameterNotNumeric    = errors.New("process parameter must be a NUMERIC parameter")
+	ErrDuplicateStepParameter = errors.New("duplicate parameter in process step")
+)
+
+// Recipe describes how a quantity of product is made: the raw materials
+// consumed and the machine process steps it goes through.
+type Recipe struct {
+	ProductCode    string
+	OutputQuantity float64
+	OutputUOM      uom.Code
+	Materials      []MaterialLine
+	Steps          []ProcessStep
+	// ElectricityRate is the price of one kWh.
+	ElectricityRate float64
+}
+
+// MaterialLine is a raw material consumed by the recipe.
+type MaterialLine struct {
+	MaterialCode string
+	// Quantity is the net consumption for the recipe output, in UOM.
+	Quantity float64
+	UOM      uom.Code
+	// UnitPrice is the price of one PriceUOM of the material.
+	UnitPrice float64
+	PriceUOM  uom.Code
+	// WastePercent is the share of the gross input lost in processing.
+	WastePercent float64
+}
+
+// ProcessStep is a machine operation the whole recipe output goes through.
+type ProcessStep struct {
+	StepCode    string
+	MachineCode string
+	// OutputUOM is the unit the machine production rate is measured in.
+	OutputUOM uom.Code
+	// Parameters holds the numeric machine parameters by parameter code,
+	// see ParamProductionRate and friends.
+	Parameters          map[string]float64
+	LaborRatePerHour    float64
+	OverheadRatePerHour float64
+}
+
+// Component is one line of the cost breakdown.
+type Component struct {
+	Type ComponentType
+	// Reference is the material code or process step code.
+	Reference   string
+	Description string
+	Quantity    float64
+	UOM         string
+	UnitCost    float64
+	Amount      float64
+}
+
+// StepResult reports how a process step was costed.
+type StepResult struct {
+	StepCode    string
+	MachineCode string
+	Output      float64
+	OutputUOM   uom.Code
+	// ProductionRate is the effective output per hour after efficiency.
+	ProductionRate float64
+	Hours          float64
+	EnergyKWh      float64
+}
+
+// Breakdown is the result of a cost calculation.
+type Breakdown struct {
+	ProductCode    string
+	OutputQuantity float64
+	OutputUOM      uom.Code
+	Components     []Component
+	Steps          []StepResult
+	MaterialCost   float64
+	ProcessCost    float64
+	TotalCost      float64
+	// UnitCost is the total cost of one OutputUOM of product.
+	UnitCost float64
+}
@@ -0,0 +1,43 @@
+package costing
+
+import "math"
+
+// ComponentType is the kind of cost a component represents.
+type ComponentType string
+
+const (
+	ComponentTypeMaterial ComponentType = "MATERIAL"
+	ComponentTypeEnergy   ComponentType = "ENERGY"
+	ComponentTypeLabor    ComponentType = "LABOR"
+	ComponentTypeOverhead ComponentType = "OVERHEAD"
+)
+
+// String returns the string representation.
+func (t ComponentType) String() string {
+	return string(t)
+}
+
+// Parameter codes the engine reads from process steps. They must be defined
+// as NUMERIC parameters in the parameter master.
+const (
+	// ParamProductionRate is the output per hour at 100% efficiency, in the
+	// step output UOM. When set it takes precedence over RPM.
+	ParamProductionRate = "PRODUCTION_RATE"
+	// ParamRPM is the machine speed in revolutions per minute.
+	ParamRPM = "RPM"
+	// ParamOutputPerRev is the output per revolution, in the step output UOM.
+	ParamOutputPerRev = "OUTPUT_PER_REV"
+	// ParamEfficiency is the machine efficiency in percent, 100 when omitted.
+	ParamEfficiency = "EFFICIENCY"
+	// ParamPowerKWh is the power drawn per running hour in kWh, 0 when omitted.
+	ParamPowerKWh = "POWER_KWH"
+)
+
+// Precision is the number of decimals cost amounts are rounded to.
+const Precision = 4
+
+// round rounds x half away from zero to Precision decimals.
+func round(x float64) float64 {
+	scale := math.Pow10(Precision)
+	return math.Round(x*scale) / scale
+}
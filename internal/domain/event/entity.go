@@ -0,0 +1,54 @@
+package event
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Event is a change of a master-data aggregate, raised by the aggregate
+// itself and saved to the outbox with the change.
+type Event struct {
+	Type          Type
+	AggregateCode string
+	OccurredAt    time.Time
+}
+
+// Recorder collects the events an aggregate raises until the repository
+// saving it pulls them. The zero value is ready to use.
+type Recorder struct {
+	pending []Event
+}
+
+// Raise records an event. An aggregate created in the same unit of work
+// only reports its creation, whose payload already carries the final state,
+// and an event already pending is not repeated.
+func (r *Recorder) Raise(eventType Type, code string) {
+	for _, e := range r.pending {
+		if e.Type == eventType || e.Type.Action() == "created" {
+			return
+		}
+	}
+	r.pending = append(r.pending, Event{Type: eventType, AggregateCode: code, OccurredAt: time.Now()})
+}
+
+// Pull returns the pending events and clears them.
+func (r *Recorder) Pull() []Event {
+	events := r.pending
+	r.pending = nil
+	return events
+}
+
+// Message is an event as stored in the outbox and handed to publishers.
+// Payload is the state of the aggregate right after the change.
+type Message struct {
+	// Sequence orders the messages of the outbox.
+	Sequence      int64           `json:"sequence"`
+	EventID       string          `json:"event_id"`
+	Type          Type            `json:"event_type"`
+	AggregateType string          `json:"aggregate_type"`
+	AggregateCode string          `json:"aggregate_code"`
+	OccurredAt    time.Time       `json:"occurred_at"`
+	Payload       json.RawMessage `json:"payload"`
+	// Attempts counts the failed publish attempts so far.
+	Attempts int `json:"-"`
+}
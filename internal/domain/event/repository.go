@@ -0,0 +1,29 @@
+package event
+
+import (
+	"context"
+	"time"
+)
+
+// Outbox holds the events saved with the changes that raised them until
+// they are published. Repositories write to it in their own transactions.
+type Outbox interface {
+	// Claim leases up to limit unpublished messages, oldest first. Other
+	// relays skip them until the lease expires.
+	Claim(ctx context.Context, limit int, lease time.Duration) ([]*Message, error)
+
+	// MarkPublished records that the messages were published.
+	MarkPublished(ctx context.Context, sequences ...int64) error
+
+	// MarkFailed records a failed publish attempt. The message is claimed
+	// again once its lease expires.
+	MarkFailed(ctx context.Context, sequence int64, cause error) error
+}
+
+// Notifier signals that messages may have been added to the outbox, so change
+// log readers need not poll. Signals are coalesced and may be spurious.
+type Notifier interface {
+	// Subscribe returns a channel signalled after each commit that wrote to
+	// the outbox, and a function ending the subscription.
+	Subscribe() (<-chan struct{}, func())
+}
@@ -0,0 +1,100 @@
+package event
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// Type names a kind of domain event as "<aggregate>.<action>".
+type Type string
+
+const (
+	UOMCreated  Type = "uom.created"
+	UOMUpdated  Type = "uom.updated"
+	UOMDeleted  Type = "uom.deleted"
+	UOMRestored Type = "uom.restored"
+
+	ParameterCreated     Type = "parameter.created"
+	ParameterUpdated     Type = "parameter.updated"
+	ParameterActivated   Type = "parameter.activated"
+	ParameterDeactivated Type = "parameter.deactivated"
+	ParameterDeleted     Type = "parameter.deleted"
+	ParameterRestored    Type = "parameter.restored"
+)
+
+// ErrInvalidType is returned when a string names no known event type.
+var ErrInvalidType = errors.New("invalid event type")
+
+// Types lists every event type.
+func Types() []Type {
+	return []Type{
+		UOMCreated, UOMUpdated, UOMDeleted, UOMRestored,
+		ParameterCreated, ParameterUpdated, ParameterActivated,
+		ParameterDeactivated, ParameterDeleted, ParameterRestored,
+	}
+}
+
+// NewType creates a validated event type.
+func NewType(eventType string) (Type, error) {
+	for _, t := range Types() {
+		if string(t) == eventType {
+			return t, nil
+		}
+	}
+	return "", ErrInvalidType
+}
+
+// Aggregate returns the kind of aggregate the event is about, e.g. "parameter".
+func (t Type) Aggregate() string {
+	aggregate, _, _ := strings.Cut(string(t), ".")
+	return aggregate
+}
+
+// Action returns what happened to the aggregate, e.g. "updated".
+func (t Type) Action() string {
+	_, action, _ := strings.Cut(string(t), ".")
+	return action
+}
+
+// String returns the string representation.
+func (t Type) String() string {
+	return string(t)
+}
+
+// ErrInvalidPosition is returned when a position string cannot be parsed.
+var ErrInvalidPosition = errors.New("invalid change position")
+
+// Position locates a message in the change log. Messages are ordered by the
+// transaction that wrote them, then by sequence, so a reader that has seen a
+// position never misses a message committed after it.
+type Position struct {
+	Tx       int64
+	Sequence int64
+}
+
+// ParsePosition parses a position written by String. An empty string is the
+// start of the log.
+func ParsePosition(s string) (Position, error) {
+	if s == "" {
+		return Position{}, nil
+	}
+	tx, seq, ok := strings.Cut(s, ".")
+	if !ok {
+		return Position{}, ErrInvalidPosition
+	}
+	var p Position
+	var err error
+	if p.Tx, err = strconv.ParseInt(tx, 10, 64); err != nil || p.Tx < 0 {
+		return Position{}, ErrInvalidPosition
+	}
+	if p.Sequence, err = strconv.ParseInt(seq, 10, 64); err != nil || p.Sequence < 0 {
+		return Position{}, ErrInvalidPosition
+	}
+	return p, nil
+}
+
+// String returns the string representation, "<tx>.<sequence>".
+func (p Position) String() string {
+	return strconv.FormatInt(p.Tx, 10) + "." + strconv.FormatInt(p.Sequence, 10)
+}
@@ -0,0 +1,246 @@
+package machine
+
+import (
+	"errors"
+	"time"
+)
+
+// Domain errors.
+var (
+	ErrNotFound                  = errors.New("machine not found")
+	ErrAlreadyExists             = errors.New("machine already exists")
+	ErrTypeNotFound              = errors.New("machine type not found")
+	ErrTypeAlreadyExists         = errors.New("machine type already exists")
+	ErrTypeInUse                 = errors.New("machine type has machines")
+	ErrTypeInactive              = errors.New("machine type is inactive")
+	ErrEmptyName                 = errors.New("name cannot be empty")
+	ErrEmptyCreatedBy            = errors.New("created_by cannot be empty")
+	ErrInvalidCode               = errors.New("invalid machine code format")
+	ErrInvalidTypeCode           = errors.New("invalid machine type code format")
+	ErrDuplicateParameter        = errors.New("parameter listed more than once")
+	ErrParameterNotMachine       = errors.New("template parameters must be in the MACHINE category")
+	ErrParameterNotInTemplate    = errors.New("parameter is not part of the machine type template")
+	ErrMandatoryParameterMissing = errors.New("mandatory parameter has no value")
+	ErrTemplateParameterInUse    = errors.New("template parameter has machine values")
+)
+
+// MachineType is the aggregate root for a kind of machine (ring frame,
+// carding, draw frame, winding...) and its parameter template.
+type MachineType struct {
+	code        TypeCode
+	name        string
+	description *string
+	parameters  []TemplateParameter
+	isActive    bool
+	createdAt   time.Time
+	createdBy   string
+	updatedAt   *time.Time
+	updatedBy   *string
+}
+
+// NewMachineType creates a new MachineType with validation.
+func NewMachineType(code TypeCode, name string, createdBy string) (*MachineType, error) {
+	if name == "" {
+		return nil, ErrEmptyName
+	}
+	if createdBy == "" {
+		return nil, ErrEmptyCreatedBy
+	}
+
+	return &MachineType{
+		code:      code,
+		name:      name,
+		isActive:  true,
+		createdAt: time.Now(),
+		createdBy: createdBy,
+	}, nil
+}
+
+// ReconstituteType creates a MachineType from persistence (no validation).
+func ReconstituteType(
+	code TypeCode,
+	name string,
+	description *string,
+	parameters []TemplateParameter,
+	isActive bool,
+	createdAt time.Time,
+	createdBy string,
+	updatedAt *time.Time,
+	updatedBy *string,
+) *MachineType {
+	return &MachineType{
+		code:        code,
+		name:        name,
+		description: description,
+		parameters:  parameters,
+		isActive:    isActive,
+		createdAt:   createdAt,
+		createdBy:   createdBy,
+		updatedAt:   updatedAt,
+		updatedBy:   updatedBy,
+	}
+}
+
+// Getters.
+func (t *MachineType) Code() TypeCode                  { return t.code }
+func (t *MachineType) Name() string                    { return t.name }
+func (t *MachineType) Description() *string            { return t.description }
+func (t *MachineType) Parameters() []TemplateParameter { return t.parameters }
+func (t *MachineType) IsActive() bool                  { return t.isActive }
+func (t *MachineType) CreatedAt() time.Time            { return t.createdAt }
+func (t *MachineType) CreatedBy() string               { return t.createdBy }
+func (t *MachineType) UpdatedAt() *time.Time           { return t.updatedAt }
+func (t *MachineType) UpdatedBy() *string              { return t.updatedBy }
+
+// SetParameters replaces the parameter template, keeping the given order.
+func (t *MachineType) SetParameters(parameters []TemplateParameter) error {
+	seen := make(map[string]bool, len(parameters))
+	for _, p := range parameters {
+		if seen[p.parameterCode.String()] {
+			return ErrDuplicateParameter
+		}
+		seen[p.parameterCode.String()] = true
+	}
+	t.parameters = parameters
+	return nil
+}
+
+// SetDescription sets the description.
+func (t *MachineType) SetDescription(desc *string) {
+	t.description = desc
+}
+
+// Activate activates the machine type.
+func (t *MachineType) Activate() {
+	t.isActive = true
+}
+
+// Deactivate deactivates the machine type.
+func (t *MachineType) Deactivate() {
+	t.isActive = false
+}
+
+// Update updates the machine type.
+func (t *MachineType) Update(name string, updatedBy string) error {
+	if name == "" {
+		return ErrEmptyName
+	}
+	if updatedBy == "" {
+		return ErrEmptyCreatedBy
+	}
+
+	t.name = name
+	now := time.Now()
+	t.updatedAt = &now
+	t.updatedBy = &updatedBy
+	return nil
+}
+
+// Machine is the aggregate root for a machine instance and its parameter values.
+type Machine struct {
+	code        Code
+	name        string
+	machineType TypeCode
+	description *string
+	values      []Value
+	isActive    bool
+	createdAt   time.Time
+	createdBy   string
+	updatedAt   *time.Time
+	updatedBy   *string
+}
+
+// NewMachine creates a new Machine with validation.
+func NewMachine(code Code, name string, machineType TypeCode, createdBy string) (*Machine, error) {
+	if name == "" {
+		return nil, ErrEmptyName
+	}
+	if createdBy == "" {
+		return nil, ErrEmptyCreatedBy
+	}
+
+	return &Machine{
+		code:        code,
+		name:        name,
+		machineType: machineType,
+		isActive:    true,
+		createdAt:   time.Now(),
+		createdBy:   createdBy,
+	}, nil
+}
+
+// Reconstitute creates a Machine from persistence (no validation).
+func Reconstitute(
+	code Code,
+	name string,
+	machineType TypeCode,
+	description *string,
+	values []Value,
+	isActive bool,
+	createdAt time.Time,
+	createdBy string,
+	updatedAt *time.Time,
+	updatedBy *string,
+) *Machine {
+	return &Machine{
+		code:        code,
+		name:        name,
+		machineType: machineType,
+		description: description,
+		values:      values,
+		isActive:    isActive,
+		createdAt:   createdAt,
+		createdBy:   createdBy,
+		updatedAt:   updatedAt,
+		updatedBy:   updatedBy,
+	}
+}
+
+// Getters.
+func (m *Machine) Code() Code            { return m.code }
+func (m *Machine) Name() string          { return m.name }
+func (m *Machine) MachineType() TypeCode { return m.machineType }
+func (m *Machine) Description() *string  { return m.description }
+func (m *Machine) Values() []Value       { return m.values }
+func (m *Machine) IsActive() bool        { return m.isActive }
+func (m *Machine) CreatedAt() time.Time  { return m.createdAt }
+func (m *Machine) CreatedBy() string     { return m.createdBy }
+func (m *Machine) UpdatedAt() *time.Time { return m.updatedAt }
+func (m *Machine) UpdatedBy() *string    { return m.updatedBy }
+
+// SetValues replaces the parameter values. Values must come from ResolveValues.
+func (m *Machine) SetValues(values []Value) {
+	m.values = values
+}
+
+// SetDescription sets the description.
+func (m *Machine) SetDescription(desc *string) {
+	m.description = desc
+}
+
+// Activate activates the machine.
+func (m *Machine) Activate() {
+	m.isActive = true
+}
+
+// Deactivate deactivates the machine.
+func (m *Machine) Deactivate() {
+	m.isActive = false
+}
+
+// Update updates the machine.
+func (m *Machine) Update(name string, machineType TypeCode, updatedBy string) error {
+	if name == "" {
+		return ErrEmptyName
+	}
+	if updatedBy == "" {
+		return ErrEmptyCreatedBy
+	}
+
+	m.name = name
+	m.machineType = machineType
+	now := time.Now()
+	m.updatedAt = &now
+	m.updatedBy = &updatedBy
+	return nil
+}
@@ -0,0 +1,98 @@
+package machine
+
+import "context"
+
+// TypeRepository defines the interface for MachineType persistence.
+type TypeRepository interface {
+	// Create persists a new MachineType with its parameter template.
+	Create(ctx context.Context, machineType *MachineType) error
+
+	// GetByCode retrieves a MachineType by its code.
+	GetByCode(ctx context.Context, code TypeCode) (*MachineType, error)
+
+	// List retrieves MachineTypes with optional filtering.
+	List(ctx context.Context, filter TypeListFilter) ([]*MachineType, int64, error)
+
+	// Update persists changes to an existing MachineType and its template.
+	Update(ctx context.Context, machineType *MachineType) error
+
+	// Delete removes a MachineType by its code.
+	Delete(ctx context.Context, code TypeCode) error
+
+	// ExistsByCode checks if a MachineType with the given code exists.
+	ExistsByCode(ctx context.Context, code TypeCode) (bool, error)
+}
+
+// Repository defines the interface for Machine persistence.
+type Repository interface {
+	// Create persists a new Machine with its parameter values.
+	Create(ctx context.Context, machine *Machine) error
+
+	// GetByCode retrieves a Machine by its code.
+	GetByCode(ctx context.Context, code Code) (*Machine, error)
+
+	// List retrieves Machines with optional filtering.
+	List(ctx context.Context, filter ListFilter) ([]*Machine, int64, error)
+
+	// Update persists changes to an existing Machine and its values.
+	Update(ctx context.Context, machine *Machine) error
+
+	// Delete removes a Machine by its code.
+	Delete(ctx context.Context, code Code) error
+
+	// ExistsByCode checks if a Machine with the given code exists.
+	ExistsByCode(ctx context.Context, code Code) (bool, error)
+}
+
+// TypeListFilter contains filtering and pagination options for machine types.
+type TypeListFilter struct {
+	IsActive *bool
+	Page     int
+	PageSize int
+}
+
+// Offset calculates the offset for pagination.
+func (f TypeListFilter) Offset() int {
+	if f.Page <= 0 {
+		f.Page = 1
+	}
+	return (f.Page - 1) * f.PageSize
+}
+
+// Limit returns the page size.
+func (f TypeListFilter) Limit() int {
+	if f.PageSize <= 0 {
+		return 10
+	}
+	if f.PageSize > 100 {
+		return 100
+	}
+	return f.PageSize
+}
+
+// ListFilter contains filtering and pagination options for machines.
+type ListFilter struct {
+	MachineType *TypeCode
+	IsActive    *bool
+	Page        int
+	PageSize    int
+}
+
+// Offset calculates the offset for pagination.
+func (f ListFilter) Offset() int {
+	if f.Page <= 0 {
+		f.Page = 1
+	}
+	return (f.Page - 1) * f.PageSize
+}
+
+// Limit returns the page size.
+func (f ListFilter) Limit() int {
+	if f.PageSize <= 0 {
+		return 10
+	}
+	if f.PageSize > 100 {
+		return 100
+	}
+	return f.PageSize
+}
@@ -0,0 +1,59 @@
+package machine
+
+import (
+	"fmt"
+
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/parameter"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/parametervalue"
+)
+
+// CheckTemplateParameter verifies that definition may be declared by a machine type.
+func CheckTemplateParameter(definition *parameter.Parameter) error {
+	if definition.Category() != parameter.CategoryMachine {
+		return ErrParameterNotMachine
+	}
+	return nil
+}
+
+// ResolveValues validates raw machine values against the template of
+// machineType and the parameter definitions, returning them normalized in
+// template order. Every mandatory template parameter must have a value;
+// optional parameters left empty are omitted.
+func ResolveValues(
+	machineType *MachineType,
+	definitions map[parameter.Code]*parameter.Parameter,
+	raw map[parameter.Code]string,
+) ([]Value, error) {
+	declared := make(map[parameter.Code]bool, len(machineType.parameters))
+	for _, p := range machineType.parameters {
+		declared[p.parameterCode] = true
+	}
+	for code := range raw {
+		if !declared[code] {
+			return nil, fmt.Errorf("parameter %s: %w", code, ErrParameterNotInTemplate)
+		}
+	}
+
+	values := make([]Value, 0, len(raw))
+	for _, p := range machineType.parameters {
+		input, ok := raw[p.parameterCode]
+		if !ok || input == "" {
+			if p.isMandatory {
+				return nil, fmt.Errorf("parameter %s: %w", p.parameterCode, ErrMandatoryParameterMissing)
+			}
+			continue
+		}
+
+		definition, ok := definitions[p.parameterCode]
+		if !ok {
+			return nil, fmt.Errorf("parameter %s: %w", p.parameterCode, parameter.ErrNotFound)
+		}
+		normalized, _, err := parametervalue.ParseValue(definition, input)
+		if err != nil {
+			return nil, fmt.Errorf("parameter %s: %w", p.parameterCode, err)
+		}
+		values = append(values, NewValue(p.parameterCode, normalized))
+	}
+
+	return values, nil
+}
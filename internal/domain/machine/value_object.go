@@ -0,0 +1,77 @@
+package machine
+
+import (
+	"regexp"
+
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/parameter"
+)
+
+// Code is a value object for machine identifier.
+type Code string
+
+var machineCodePattern = regexp.MustCompile(`^[A-Z0-9][A-Z0-9_\-]{0,49}$`)
+
+// NewMachineCode creates a validated machine code.
+func NewMachineCode(code string) (Code, error) {
+	if !machineCodePattern.MatchString(code) {
+		return "", ErrInvalidCode
+	}
+	return Code(code), nil
+}
+
+// String returns the string representation.
+func (c Code) String() string {
+	return string(c)
+}
+
+// TypeCode is a value object for machine type identifier (e.g., RING_FRAME, CARDING).
+type TypeCode string
+
+var typeCodePattern = regexp.MustCompile(`^[A-Z][A-Z0-9_]{0,29}$`)
+
+// NewTypeCode creates a validated machine type code.
+func NewTypeCode(code string) (TypeCode, error) {
+	if !typeCodePattern.MatchString(code) {
+		return "", ErrInvalidTypeCode
+	}
+	return TypeCode(code), nil
+}
+
+// String returns the string representation.
+func (c TypeCode) String() string {
+	return string(c)
+}
+
+// TemplateParameter is a MACHINE-category parameter declared by a machine type.
+type TemplateParameter struct {
+	parameterCode parameter.Code
+	isMandatory   bool
+}
+
+// NewTemplateParameter creates a template parameter.
+func NewTemplateParameter(parameterCode parameter.Code, isMandatory bool) TemplateParameter {
+	return TemplateParameter{parameterCode: parameterCode, isMandatory: isMandatory}
+}
+
+// ParameterCode returns the parameter code.
+func (p TemplateParameter) ParameterCode() parameter.Code { return p.parameterCode }
+
+// IsMandatory reports whether every machine of the type must have a value.
+func (p TemplateParameter) IsMandatory() bool { return p.isMandatory }
+
+// Value is the normalized value of a template parameter on a machine.
+type Value struct {
+	parameterCode parameter.Code
+	value         string
+}
+
+// NewValue creates a machine parameter value. Use ResolveValues to validate raw input.
+func NewValue(parameterCode parameter.Code, value string) Value {
+	return Value{parameterCode: parameterCode, value: value}
+}
+
+// ParameterCode returns the parameter code.
+func (v Value) ParameterCode() parameter.Code { return v.parameterCode }
+
+// Value returns the normalized value.
+func (v Value) Value() string { return v.value }
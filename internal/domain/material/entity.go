@@ -0,0 +1,147 @@
+package material
+
+import (
+	"errors"
+	"time"
+
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/uom"
+)
+
+// Domain errors.
+var (
+	ErrNotFound            = errors.New("material not found")
+	ErrAlreadyExists       = errors.New("material already exists")
+	ErrEmptyName           = errors.New("material name cannot be empty")
+	ErrEmptyCreatedBy      = errors.New("created_by cannot be empty")
+	ErrInvalidCode         = errors.New("invalid material code format")
+	ErrInvalidType         = errors.New("invalid material type")
+	ErrInvalidCurrency     = errors.New("invalid currency, expected ISO 4217 code")
+	ErrNegativePrice       = errors.New("standard price cannot be negative")
+	ErrPurchaseUOMNotFound = errors.New("purchase uom not found")
+)
+
+// Material is the aggregate root for material master data
+// (fibres, yarns, chemicals, packaging).
+type Material struct {
+	code          Code
+	name          string
+	materialType  Type
+	purchaseUOM   uom.Code
+	standardPrice Price
+	description   *string
+	isActive      bool
+	createdAt     time.Time
+	createdBy     string
+	updatedAt     *time.Time
+	updatedBy     *string
+}
+
+// NewMaterial creates a new Material with validation.
+func NewMaterial(
+	code Code,
+	name string,
+	materialType Type,
+	purchaseUOM uom.Code,
+	standardPrice Price,
+	createdBy string,
+) (*Material, error) {
+	if name == "" {
+		return nil, ErrEmptyName
+	}
+	if createdBy == "" {
+		return nil, ErrEmptyCreatedBy
+	}
+
+	return &Material{
+		code:          code,
+		name:          name,
+		materialType:  materialType,
+		purchaseUOM:   purchaseUOM,
+		standardPrice: standardPrice,
+		isActive:      true,
+		createdAt:     time.Now(),
+		createdBy:     createdBy,
+	}, nil
+}
+
+// Reconstitute creates a Material from persistence (no validation).
+func Reconstitute(
+	code Code,
+	name string,
+	materialType Type,
+	purchaseUOM uom.Code,
+	standardPrice Price,
+	description *string,
+	isActive bool,
+	createdAt time.Time,
+	createdBy string,
+	updatedAt *time.Time,
+	updatedBy *string,
+) *Material {
+	return &Material{
+		code:          code,
+		name:          name,
+		materialType:  materialType,
+		purchaseUOM:   purchaseUOM,
+		standardPrice: standardPrice,
+		description:   description,
+		isActive:      isActive,
+		createdAt:     createdAt,
+		createdBy:     createdBy,
+		updatedAt:     updatedAt,
+		updatedBy:     updatedBy,
+	}
+}
+
+// Getters.
+func (m *Material) Code() Code            { return m.code }
+func (m *Material) Name() string          { return m.name }
+func (m *Material) Type() Type            { return m.materialType }
+func (m *Material) PurchaseUOM() uom.Code { return m.purchaseUOM }
+func (m *Material) StandardPrice() Price  { return m.standardPrice }
+func (m *Material) Description() *string  { return m.description }
+func (m *Material) IsActive() bool        { return m.isActive }
+func (m *Material) CreatedAt() time.Time  { return m.createdAt }
+func (m *Material) CreatedBy() string     { return m.createdBy }
+func (m *Material) UpdatedAt() *time.Time { return m.updatedAt }
+func (m *Material) UpdatedBy() *string    { return m.updatedBy }
+
+// SetDescription sets the description.
+func (m *Material) SetDescription(desc *string) {
+	m.description = desc
+}
+
+// Activate activates the material.
+func (m *Material) Activate() {
+	m.isActive = true
+}
+
+// Deactivate deactivates the material.
+func (m *Material) Deactivate() {
+	m.isActive = false
+}
+
+// Update updates the material.
+func (m *Material) Update(
+	name string,
+	materialType Type,
+	purchaseUOM uom.Code,
+	standardPrice Price,
+	updatedBy string,
+) error {
+	if name == "" {
+		return ErrEmptyName
+	}
+	if updatedBy == "" {
+		return ErrEmptyCreatedBy
+	}
+
+	m.name = name
+	m.materialType = materialType
+	m.purchaseUOM = purchaseUOM
+	m.standardPrice = standardPrice
+	now := time.Now()
+	m.updatedAt = &now
+	m.updatedBy = &updatedBy
+	return nil
+}
@@ -0,0 +1,51 @@
+package material
+
+import "context"
+
+// Repository defines the interface for Material persistence.
+type Repository interface {
+	// Create persists a new Material.
+	Create(ctx context.Context, material *Material) error
+
+	// GetByCode retrieves a Material by its code.
+	GetByCode(ctx context.Context, code Code) (*Material, error)
+
+	// List retrieves Materials with optional filtering.
+	List(ctx context.Context, filter ListFilter) ([]*Material, int64, error)
+
+	// Update persists changes to an existing Material.
+	Update(ctx context.Context, material *Material) error
+
+	// Delete removes a Material by its code.
+	Delete(ctx context.Context, code Code) error
+
+	// ExistsByCode checks if a Material with the given code exists.
+	ExistsByCode(ctx context.Context, code Code) (bool, error)
+}
+
+// ListFilter contains filtering and pagination options.
+type ListFilter struct {
+	Type     *Type
+	IsActive *bool
+	Page     int
+	PageSize int
+}
+
+// Offset calculates the offset for pagination.
+func (f ListFilter) Offset() int {
+	if f.Page <= 0 {
+		f.Page = 1
+	}
+	return (f.Page - 1) * f.PageSize
+}
+
+// Limit returns the page size.
+func (f ListFilter) Limit() int {
+	if f.PageSize <= 0 {
+		return 10
+	}
+	if f.PageSize > 100 {
+		return 100
+	}
+	return f.PageSize
+}
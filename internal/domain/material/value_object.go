@@ -0,0 +1,86 @@
+package material
+
+import (
+	"regexp"
+)
+
+// Code is a value object for material identifier.
+type Code string
+
+var materialCodePattern = regexp.MustCompile(`^[A-Z0-9][A-Z0-9_\-]{0,49}$`)
+
+// NewMaterialCode creates a validated material code.
+func NewMaterialCode(code string) (Code, error) {
+	if !materialCodePattern.MatchString(code) {
+		return "", ErrInvalidCode
+	}
+	return Code(code), nil
+}
+
+// String returns the string representation.
+func (c Code) String() string {
+	return string(c)
+}
+
+// Type represents the kind of material.
+type Type string
+
+const (
+	TypeFibre     Type = "FIBRE"
+	TypeYarn      Type = "YARN"
+	TypeChemical  Type = "CHEMICAL"
+	TypePackaging Type = "PACKAGING"
+)
+
+// NewType creates a validated material type.
+func NewType(materialType string) (Type, error) {
+	switch Type(materialType) {
+	case TypeFibre, TypeYarn, TypeChemical, TypePackaging:
+		return Type(materialType), nil
+	default:
+		return "", ErrInvalidType
+	}
+}
+
+// String returns the string representation.
+func (t Type) String() string {
+	return string(t)
+}
+
+// Currency is an ISO 4217 currency code.
+type Currency string
+
+var currencyPattern = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// NewCurrency creates a validated currency code.
+func NewCurrency(currency string) (Currency, error) {
+	if !currencyPattern.MatchString(currency) {
+		return "", ErrInvalidCurrency
+	}
+	return Currency(currency), nil
+}
+
+// String returns the string representation.
+func (c Currency) String() string {
+	return string(c)
+}
+
+// Price is a standard price of one purchase UOM in a currency.
+type Price struct {
+	amount   float64
+	currency Currency
+}
+
+// NewPrice creates a validated price.
+func NewPrice(amount float64, currency Currency) (Price, error) {
+	if amount < 0 {
+		return Price{}, ErrNegativePrice
+	}
+	return Price{amount: amount, currency: currency}, nil
+}
+
+// Amount returns the price amount.
+func (p Price) Amount() float64 { return p.amount }
+
+// Currency returns the price currency.
+func (p Price) Currency() Currency { return p.currency }
@@ -3,19 +3,32 @@ package parameter
 import (
 	"errors"
 	"time"
+
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/event"
 )
 
 // Domain errors.
 var (
-	ErrNotFound          = errors.New("parameter not found")
-	ErrAlreadyExists     = errors.New("parameter already exists")
-	ErrEmptyName         = errors.New("parameter name cannot be empty")
-	ErrEmptyCreatedBy    = errors.New("created_by cannot be empty")
-	ErrInvalidCode       = errors.New("invalid parameter code format")
-	ErrInvalidCategory   = errors.New("invalid parameter category")
-	ErrInvalidDataType   = errors.New("invalid parameter data type")
-	ErrMinGreaterThanMax = errors.New("min_value cannot be greater than max_value")
-	ErrDropdownNoOptions = errors.New("dropdown type requires allowed_values")
+	ErrNotFound           = errors.New("parameter not found")
+	ErrAlreadyExists      = errors.New("parameter already exists")
+	ErrInUse              = errors.New("parameter is in use by values or machine types")
+	ErrEmptyName          = errors.New("parameter name cannot be empty")
+	ErrEmptyCreatedBy     = errors.New("created_by cannot be empty")
+	ErrInvalidCode        = errors.New("invalid parameter code format")
+	ErrInvalidCategory    = errors.New("invalid parameter category")
+	ErrInvalidDataType    = errors.New("invalid parameter data type")
+	ErrMinGreaterThanMax  = errors.New("min_value cannot be greater than max_value")
+	ErrDropdownNoOptions  = errors.New("dropdown type requires allowed_values")
+	ErrVersionConflict    = errors.New("parameter was modified by another request, reload and retry")
+	ErrNotDeleted         = errors.New("parameter is not deleted")
+	ErrDeleted            = errors.New("parameter is deleted, restore it first")
+	ErrUOMNotFound        = errors.New("uom does not exist")
+	ErrUOMNotAllowed      = errors.New("uom is only allowed on NUMERIC parameters")
+	ErrInvalidSortField   = errors.New("invalid parameter sort field")
+	ErrInvalidTimeRange   = errors.New("invalid time range, expected RFC 3339 timestamps with from before to")
+	ErrInvalidPageToken   = errors.New("invalid page token")
+	ErrInvalidResumeToken = errors.New("invalid resume token")
+	ErrInvalidAsOf        = errors.New("invalid as_of, expected an RFC 3339 timestamp")
 )
 
 // Parameter is the aggregate root for configuration parameters.
@@ -35,6 +48,12 @@ type Parameter struct {
 	createdBy     string
 	updatedAt     *time.Time
 	updatedBy     *string
+	deletedAt     *time.Time
+	deletedBy     *string
+	// version is the persisted revision, used for optimistic concurrency.
+	version int
+	// events are raised by changes and saved to the outbox with them.
+	events event.Recorder
 }
 
 // NewParameter creates a new Parameter with validation.
@@ -52,7 +71,7 @@ func NewParameter(
 		return nil, ErrEmptyCreatedBy
 	}
 
-	return &Parameter{
+	p := &Parameter{
 		code:        code,
 		name:        name,
 		category:    category,
@@ -61,7 +80,10 @@ func NewParameter(
 		isMandatory: false,
 		createdAt:   time.Now(),
 		createdBy:   createdBy,
-	}, nil
+		version:     1,
+	}
+	p.events.Raise(event.ParameterCreated, code.String())
+	return p, nil
 }
 
 // Reconstitute creates a Parameter from persistence (no validation).
@@ -81,6 +103,9 @@ func Reconstitute(
 	createdBy string,
 	updatedAt *time.Time,
 	updatedBy *string,
+	deletedAt *time.Time,
+	deletedBy *string,
+	version int,
 ) *Parameter {
 	return &Parameter{
 		code:          code,
@@ -98,6 +123,9 @@ func Reconstitute(
 		createdBy:     createdBy,
 		updatedAt:     updatedAt,
 		updatedBy:     updatedBy,
+		deletedAt:     deletedAt,
+		deletedBy:     deletedBy,
+		version:       version,
 	}
 }
 
@@ -117,6 +145,39 @@ func (p *Parameter) CreatedAt() time.Time    { return p.createdAt }
 func (p *Parameter) CreatedBy() string       { return p.createdBy }
 func (p *Parameter) UpdatedAt() *time.Time   { return p.updatedAt }
 func (p *Parameter) UpdatedBy() *string      { return p.updatedBy }
+func (p *Parameter) DeletedAt() *time.Time   { return p.deletedAt }
+func (p *Parameter) DeletedBy() *string      { return p.deletedBy }
+func (p *Parameter) IsDeleted() bool         { return p.deletedAt != nil }
+func (p *Parameter) Version() int            { return p.version }
+
+// CheckVersion ensures a change is based on the current revision.
+func (p *Parameter) CheckVersion(version int) error {
+	if version != p.version {
+		return ErrVersionConflict
+	}
+	return nil
+}
+
+// IncrementVersion records a successful save; called by the repository.
+func (p *Parameter) IncrementVersion() {
+	p.version++
+}
+
+// Version is the definition of a Parameter in force over [EffectiveFrom,
+// EffectiveTo). Every save of a Parameter closes the version in force and
+// opens the next, numbered by the Parameter's version.
+type Version struct {
+	Parameter     *Parameter
+	EffectiveFrom time.Time
+	EffectiveTo   *time.Time
+	RecordedBy    string
+}
+
+// PullEvents returns the events raised since the last save and clears them;
+// called by the repository.
+func (p *Parameter) PullEvents() []event.Event {
+	return p.events.Pull()
+}
 
 // SetNumericConstraints sets min/max values for numeric parameters.
 func (p *Parameter) SetNumericConstraints(minVal, maxVal *float64) error {
@@ -137,9 +198,13 @@ func (p *Parameter) SetAllowedValues(values []string) error {
 	return nil
 }
 
-// SetUOM sets the unit of measure.
-func (p *Parameter) SetUOM(uom *string) {
+// SetUOM sets the unit of measure. Only numeric parameters have one.
+func (p *Parameter) SetUOM(uom *string) error {
+	if uom != nil && p.dataType != DataTypeNumeric {
+		return ErrUOMNotAllowed
+	}
 	p.uom = uom
+	return nil
 }
 
 // SetDescription sets the description.
@@ -154,14 +219,42 @@ func (p *Parameter) SetMandatory(mandatory bool) {
 
 // Activate activates the parameter.
 func (p *Parameter) Activate() {
+	if !p.isActive {
+		p.events.Raise(event.ParameterActivated, p.code.String())
+	}
 	p.isActive = true
 }
 
 // Deactivate deactivates the parameter.
 func (p *Parameter) Deactivate() {
+	if p.isActive {
+		p.events.Raise(event.ParameterDeactivated, p.code.String())
+	}
 	p.isActive = false
 }
 
+// SoftDelete marks the parameter as deleted. The row is kept for history.
+func (p *Parameter) SoftDelete(deletedBy string) {
+	now := time.Now()
+	p.deletedAt = &now
+	p.deletedBy = &deletedBy
+	p.events.Raise(event.ParameterDeleted, p.code.String())
+}
+
+// Restore undoes a soft delete.
+func (p *Parameter) Restore(restoredBy string) error {
+	if p.deletedAt == nil {
+		return ErrNotDeleted
+	}
+	p.deletedAt = nil
+	p.deletedBy = nil
+	now := time.Now()
+	p.updatedAt = &now
+	p.updatedBy = &restoredBy
+	p.events.Raise(event.ParameterRestored, p.code.String())
+	return nil
+}
+
 // Update updates the parameter.
 func (p *Parameter) Update(
 	name string,
@@ -182,5 +275,6 @@ func (p *Parameter) Update(
 	now := time.Now()
 	p.updatedAt = &now
 	p.updatedBy = &updatedBy
+	p.events.Raise(event.ParameterUpdated, p.code.String())
 	return nil
 }
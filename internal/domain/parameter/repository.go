@@ -1,36 +1,136 @@
 package parameter
 
-import "context"
+import (
+	"context"
+	"time"
+
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/event"
+)
 
 // Repository defines the interface for Parameter persistence.
 type Repository interface {
 	// Create persists a new Parameter.
 	Create(ctx context.Context, param *Parameter) error
 
-	// GetByCode retrieves a Parameter by its code.
+	// GetByCode retrieves a Parameter by its code. Soft-deleted Parameters are not found.
 	GetByCode(ctx context.Context, code Code) (*Parameter, error)
 
+	// GetByCodeIncludingDeleted retrieves a Parameter by its code, even if soft-deleted.
+	GetByCodeIncludingDeleted(ctx context.Context, code Code) (*Parameter, error)
+
 	// List retrieves Parameters with optional filtering.
 	List(ctx context.Context, filter ListFilter) ([]*Parameter, int64, error)
 
-	// Update persists changes to an existing Parameter.
+	// ForEach calls fn for every Parameter matching the filter, in the
+	// filter's order, without loading them all. Paging is ignored; an error from fn stops
+	// the iteration and is returned.
+	ForEach(ctx context.Context, filter ListFilter, fn func(*Parameter) error) error
+
+	// Update persists changes to an existing Parameter, including soft deletion.
 	Update(ctx context.Context, param *Parameter) error
 
-	// Delete removes a Parameter by its code.
-	Delete(ctx context.Context, code Code) error
+	// GetVersionAsOf retrieves the version of a Parameter in force at asOf.
+	// A Parameter not yet created or deleted at asOf is not found.
+	GetVersionAsOf(ctx context.Context, code Code, asOf time.Time) (*Version, error)
+
+	// ListVersions retrieves the versions of a Parameter, newest first,
+	// including those recording its deletion.
+	ListVersions(ctx context.Context, filter VersionFilter) ([]*Version, int64, error)
 
 	// ExistsByCode checks if a Parameter with the given code exists.
+	// Soft-deleted Parameters count, as their codes cannot be reused.
 	ExistsByCode(ctx context.Context, code Code) (bool, error)
+
+	// IsInUse checks if parameter values or machine type templates use the Parameter.
+	IsInUse(ctx context.Context, code Code) (bool, error)
+
+	// SaveBatch creates or updates Parameters in a single transaction and
+	// returns one error per entry, nil if it was saved. An atomic batch is
+	// rolled back entirely when any entry fails.
+	SaveBatch(ctx context.Context, entries []BatchEntry, atomic bool) ([]error, error)
+}
+
+// ChangeFeed reads the committed changes of Parameters from the change log.
+type ChangeFeed interface {
+	// Head returns the position of the latest readable change.
+	Head(ctx context.Context) (event.Position, error)
+
+	// ParameterChangesAfter returns up to limit changes following after, in log order.
+	ParameterChangesAfter(ctx context.Context, after event.Position, limit int) ([]*Change, error)
+}
+
+// Change is a committed change of a Parameter with its state right after it.
+type Change struct {
+	Position   event.Position
+	Type       event.Type
+	OccurredAt time.Time
+	Parameter  *Parameter
+}
+
+// VersionFilter selects the versions of one Parameter, with pagination.
+type VersionFilter struct {
+	Code     Code
+	Page     int
+	PageSize int
 }
 
-// ListFilter contains filtering and pagination options.
+// Offset calculates the offset for pagination.
+func (f VersionFilter) Offset() int {
+	if f.Page <= 0 {
+		f.Page = 1
+	}
+	return (f.Page - 1) * f.PageSize
+}
+
+// Limit returns the page size.
+func (f VersionFilter) Limit() int {
+	if f.PageSize <= 0 {
+		return 10
+	}
+	if f.PageSize > 100 {
+		return 100
+	}
+	return f.PageSize
+}
+
+// BatchEntry is a Parameter to be created or updated as part of a batch.
+type BatchEntry struct {
+	Parameter *Parameter
+	IsNew     bool
+}
+
+// ListFilter contains filtering, sorting and pagination options.
+// Time ranges are half-open: [From, To).
 type ListFilter struct {
-	Category *Category
-	IsActive *bool
+	Category       *Category
+	IsActive       *bool
+	IncludeDeleted bool
+	// Search matches a substring of the code, name or description, ignoring case.
+	Search      string
+	DataType    *DataType
+	UOM         *string
+	IsMandatory *bool
+	CreatedBy   *string
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	UpdatedFrom *time.Time
+	UpdatedTo   *time.Time
+	// SortBy defaults to the code; ties are always broken by the code.
+	SortBy   SortField
+	SortDesc bool
+	// After selects the page following a row, in place of Page.
+	After    *Cursor
 	Page     int
 	PageSize int
 }
 
+// Cursor is the keyset position of a listed Parameter: its value of the
+// sort field, nil when NULL, and its code.
+type Cursor struct {
+	SortValue *string
+	Code      Code
+}
+
 // Offset calculates the offset for pagination.
 func (f ListFilter) Offset() int {
 	if f.Page <= 0 {
@@ -49,3 +149,13 @@ func (f ListFilter) Limit() int {
 	}
 	return f.PageSize
 }
+
+// HasNext reports whether a page of count rows, out of total matching rows,
+// may be followed by another. A keyset page cannot tell from total, so any
+// full one may be.
+func (f ListFilter) HasNext(count int, total int64) bool {
+	if count == 0 || count < f.Limit() {
+		return false
+	}
+	return f.After != nil || int64(f.Offset()+count) < total
+}
@@ -2,6 +2,7 @@ package parameter
 
 import (
 	"regexp"
+	"time"
 )
 
 // Code is a value object for parameter identifier.
@@ -72,3 +73,65 @@ func NewDataType(dataType string) (DataType, error) {
 func (d DataType) String() string {
 	return string(d)
 }
+
+// SortField represents a field Parameters can be listed by.
+type SortField string
+
+const (
+	SortByCode      SortField = "CODE"
+	SortByName      SortField = "NAME"
+	SortByCategory  SortField = "CATEGORY"
+	SortByDataType  SortField = "DATA_TYPE"
+	SortByCreatedAt SortField = "CREATED_AT"
+	SortByUpdatedAt SortField = "UPDATED_AT"
+)
+
+// NewSortField creates a validated sort field. An empty field sorts by code.
+func NewSortField(field string) (SortField, error) {
+	switch SortField(field) {
+	case "":
+		return SortByCode, nil
+	case SortByCode, SortByName, SortByCategory, SortByDataType, SortByCreatedAt, SortByUpdatedAt:
+		return SortField(field), nil
+	default:
+		return "", ErrInvalidSortField
+	}
+}
+
+// String returns the string representation.
+func (s SortField) String() string {
+	return string(s)
+}
+
+// Value returns the value of the sort field of param, as held by a Cursor.
+// Timestamps are in RFC 3339 with nanoseconds; nil means NULL.
+func (s SortField) Value(param *Parameter) *string {
+	var value string
+	switch s {
+	case SortByName:
+		value = param.Name()
+	case SortByCategory:
+		value = param.Category().String()
+	case SortByDataType:
+		value = param.DataType().String()
+	case SortByCreatedAt:
+		value = param.CreatedAt().UTC().Format(time.RFC3339Nano)
+	case SortByUpdatedAt:
+		if param.UpdatedAt() == nil {
+			return nil
+		}
+		value = param.UpdatedAt().UTC().Format(time.RFC3339Nano)
+	default:
+		value = param.Code().String()
+	}
+	return &value
+}
+
+// NewAsOf parses the RFC 3339 time a definition is looked up at.
+func NewAsOf(asOf string) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339, asOf)
+	if err != nil {
+		return time.Time{}, ErrInvalidAsOf
+	}
+	return t, nil
+}
@@ -0,0 +1,227 @@
+package parametervalue
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/parameter"
+)
+
+// Domain errors.
+var (
+	ErrNotFound           = errors.New("parameter value not found")
+	ErrAlreadyExists      = errors.New("parameter value already exists for this effective date")
+	ErrEmptyCreatedBy     = errors.New("created_by cannot be empty")
+	ErrInvalidSubjectType = errors.New("invalid subject type")
+	ErrInvalidSubjectCode = errors.New("invalid subject code format")
+	ErrInvalidDate        = errors.New("invalid date format, expected YYYY-MM-DD")
+	ErrInvalidPeriod      = errors.New("effective_to must be after effective_from")
+	ErrPeriodOverlap      = errors.New("effective period overlaps an existing value")
+	ErrParameterInactive  = errors.New("parameter is inactive")
+
+	ErrEmptyValue      = errors.New("value cannot be empty")
+	ErrValueNotNumeric = errors.New("value must be numeric")
+	ErrValueBelowMin   = errors.New("value is below the parameter min_value")
+	ErrValueAboveMax   = errors.New("value is above the parameter max_value")
+	ErrValueNotBoolean = errors.New("value must be true or false")
+	ErrValueNotAllowed = errors.New("value is not one of the parameter allowed_values")
+)
+
+// ParameterValue is the aggregate root for the value of a parameter recorded
+// for a subject (machine, material or product) over an effective period.
+type ParameterValue struct {
+	id            int64
+	subjectType   SubjectType
+	subjectCode   SubjectCode
+	parameterCode parameter.Code
+	dataType      parameter.DataType
+	value         string
+	numericValue  *float64
+	period        Period
+	remarks       *string
+	createdAt     time.Time
+	createdBy     string
+	updatedAt     *time.Time
+	updatedBy     *string
+}
+
+// NewParameterValue creates a new ParameterValue validated against the
+// parameter definition.
+func NewParameterValue(
+	definition *parameter.Parameter,
+	subjectType SubjectType,
+	subjectCode SubjectCode,
+	value string,
+	period Period,
+	createdBy string,
+) (*ParameterValue, error) {
+	if createdBy == "" {
+		return nil, ErrEmptyCreatedBy
+	}
+
+	normalized, numeric, err := ParseValue(definition, value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ParameterValue{
+		subjectType:   subjectType,
+		subjectCode:   subjectCode,
+		parameterCode: definition.Code(),
+		dataType:      definition.DataType(),
+		value:         normalized,
+		numericValue:  numeric,
+		period:        period,
+		createdAt:     time.Now(),
+		createdBy:     createdBy,
+	}, nil
+}
+
+// Reconstitute creates a ParameterValue from persistence (no validation).
+func Reconstitute(
+	id int64,
+	subjectType SubjectType,
+	subjectCode SubjectCode,
+	parameterCode parameter.Code,
+	dataType parameter.DataType,
+	value string,
+	numericValue *float64,
+	period Period,
+	remarks *string,
+	createdAt time.Time,
+	createdBy string,
+	updatedAt *time.Time,
+	updatedBy *string,
+) *ParameterValue {
+	return &ParameterValue{
+		id:            id,
+		subjectType:   subjectType,
+		subjectCode:   subjectCode,
+		parameterCode: parameterCode,
+		dataType:      dataType,
+		value:         value,
+		numericValue:  numericValue,
+		period:        period,
+		remarks:       remarks,
+		createdAt:     createdAt,
+		createdBy:     createdBy,
+		updatedAt:     updatedAt,
+		updatedBy:     updatedBy,
+	}
+}
+
+// Getters.
+func (v *ParameterValue) ID() int64                     { return v.id }
+func (v *ParameterValue) SubjectType() SubjectType      { return v.subjectType }
+func (v *ParameterValue) SubjectCode() SubjectCode      { return v.subjectCode }
+func (v *ParameterValue) ParameterCode() parameter.Code { return v.parameterCode }
+func (v *ParameterValue) DataType() parameter.DataType  { return v.dataType }
+func (v *ParameterValue) Value() string                 { return v.value }
+func (v *ParameterValue) NumericValue() *float64        { return v.numericValue }
+func (v *ParameterValue) Period() Period                { return v.period }
+func (v *ParameterValue) Remarks() *string              { return v.remarks }
+func (v *ParameterValue) CreatedAt() time.Time          { return v.createdAt }
+func (v *ParameterValue) CreatedBy() string             { return v.createdBy }
+func (v *ParameterValue) UpdatedAt() *time.Time         { return v.updatedAt }
+func (v *ParameterValue) UpdatedBy() *string            { return v.updatedBy }
+
+// AssignID sets the identifier generated by persistence.
+func (v *ParameterValue) AssignID(id int64) {
+	v.id = id
+}
+
+// SetRemarks sets the remarks.
+func (v *ParameterValue) SetRemarks(remarks *string) {
+	v.remarks = remarks
+}
+
+// Update changes the value and period, validating against the parameter definition.
+func (v *ParameterValue) Update(definition *parameter.Parameter, value string, period Period, updatedBy string) error {
+	if updatedBy == "" {
+		return ErrEmptyCreatedBy
+	}
+
+	normalized, numeric, err := ParseValue(definition, value)
+	if err != nil {
+		return err
+	}
+
+	v.dataType = definition.DataType()
+	v.value = normalized
+	v.numericValue = numeric
+	v.period = period
+	v.touch(updatedBy)
+	return nil
+}
+
+// Close ends the effective period on the given date, exclusive.
+func (v *ParameterValue) Close(to time.Time, updatedBy string) error {
+	if updatedBy == "" {
+		return ErrEmptyCreatedBy
+	}
+
+	period, err := NewPeriod(v.period.From(), &to)
+	if err != nil {
+		return err
+	}
+
+	v.period = period
+	v.touch(updatedBy)
+	return nil
+}
+
+// touch records an update.
+func (v *ParameterValue) touch(updatedBy string) {
+	now := time.Now()
+	v.updatedAt = &now
+	v.updatedBy = &updatedBy
+}
+
+// ParseValue validates a raw value against the parameter definition and
+// returns its normalized form, plus the number for NUMERIC parameters.
+func ParseValue(definition *parameter.Parameter, raw string) (string, *float64, error) {
+	if !definition.IsActive() {
+		return "", nil, ErrParameterInactive
+	}
+
+	value := strings.TrimSpace(raw)
+	if value == "" {
+		return "", nil, ErrEmptyValue
+	}
+
+	switch definition.DataType() {
+	case parameter.DataTypeNumeric:
+		number, err := strconv.ParseFloat(value, 64)
+		if err != nil || math.IsNaN(number) || math.IsInf(number, 0) {
+			return "", nil, ErrValueNotNumeric
+		}
+		if minVal := definition.MinValue(); minVal != nil && number < *minVal {
+			return "", nil, ErrValueBelowMin
+		}
+		if maxVal := definition.MaxValue(); maxVal != nil && number > *maxVal {
+			return "", nil, ErrValueAboveMax
+		}
+		return strconv.FormatFloat(number, 'f', -1, 64), &number, nil
+
+	case parameter.DataTypeBoolean:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return "", nil, ErrValueNotBoolean
+		}
+		return strconv.FormatBool(b), nil, nil
+
+	case parameter.DataTypeDropdown:
+		for _, allowed := range definition.AllowedValues() {
+			if value == allowed {
+				return value, nil, nil
+			}
+		}
+		return "", nil, ErrValueNotAllowed
+
+	default:
+		return value, nil, nil
+	}
+}
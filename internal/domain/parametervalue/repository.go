@@ -0,0 +1,76 @@
+package parametervalue
+
+import (
+	"context"
+	"time"
+
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/parameter"
+)
+
+// Repository defines the interface for ParameterValue persistence.
+type Repository interface {
+	// Create persists a new ParameterValue and assigns its ID.
+	Create(ctx context.Context, value *ParameterValue) error
+
+	// Supersede closes previous and persists next atomically.
+	Supersede(ctx context.Context, previous, next *ParameterValue) error
+
+	// GetByID retrieves a ParameterValue by its ID.
+	GetByID(ctx context.Context, id int64) (*ParameterValue, error)
+
+	// List retrieves ParameterValues with optional filtering.
+	List(ctx context.Context, filter ListFilter) ([]*ParameterValue, int64, error)
+
+	// LockSubjectParameter serializes writes to the periods of a subject and
+	// parameter until the surrounding transaction ends, so an overlap check
+	// and the write it guards cannot interleave with another.
+	LockSubjectParameter(
+		ctx context.Context,
+		subjectType SubjectType,
+		subjectCode SubjectCode,
+		parameterCode parameter.Code,
+	) error
+
+	// ListBySubjectParameter retrieves every period recorded for a subject and parameter.
+	ListBySubjectParameter(
+		ctx context.Context,
+		subjectType SubjectType,
+		subjectCode SubjectCode,
+		parameterCode parameter.Code,
+	) ([]*ParameterValue, error)
+
+	// Update persists changes to an existing ParameterValue.
+	Update(ctx context.Context, value *ParameterValue) error
+
+	// Delete removes a ParameterValue by its ID.
+	Delete(ctx context.Context, id int64) error
+}
+
+// ListFilter contains filtering and pagination options.
+type ListFilter struct {
+	SubjectType   *SubjectType
+	SubjectCode   *SubjectCode
+	ParameterCode *parameter.Code
+	AsOf          *time.Time
+	Page          int
+	PageSize      int
+}
+
+// Offset calculates the offset for pagination.
+func (f ListFilter) Offset() int {
+	if f.Page <= 0 {
+		f.Page = 1
+	}
+	return (f.Page - 1) * f.PageSize
+}
+
+// Limit returns the page size.
+func (f ListFilter) Limit() int {
+	if f.PageSize <= 0 {
+		return 10
+	}
+	if f.PageSize > 100 {
+		return 100
+	}
+	return f.PageSize
+}
@@ -0,0 +1,36 @@
+package parametervalue
+
+// Schedule places next among the existing values of the same subject and
+// parameter.
+//
+// Values of a subject and parameter never overlap. When an open-ended next
+// starts after an open-ended value, that value is closed the day next takes
+// effect and returned so it can be persisted together with next; any other
+// overlap is rejected. existing may include next itself (matched by ID) which is skipped.
+func Schedule(next *ParameterValue, existing []*ParameterValue, updatedBy string) (*ParameterValue, error) {
+	var superseded *ParameterValue
+	for _, current := range existing {
+		if next.ID() != 0 && current.ID() == next.ID() {
+			continue
+		}
+		if current.Period().From().Equal(next.Period().From()) {
+			return nil, ErrAlreadyExists
+		}
+		if !current.Period().Overlaps(next.Period()) {
+			continue
+		}
+		if superseded == nil && next.ID() == 0 && next.Period().IsOpen() &&
+			current.Period().IsOpen() && current.Period().From().Before(next.Period().From()) {
+			superseded = current
+			continue
+		}
+		return nil, ErrPeriodOverlap
+	}
+
+	if superseded != nil {
+		if err := superseded.Close(next.Period().From(), updatedBy); err != nil {
+			return nil, err
+		}
+	}
+	return superseded, nil
+}
@@ -0,0 +1,115 @@
+package parametervalue
+
+import (
+	"regexp"
+	"time"
+)
+
+// SubjectType is the kind of entity a parameter value is recorded for.
+type SubjectType string
+
+const (
+	SubjectTypeMachine  SubjectType = "MACHINE"
+	SubjectTypeMaterial SubjectType = "MATERIAL"
+	SubjectTypeProduct  SubjectType = "PRODUCT"
+)
+
+// NewSubjectType creates a validated subject type.
+func NewSubjectType(subjectType string) (SubjectType, error) {
+	switch SubjectType(subjectType) {
+	case SubjectTypeMachine, SubjectTypeMaterial, SubjectTypeProduct:
+		return SubjectType(subjectType), nil
+	default:
+		return "", ErrInvalidSubjectType
+	}
+}
+
+// String returns the string representation.
+func (s SubjectType) String() string {
+	return string(s)
+}
+
+// SubjectCode is a value object for the code of the machine, material or product.
+type SubjectCode string
+
+var subjectCodePattern = regexp.MustCompile(`^[A-Z0-9][A-Z0-9_\-]{0,49}$`)
+
+// NewSubjectCode creates a validated subject code.
+func NewSubjectCode(code string) (SubjectCode, error) {
+	if !subjectCodePattern.MatchString(code) {
+		return "", ErrInvalidSubjectCode
+	}
+	return SubjectCode(code), nil
+}
+
+// String returns the string representation.
+func (c SubjectCode) String() string {
+	return string(c)
+}
+
+// DateLayout is the format of effective dates.
+const DateLayout = "2006-01-02"
+
+// NewDate parses an effective date in DateLayout.
+func NewDate(date string) (time.Time, error) {
+	t, err := time.Parse(DateLayout, date)
+	if err != nil {
+		return time.Time{}, ErrInvalidDate
+	}
+	return t, nil
+}
+
+// Period is the half-open date range [From, To) in which a value is effective.
+// A nil To means the value is effective until superseded.
+type Period struct {
+	from time.Time
+	to   *time.Time
+}
+
+// NewPeriod creates a validated period. Times are truncated to dates.
+func NewPeriod(from time.Time, to *time.Time) (Period, error) {
+	p := Period{from: truncateDate(from)}
+	if to != nil {
+		end := truncateDate(*to)
+		if !end.After(p.from) {
+			return Period{}, ErrInvalidPeriod
+		}
+		p.to = &end
+	}
+	return p, nil
+}
+
+// From returns the first effective date.
+func (p Period) From() time.Time { return p.from }
+
+// To returns the first date the value is no longer effective, or nil if open-ended.
+func (p Period) To() *time.Time { return p.to }
+
+// IsOpen reports whether the period has no end date.
+func (p Period) IsOpen() bool { return p.to == nil }
+
+// Contains reports whether the period covers the date of t.
+func (p Period) Contains(t time.Time) bool {
+	day := truncateDate(t)
+	if day.Before(p.from) {
+		return false
+	}
+	return p.to == nil || day.Before(*p.to)
+}
+
+// Overlaps reports whether the two periods share at least one date.
+func (p Period) Overlaps(other Period) bool {
+	if p.to != nil && !p.to.After(other.from) {
+		return false
+	}
+	if other.to != nil && !other.to.After(p.from) {
+		return false
+	}
+	return true
+}
+
+// truncateDate drops the time of day, keeping the calendar date in UTC.
+func truncateDate(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,188 @@
+package uom
+
+import (
+	"math"
+	"time"
+)
+
+// Conversion is an explicit conversion between two UOMs of different
+// categories, e.g. 1 CONE = 2.5 KG. Conversions within a category do not
+// need one, they go through the conversion factor of each UOM.
+type Conversion struct {
+	fromUOM     Code
+	toUOM       Code
+	factor      float64
+	description *string
+	createdAt   time.Time
+	createdBy   string
+}
+
+// NewConversion creates a new Conversion with validation.
+// One unit of from equals factor units of to.
+func NewConversion(from, to Code, factor float64, createdBy string) (*Conversion, error) {
+	if from == to {
+		return nil, ErrConversionSameUOM
+	}
+	if factor <= 0 {
+		return nil, ErrInvalidConversionFactor
+	}
+	if createdBy == "" {
+		return nil, ErrEmptyCreatedBy
+	}
+
+	return &Conversion{
+		fromUOM:   from,
+		toUOM:     to,
+		factor:    factor,
+		createdAt: time.Now(),
+		createdBy: createdBy,
+	}, nil
+}
+
+// ReconstituteConversion creates a Conversion from persistence (no validation).
+func ReconstituteConversion(
+	from Code,
+	to Code,
+	factor float64,
+	description *string,
+	createdAt time.Time,
+	createdBy string,
+) *Conversion {
+	return &Conversion{
+		fromUOM:     from,
+		toUOM:       to,
+		factor:      factor,
+		description: description,
+		createdAt:   createdAt,
+		createdBy:   createdBy,
+	}
+}
+
+// Getters.
+func (c *Conversion) FromUOM() Code        { return c.fromUOM }
+func (c *Conversion) ToUOM() Code          { return c.toUOM }
+func (c *Conversion) Factor() float64      { return c.factor }
+func (c *Conversion) Description() *string { return c.description }
+func (c *Conversion) CreatedAt() time.Time { return c.createdAt }
+func (c *Conversion) CreatedBy() string    { return c.createdBy }
+
+// SetDescription sets the description.
+func (c *Conversion) SetDescription(desc *string) {
+	c.description = desc
+}
+
+// Converter converts quantities between UOMs.
+//
+// Units of the same category convert through the category base unit using
+// their conversion factors. Units of different categories need an explicit
+// Conversion linking the two categories; the quantity is first scaled to the
+// conversion's source unit, converted, then scaled to the target unit.
+type Converter struct {
+	units       map[Code]*UOM
+	conversions []*Conversion
+}
+
+// NewConverter creates a converter over the given units and explicit conversions.
+// Every UOM referenced by a conversion must be present in units.
+func NewConverter(units []*UOM, conversions []*Conversion) *Converter {
+	byCode := make(map[Code]*UOM, len(units))
+	for _, u := range units {
+		byCode[u.Code()] = u
+	}
+	return &Converter{units: byCode, conversions: conversions}
+}
+
+// conversionTolerance is the relative difference under which two conversion
+// paths are considered to give the same result.
+const conversionTolerance = 1e-9
+
+// Factor returns the multiplier that converts a quantity in from into to.
+func (c *Converter) Factor(from, to Code) (float64, error) {
+	fromUOM, ok := c.units[from]
+	if !ok {
+		return 0, ErrNotFound
+	}
+	toUOM, ok := c.units[to]
+	if !ok {
+		return 0, ErrNotFound
+	}
+
+	if from == to {
+		return 1, nil
+	}
+	if fromUOM.Category() == toUOM.Category() {
+		return fromUOM.ConversionFactor() / toUOM.ConversionFactor(), nil
+	}
+
+	// Prefer the most specific conversions: those linking from and to
+	// directly, then those touching either unit, then any linking the
+	// two categories. Candidates of the chosen rank must agree.
+	bestRank := -1
+	var result float64
+	for _, conv := range c.conversions {
+		factor, ok := c.viaConversion(fromUOM, toUOM, conv)
+		if !ok {
+			continue
+		}
+		rank := conversionRank(from, to, conv)
+		switch {
+		case rank > bestRank:
+			bestRank = rank
+			result = factor
+		case rank == bestRank &&
+			math.Abs(factor-result) > conversionTolerance*math.Max(math.Abs(factor), math.Abs(result)):
+			return 0, ErrAmbiguousConversion
+		}
+	}
+	if bestRank < 0 {
+		return 0, ErrConversionNotFound
+	}
+	return result, nil
+}
+
+// conversionRank scores how specifically conv links from and to.
+func conversionRank(from, to Code, conv *Conversion) int {
+	rank := 0
+	if conv.FromUOM() == from || conv.ToUOM() == from {
+		rank++
+	}
+	if conv.FromUOM() == to || conv.ToUOM() == to {
+		rank++
+	}
+	return rank
+}
+
+// Convert converts quantity from one UOM into another.
+func (c *Converter) Convert(quantity float64, from, to Code) (float64, error) {
+	factor, err := c.Factor(from, to)
+	if err != nil {
+		return 0, err
+	}
+	return quantity * factor, nil
+}
+
+// viaConversion computes the factor from -> to through conv, in either direction.
+func (c *Converter) viaConversion(from, to *UOM, conv *Conversion) (float64, bool) {
+	src, ok := c.units[conv.FromUOM()]
+	if !ok {
+		return 0, false
+	}
+	dst, ok := c.units[conv.ToUOM()]
+	if !ok {
+		return 0, false
+	}
+
+	switch {
+	case src.Category() == from.Category() && dst.Category() == to.Category():
+		// from -> src (same category), src -> dst (explicit), dst -> to (same category).
+		return from.ConversionFactor() / src.ConversionFactor() *
+			conv.Factor() *
+			dst.ConversionFactor() / to.ConversionFactor(), true
+	case dst.Category() == from.Category() && src.Category() == to.Category():
+		return from.ConversionFactor() / dst.ConversionFactor() /
+			conv.Factor() *
+			src.ConversionFactor() / to.ConversionFactor(), true
+	default:
+		return 0, false
+	}
+}
@@ -3,6 +3,8 @@ package uom
 import (
 	"errors"
 	"time"
+
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/event"
 )
 
 // Domain errors.
@@ -13,6 +15,26 @@ var (
 	ErrEmptyCreatedBy  = errors.New("created_by cannot be empty")
 	ErrInvalidUOMCode  = errors.New("invalid uom code format")
 	ErrInvalidCategory = errors.New("invalid uom category")
+
+	ErrInvalidConversionFactor = errors.New("conversion factor must be greater than zero")
+	ErrBaseFactorNotOne        = errors.New("base uom must have a conversion factor of 1")
+	ErrConversionSameUOM       = errors.New("conversion requires two different uoms")
+	ErrConversionSameCategory  = errors.New("explicit conversion must link two different categories")
+	ErrConversionNotFound      = errors.New("uom conversion not found")
+	ErrConversionExists        = errors.New("uom conversion already exists")
+	ErrAmbiguousConversion     = errors.New("uom conversion is ambiguous")
+
+	ErrBaseUOMExists         = errors.New("category already has a base uom")
+	ErrBaseUOMRequired       = errors.New("category must keep its base uom, promote another uom instead")
+	ErrBasePromotionCategory = errors.New("cannot change category while promoting to base uom")
+	ErrCategoryChangeFactor  = errors.New("changing category requires a conversion factor relative to the new base uom")
+
+	ErrVersionConflict    = errors.New("uom was modified by another request, reload and retry")
+	ErrInUse              = errors.New("uom is in use by active parameters, use force to delete anyway")
+	ErrNotDeleted         = errors.New("uom is not deleted")
+	ErrDeleted            = errors.New("uom is deleted, restore it first")
+	ErrInvalidPageToken   = errors.New("invalid page token")
+	ErrInvalidResumeToken = errors.New("invalid resume token")
 )
 
 // UOM is the aggregate root for Unit of Measure.
@@ -21,10 +43,19 @@ type UOM struct {
 	name      string
 	category  Category
 	isBaseUOM bool
-	createdAt time.Time
-	createdBy string
-	updatedAt *time.Time
-	updatedBy *string
+	// conversionFactor is the number of base units of the category in one
+	// unit of this UOM (e.g. TON = 1000 when KG is the base).
+	conversionFactor float64
+	createdAt        time.Time
+	createdBy        string
+	updatedAt        *time.Time
+	updatedBy        *string
+	deletedAt        *time.Time
+	deletedBy        *string
+	// version is the persisted revision, used for optimistic concurrency.
+	version int
+	// events are raised by changes and saved to the outbox with them.
+	events event.Recorder
 }
 
 // NewUOM creates a new UOM with validation.
@@ -36,14 +67,18 @@ func NewUOM(code Code, name string, category Category, createdBy string) (*UOM,
 		return nil, ErrEmptyCreatedBy
 	}
 
-	return &UOM{
-		code:      code,
-		name:      name,
-		category:  category,
-		isBaseUOM: false,
-		createdAt: time.Now(),
-		createdBy: createdBy,
-	}, nil
+	u := &UOM{
+		code:             code,
+		name:             name,
+		category:         category,
+		isBaseUOM:        false,
+		conversionFactor: 1,
+		createdAt:        time.Now(),
+		createdBy:        createdBy,
+		version:          1,
+	}
+	u.events.Raise(event.UOMCreated, code.String())
+	return u, nil
 }
 
 // Reconstitute creates a UOM from persistence (no validation, used by repository).
@@ -52,36 +87,82 @@ func Reconstitute(
 	name string,
 	category Category,
 	isBaseUOM bool,
+	conversionFactor float64,
 	createdAt time.Time,
 	createdBy string,
 	updatedAt *time.Time,
 	updatedBy *string,
+	deletedAt *time.Time,
+	deletedBy *string,
+	version int,
 ) *UOM {
 	return &UOM{
-		code:      code,
-		name:      name,
-		category:  category,
-		isBaseUOM: isBaseUOM,
-		createdAt: createdAt,
-		createdBy: createdBy,
-		updatedAt: updatedAt,
-		updatedBy: updatedBy,
+		code:             code,
+		name:             name,
+		category:         category,
+		isBaseUOM:        isBaseUOM,
+		conversionFactor: conversionFactor,
+		createdAt:        createdAt,
+		createdBy:        createdBy,
+		updatedAt:        updatedAt,
+		updatedBy:        updatedBy,
+		deletedAt:        deletedAt,
+		deletedBy:        deletedBy,
+		version:          version,
 	}
 }
 
 // Getters - expose internal state read-only.
-func (u *UOM) Code() Code            { return u.code }
-func (u *UOM) Name() string          { return u.name }
-func (u *UOM) Category() Category    { return u.category }
-func (u *UOM) IsBaseUOM() bool       { return u.isBaseUOM }
-func (u *UOM) CreatedAt() time.Time  { return u.createdAt }
-func (u *UOM) CreatedBy() string     { return u.createdBy }
-func (u *UOM) UpdatedAt() *time.Time { return u.updatedAt }
-func (u *UOM) UpdatedBy() *string    { return u.updatedBy }
+func (u *UOM) Code() Code                { return u.code }
+func (u *UOM) Name() string              { return u.name }
+func (u *UOM) Category() Category        { return u.category }
+func (u *UOM) IsBaseUOM() bool           { return u.isBaseUOM }
+func (u *UOM) ConversionFactor() float64 { return u.conversionFactor }
+func (u *UOM) CreatedAt() time.Time      { return u.createdAt }
+func (u *UOM) CreatedBy() string         { return u.createdBy }
+func (u *UOM) UpdatedAt() *time.Time     { return u.updatedAt }
+func (u *UOM) UpdatedBy() *string        { return u.updatedBy }
+func (u *UOM) DeletedAt() *time.Time     { return u.deletedAt }
+func (u *UOM) DeletedBy() *string        { return u.deletedBy }
+func (u *UOM) IsDeleted() bool           { return u.deletedAt != nil }
+func (u *UOM) Version() int              { return u.version }
+
+// CheckVersion ensures a change is based on the current revision.
+func (u *UOM) CheckVersion(version int) error {
+	if version != u.version {
+		return ErrVersionConflict
+	}
+	return nil
+}
+
+// IncrementVersion records a successful save; called by the repository.
+func (u *UOM) IncrementVersion() {
+	u.version++
+}
+
+// PullEvents returns the events raised since the last save and clears them;
+// called by the repository.
+func (u *UOM) PullEvents() []event.Event {
+	return u.events.Pull()
+}
 
 // SetAsBaseUOM marks this UOM as the base unit for its category.
+// The base unit always converts to itself with a factor of 1.
 func (u *UOM) SetAsBaseUOM() {
 	u.isBaseUOM = true
+	u.conversionFactor = 1
+}
+
+// SetConversionFactor sets the factor relative to the category base UOM.
+func (u *UOM) SetConversionFactor(factor float64) error {
+	if factor <= 0 {
+		return ErrInvalidConversionFactor
+	}
+	if u.isBaseUOM && factor != 1 {
+		return ErrBaseFactorNotOne
+	}
+	u.conversionFactor = factor
+	return nil
 }
 
 // Update updates the UOM properties.
@@ -96,8 +177,47 @@ func (u *UOM) Update(name string, category Category, isBaseUOM bool, updatedBy s
 	u.name = name
 	u.category = category
 	u.isBaseUOM = isBaseUOM
+	if isBaseUOM {
+		u.conversionFactor = 1
+	}
+	u.touch(updatedBy)
+	u.events.Raise(event.UOMUpdated, u.code.String())
+	return nil
+}
+
+// SoftDelete marks the UOM as deleted. The row is kept so that parameters
+// and materials referencing it stay intact.
+func (u *UOM) SoftDelete(deletedBy string) {
+	now := time.Now()
+	u.deletedAt = &now
+	u.deletedBy = &deletedBy
+	u.events.Raise(event.UOMDeleted, u.code.String())
+}
+
+// Restore undoes a soft delete.
+func (u *UOM) Restore(restoredBy string) error {
+	if u.deletedAt == nil {
+		return ErrNotDeleted
+	}
+	u.deletedAt = nil
+	u.deletedBy = nil
+	u.touch(restoredBy)
+	u.events.Raise(event.UOMRestored, u.code.String())
+	return nil
+}
+
+// rebase rescales the conversion factor against a new category base whose
+// factor relative to the old base is divisor, demoting this unit if needed.
+func (u *UOM) rebase(divisor float64, updatedBy string) {
+	u.isBaseUOM = false
+	u.conversionFactor /= divisor
+	u.touch(updatedBy)
+	u.events.Raise(event.UOMUpdated, u.code.String())
+}
+
+// touch records an update.
+func (u *UOM) touch(updatedBy string) {
 	now := time.Now()
 	u.updatedAt = &now
 	u.updatedBy = &updatedBy
-	return nil
 }
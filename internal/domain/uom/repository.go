@@ -1,6 +1,11 @@
 package uom
 
-import "context"
+import (
+	"context"
+	"time"
+
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/event"
+)
 
 // Repository defines the interface for UOM persistence.
 // This interface is defined in domain, implemented in infrastructure.
@@ -8,25 +13,94 @@ type Repository interface {
 	// Create persists a new UOM.
 	Create(ctx context.Context, uom *UOM) error
 
-	// GetByCode retrieves a UOM by its code.
+	// GetByCode retrieves a UOM by its code. Soft-deleted UOMs are not found.
 	GetByCode(ctx context.Context, code Code) (*UOM, error)
 
+	// GetByCodeIncludingDeleted retrieves a UOM by its code, even if soft-deleted.
+	GetByCodeIncludingDeleted(ctx context.Context, code Code) (*UOM, error)
+
 	// List retrieves UOMs with optional filtering.
 	List(ctx context.Context, filter ListFilter) ([]*UOM, int64, error)
 
-	// Update persists changes to an existing UOM.
-	Update(ctx context.Context, uom *UOM) error
+	// ForEach calls fn for every UOM matching the filter, in code order,
+	// without loading them all. Paging is ignored; an error from fn stops
+	// the iteration and is returned.
+	ForEach(ctx context.Context, filter ListFilter, fn func(*UOM) error) error
 
-	// Delete removes a UOM by its code.
-	Delete(ctx context.Context, code Code) error
+	// Update persists changes to an existing UOM, including soft deletion.
+	Update(ctx context.Context, uom *UOM) error
 
-	// ExistsByCode checks if a UOM with the given code exists.
+	// ExistsByCode checks if a UOM with the given code exists. Soft-deleted
+	// UOMs count, as their codes cannot be reused.
 	ExistsByCode(ctx context.Context, code Code) (bool, error)
+
+	// HasActiveParameters checks if active, non-deleted parameters use the UOM.
+	HasActiveParameters(ctx context.Context, code Code) (bool, error)
+
+	// ListByCategory retrieves every UOM of a category, including soft-deleted
+	// ones so that their factors follow a change of base.
+	ListByCategory(ctx context.Context, category Category) ([]*UOM, error)
+
+	// GetBaseByCategory retrieves the base UOM of a category.
+	GetBaseByCategory(ctx context.Context, category Category) (*UOM, error)
+
+	// UpdateBase persists a base UOM change atomically: the rescaled or
+	// demoted units are saved before the new base.
+	UpdateBase(ctx context.Context, base *UOM, others []*UOM) error
+
+	// CreateConversion persists a new explicit conversion.
+	CreateConversion(ctx context.Context, conversion *Conversion) error
+
+	// ListConversions retrieves explicit conversions with optional filtering.
+	ListConversions(ctx context.Context, filter ConversionFilter) ([]*Conversion, error)
+
+	// DeleteConversion removes the conversion between two UOMs.
+	DeleteConversion(ctx context.Context, from, to Code) error
+
+	// ExistsConversion checks if a conversion between two UOMs exists in either direction.
+	ExistsConversion(ctx context.Context, from, to Code) (bool, error)
+
+	// SaveBatch creates or updates UOMs in a single transaction and returns
+	// one error per entry, nil if it was saved. An atomic batch is rolled
+	// back entirely when any entry fails.
+	SaveBatch(ctx context.Context, entries []BatchEntry, atomic bool) ([]error, error)
+}
+
+// ChangeFeed reads the committed changes of UOMs from the change log.
+type ChangeFeed interface {
+	// Head returns the position of the latest readable change.
+	Head(ctx context.Context) (event.Position, error)
+
+	// UOMChangesAfter returns up to limit changes following after, in log order.
+	UOMChangesAfter(ctx context.Context, after event.Position, limit int) ([]*Change, error)
+}
+
+// Change is a committed change of a UOM with its state right after it.
+type Change struct {
+	Position   event.Position
+	Type       event.Type
+	OccurredAt time.Time
+	UOM        *UOM
+}
+
+// BatchEntry is a UOM to be created or updated as part of a batch.
+type BatchEntry struct {
+	UOM   *UOM
+	IsNew bool
+}
+
+// ConversionFilter contains filtering options for listing conversions.
+type ConversionFilter struct {
+	// UOMCode matches conversions where the UOM is either side.
+	UOMCode *Code
 }
 
 // ListFilter contains filtering and pagination options for listing UOMs.
 type ListFilter struct {
-	Category *Category
+	Category       *Category
+	IncludeDeleted bool
+	// After selects the page following the UOM with this code, in place of Page.
+	After    *Code
 	Page     int
 	PageSize int
 }
@@ -49,3 +123,13 @@ func (f ListFilter) Limit() int {
 	}
 	return f.PageSize
 }
+
+// HasNext reports whether a page of count rows, out of total matching rows,
+// may be followed by another. A keyset page cannot tell from total, so any
+// full one may be.
+func (f ListFilter) HasNext(count int, total int64) bool {
+	if count == 0 || count < f.Limit() {
+		return false
+	}
+	return f.After != nil || int64(f.Offset()+count) < total
+}
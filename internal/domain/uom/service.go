@@ -0,0 +1,48 @@
+package uom
+
+// PromoteToBase makes unit the base UOM of its category.
+//
+// A category has exactly one base UOM and every conversion factor is relative
+// to it, so the previous base is demoted and all other units of the category
+// are rescaled against the new base. siblings are the units currently in the
+// category (unit itself may be included and is skipped). The returned units
+// are the siblings that changed and must be persisted together with unit.
+func PromoteToBase(unit *UOM, siblings []*UOM, updatedBy string) ([]*UOM, error) {
+	if updatedBy == "" {
+		return nil, ErrEmptyCreatedBy
+	}
+	if unit.IsBaseUOM() {
+		return nil, nil
+	}
+
+	divisor := unit.ConversionFactor()
+	changed := make([]*UOM, 0, len(siblings))
+	for _, sibling := range siblings {
+		if sibling.Code() == unit.Code() || sibling.Category() != unit.Category() {
+			continue
+		}
+		sibling.rebase(divisor, updatedBy)
+		changed = append(changed, sibling)
+	}
+
+	unit.SetAsBaseUOM()
+	unit.touch(updatedBy)
+	return changed, nil
+}
+
+// EnsureBaseRetained rejects changes that would leave the category of a base
+// UOM without a base while other live units still depend on it.
+func EnsureBaseRetained(unit *UOM, siblings []*UOM) error {
+	if !unit.IsBaseUOM() {
+		return nil
+	}
+	for _, sibling := range siblings {
+		if sibling.IsDeleted() {
+			continue
+		}
+		if sibling.Code() != unit.Code() && sibling.Category() == unit.Category() {
+			return ErrBaseUOMRequired
+		}
+	}
+	return nil
+}
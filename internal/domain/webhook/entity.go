@@ -0,0 +1,245 @@
+package webhook
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/event"
+)
+
+// Domain errors.
+var (
+	ErrSubscriptionNotFound = errors.New("webhook subscription not found")
+	ErrDeliveryNotFound     = errors.New("webhook delivery not found")
+	ErrInvalidURL           = errors.New("webhook url must be an absolute https url")
+	ErrNoEventTypes         = errors.New("webhook subscription needs at least one event type")
+	ErrInvalidStatus        = errors.New("invalid webhook delivery status")
+	ErrDeliveryPending      = errors.New("webhook delivery is still pending")
+	ErrEmptyCreatedBy       = errors.New("created_by cannot be empty")
+)
+
+// Subscription registers an endpoint for the events of the listed types.
+type Subscription struct {
+	id          int64
+	url         EndpointURL
+	eventTypes  []event.Type
+	secret      string
+	description *string
+	createdAt   time.Time
+	createdBy   string
+}
+
+// NewSubscription creates a new Subscription with a generated signing secret.
+// Repeated event types are dropped.
+func NewSubscription(
+	url EndpointURL,
+	eventTypes []event.Type,
+	description *string,
+	createdBy string,
+) (*Subscription, error) {
+	if createdBy == "" {
+		return nil, ErrEmptyCreatedBy
+	}
+
+	types := make([]event.Type, 0, len(eventTypes))
+	seen := make(map[event.Type]bool, len(eventTypes))
+	for _, t := range eventTypes {
+		if !seen[t] {
+			seen[t] = true
+			types = append(types, t)
+		}
+	}
+	if len(types) == 0 {
+		return nil, ErrNoEventTypes
+	}
+
+	secret, err := NewSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Subscription{
+		url:         url,
+		eventTypes:  types,
+		secret:      secret,
+		description: description,
+		createdAt:   time.Now(),
+		createdBy:   createdBy,
+	}, nil
+}
+
+// ReconstituteSubscription creates a Subscription from persistence (no validation).
+func ReconstituteSubscription(
+	id int64,
+	url EndpointURL,
+	eventTypes []event.Type,
+	secret string,
+	description *string,
+	createdAt time.Time,
+	createdBy string,
+) *Subscription {
+	return &Subscription{
+		id:          id,
+		url:         url,
+		eventTypes:  eventTypes,
+		secret:      secret,
+		description: description,
+		createdAt:   createdAt,
+		createdBy:   createdBy,
+	}
+}
+
+// Getters.
+func (s *Subscription) ID() int64                { return s.id }
+func (s *Subscription) URL() EndpointURL         { return s.url }
+func (s *Subscription) EventTypes() []event.Type { return s.eventTypes }
+func (s *Subscription) Secret() string           { return s.secret }
+func (s *Subscription) Description() *string     { return s.description }
+func (s *Subscription) CreatedAt() time.Time     { return s.createdAt }
+func (s *Subscription) CreatedBy() string        { return s.createdBy }
+
+// AssignID sets the ID generated by persistence.
+func (s *Subscription) AssignID(id int64) {
+	s.id = id
+}
+
+// Subscribes reports whether the subscription wants events of eventType.
+func (s *Subscription) Subscribes(eventType event.Type) bool {
+	for _, t := range s.eventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// Delivery is one event to be posted to one subscription. It is attempted
+// until the endpoint acknowledges it or the retry policy gives up, leaving it
+// dead until replayed.
+type Delivery struct {
+	id             int64
+	subscriptionID int64
+	eventID        string
+	eventType      event.Type
+	body           json.RawMessage
+	status         Status
+	attempts       int
+	nextAttemptAt  time.Time
+	lastError      *string
+	lastStatusCode *int
+	createdAt      time.Time
+	deliveredAt    *time.Time
+}
+
+// NewDelivery creates a pending Delivery of msg to sub, due at once. The body
+// is msg as JSON.
+func NewDelivery(sub *Subscription, msg *event.Message) (*Delivery, error) {
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	return &Delivery{
+		subscriptionID: sub.ID(),
+		eventID:        msg.EventID,
+		eventType:      msg.Type,
+		body:           body,
+		status:         StatusPending,
+		nextAttemptAt:  now,
+		createdAt:      now,
+	}, nil
+}
+
+// ReconstituteDelivery creates a Delivery from persistence (no validation).
+func ReconstituteDelivery(
+	id int64,
+	subscriptionID int64,
+	eventID string,
+	eventType event.Type,
+	body json.RawMessage,
+	status Status,
+	attempts int,
+	nextAttemptAt time.Time,
+	lastError *string,
+	lastStatusCode *int,
+	createdAt time.Time,
+	deliveredAt *time.Time,
+) *Delivery {
+	return &Delivery{
+		id:             id,
+		subscriptionID: subscriptionID,
+		eventID:        eventID,
+		eventType:      eventType,
+		body:           body,
+		status:         status,
+		attempts:       attempts,
+		nextAttemptAt:  nextAttemptAt,
+		lastError:      lastError,
+		lastStatusCode: lastStatusCode,
+		createdAt:      createdAt,
+		deliveredAt:    deliveredAt,
+	}
+}
+
+// Getters.
+func (d *Delivery) ID() int64                { return d.id }
+func (d *Delivery) SubscriptionID() int64    { return d.subscriptionID }
+func (d *Delivery) EventID() string          { return d.eventID }
+func (d *Delivery) EventType() event.Type    { return d.eventType }
+func (d *Delivery) Body() json.RawMessage    { return d.body }
+func (d *Delivery) Status() Status           { return d.status }
+func (d *Delivery) Attempts() int            { return d.attempts }
+func (d *Delivery) NextAttemptAt() time.Time { return d.nextAttemptAt }
+func (d *Delivery) LastError() *string       { return d.lastError }
+func (d *Delivery) LastStatusCode() *int     { return d.lastStatusCode }
+func (d *Delivery) CreatedAt() time.Time     { return d.createdAt }
+func (d *Delivery) DeliveredAt() *time.Time  { return d.deliveredAt }
+
+// AssignID sets the ID generated by persistence.
+func (d *Delivery) AssignID(id int64) {
+	d.id = id
+}
+
+// Succeeded records an attempt the endpoint acknowledged with statusCode.
+func (d *Delivery) Succeeded(statusCode int, at time.Time) {
+	d.attempts++
+	d.status = StatusSucceeded
+	d.lastStatusCode = &statusCode
+	d.lastError = nil
+	d.deliveredAt = &at
+}
+
+// Failed records a failed attempt. statusCode is zero when no response was
+// received. The delivery is scheduled again after the policy's backoff, or
+// is dead once the policy's attempts are used up.
+func (d *Delivery) Failed(cause error, statusCode int, policy RetryPolicy, at time.Time) {
+	d.attempts++
+	msg := cause.Error()
+	d.lastError = &msg
+	d.lastStatusCode = nil
+	if statusCode != 0 {
+		d.lastStatusCode = &statusCode
+	}
+
+	if d.attempts >= policy.MaxAttempts {
+		d.status = StatusDead
+		return
+	}
+	d.nextAttemptAt = at.Add(policy.Backoff(d.attempts))
+}
+
+// Replay schedules a finished delivery to be sent again at once with a fresh
+// set of attempts. The outcome of the previous attempts is kept until the
+// next one.
+func (d *Delivery) Replay(at time.Time) error {
+	if d.status == StatusPending {
+		return ErrDeliveryPending
+	}
+	d.status = StatusPending
+	d.attempts = 0
+	d.nextAttemptAt = at
+	d.deliveredAt = nil
+	return nil
+}
@@ -0,0 +1,74 @@
+package webhook
+
+import (
+	"context"
+	"time"
+
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/event"
+)
+
+// Repository defines the interface for webhook subscription and delivery
+// persistence.
+type Repository interface {
+	// CreateSubscription persists a new Subscription and assigns its ID.
+	CreateSubscription(ctx context.Context, sub *Subscription) error
+
+	// GetSubscription retrieves a Subscription by its ID.
+	GetSubscription(ctx context.Context, id int64) (*Subscription, error)
+
+	// ListSubscriptions retrieves every Subscription, oldest first.
+	ListSubscriptions(ctx context.Context) ([]*Subscription, error)
+
+	// SubscriptionsFor retrieves the Subscriptions to events of eventType.
+	SubscriptionsFor(ctx context.Context, eventType event.Type) ([]*Subscription, error)
+
+	// DeleteSubscription removes a Subscription and its deliveries.
+	DeleteSubscription(ctx context.Context, id int64) error
+
+	// CreateDeliveries persists new Deliveries. A delivery of an event
+	// already recorded for the subscription is skipped, so the outbox may
+	// hand the same event over again.
+	CreateDeliveries(ctx context.Context, deliveries ...*Delivery) error
+
+	// ClaimDueDeliveries leases up to limit pending Deliveries that are due,
+	// oldest first. Other dispatchers skip them until the lease expires.
+	ClaimDueDeliveries(ctx context.Context, limit int, lease time.Duration) ([]*Delivery, error)
+
+	// GetDelivery retrieves a Delivery by its ID.
+	GetDelivery(ctx context.Context, id int64) (*Delivery, error)
+
+	// ListDeliveries retrieves Deliveries with optional filtering, newest first.
+	ListDeliveries(ctx context.Context, filter DeliveryFilter) ([]*Delivery, int64, error)
+
+	// UpdateDelivery persists the outcome of an attempt or a replay and
+	// releases the lease.
+	UpdateDelivery(ctx context.Context, delivery *Delivery) error
+}
+
+// DeliveryFilter contains filtering and pagination options.
+type DeliveryFilter struct {
+	SubscriptionID *int64
+	Status         *Status
+	EventID        *string
+	Page           int
+	PageSize       int
+}
+
+// Offset calculates the offset for pagination.
+func (f DeliveryFilter) Offset() int {
+	if f.Page <= 0 {
+		f.Page = 1
+	}
+	return (f.Page - 1) * f.PageSize
+}
+
+// Limit returns the page size.
+func (f DeliveryFilter) Limit() int {
+	if f.PageSize <= 0 {
+		return 10
+	}
+	if f.PageSize > 100 {
+		return 100
+	}
+	return f.PageSize
+}
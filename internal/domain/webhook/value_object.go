@@ -0,0 +1,96 @@
+package webhook
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"net/url"
+	"time"
+)
+
+// EndpointURL is the HTTPS address a subscription's deliveries are posted to.
+type EndpointURL string
+
+// NewEndpointURL creates a validated endpoint URL. Only absolute https URLs
+// are accepted, so payloads and signatures never travel in clear text.
+func NewEndpointURL(endpoint string) (EndpointURL, error) {
+	if len(endpoint) > 500 {
+		return "", ErrInvalidURL
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Scheme != "https" || u.Host == "" || u.User != nil || u.Fragment != "" {
+		return "", ErrInvalidURL
+	}
+	return EndpointURL(endpoint), nil
+}
+
+// String returns the string representation.
+func (u EndpointURL) String() string {
+	return string(u)
+}
+
+// secretPrefix marks signing secrets so they are recognizable in config files.
+const secretPrefix = "whsec_"
+
+// NewSecret generates a random signing secret.
+func NewSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return secretPrefix + hex.EncodeToString(b), nil
+}
+
+// Status is the state of a delivery.
+type Status string
+
+const (
+	// StatusPending deliveries are attempted once due.
+	StatusPending Status = "PENDING"
+	// StatusSucceeded deliveries were acknowledged by the endpoint.
+	StatusSucceeded Status = "SUCCEEDED"
+	// StatusDead deliveries exhausted their attempts; they are kept as
+	// dead letters until replayed.
+	StatusDead Status = "DEAD"
+)
+
+// NewStatus creates a validated status.
+func NewStatus(status string) (Status, error) {
+	switch Status(status) {
+	case StatusPending, StatusSucceeded, StatusDead:
+		return Status(status), nil
+	default:
+		return "", ErrInvalidStatus
+	}
+}
+
+// String returns the string representation.
+func (s Status) String() string {
+	return string(s)
+}
+
+// RetryPolicy decides when a failed delivery is attempted again.
+type RetryPolicy struct {
+	// MaxAttempts is the number of attempts before a delivery is dead.
+	MaxAttempts int
+	// InitialBackoff is the wait after the first failure; it doubles after
+	// every further failure.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the wait between attempts.
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy makes 8 attempts over roughly two hours.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    8,
+	InitialBackoff: time.Minute,
+	MaxBackoff:     time.Hour,
+}
+
+// Backoff returns the wait after the given number of failed attempts.
+func (p RetryPolicy) Backoff(attempts int) time.Duration {
+	wait := p.InitialBackoff
+	for i := 1; i < attempts && wait < p.MaxBackoff; i++ {
+		wait *= 2
+	}
+	return min(wait, p.MaxBackoff)
+}
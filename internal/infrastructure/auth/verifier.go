@@ -0,0 +1,179 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/homindolenern/goapps-costing-v1/internal/config"
+	pkgauth "github.com/homindolenern/goapps-costing-v1/pkg/auth"
+)
+
+// ErrNoKeySource is returned when auth is enabled without any way to verify tokens.
+var ErrNoKeySource = errors.New("auth: one of jwks_url, public_key_file or hmac_secret is required")
+
+var (
+	asymmetricMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}
+	hmacMethods       = []string{"HS256", "HS384", "HS512"}
+)
+
+// JWTVerifier verifies bearer JWTs against a JWKS endpoint or a static key.
+type JWTVerifier struct {
+	key        func(ctx context.Context, kid string) (interface{}, error)
+	parser     *jwt.Parser
+	rolesClaim string
+}
+
+// NewJWTVerifier creates a verifier from configuration.
+func NewJWTVerifier(cfg config.AuthConfig) (*JWTVerifier, error) {
+	var (
+		key     func(ctx context.Context, kid string) (interface{}, error)
+		methods []string
+	)
+
+	switch {
+	case cfg.JWKSURL != "":
+		jwks := NewJWKS(cfg.JWKSURL, cfg.JWKSRefreshInterval)
+		key = jwks.Key
+		methods = asymmetricMethods
+	case cfg.PublicKeyFile != "":
+		pemBytes, err := os.ReadFile(cfg.PublicKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("auth: read public key: %w", err)
+		}
+		publicKey, err := parsePublicKey(pemBytes)
+		if err != nil {
+			return nil, err
+		}
+		key = staticKey(publicKey)
+		methods = methodsForKey(publicKey)
+	case cfg.HMACSecret != "":
+		key = staticKey([]byte(cfg.HMACSecret))
+		methods = hmacMethods
+	default:
+		return nil, ErrNoKeySource
+	}
+
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods(methods),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(cfg.Leeway),
+	}
+	if cfg.Issuer != "" {
+		opts = append(opts, jwt.WithIssuer(cfg.Issuer))
+	}
+	if cfg.Audience != "" {
+		opts = append(opts, jwt.WithAudience(cfg.Audience))
+	}
+
+	rolesClaim := cfg.RolesClaim
+	if rolesClaim == "" {
+		rolesClaim = "roles"
+	}
+
+	return &JWTVerifier{
+		key:        key,
+		parser:     jwt.NewParser(opts...),
+		rolesClaim: rolesClaim,
+	}, nil
+}
+
+// Verify parses and verifies a token and returns its principal.
+func (v *JWTVerifier) Verify(ctx context.Context, raw string) (*pkgauth.Principal, error) {
+	claims := jwt.MapClaims{}
+	_, err := v.parser.ParseWithClaims(raw, claims, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		return v.key(ctx, kid)
+	})
+	if err != nil {
+		// A key source failure (e.g. JWKS endpoint down) says nothing about
+		// the token itself, so it is not reported as an invalid token.
+		if errors.Is(err, jwt.ErrTokenUnverifiable) && !errors.Is(err, ErrUnknownKey) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: %v", pkgauth.ErrInvalidToken, err)
+	}
+
+	subject, err := claims.GetSubject()
+	if err != nil || subject == "" {
+		return nil, fmt.Errorf("%w: missing subject", pkgauth.ErrInvalidToken)
+	}
+
+	principal := &pkgauth.Principal{
+		Subject: subject,
+		Roles:   stringList(claimPath(claims, v.rolesClaim)),
+	}
+	principal.Username, _ = claims["preferred_username"].(string)
+	principal.Email, _ = claims["email"].(string)
+
+	return principal, nil
+}
+
+func staticKey(key interface{}) func(context.Context, string) (interface{}, error) {
+	return func(context.Context, string) (interface{}, error) {
+		return key, nil
+	}
+}
+
+func parsePublicKey(pemBytes []byte) (interface{}, error) {
+	if key, err := jwt.ParseRSAPublicKeyFromPEM(pemBytes); err == nil {
+		return key, nil
+	}
+	if key, err := jwt.ParseECPublicKeyFromPEM(pemBytes); err == nil {
+		return key, nil
+	}
+	if key, err := jwt.ParseEdPublicKeyFromPEM(pemBytes); err == nil {
+		return key, nil
+	}
+	return nil, errors.New("auth: public key must be a PEM-encoded RSA, ECDSA or Ed25519 key")
+}
+
+func methodsForKey(key interface{}) []string {
+	switch key.(type) {
+	case *rsa.PublicKey:
+		return []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512"}
+	case *ecdsa.PublicKey:
+		return []string{"ES256", "ES384", "ES512"}
+	case ed25519.PublicKey:
+		return []string{"EdDSA"}
+	default:
+		return nil
+	}
+}
+
+// claimPath resolves a dotted claim path such as "realm_access.roles".
+func claimPath(claims jwt.MapClaims, path string) interface{} {
+	var current interface{} = map[string]interface{}(claims)
+	for _, part := range strings.Split(path, ".") {
+		m, ok := current.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		current = m[part]
+	}
+	return current
+}
+
+func stringList(v interface{}) []string {
+	switch list := v.(type) {
+	case []interface{}:
+		out := make([]string, 0, len(list))
+		for _, item := range list {
+			if s, ok := item.(string); ok {
+				out = append(out, s)
+			}
+		}
+		return out
+	case string:
+		return strings.Fields(list)
+	default:
+		return nil
+	}
+}
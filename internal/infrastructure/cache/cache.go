@@ -14,6 +14,11 @@ type Cache interface {
 	Set(ctx context.Context, key string, value interface{}, ttl time.Duration) error
 	Delete(ctx context.Context, keys ...string) error
 	DeleteByPattern(ctx context.Context, pattern string) error
+
+	// Generation returns the current generation of a tag. Keys that embed
+	// it are invalidated together by BumpGeneration in O(1).
+	Generation(ctx context.Context, tag string) (int64, error)
+	BumpGeneration(ctx context.Context, tag string) error
 }
 
 // RedisCache implements Cache using Redis.
@@ -78,6 +83,24 @@ func (c *RedisCache) DeleteByPattern(ctx context.Context, pattern string) error
 	return c.client.DeleteByPattern(ctx, c.prefix+pattern)
 }
 
+// Generation returns the current generation of a tag.
+func (c *RedisCache) Generation(ctx context.Context, tag string) (int64, error) {
+	if c == nil || c.client == nil {
+		return 0, nil
+	}
+	return c.client.GetInt64(ctx, c.prefix+redis.GenerationKey(tag))
+}
+
+// BumpGeneration invalidates every key built from the tag's current generation.
+// Stale entries are left to expire by TTL.
+func (c *RedisCache) BumpGeneration(ctx context.Context, tag string) error {
+	if c == nil || c.client == nil {
+		return nil
+	}
+	_, err := c.client.Incr(ctx, c.prefix+redis.GenerationKey(tag))
+	return err
+}
+
 // NoOpCache is a cache that does nothing (for when Redis is not available).
 type NoOpCache struct{}
 
@@ -102,6 +125,14 @@ func (c *NoOpCache) DeleteByPattern(ctx context.Context, pattern string) error {
 	return nil
 }
 
+func (c *NoOpCache) Generation(ctx context.Context, tag string) (int64, error) {
+	return 0, nil
+}
+
+func (c *NoOpCache) BumpGeneration(ctx context.Context, tag string) error {
+	return nil
+}
+
 // Cached wraps a function with caching.
 func Cached[T any](
 	ctx context.Context,
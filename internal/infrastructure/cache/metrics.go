@@ -0,0 +1,43 @@
+package cache
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Cache metrics.
+var (
+	requestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "costing_cache_requests_total",
+		Help: "Cache lookups by cache name and result (hit or miss).",
+	}, []string{"cache", "result"})
+
+	invalidationErrorsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "costing_cache_invalidation_errors_total",
+		Help: "Cache invalidations that failed and left entries to expire by TTL.",
+	}, []string{"cache"})
+)
+
+// InstrumentedCache records hit/miss metrics for a named cache.
+type InstrumentedCache struct {
+	Cache
+	name string
+}
+
+// NewInstrumented wraps a cache with hit/miss metrics labelled by name.
+func NewInstrumented(cache Cache, name string) *InstrumentedCache {
+	return &InstrumentedCache{Cache: cache, name: name}
+}
+
+// Get retrieves a value from cache and records a hit or miss.
+func (c *InstrumentedCache) Get(ctx context.Context, key string, dest interface{}) (bool, error) {
+	found, err := c.Cache.Get(ctx, key, dest)
+	if err == nil && found {
+		requestsTotal.WithLabelValues(c.name, "hit").Inc()
+	} else {
+		requestsTotal.WithLabelValues(c.name, "miss").Inc()
+	}
+	return found, err
+}
@@ -0,0 +1,241 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/parameter"
+	"github.com/homindolenern/goapps-costing-v1/internal/infrastructure/postgres"
+	"github.com/homindolenern/goapps-costing-v1/internal/infrastructure/redis"
+)
+
+// parameterEntry is the cached form of a Parameter.
+type parameterEntry struct {
+	Code          string     `json:"code"`
+	Name          string     `json:"name"`
+	Category      string     `json:"category"`
+	DataType      string     `json:"data_type"`
+	UOM           *string    `json:"uom,omitempty"`
+	MinValue      *float64   `json:"min_value,omitempty"`
+	MaxValue      *float64   `json:"max_value,omitempty"`
+	AllowedValues []string   `json:"allowed_values,omitempty"`
+	IsMandatory   bool       `json:"is_mandatory"`
+	Description   *string    `json:"description,omitempty"`
+	IsActive      bool       `json:"is_active"`
+	CreatedAt     time.Time  `json:"created_at"`
+	CreatedBy     string     `json:"created_by"`
+	UpdatedAt     *time.Time `json:"updated_at,omitempty"`
+	UpdatedBy     *string    `json:"updated_by,omitempty"`
+	DeletedAt     *time.Time `json:"deleted_at,omitempty"`
+	DeletedBy     *string    `json:"deleted_by,omitempty"`
+	Version       int        `json:"version"`
+}
+
+func newParameterEntry(entity *parameter.Parameter) parameterEntry {
+	return parameterEntry{
+		Code:          entity.Code().String(),
+		Name:          entity.Name(),
+		Category:      entity.Category().String(),
+		DataType:      entity.DataType().String(),
+		UOM:           entity.UOM(),
+		MinValue:      entity.MinValue(),
+		MaxValue:      entity.MaxValue(),
+		AllowedValues: entity.AllowedValues(),
+		IsMandatory:   entity.IsMandatory(),
+		Description:   entity.Description(),
+		IsActive:      entity.IsActive(),
+		CreatedAt:     entity.CreatedAt(),
+		CreatedBy:     entity.CreatedBy(),
+		UpdatedAt:     entity.UpdatedAt(),
+		UpdatedBy:     entity.UpdatedBy(),
+		DeletedAt:     entity.DeletedAt(),
+		DeletedBy:     entity.DeletedBy(),
+		Version:       entity.Version(),
+	}
+}
+
+func (e parameterEntry) toEntity() *parameter.Parameter {
+	return parameter.Reconstitute(
+		parameter.Code(e.Code),
+		e.Name,
+		parameter.Category(e.Category),
+		parameter.DataType(e.DataType),
+		e.UOM,
+		e.MinValue,
+		e.MaxValue,
+		e.AllowedValues,
+		e.IsMandatory,
+		e.Description,
+		e.IsActive,
+		e.CreatedAt,
+		e.CreatedBy,
+		e.UpdatedAt,
+		e.UpdatedBy,
+		e.DeletedAt,
+		e.DeletedBy,
+		e.Version,
+	)
+}
+
+// parameterListEntry is the cached form of a Parameter list page.
+type parameterListEntry struct {
+	Items []parameterEntry `json:"items"`
+	Total int64            `json:"total"`
+}
+
+// ParameterRepository is a parameter.Repository decorator that caches
+// GetByCode and List and invalidates the affected keys after every
+// successful write. All other methods pass through to the wrapped repository.
+type ParameterRepository struct {
+	parameter.Repository
+	cache Cache
+	ttl   time.Duration
+}
+
+// NewParameterRepository wraps repo with caching.
+func NewParameterRepository(repo parameter.Repository, cache Cache, ttl time.Duration) *ParameterRepository {
+	return &ParameterRepository{Repository: repo, cache: cache, ttl: ttl}
+}
+
+// GetByCode retrieves a Parameter by its code, from cache when possible.
+func (r *ParameterRepository) GetByCode(ctx context.Context, code parameter.Code) (*parameter.Parameter, error) {
+	entry, err := Cached(ctx, r.cache, redis.ParameterCacheKey(code.String()), r.ttl, func() (parameterEntry, error) {
+		entity, err := r.Repository.GetByCode(ctx, code)
+		if err != nil {
+			return parameterEntry{}, err
+		}
+		return newParameterEntry(entity), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entry.toEntity(), nil
+}
+
+// List retrieves Parameters with optional filtering, from cache when possible.
+// Lists including deleted Parameters are rare admin reads, and searches and
+// the finer filters rarely repeat, so they bypass the cache.
+func (r *ParameterRepository) List(ctx context.Context, filter parameter.ListFilter) ([]*parameter.Parameter, int64, error) {
+	if filter.IncludeDeleted || narrowedParameterList(filter) {
+		return r.Repository.List(ctx, filter)
+	}
+
+	category := ""
+	if filter.Category != nil {
+		category = filter.Category.String()
+	}
+
+	// Without a generation the page cannot be keyed safely, so read through
+	generation, err := r.cache.Generation(ctx, redis.ParameterListTag)
+	if err != nil {
+		return r.Repository.List(ctx, filter)
+	}
+	sort := filter.SortBy.String()
+	if filter.SortDesc {
+		sort += ":desc"
+	}
+	after := ""
+	if filter.After != nil {
+		// A NULL sort value must not share a key with an empty one
+		after = "null:" + filter.After.Code.String()
+		if filter.After.SortValue != nil {
+			after = "value:" + *filter.After.SortValue + ":" + filter.After.Code.String()
+		}
+	}
+	key := redis.ParameterListCacheKey(generation, filter.Page, filter.Limit(), category, filter.IsActive, sort, after)
+
+	entry, err := Cached(ctx, r.cache, key, r.ttl, func() (parameterListEntry, error) {
+		params, total, err := r.Repository.List(ctx, filter)
+		if err != nil {
+			return parameterListEntry{}, err
+		}
+		items := make([]parameterEntry, len(params))
+		for i, entity := range params {
+			items[i] = newParameterEntry(entity)
+		}
+		return parameterListEntry{Items: items, Total: total}, nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	params := make([]*parameter.Parameter, len(entry.Items))
+	for i, item := range entry.Items {
+		params[i] = item.toEntity()
+	}
+	return params, entry.Total, nil
+}
+
+// narrowedParameterList reports whether a list uses filters beyond category and is_active.
+func narrowedParameterList(filter parameter.ListFilter) bool {
+	return filter.Search != "" || filter.DataType != nil || filter.UOM != nil ||
+		filter.IsMandatory != nil || filter.CreatedBy != nil ||
+		filter.CreatedFrom != nil || filter.CreatedTo != nil ||
+		filter.UpdatedFrom != nil || filter.UpdatedTo != nil
+}
+
+// Create persists a new Parameter and invalidates the list cache.
+func (r *ParameterRepository) Create(ctx context.Context, entity *parameter.Parameter) error {
+	if err := r.Repository.Create(ctx, entity); err != nil {
+		return err
+	}
+	r.invalidate(ctx, entity.Code())
+	return nil
+}
+
+// Update persists changes to a Parameter and invalidates its cache entries.
+func (r *ParameterRepository) Update(ctx context.Context, entity *parameter.Parameter) error {
+	if err := r.Repository.Update(ctx, entity); err != nil {
+		return err
+	}
+	r.invalidate(ctx, entity.Code())
+	return nil
+}
+
+// SaveBatch persists a batch of Parameters and invalidates the saved ones.
+func (r *ParameterRepository) SaveBatch(ctx context.Context, entries []parameter.BatchEntry, atomic bool) ([]error, error) {
+	errs, err := r.Repository.SaveBatch(ctx, entries, atomic)
+	if err != nil {
+		return errs, err
+	}
+	codes := make([]parameter.Code, 0, len(entries))
+	for i, entry := range entries {
+		if errs[i] == nil {
+			codes = append(codes, entry.Parameter.Code())
+		}
+	}
+	if len(codes) > 0 {
+		r.invalidate(ctx, codes...)
+	}
+	return errs, nil
+}
+
+// invalidate drops the entries of the given Parameters and bumps the list
+// generation, which orphans every cached list page in O(1).
+// It runs once the surrounding transaction ends, so a reader cannot refill
+// the cache with rows from before the commit.
+// Failures are logged: the write already succeeded and entries expire by TTL.
+func (r *ParameterRepository) invalidate(ctx context.Context, codes ...parameter.Code) {
+	postgres.AfterTx(ctx, func() { r.drop(ctx, codes...) })
+}
+
+func (r *ParameterRepository) drop(ctx context.Context, codes ...parameter.Code) {
+	keys := make([]string, len(codes))
+	for i, code := range codes {
+		keys[i] = redis.ParameterCacheKey(code.String())
+	}
+
+	if err := r.cache.Delete(ctx, keys...); err != nil {
+		invalidationErrorsTotal.WithLabelValues("parameter").Inc()
+		log.Warn().Err(err).Strs("keys", keys).Msg("Failed to invalidate Parameter cache")
+	}
+	if err := r.cache.BumpGeneration(ctx, redis.ParameterListTag); err != nil {
+		// Fall back to deleting the pages directly
+		if err := r.cache.DeleteByPattern(ctx, redis.ParameterListTag+":*"); err != nil {
+			invalidationErrorsTotal.WithLabelValues("parameter").Inc()
+			log.Warn().Err(err).Msg("Failed to invalidate Parameter list cache")
+		}
+	}
+}
@@ -0,0 +1,220 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/uom"
+	"github.com/homindolenern/goapps-costing-v1/internal/infrastructure/postgres"
+	"github.com/homindolenern/goapps-costing-v1/internal/infrastructure/redis"
+)
+
+// uomEntry is the cached form of a UOM.
+type uomEntry struct {
+	Code             string     `json:"code"`
+	Name             string     `json:"name"`
+	Category         string     `json:"category"`
+	IsBaseUOM        bool       `json:"is_base_uom"`
+	ConversionFactor float64    `json:"conversion_factor"`
+	CreatedAt        time.Time  `json:"created_at"`
+	CreatedBy        string     `json:"created_by"`
+	UpdatedAt        *time.Time `json:"updated_at,omitempty"`
+	UpdatedBy        *string    `json:"updated_by,omitempty"`
+	DeletedAt        *time.Time `json:"deleted_at,omitempty"`
+	DeletedBy        *string    `json:"deleted_by,omitempty"`
+	Version          int        `json:"version"`
+}
+
+func newUOMEntry(entity *uom.UOM) uomEntry {
+	return uomEntry{
+		Code:             entity.Code().String(),
+		Name:             entity.Name(),
+		Category:         entity.Category().String(),
+		IsBaseUOM:        entity.IsBaseUOM(),
+		ConversionFactor: entity.ConversionFactor(),
+		CreatedAt:        entity.CreatedAt(),
+		CreatedBy:        entity.CreatedBy(),
+		UpdatedAt:        entity.UpdatedAt(),
+		UpdatedBy:        entity.UpdatedBy(),
+		DeletedAt:        entity.DeletedAt(),
+		DeletedBy:        entity.DeletedBy(),
+		Version:          entity.Version(),
+	}
+}
+
+func (e uomEntry) toEntity() *uom.UOM {
+	return uom.Reconstitute(
+		uom.Code(e.Code),
+		e.Name,
+		uom.Category(e.Category),
+		e.IsBaseUOM,
+		e.ConversionFactor,
+		e.CreatedAt,
+		e.CreatedBy,
+		e.UpdatedAt,
+		e.UpdatedBy,
+		e.DeletedAt,
+		e.DeletedBy,
+		e.Version,
+	)
+}
+
+// uomListEntry is the cached form of a UOM list page.
+type uomListEntry struct {
+	Items []uomEntry `json:"items"`
+	Total int64      `json:"total"`
+}
+
+// UOMRepository is a uom.Repository decorator that caches GetByCode and List
+// and invalidates the affected keys after every successful write.
+// All other methods pass through to the wrapped repository.
+type UOMRepository struct {
+	uom.Repository
+	cache Cache
+	ttl   time.Duration
+}
+
+// NewUOMRepository wraps repo with caching.
+func NewUOMRepository(repo uom.Repository, cache Cache, ttl time.Duration) *UOMRepository {
+	return &UOMRepository{Repository: repo, cache: cache, ttl: ttl}
+}
+
+// GetByCode retrieves a UOM by its code, from cache when possible.
+func (r *UOMRepository) GetByCode(ctx context.Context, code uom.Code) (*uom.UOM, error) {
+	entry, err := Cached(ctx, r.cache, redis.UOMCacheKey(code.String()), r.ttl, func() (uomEntry, error) {
+		entity, err := r.Repository.GetByCode(ctx, code)
+		if err != nil {
+			return uomEntry{}, err
+		}
+		return newUOMEntry(entity), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entry.toEntity(), nil
+}
+
+// List retrieves UOMs with optional filtering, from cache when possible.
+// Lists including deleted UOMs are rare admin reads and bypass the cache.
+func (r *UOMRepository) List(ctx context.Context, filter uom.ListFilter) ([]*uom.UOM, int64, error) {
+	if filter.IncludeDeleted {
+		return r.Repository.List(ctx, filter)
+	}
+
+	category := ""
+	if filter.Category != nil {
+		category = filter.Category.String()
+	}
+
+	// Without a generation the page cannot be keyed safely, so read through
+	generation, err := r.cache.Generation(ctx, redis.UOMListTag)
+	if err != nil {
+		return r.Repository.List(ctx, filter)
+	}
+	after := ""
+	if filter.After != nil {
+		after = filter.After.String()
+	}
+	key := redis.UOMListCacheKey(generation, filter.Page, filter.Limit(), category, after)
+
+	entry, err := Cached(ctx, r.cache, key, r.ttl, func() (uomListEntry, error) {
+		uoms, total, err := r.Repository.List(ctx, filter)
+		if err != nil {
+			return uomListEntry{}, err
+		}
+		items := make([]uomEntry, len(uoms))
+		for i, entity := range uoms {
+			items[i] = newUOMEntry(entity)
+		}
+		return uomListEntry{Items: items, Total: total}, nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	uoms := make([]*uom.UOM, len(entry.Items))
+	for i, item := range entry.Items {
+		uoms[i] = item.toEntity()
+	}
+	return uoms, entry.Total, nil
+}
+
+// Create persists a new UOM and invalidates the list cache.
+func (r *UOMRepository) Create(ctx context.Context, entity *uom.UOM) error {
+	if err := r.Repository.Create(ctx, entity); err != nil {
+		return err
+	}
+	r.invalidate(ctx, entity.Code())
+	return nil
+}
+
+// Update persists changes to a UOM and invalidates its cache entries.
+func (r *UOMRepository) Update(ctx context.Context, entity *uom.UOM) error {
+	if err := r.Repository.Update(ctx, entity); err != nil {
+		return err
+	}
+	r.invalidate(ctx, entity.Code())
+	return nil
+}
+
+// UpdateBase persists a base UOM change and invalidates every touched UOM.
+func (r *UOMRepository) UpdateBase(ctx context.Context, base *uom.UOM, others []*uom.UOM) error {
+	if err := r.Repository.UpdateBase(ctx, base, others); err != nil {
+		return err
+	}
+	codes := make([]uom.Code, 0, len(others)+1)
+	codes = append(codes, base.Code())
+	for _, other := range others {
+		codes = append(codes, other.Code())
+	}
+	r.invalidate(ctx, codes...)
+	return nil
+}
+
+// SaveBatch persists a batch of UOMs and invalidates the saved ones.
+func (r *UOMRepository) SaveBatch(ctx context.Context, entries []uom.BatchEntry, atomic bool) ([]error, error) {
+	errs, err := r.Repository.SaveBatch(ctx, entries, atomic)
+	if err != nil {
+		return errs, err
+	}
+	codes := make([]uom.Code, 0, len(entries))
+	for i, entry := range entries {
+		if errs[i] == nil {
+			codes = append(codes, entry.UOM.Code())
+		}
+	}
+	if len(codes) > 0 {
+		r.invalidate(ctx, codes...)
+	}
+	return errs, nil
+}
+
+// invalidate drops the entries of the given UOMs and bumps the list
+// generation, which orphans every cached list page in O(1).
+// It runs once the surrounding transaction ends, so a reader cannot refill
+// the cache with rows from before the commit.
+// Failures are logged: the write already succeeded and entries expire by TTL.
+func (r *UOMRepository) invalidate(ctx context.Context, codes ...uom.Code) {
+	postgres.AfterTx(ctx, func() { r.drop(ctx, codes...) })
+}
+
+func (r *UOMRepository) drop(ctx context.Context, codes ...uom.Code) {
+	keys := make([]string, len(codes))
+	for i, code := range codes {
+		keys[i] = redis.UOMCacheKey(code.String())
+	}
+
+	if err := r.cache.Delete(ctx, keys...); err != nil {
+		invalidationErrorsTotal.WithLabelValues("uom").Inc()
+		log.Warn().Err(err).Strs("keys", keys).Msg("Failed to invalidate UOM cache")
+	}
+	if err := r.cache.BumpGeneration(ctx, redis.UOMListTag); err != nil {
+		// Fall back to deleting the pages directly
+		if err := r.cache.DeleteByPattern(ctx, redis.UOMListTag+":*"); err != nil {
+			invalidationErrorsTotal.WithLabelValues("uom").Inc()
+			log.Warn().Err(err).Msg("Failed to invalidate UOM list cache")
+		}
+	}
+}
@@ -0,0 +1,203 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/audit"
+)
+
+// AuditRepository implements audit.Repository interface.
+type AuditRepository struct {
+	db *DB
+}
+
+// NewAuditRepository creates a new audit repository.
+func NewAuditRepository(db *DB) *AuditRepository {
+	return &AuditRepository{db: db}
+}
+
+// Verify interface implementation at compile time.
+var _ audit.Repository = (*AuditRepository)(nil)
+
+// auditColumns lists the columns read by scanAuditEvent.
+const auditColumns = `id, entity_type, entity_code, action, before_data, after_data, changes, changed_by, occurred_at`
+
+// Append persists a new Event and assigns its ID.
+func (r *AuditRepository) Append(ctx context.Context, event *audit.Event) error {
+	before, err := marshalNullableJSON(event.Before())
+	if err != nil {
+		return fmt.Errorf("failed to marshal before_data: %w", err)
+	}
+	after, err := marshalNullableJSON(event.After())
+	if err != nil {
+		return fmt.Errorf("failed to marshal after_data: %w", err)
+	}
+	changes, err := json.Marshal(event.Changes())
+	if err != nil {
+		return fmt.Errorf("failed to marshal changes: %w", err)
+	}
+
+	query := `
+		INSERT INTO audit_log (
+			entity_type, entity_code, action, before_data, after_data, changes, changed_by, occurred_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id
+	`
+
+	var id int64
+	err = r.db.QueryRowContext(ctx, query,
+		event.EntityType().String(),
+		event.EntityCode(),
+		event.Action().String(),
+		before,
+		after,
+		changes,
+		event.ChangedBy(),
+		event.OccurredAt(),
+	).Scan(&id)
+	if err != nil {
+		return err
+	}
+
+	event.AssignID(id)
+	return nil
+}
+
+// List retrieves Events, newest first, with optional filtering.
+func (r *AuditRepository) List(ctx context.Context, filter audit.ListFilter) ([]*audit.Event, int64, error) {
+	// Base query
+	baseQuery := `FROM audit_log WHERE 1=1`
+	args := []interface{}{}
+	argIndex := 1
+
+	// Apply filters
+	if filter.EntityType != nil {
+		baseQuery += fmt.Sprintf(` AND entity_type = $%d`, argIndex)
+		args = append(args, filter.EntityType.String())
+		argIndex++
+	}
+	if filter.EntityCode != nil {
+		baseQuery += fmt.Sprintf(` AND entity_code = $%d`, argIndex)
+		args = append(args, *filter.EntityCode)
+		argIndex++
+	}
+	if filter.ChangedBy != nil {
+		baseQuery += fmt.Sprintf(` AND changed_by = $%d`, argIndex)
+		args = append(args, *filter.ChangedBy)
+		argIndex++
+	}
+	if filter.From != nil {
+		baseQuery += fmt.Sprintf(` AND occurred_at >= $%d`, argIndex)
+		args = append(args, *filter.From)
+		argIndex++
+	}
+	if filter.To != nil {
+		baseQuery += fmt.Sprintf(` AND occurred_at < $%d`, argIndex)
+		args = append(args, *filter.To)
+		argIndex++
+	}
+
+	// Count query
+	countQuery := `SELECT COUNT(*) ` + baseQuery
+	var total int64
+	err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&total)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Data query with pagination
+	dataQuery := `SELECT ` + auditColumns + ` ` + baseQuery +
+		fmt.Sprintf(` ORDER BY occurred_at DESC, id DESC LIMIT $%d OFFSET $%d`, argIndex, argIndex+1)
+	args = append(args, filter.Limit(), filter.Offset())
+
+	rows, err := r.db.QueryContext(ctx, dataQuery, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var result []*audit.Event
+	for rows.Next() {
+		event, err := scanAuditEvent(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		result = append(result, event)
+	}
+
+	return result, total, rows.Err()
+}
+
+// marshalNullableJSON renders a snapshot as JSONB, keeping nil as SQL NULL.
+func marshalNullableJSON(s audit.Snapshot) ([]byte, error) {
+	if s == nil {
+		return nil, nil
+	}
+	return json.Marshal(s)
+}
+
+// scanAuditEvent reads a row selected with auditColumns.
+func scanAuditEvent(row rowScanner) (*audit.Event, error) {
+	var (
+		id          int64
+		entityType  string
+		entityCode  string
+		action      string
+		beforeJSON  []byte
+		afterJSON   []byte
+		changesJSON []byte
+		changedBy   string
+		occurredAt  time.Time
+	)
+
+	if err := row.Scan(
+		&id,
+		&entityType,
+		&entityCode,
+		&action,
+		&beforeJSON,
+		&afterJSON,
+		&changesJSON,
+		&changedBy,
+		&occurredAt,
+	); err != nil {
+		return nil, err
+	}
+
+	// Parse JSONB columns
+	var before, after audit.Snapshot
+	if beforeJSON != nil {
+		if err := json.Unmarshal(beforeJSON, &before); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal before_data: %w", err)
+		}
+	}
+	if afterJSON != nil {
+		if err := json.Unmarshal(afterJSON, &after); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal after_data: %w", err)
+		}
+	}
+	var changes []audit.Change
+	if err := json.Unmarshal(changesJSON, &changes); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal changes: %w", err)
+	}
+
+	// Create value objects
+	entityTypeVO, _ := audit.NewEntityType(entityType)
+	actionVO, _ := audit.NewAction(action)
+
+	return audit.Reconstitute(
+		id,
+		entityTypeVO,
+		entityCode,
+		actionVO,
+		before,
+		after,
+		changes,
+		changedBy,
+		occurredAt,
+	), nil
+}
@@ -0,0 +1,256 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/audit"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/changerequest"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/parameter"
+)
+
+// ChangeRequestRepository implements changerequest.Repository interface.
+type ChangeRequestRepository struct {
+	db *DB
+}
+
+// NewChangeRequestRepository creates a new change request repository.
+func NewChangeRequestRepository(db *DB) *ChangeRequestRepository {
+	return &ChangeRequestRepository{db: db}
+}
+
+// Verify interface implementation at compile time.
+var _ changerequest.Repository = (*ChangeRequestRepository)(nil)
+
+// changeRequestColumns lists the columns read by scanChangeRequest.
+const changeRequestColumns = `id, parameter_code, base_version, action, proposal, changes, status,
+	requested_by, requested_by_subject, requested_at, reviewed_by, reviewed_by_subject, reviewed_at, review_comment`
+
+// Create persists a new pending ChangeRequest and assigns its ID.
+func (r *ChangeRequestRepository) Create(ctx context.Context, request *changerequest.ChangeRequest) error {
+	proposal, err := json.Marshal(request.Proposal())
+	if err != nil {
+		return fmt.Errorf("failed to marshal proposal: %w", err)
+	}
+	changes, err := json.Marshal(request.Changes())
+	if err != nil {
+		return fmt.Errorf("failed to marshal changes: %w", err)
+	}
+
+	query := `
+		INSERT INTO mst_parameter_change_request (
+			parameter_code, base_version, action, proposal, changes, status,
+			requested_by, requested_by_subject, requested_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING id
+	`
+
+	var id int64
+	err = r.db.QueryRowContext(ctx, query,
+		request.ParameterCode().String(),
+		request.BaseVersion(),
+		request.Action().String(),
+		proposal,
+		changes,
+		request.Status().String(),
+		request.RequestedBy(),
+		request.RequestedBySubject(),
+		request.RequestedAt(),
+	).Scan(&id)
+	if isUniqueViolation(err, "uq_mst_parameter_change_request_pending") {
+		return changerequest.ErrPendingExists
+	}
+	if err != nil {
+		return err
+	}
+
+	request.AssignID(id)
+	return nil
+}
+
+// GetByID retrieves a ChangeRequest by its ID.
+func (r *ChangeRequestRepository) GetByID(ctx context.Context, id int64) (*changerequest.ChangeRequest, error) {
+	query := `SELECT ` + changeRequestColumns + ` FROM mst_parameter_change_request WHERE id = $1`
+
+	request, err := scanChangeRequest(r.db.QueryRowContext(ctx, query, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, changerequest.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return request, nil
+}
+
+// List retrieves ChangeRequests with optional filtering, newest first.
+func (r *ChangeRequestRepository) List(ctx context.Context, filter changerequest.ListFilter) ([]*changerequest.ChangeRequest, int64, error) {
+	// Base query
+	baseQuery := ` FROM mst_parameter_change_request WHERE 1=1`
+	args := []interface{}{}
+	argIndex := 1
+
+	// Apply filters
+	if filter.ParameterCode != nil {
+		baseQuery += fmt.Sprintf(` AND parameter_code = $%d`, argIndex)
+		args = append(args, filter.ParameterCode.String())
+		argIndex++
+	}
+	if filter.Status != nil {
+		baseQuery += fmt.Sprintf(` AND status = $%d`, argIndex)
+		args = append(args, filter.Status.String())
+		argIndex++
+	}
+	if filter.RequestedBy != nil {
+		baseQuery += fmt.Sprintf(` AND requested_by = $%d`, argIndex)
+		args = append(args, *filter.RequestedBy)
+		argIndex++
+	}
+
+	// Count query
+	countQuery := `SELECT COUNT(*)` + baseQuery
+	var total int64
+	if err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	// Data query with pagination
+	dataQuery := `SELECT ` + changeRequestColumns + baseQuery +
+		fmt.Sprintf(` ORDER BY id DESC LIMIT $%d OFFSET $%d`, argIndex, argIndex+1)
+	args = append(args, filter.Limit(), filter.Offset())
+
+	rows, err := r.db.QueryContext(ctx, dataQuery, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var result []*changerequest.ChangeRequest
+	for rows.Next() {
+		request, err := scanChangeRequest(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		result = append(result, request)
+	}
+
+	return result, total, rows.Err()
+}
+
+// Review persists the review of a ChangeRequest that is still pending.
+func (r *ChangeRequestRepository) Review(ctx context.Context, request *changerequest.ChangeRequest) error {
+	query := `
+		UPDATE mst_parameter_change_request
+		SET status = $2, reviewed_by = $3, reviewed_by_subject = $4, reviewed_at = $5, review_comment = $6
+		WHERE id = $1 AND status = 'PENDING'
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		request.ID(),
+		request.Status().String(),
+		request.ReviewedBy(),
+		request.ReviewedBySubject(),
+		request.ReviewedAt(),
+		request.ReviewComment(),
+	)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return changerequest.ErrNotPending
+	}
+
+	return nil
+}
+
+// scanChangeRequest reads a row selected with changeRequestColumns.
+func scanChangeRequest(row rowScanner) (*changerequest.ChangeRequest, error) {
+	var (
+		id                 int64
+		parameterCode      string
+		baseVersion        int
+		action             string
+		proposalJSON       []byte
+		changesJSON        []byte
+		status             string
+		requestedBy        string
+		requestedBySubject string
+		requestedAt        time.Time
+		reviewedBy         sql.NullString
+		reviewedBySubject  sql.NullString
+		reviewedAt         sql.NullTime
+		reviewComment      sql.NullString
+	)
+
+	if err := row.Scan(
+		&id,
+		&parameterCode,
+		&baseVersion,
+		&action,
+		&proposalJSON,
+		&changesJSON,
+		&status,
+		&requestedBy,
+		&requestedBySubject,
+		&requestedAt,
+		&reviewedBy,
+		&reviewedBySubject,
+		&reviewedAt,
+		&reviewComment,
+	); err != nil {
+		return nil, err
+	}
+
+	var proposal changerequest.Proposal
+	if err := json.Unmarshal(proposalJSON, &proposal); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal proposal: %w", err)
+	}
+	var changes []audit.Change
+	if err := json.Unmarshal(changesJSON, &changes); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal changes: %w", err)
+	}
+
+	// Handle nullable fields
+	var reviewedByPtr, reviewedBySubjectPtr, reviewCommentPtr *string
+	var reviewedAtPtr *time.Time
+
+	if reviewedBy.Valid {
+		reviewedByPtr = &reviewedBy.String
+	}
+	if reviewedBySubject.Valid {
+		reviewedBySubjectPtr = &reviewedBySubject.String
+	}
+	if reviewedAt.Valid {
+		reviewedAtPtr = &reviewedAt.Time
+	}
+	if reviewComment.Valid {
+		reviewCommentPtr = &reviewComment.String
+	}
+
+	return changerequest.Reconstitute(
+		id,
+		parameter.Code(parameterCode),
+		baseVersion,
+		changerequest.Action(action),
+		proposal,
+		changes,
+		changerequest.Status(status),
+		requestedBy,
+		requestedBySubject,
+		requestedAt,
+		reviewedByPtr,
+		reviewedBySubjectPtr,
+		reviewedAtPtr,
+		reviewCommentPtr,
+	), nil
+}
@@ -3,9 +3,11 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"time"
 
+	"github.com/jackc/pgx/v5/pgconn"
 	_ "github.com/jackc/pgx/v5/stdlib"
 	"github.com/rs/zerolog/log"
 
@@ -60,3 +62,190 @@ func (db *DB) Close() error {
 func (db *DB) HealthCheck(ctx context.Context) error {
 	return db.PingContext(ctx)
 }
+
+// ambientTx is a transaction started by InTx, carried by the context so the
+// repositories called with it take part.
+type ambientTx struct {
+	tx    *sql.Tx
+	after []func()
+}
+
+type ambientTxKey struct{}
+
+func ambientFromContext(ctx context.Context) (*ambientTx, bool) {
+	ambient, ok := ctx.Value(ambientTxKey{}).(*ambientTx)
+	return ambient, ok
+}
+
+// InTx runs fn in a transaction carried by the context passed to it: every
+// statement run with that context, through any repository, takes part in it.
+// A nested call joins the outer transaction.
+func (db *DB) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ambientFromContext(ctx); ok {
+		return fn(ctx)
+	}
+
+	ambient := &ambientTx{}
+	defer func() {
+		for _, hook := range ambient.after {
+			hook()
+		}
+	}()
+	return db.WithTx(ctx, func(tx *sql.Tx) error {
+		ambient.tx = tx
+		return fn(context.WithValue(ctx, ambientTxKey{}, ambient))
+	})
+}
+
+// AfterTx runs fn once the transaction of ctx has ended, committed or not,
+// or at once outside InTx. Caches use it so they are not refilled with rows
+// another transaction cannot see yet.
+func AfterTx(ctx context.Context, fn func()) {
+	if ambient, ok := ambientFromContext(ctx); ok {
+		ambient.after = append(ambient.after, fn)
+		return
+	}
+	fn()
+}
+
+// WithTx runs fn inside a transaction, committing on success and rolling back on error.
+// Within InTx, fn runs in its transaction behind a savepoint instead.
+func (db *DB) WithTx(ctx context.Context, fn func(tx *sql.Tx) error) error {
+	if ambient, ok := ambientFromContext(ctx); ok && ambient.tx != nil {
+		return savepoint(ctx, ambient.tx, fn)
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// savepoint runs fn in tx, undoing only its own statements on error.
+func savepoint(ctx context.Context, tx *sql.Tx, fn func(tx *sql.Tx) error) error {
+	if _, err := tx.ExecContext(ctx, `SAVEPOINT nested_tx`); err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		if _, rollbackErr := tx.ExecContext(ctx, `ROLLBACK TO SAVEPOINT nested_tx`); rollbackErr != nil {
+			return rollbackErr
+		}
+		return err
+	}
+	_, err := tx.ExecContext(ctx, `RELEASE SAVEPOINT nested_tx`)
+	return err
+}
+
+// ExecContext executes a statement in the transaction of ctx, if any.
+func (db *DB) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	if ambient, ok := ambientFromContext(ctx); ok && ambient.tx != nil {
+		return ambient.tx.ExecContext(ctx, query, args...)
+	}
+	return db.DB.ExecContext(ctx, query, args...)
+}
+
+// QueryContext runs a query in the transaction of ctx, if any.
+func (db *DB) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	if ambient, ok := ambientFromContext(ctx); ok && ambient.tx != nil {
+		return ambient.tx.QueryContext(ctx, query, args...)
+	}
+	return db.DB.QueryContext(ctx, query, args...)
+}
+
+// QueryRowContext runs a single-row query in the transaction of ctx, if any.
+func (db *DB) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	if ambient, ok := ambientFromContext(ctx); ok && ambient.tx != nil {
+		return ambient.tx.QueryRowContext(ctx, query, args...)
+	}
+	return db.DB.QueryRowContext(ctx, query, args...)
+}
+
+// errBatchRejected rolls back an atomic batch in which an entry failed.
+var errBatchRejected = errors.New("batch rejected")
+
+// saveBatch calls save for each of n entries inside one transaction and
+// returns the per-entry errors. Each entry runs behind a savepoint so a failed
+// entry does not abort the transaction for the others. An atomic batch is
+// rolled back if any entry failed.
+func (db *DB) saveBatch(ctx context.Context, n int, atomic bool, save func(tx *sql.Tx, i int) error) ([]error, error) {
+	errs := make([]error, n)
+	err := db.WithTx(ctx, func(tx *sql.Tx) error {
+		failed := false
+		for i := 0; i < n; i++ {
+			if _, err := tx.ExecContext(ctx, `SAVEPOINT batch_entry`); err != nil {
+				return err
+			}
+			if err := save(tx, i); err != nil {
+				errs[i] = err
+				failed = true
+				if _, err := tx.ExecContext(ctx, `ROLLBACK TO SAVEPOINT batch_entry`); err != nil {
+					return err
+				}
+				continue
+			}
+			if _, err := tx.ExecContext(ctx, `RELEASE SAVEPOINT batch_entry`); err != nil {
+				return err
+			}
+		}
+		if atomic && failed {
+			return errBatchRejected
+		}
+		return nil
+	})
+	if errors.Is(err, errBatchRejected) {
+		return errs, nil
+	}
+	return errs, err
+}
+
+// execer is implemented by both *sql.DB and *sql.Tx.
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+// queryer is implemented by both *sql.DB and *sql.Tx.
+type queryer interface {
+	execer
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// PostgreSQL SQLSTATE codes for integrity violations.
+const (
+	pgForeignKeyViolation = "23503"
+	pgUniqueViolation     = "23505"
+	pgExclusionViolation  = "23P01"
+)
+
+// isUniqueViolation reports whether err violates the named unique constraint or index.
+func isUniqueViolation(err error, constraint string) bool {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return false
+	}
+	return pgErr.Code == pgUniqueViolation && pgErr.ConstraintName == constraint
+}
+
+// isExclusionViolation reports whether err violates the named exclusion constraint.
+func isExclusionViolation(err error, constraint string) bool {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return false
+	}
+	return pgErr.Code == pgExclusionViolation && pgErr.ConstraintName == constraint
+}
+
+// isForeignKeyViolation reports whether err violates the named foreign key.
+func isForeignKeyViolation(err error, constraint string) bool {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return false
+	}
+	return pgErr.Code == pgForeignKeyViolation && pgErr.ConstraintName == constraint
+}
@@ -0,0 +1,106 @@
+package postgres
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog/log"
+
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/event"
+)
+
+// outboxChannel is the NOTIFY channel of the outbox_event insert trigger.
+const outboxChannel = "outbox_event"
+
+// Listener implements event.Notifier with LISTEN on a dedicated connection.
+type Listener struct {
+	dsn string
+
+	mu          sync.Mutex
+	subscribers map[chan struct{}]struct{}
+}
+
+// Verify interface implementation at compile time.
+var _ event.Notifier = (*Listener)(nil)
+
+// NewListener creates a listener connecting with dsn. It listens once Run is
+// called.
+func NewListener(dsn string) *Listener {
+	return &Listener{dsn: dsn, subscribers: make(map[chan struct{}]struct{})}
+}
+
+// Subscribe returns a channel signalled after each commit that wrote to the
+// outbox, and a function ending the subscription.
+func (l *Listener) Subscribe() (<-chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+
+	l.mu.Lock()
+	l.subscribers[ch] = struct{}{}
+	l.mu.Unlock()
+
+	return ch, func() {
+		l.mu.Lock()
+		delete(l.subscribers, ch)
+		l.mu.Unlock()
+	}
+}
+
+// Run listens until ctx is done, reconnecting after failures. Subscribers are
+// signalled on every reconnect too, as notifications may have been missed.
+func (l *Listener) Run(ctx context.Context) error {
+	backoff := time.Second
+	for {
+		started := time.Now()
+		err := l.listen(ctx)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if time.Since(started) > time.Minute {
+			backoff = time.Second
+		}
+		log.Warn().Err(err).Dur("retry_in", backoff).Msg("Outbox listener disconnected")
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, 30*time.Second)
+	}
+}
+
+func (l *Listener) listen(ctx context.Context) error {
+	conn, err := pgx.Connect(ctx, l.dsn)
+	if err != nil {
+		return err
+	}
+	defer conn.Close(context.WithoutCancel(ctx))
+
+	if _, err := conn.Exec(ctx, "LISTEN "+outboxChannel); err != nil {
+		return err
+	}
+	l.broadcast()
+
+	for {
+		if _, err := conn.WaitForNotification(ctx); err != nil {
+			return err
+		}
+		l.broadcast()
+	}
+}
+
+// broadcast signals every subscriber without blocking; a pending signal
+// already covers this one.
+func (l *Listener) broadcast() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for ch := range l.subscribers {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
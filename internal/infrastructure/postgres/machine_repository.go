@@ -0,0 +1,332 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/machine"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/parameter"
+)
+
+// MachineRepository implements machine.Repository interface.
+type MachineRepository struct {
+	db *DB
+}
+
+// NewMachineRepository creates a new Machine repository.
+func NewMachineRepository(db *DB) *MachineRepository {
+	return &MachineRepository{db: db}
+}
+
+// Verify interface implementation at compile time.
+var _ machine.Repository = (*MachineRepository)(nil)
+
+// machineColumns lists the columns read by scanMachine.
+const machineColumns = `machine_code, machine_name, machine_type_code, description, is_active,
+	created_at, created_by, updated_at, updated_by`
+
+// Create persists a new Machine with its parameter values.
+func (r *MachineRepository) Create(ctx context.Context, entity *machine.Machine) error {
+	return r.db.WithTx(ctx, func(tx *sql.Tx) error {
+		query := `
+			INSERT INTO mst_machine (
+				machine_code, machine_name, machine_type_code, description, is_active, created_at, created_by
+			)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)
+		`
+
+		_, err := tx.ExecContext(ctx, query,
+			entity.Code().String(),
+			entity.Name(),
+			entity.MachineType().String(),
+			entity.Description(),
+			entity.IsActive(),
+			entity.CreatedAt(),
+			entity.CreatedBy(),
+		)
+		if isForeignKeyViolation(err, machineTypeFK) {
+			return machine.ErrTypeNotFound
+		}
+		if err != nil {
+			return err
+		}
+
+		return insertMachineValues(ctx, tx, entity)
+	})
+}
+
+// GetByCode retrieves a Machine by its code.
+func (r *MachineRepository) GetByCode(ctx context.Context, code machine.Code) (*machine.Machine, error) {
+	query := `SELECT ` + machineColumns + ` FROM mst_machine WHERE machine_code = $1`
+
+	values, err := r.loadValues(ctx, []string{code.String()})
+	if err != nil {
+		return nil, err
+	}
+
+	entity, err := scanMachine(r.db.QueryRowContext(ctx, query, code.String()), values[code.String()])
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, machine.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+// List retrieves Machines with optional filtering.
+func (r *MachineRepository) List(ctx context.Context, filter machine.ListFilter) ([]*machine.Machine, int64, error) {
+	// Base query
+	baseQuery := `FROM mst_machine WHERE 1=1`
+	args := []interface{}{}
+	argIndex := 1
+
+	// Apply filters
+	if filter.MachineType != nil {
+		baseQuery += fmt.Sprintf(` AND machine_type_code = $%d`, argIndex)
+		args = append(args, filter.MachineType.String())
+		argIndex++
+	}
+	if filter.IsActive != nil {
+		baseQuery += fmt.Sprintf(` AND is_active = $%d`, argIndex)
+		args = append(args, *filter.IsActive)
+		argIndex++
+	}
+
+	// Count query
+	countQuery := `SELECT COUNT(*) ` + baseQuery
+	var total int64
+	err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&total)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Data query with pagination
+	dataQuery := `SELECT ` + machineColumns + ` ` + baseQuery +
+		fmt.Sprintf(` ORDER BY machine_code LIMIT $%d OFFSET $%d`, argIndex, argIndex+1)
+	args = append(args, filter.Limit(), filter.Offset())
+
+	rows, err := r.db.QueryContext(ctx, dataQuery, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var result []*machine.Machine
+	var codes []string
+	for rows.Next() {
+		entity, err := scanMachine(rows, nil)
+		if err != nil {
+			return nil, 0, err
+		}
+		result = append(result, entity)
+		codes = append(codes, entity.Code().String())
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	// Attach the values of the page in one query
+	values, err := r.loadValues(ctx, codes)
+	if err != nil {
+		return nil, 0, err
+	}
+	for _, entity := range result {
+		entity.SetValues(values[entity.Code().String()])
+	}
+
+	return result, total, nil
+}
+
+// Update persists changes to an existing Machine and replaces its values.
+func (r *MachineRepository) Update(ctx context.Context, entity *machine.Machine) error {
+	return r.db.WithTx(ctx, func(tx *sql.Tx) error {
+		query := `
+			UPDATE mst_machine
+			SET machine_name = $2, machine_type_code = $3, description = $4, is_active = $5,
+			    updated_at = $6, updated_by = $7
+			WHERE machine_code = $1
+		`
+
+		result, err := tx.ExecContext(ctx, query,
+			entity.Code().String(),
+			entity.Name(),
+			entity.MachineType().String(),
+			entity.Description(),
+			entity.IsActive(),
+			entity.UpdatedAt(),
+			entity.UpdatedBy(),
+		)
+		if isForeignKeyViolation(err, machineTypeFK) {
+			return machine.ErrTypeNotFound
+		}
+		if err != nil {
+			return err
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rowsAffected == 0 {
+			return machine.ErrNotFound
+		}
+
+		_, err = tx.ExecContext(ctx, `DELETE FROM mst_machine_parameter WHERE machine_code = $1`, entity.Code().String())
+		if err != nil {
+			return err
+		}
+
+		return insertMachineValues(ctx, tx, entity)
+	})
+}
+
+// Delete removes a Machine and its values by its code.
+func (r *MachineRepository) Delete(ctx context.Context, code machine.Code) error {
+	query := `DELETE FROM mst_machine WHERE machine_code = $1`
+
+	result, err := r.db.ExecContext(ctx, query, code.String())
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return machine.ErrNotFound
+	}
+
+	return nil
+}
+
+// ExistsByCode checks if a Machine with the given code exists.
+func (r *MachineRepository) ExistsByCode(ctx context.Context, code machine.Code) (bool, error) {
+	query := `SELECT EXISTS(SELECT 1 FROM mst_machine WHERE machine_code = $1)`
+
+	var exists bool
+	err := r.db.QueryRowContext(ctx, query, code.String()).Scan(&exists)
+	return exists, err
+}
+
+// loadValues reads the parameter values of the given machines in template order, keyed by machine code.
+func (r *MachineRepository) loadValues(ctx context.Context, codes []string) (map[string][]machine.Value, error) {
+	values := make(map[string][]machine.Value, len(codes))
+	if len(codes) == 0 {
+		return values, nil
+	}
+
+	query := `
+		SELECT v.machine_code, v.parameter_code, v.value
+		FROM mst_machine_parameter v
+		JOIN mst_machine_type_parameter t
+		  ON t.machine_type_code = v.machine_type_code AND t.parameter_code = v.parameter_code
+		WHERE v.machine_code = ANY($1)
+		ORDER BY v.machine_code, t.sort_order, v.parameter_code
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, codes)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var machineCode, paramCode, value string
+		if err := rows.Scan(&machineCode, &paramCode, &value); err != nil {
+			return nil, err
+		}
+		values[machineCode] = append(values[machineCode], machine.NewValue(parameter.Code(paramCode), value))
+	}
+
+	return values, rows.Err()
+}
+
+// insertMachineValues writes the parameter values of entity.
+func insertMachineValues(ctx context.Context, ex execer, entity *machine.Machine) error {
+	query := `
+		INSERT INTO mst_machine_parameter (machine_code, machine_type_code, parameter_code, value)
+		VALUES ($1, $2, $3, $4)
+	`
+
+	for _, v := range entity.Values() {
+		_, err := ex.ExecContext(ctx, query,
+			entity.Code().String(),
+			entity.MachineType().String(),
+			v.ParameterCode().String(),
+			v.Value(),
+		)
+		if isForeignKeyViolation(err, machineParameterTemplateFK) {
+			return fmt.Errorf("parameter %s: %w", v.ParameterCode(), machine.ErrParameterNotInTemplate)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// scanMachine reads a row selected with machineColumns.
+func scanMachine(row rowScanner, values []machine.Value) (*machine.Machine, error) {
+	var (
+		machineCode string
+		machineName string
+		typeCode    string
+		description sql.NullString
+		isActive    bool
+		createdAt   time.Time
+		createdBy   string
+		updatedAt   sql.NullTime
+		updatedBy   sql.NullString
+	)
+
+	if err := row.Scan(
+		&machineCode,
+		&machineName,
+		&typeCode,
+		&description,
+		&isActive,
+		&createdAt,
+		&createdBy,
+		&updatedAt,
+		&updatedBy,
+	); err != nil {
+		return nil, err
+	}
+
+	// Create value objects
+	codeVO, _ := machine.NewMachineCode(machineCode)
+	typeVO, _ := machine.NewTypeCode(typeCode)
+
+	// Handle nullable fields
+	var descPtr, updatedByPtr *string
+	var updatedAtPtr *time.Time
+
+	if description.Valid {
+		descPtr = &description.String
+	}
+	if updatedAt.Valid {
+		updatedAtPtr = &updatedAt.Time
+	}
+	if updatedBy.Valid {
+		updatedByPtr = &updatedBy.String
+	}
+
+	return machine.Reconstitute(
+		codeVO,
+		machineName,
+		typeVO,
+		descPtr,
+		values,
+		isActive,
+		createdAt,
+		createdBy,
+		updatedAtPtr,
+		updatedByPtr,
+	), nil
+}
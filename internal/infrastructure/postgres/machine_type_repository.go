@@ -0,0 +1,362 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/machine"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/parameter"
+)
+
+// MachineTypeRepository implements machine.TypeRepository interface.
+type MachineTypeRepository struct {
+	db *DB
+}
+
+// NewMachineTypeRepository creates a new MachineType repository.
+func NewMachineTypeRepository(db *DB) *MachineTypeRepository {
+	return &MachineTypeRepository{db: db}
+}
+
+// Verify interface implementation at compile time.
+var _ machine.TypeRepository = (*MachineTypeRepository)(nil)
+
+// machineTypeFK keeps machine types with machines from being deleted.
+const machineTypeFK = "fk_mst_machine_type"
+
+// machineParameterTemplateFK keeps template parameters with machine values in the template.
+const machineParameterTemplateFK = "fk_mst_machine_parameter_template"
+
+// machineTypeColumns lists the columns read by scanMachineType.
+const machineTypeColumns = `machine_type_code, machine_type_name, description, is_active,
+	created_at, created_by, updated_at, updated_by`
+
+// Create persists a new MachineType with its parameter template.
+func (r *MachineTypeRepository) Create(ctx context.Context, entity *machine.MachineType) error {
+	return r.db.WithTx(ctx, func(tx *sql.Tx) error {
+		query := `
+			INSERT INTO mst_machine_type (
+				machine_type_code, machine_type_name, description, is_active, created_at, created_by
+			)
+			VALUES ($1, $2, $3, $4, $5, $6)
+		`
+
+		_, err := tx.ExecContext(ctx, query,
+			entity.Code().String(),
+			entity.Name(),
+			entity.Description(),
+			entity.IsActive(),
+			entity.CreatedAt(),
+			entity.CreatedBy(),
+		)
+		if err != nil {
+			return err
+		}
+
+		return upsertTemplateParameters(ctx, tx, entity)
+	})
+}
+
+// GetByCode retrieves a MachineType by its code.
+func (r *MachineTypeRepository) GetByCode(ctx context.Context, code machine.TypeCode) (*machine.MachineType, error) {
+	query := `SELECT ` + machineTypeColumns + ` FROM mst_machine_type WHERE machine_type_code = $1`
+
+	templates, err := r.loadTemplates(ctx, []string{code.String()})
+	if err != nil {
+		return nil, err
+	}
+
+	entity, err := scanMachineType(r.db.QueryRowContext(ctx, query, code.String()), templates[code.String()])
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, machine.ErrTypeNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+// List retrieves MachineTypes with optional filtering.
+func (r *MachineTypeRepository) List(ctx context.Context, filter machine.TypeListFilter) ([]*machine.MachineType, int64, error) {
+	// Base query
+	baseQuery := `FROM mst_machine_type WHERE 1=1`
+	args := []interface{}{}
+	argIndex := 1
+
+	// Apply filters
+	if filter.IsActive != nil {
+		baseQuery += fmt.Sprintf(` AND is_active = $%d`, argIndex)
+		args = append(args, *filter.IsActive)
+		argIndex++
+	}
+
+	// Count query
+	countQuery := `SELECT COUNT(*) ` + baseQuery
+	var total int64
+	err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&total)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Data query with pagination
+	dataQuery := `SELECT ` + machineTypeColumns + ` ` + baseQuery +
+		fmt.Sprintf(` ORDER BY machine_type_code LIMIT $%d OFFSET $%d`, argIndex, argIndex+1)
+	args = append(args, filter.Limit(), filter.Offset())
+
+	rows, err := r.db.QueryContext(ctx, dataQuery, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var result []*machine.MachineType
+	var codes []string
+	for rows.Next() {
+		entity, err := scanMachineType(rows, nil)
+		if err != nil {
+			return nil, 0, err
+		}
+		result = append(result, entity)
+		codes = append(codes, entity.Code().String())
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	// Attach the templates of the page in one query
+	templates, err := r.loadTemplates(ctx, codes)
+	if err != nil {
+		return nil, 0, err
+	}
+	for _, entity := range result {
+		if err := entity.SetParameters(templates[entity.Code().String()]); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	return result, total, nil
+}
+
+// Update persists changes to an existing MachineType and replaces its template.
+func (r *MachineTypeRepository) Update(ctx context.Context, entity *machine.MachineType) error {
+	return r.db.WithTx(ctx, func(tx *sql.Tx) error {
+		query := `
+			UPDATE mst_machine_type
+			SET machine_type_name = $2, description = $3, is_active = $4,
+			    updated_at = $5, updated_by = $6
+			WHERE machine_type_code = $1
+		`
+
+		result, err := tx.ExecContext(ctx, query,
+			entity.Code().String(),
+			entity.Name(),
+			entity.Description(),
+			entity.IsActive(),
+			entity.UpdatedAt(),
+			entity.UpdatedBy(),
+		)
+		if err != nil {
+			return err
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rowsAffected == 0 {
+			return machine.ErrTypeNotFound
+		}
+
+		// Remove parameters no longer declared, then write the rest
+		kept := make(map[string]bool, len(entity.Parameters()))
+		for _, p := range entity.Parameters() {
+			kept[p.ParameterCode().String()] = true
+		}
+
+		rows, err := tx.QueryContext(ctx,
+			`SELECT parameter_code FROM mst_machine_type_parameter WHERE machine_type_code = $1`,
+			entity.Code().String(),
+		)
+		if err != nil {
+			return err
+		}
+		var removed []string
+		for rows.Next() {
+			var paramCode string
+			if err := rows.Scan(&paramCode); err != nil {
+				rows.Close()
+				return err
+			}
+			if !kept[paramCode] {
+				removed = append(removed, paramCode)
+			}
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return err
+		}
+
+		for _, paramCode := range removed {
+			_, err := tx.ExecContext(ctx,
+				`DELETE FROM mst_machine_type_parameter WHERE machine_type_code = $1 AND parameter_code = $2`,
+				entity.Code().String(), paramCode,
+			)
+			if isForeignKeyViolation(err, machineParameterTemplateFK) {
+				return fmt.Errorf("parameter %s: %w", paramCode, machine.ErrTemplateParameterInUse)
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		return upsertTemplateParameters(ctx, tx, entity)
+	})
+}
+
+// Delete removes a MachineType by its code.
+func (r *MachineTypeRepository) Delete(ctx context.Context, code machine.TypeCode) error {
+	query := `DELETE FROM mst_machine_type WHERE machine_type_code = $1`
+
+	result, err := r.db.ExecContext(ctx, query, code.String())
+	if isForeignKeyViolation(err, machineTypeFK) {
+		return machine.ErrTypeInUse
+	}
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return machine.ErrTypeNotFound
+	}
+
+	return nil
+}
+
+// ExistsByCode checks if a MachineType with the given code exists.
+func (r *MachineTypeRepository) ExistsByCode(ctx context.Context, code machine.TypeCode) (bool, error) {
+	query := `SELECT EXISTS(SELECT 1 FROM mst_machine_type WHERE machine_type_code = $1)`
+
+	var exists bool
+	err := r.db.QueryRowContext(ctx, query, code.String()).Scan(&exists)
+	return exists, err
+}
+
+// loadTemplates reads the parameter templates of the given machine types, keyed by type code.
+func (r *MachineTypeRepository) loadTemplates(ctx context.Context, codes []string) (map[string][]machine.TemplateParameter, error) {
+	templates := make(map[string][]machine.TemplateParameter, len(codes))
+	if len(codes) == 0 {
+		return templates, nil
+	}
+
+	query := `
+		SELECT machine_type_code, parameter_code, is_mandatory
+		FROM mst_machine_type_parameter
+		WHERE machine_type_code = ANY($1)
+		ORDER BY machine_type_code, sort_order, parameter_code
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, codes)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var typeCode, paramCode string
+		var isMandatory bool
+		if err := rows.Scan(&typeCode, &paramCode, &isMandatory); err != nil {
+			return nil, err
+		}
+		templates[typeCode] = append(templates[typeCode],
+			machine.NewTemplateParameter(parameter.Code(paramCode), isMandatory))
+	}
+
+	return templates, rows.Err()
+}
+
+// upsertTemplateParameters writes the template of entity in declaration order.
+func upsertTemplateParameters(ctx context.Context, ex execer, entity *machine.MachineType) error {
+	query := `
+		INSERT INTO mst_machine_type_parameter (machine_type_code, parameter_code, is_mandatory, sort_order)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (machine_type_code, parameter_code)
+		DO UPDATE SET is_mandatory = EXCLUDED.is_mandatory, sort_order = EXCLUDED.sort_order
+	`
+
+	for i, p := range entity.Parameters() {
+		_, err := ex.ExecContext(ctx, query,
+			entity.Code().String(),
+			p.ParameterCode().String(),
+			p.IsMandatory(),
+			i,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// scanMachineType reads a row selected with machineTypeColumns.
+func scanMachineType(row rowScanner, parameters []machine.TemplateParameter) (*machine.MachineType, error) {
+	var (
+		typeCode    string
+		typeName    string
+		description sql.NullString
+		isActive    bool
+		createdAt   time.Time
+		createdBy   string
+		updatedAt   sql.NullTime
+		updatedBy   sql.NullString
+	)
+
+	if err := row.Scan(
+		&typeCode,
+		&typeName,
+		&description,
+		&isActive,
+		&createdAt,
+		&createdBy,
+		&updatedAt,
+		&updatedBy,
+	); err != nil {
+		return nil, err
+	}
+
+	// Create value objects
+	codeVO, _ := machine.NewTypeCode(typeCode)
+
+	// Handle nullable fields
+	var descPtr, updatedByPtr *string
+	var updatedAtPtr *time.Time
+
+	if description.Valid {
+		descPtr = &description.String
+	}
+	if updatedAt.Valid {
+		updatedAtPtr = &updatedAt.Time
+	}
+	if updatedBy.Valid {
+		updatedByPtr = &updatedBy.String
+	}
+
+	return machine.ReconstituteType(
+		codeVO,
+		typeName,
+		descPtr,
+		parameters,
+		isActive,
+		createdAt,
+		createdBy,
+		updatedAtPtr,
+		updatedByPtr,
+	), nil
+}
@@ -0,0 +1,255 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/material"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/uom"
+)
+
+// MaterialRepository implements material.Repository interface.
+type MaterialRepository struct {
+	db *DB
+}
+
+// NewMaterialRepository creates a new Material repository.
+func NewMaterialRepository(db *DB) *MaterialRepository {
+	return &MaterialRepository{db: db}
+}
+
+// Verify interface implementation at compile time.
+var _ material.Repository = (*MaterialRepository)(nil)
+
+// materialColumns lists the columns read by scanMaterial.
+const materialColumns = `material_code, material_name, material_type, purchase_uom_code,
+	standard_price, currency, description, is_active, created_at, created_by, updated_at, updated_by`
+
+// Create persists a new Material.
+func (r *MaterialRepository) Create(ctx context.Context, entity *material.Material) error {
+	query := `
+		INSERT INTO mst_material (
+			material_code, material_name, material_type, purchase_uom_code,
+			standard_price, currency, description, is_active, created_at, created_by
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		entity.Code().String(),
+		entity.Name(),
+		entity.Type().String(),
+		entity.PurchaseUOM().String(),
+		entity.StandardPrice().Amount(),
+		entity.StandardPrice().Currency().String(),
+		entity.Description(),
+		entity.IsActive(),
+		entity.CreatedAt(),
+		entity.CreatedBy(),
+	)
+
+	return err
+}
+
+// GetByCode retrieves a Material by its code.
+func (r *MaterialRepository) GetByCode(ctx context.Context, code material.Code) (*material.Material, error) {
+	query := `SELECT ` + materialColumns + ` FROM mst_material WHERE material_code = $1`
+
+	entity, err := scanMaterial(r.db.QueryRowContext(ctx, query, code.String()))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, material.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+// List retrieves Materials with optional filtering.
+func (r *MaterialRepository) List(ctx context.Context, filter material.ListFilter) ([]*material.Material, int64, error) {
+	// Base query
+	baseQuery := `FROM mst_material WHERE 1=1`
+	args := []interface{}{}
+	argIndex := 1
+
+	// Apply filters
+	if filter.Type != nil {
+		baseQuery += fmt.Sprintf(` AND material_type = $%d`, argIndex)
+		args = append(args, filter.Type.String())
+		argIndex++
+	}
+	if filter.IsActive != nil {
+		baseQuery += fmt.Sprintf(` AND is_active = $%d`, argIndex)
+		args = append(args, *filter.IsActive)
+		argIndex++
+	}
+
+	// Count query
+	countQuery := `SELECT COUNT(*) ` + baseQuery
+	var total int64
+	err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&total)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Data query with pagination
+	dataQuery := `SELECT ` + materialColumns + ` ` + baseQuery +
+		fmt.Sprintf(` ORDER BY material_code LIMIT $%d OFFSET $%d`, argIndex, argIndex+1)
+	args = append(args, filter.Limit(), filter.Offset())
+
+	rows, err := r.db.QueryContext(ctx, dataQuery, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var result []*material.Material
+	for rows.Next() {
+		entity, err := scanMaterial(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		result = append(result, entity)
+	}
+
+	return result, total, rows.Err()
+}
+
+// Update persists changes to an existing Material.
+func (r *MaterialRepository) Update(ctx context.Context, entity *material.Material) error {
+	query := `
+		UPDATE mst_material
+		SET material_name = $2, material_type = $3, purchase_uom_code = $4,
+		    standard_price = $5, currency = $6, description = $7, is_active = $8,
+		    updated_at = $9, updated_by = $10
+		WHERE material_code = $1
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		entity.Code().String(),
+		entity.Name(),
+		entity.Type().String(),
+		entity.PurchaseUOM().String(),
+		entity.StandardPrice().Amount(),
+		entity.StandardPrice().Currency().String(),
+		entity.Description(),
+		entity.IsActive(),
+		entity.UpdatedAt(),
+		entity.UpdatedBy(),
+	)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return material.ErrNotFound
+	}
+
+	return nil
+}
+
+// Delete removes a Material by its code.
+func (r *MaterialRepository) Delete(ctx context.Context, code material.Code) error {
+	query := `DELETE FROM mst_material WHERE material_code = $1`
+
+	result, err := r.db.ExecContext(ctx, query, code.String())
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return material.ErrNotFound
+	}
+
+	return nil
+}
+
+// ExistsByCode checks if a Material with the given code exists.
+func (r *MaterialRepository) ExistsByCode(ctx context.Context, code material.Code) (bool, error) {
+	query := `SELECT EXISTS(SELECT 1 FROM mst_material WHERE material_code = $1)`
+
+	var exists bool
+	err := r.db.QueryRowContext(ctx, query, code.String()).Scan(&exists)
+	return exists, err
+}
+
+// scanMaterial reads a row selected with materialColumns.
+func scanMaterial(row rowScanner) (*material.Material, error) {
+	var (
+		materialCode  string
+		materialName  string
+		materialType  string
+		purchaseUOM   string
+		standardPrice float64
+		currency      string
+		description   sql.NullString
+		isActive      bool
+		createdAt     time.Time
+		createdBy     string
+		updatedAt     sql.NullTime
+		updatedBy     sql.NullString
+	)
+
+	if err := row.Scan(
+		&materialCode,
+		&materialName,
+		&materialType,
+		&purchaseUOM,
+		&standardPrice,
+		&currency,
+		&description,
+		&isActive,
+		&createdAt,
+		&createdBy,
+		&updatedAt,
+		&updatedBy,
+	); err != nil {
+		return nil, err
+	}
+
+	// Create value objects
+	codeVO, _ := material.NewMaterialCode(materialCode)
+	typeVO, _ := material.NewType(materialType)
+	currencyVO, _ := material.NewCurrency(currency)
+	price, _ := material.NewPrice(standardPrice, currencyVO)
+
+	// Handle nullable fields
+	var descPtr, updatedByPtr *string
+	var updatedAtPtr *time.Time
+
+	if description.Valid {
+		descPtr = &description.String
+	}
+	if updatedAt.Valid {
+		updatedAtPtr = &updatedAt.Time
+	}
+	if updatedBy.Valid {
+		updatedByPtr = &updatedBy.String
+	}
+
+	return material.Reconstitute(
+		codeVO,
+		materialName,
+		typeVO,
+		uom.Code(purchaseUOM),
+		price,
+		descPtr,
+		isActive,
+		createdAt,
+		createdBy,
+		updatedAtPtr,
+		updatedByPtr,
+	), nil
+}
@@ -0,0 +1,256 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/event"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/parameter"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/uom"
+)
+
+// OutboxRepository implements event.Outbox interface.
+type OutboxRepository struct {
+	db *DB
+}
+
+// NewOutboxRepository creates a new outbox repository.
+func NewOutboxRepository(db *DB) *OutboxRepository {
+	return &OutboxRepository{db: db}
+}
+
+// Verify interface implementation at compile time.
+var (
+	_ event.Outbox         = (*OutboxRepository)(nil)
+	_ parameter.ChangeFeed = (*OutboxRepository)(nil)
+	_ uom.ChangeFeed       = (*OutboxRepository)(nil)
+)
+
+// outboxColumns lists the outbox_event columns read by scanOutboxMessage, in order.
+const outboxColumns = `id, event_id, event_type, aggregate_type, aggregate_code, occurred_at, payload, attempts`
+
+// Claim leases up to limit unpublished messages, oldest first.
+func (r *OutboxRepository) Claim(ctx context.Context, limit int, lease time.Duration) ([]*event.Message, error) {
+	query := `
+		UPDATE outbox_event SET locked_until = NOW() + $2::bigint * INTERVAL '1 millisecond'
+		WHERE id IN (
+			SELECT id FROM outbox_event
+			WHERE published_at IS NULL AND (locked_until IS NULL OR locked_until < NOW())
+			ORDER BY id
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + outboxColumns
+
+	rows, err := r.db.QueryContext(ctx, query, limit, lease.Milliseconds())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*event.Message
+	for rows.Next() {
+		msg, err := scanOutboxMessage(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, msg)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// RETURNING does not keep the order of the subquery
+	sort.Slice(result, func(i, j int) bool { return result[i].Sequence < result[j].Sequence })
+	return result, nil
+}
+
+// MarkPublished records that the messages were published.
+func (r *OutboxRepository) MarkPublished(ctx context.Context, sequences ...int64) error {
+	if len(sequences) == 0 {
+		return nil
+	}
+	_, err := r.db.ExecContext(ctx,
+		`UPDATE outbox_event SET published_at = NOW(), locked_until = NULL WHERE id = ANY($1)`,
+		sequences,
+	)
+	return err
+}
+
+// MarkFailed records a failed publish attempt.
+func (r *OutboxRepository) MarkFailed(ctx context.Context, sequence int64, cause error) error {
+	_, err := r.db.ExecContext(ctx,
+		`UPDATE outbox_event SET attempts = attempts + 1, last_error = $2 WHERE id = $1`,
+		sequence, cause.Error(),
+	)
+	return err
+}
+
+// feedVisible limits the feed to transactions older than every running one,
+// which have all committed or aborted, so no message can appear before the
+// position of one already read.
+const feedVisible = `tx_id < pg_snapshot_xmin(pg_current_snapshot())`
+
+// Head returns the position of the latest readable change.
+func (r *OutboxRepository) Head(ctx context.Context) (event.Position, error) {
+	query := `
+		SELECT tx_id::text::bigint, id FROM outbox_event
+		WHERE ` + feedVisible + `
+		ORDER BY tx_id DESC, id DESC
+		LIMIT 1
+	`
+
+	var pos event.Position
+	err := r.db.QueryRowContext(ctx, query).Scan(&pos.Tx, &pos.Sequence)
+	if errors.Is(err, sql.ErrNoRows) {
+		return event.Position{}, nil
+	}
+	return pos, err
+}
+
+// ParameterChangesAfter returns up to limit Parameter changes following after.
+func (r *OutboxRepository) ParameterChangesAfter(ctx context.Context, after event.Position, limit int) ([]*parameter.Change, error) {
+	var result []*parameter.Change
+	err := r.changesAfter(ctx, "parameter", "mst_parameter", parameterColumns, after, limit,
+		func(change *feedChange, row rowScanner) error {
+			entity, err := scanParameter(row)
+			if err != nil {
+				return err
+			}
+			result = append(result, &parameter.Change{
+				Position:   change.position,
+				Type:       change.eventType,
+				OccurredAt: change.occurredAt,
+				Parameter:  entity,
+			})
+			return nil
+		})
+	return result, err
+}
+
+// UOMChangesAfter returns up to limit UOM changes following after.
+func (r *OutboxRepository) UOMChangesAfter(ctx context.Context, after event.Position, limit int) ([]*uom.Change, error) {
+	var result []*uom.Change
+	err := r.changesAfter(ctx, "uom", "mst_uom", uomColumns, after, limit,
+		func(change *feedChange, row rowScanner) error {
+			entity, err := scanUOM(row)
+			if err != nil {
+				return err
+			}
+			result = append(result, &uom.Change{
+				Position:   change.position,
+				Type:       change.eventType,
+				OccurredAt: change.occurredAt,
+				UOM:        entity,
+			})
+			return nil
+		})
+	return result, err
+}
+
+// feedChange is the outbox part of a change feed row.
+type feedChange struct {
+	position   event.Position
+	eventType  event.Type
+	occurredAt time.Time
+}
+
+// changesAfter reads the changes of one aggregate type. The payload is
+// expanded back into a row of table so scan reads it like the table itself.
+func (r *OutboxRepository) changesAfter(
+	ctx context.Context,
+	aggregateType, table, columns string,
+	after event.Position,
+	limit int,
+	scan func(change *feedChange, row rowScanner) error,
+) error {
+	query := `
+		SELECT o.tx_id::text::bigint, o.id, o.event_type, o.occurred_at, ` + qualifyColumns("p", columns) + `
+		FROM outbox_event o
+		CROSS JOIN LATERAL jsonb_populate_record(NULL::` + table + `, o.payload) p
+		WHERE o.aggregate_type = $1 AND o.` + feedVisible + `
+		  AND (o.tx_id, o.id) > ($2::text::xid8, $3::bigint)
+		ORDER BY o.tx_id, o.id
+		LIMIT $4
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, aggregateType, strconv.FormatInt(after.Tx, 10), after.Sequence, limit)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		// The outbox columns are filled in when scan scans the row
+		var change feedChange
+		row := prefixedScanner{rowScanner: rows, prefix: []interface{}{
+			&change.position.Tx, &change.position.Sequence, &change.eventType, &change.occurredAt,
+		}}
+		if err := scan(&change, row); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// prefixedScanner scans leading columns into prefix before the destinations
+// of its caller.
+type prefixedScanner struct {
+	rowScanner
+	prefix []interface{}
+}
+
+func (s prefixedScanner) Scan(dest ...interface{}) error {
+	return s.rowScanner.Scan(append(s.prefix, dest...)...)
+}
+
+// qualifyColumns prefixes every column of a column list with alias.
+func qualifyColumns(alias, columns string) string {
+	parts := strings.Split(columns, ",")
+	for i, column := range parts {
+		parts[i] = alias + "." + strings.TrimSpace(column)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// scanOutboxMessage scans a row selected with outboxColumns.
+func scanOutboxMessage(row rowScanner) (*event.Message, error) {
+	var (
+		msg       event.Message
+		eventType string
+		payload   []byte
+	)
+	if err := row.Scan(
+		&msg.Sequence, &msg.EventID, &eventType, &msg.AggregateType, &msg.AggregateCode,
+		&msg.OccurredAt, &payload, &msg.Attempts,
+	); err != nil {
+		return nil, err
+	}
+	msg.Type = event.Type(eventType)
+	msg.Payload = payload
+	return &msg, nil
+}
+
+// writeOutbox saves events to the outbox using db, normally the transaction
+// that saved the aggregate. The payload is the aggregate's row as saved,
+// selected from table by its key column.
+func writeOutbox(ctx context.Context, db execer, events []event.Event, table, keyColumn string) error {
+	// table and keyColumn are constants of the calling repository
+	query := `
+		INSERT INTO outbox_event (event_type, aggregate_type, aggregate_code, occurred_at, payload)
+		SELECT $1, $2, $3, $4, to_jsonb(t) FROM ` + table + ` t WHERE ` + keyColumn + ` = $3`
+
+	for _, e := range events {
+		if _, err := db.ExecContext(ctx, query,
+			e.Type.String(), e.Type.Aggregate(), e.AggregateCode, e.OccurredAt,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
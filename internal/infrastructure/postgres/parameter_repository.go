@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/homindolenern/goapps-costing-v1/internal/domain/parameter"
@@ -26,54 +27,353 @@ var _ parameter.Repository = (*ParameterRepository)(nil)
 
 // Create persists a new Parameter.
 func (r *ParameterRepository) Create(ctx context.Context, entity *parameter.Parameter) error {
-	// Convert allowed_values to JSONB
-	var allowedValuesJSON []byte
-	var err error
-	if len(entity.AllowedValues()) > 0 {
-		allowedValuesJSON, err = json.Marshal(entity.AllowedValues())
+	return r.db.WithTx(ctx, func(tx *sql.Tx) error {
+		return createParameter(ctx, tx, entity)
+	})
+}
+
+// GetByCode retrieves a live Parameter by its code.
+func (r *ParameterRepository) GetByCode(ctx context.Context, code parameter.Code) (*parameter.Parameter, error) {
+	return r.getByCode(ctx, code, false)
+}
+
+// GetByCodeIncludingDeleted retrieves a Parameter by its code, even if soft-deleted.
+func (r *ParameterRepository) GetByCodeIncludingDeleted(ctx context.Context, code parameter.Code) (*parameter.Parameter, error) {
+	return r.getByCode(ctx, code, true)
+}
+
+func (r *ParameterRepository) getByCode(ctx context.Context, code parameter.Code, includeDeleted bool) (*parameter.Parameter, error) {
+	query := `SELECT ` + parameterColumns + ` FROM mst_parameter WHERE parameter_code = $1`
+	if !includeDeleted {
+		query += ` AND deleted_at IS NULL`
+	}
+
+	entity, err := scanParameter(r.db.QueryRowContext(ctx, query, code.String()))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, parameter.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+// List retrieves Parameters with optional filtering.
+func (r *ParameterRepository) List(ctx context.Context, filter parameter.ListFilter) ([]*parameter.Parameter, int64, error) {
+	baseQuery, args := parameterListQuery(filter)
+	argIndex := len(args) + 1
+
+	// Count query
+	countQuery := `SELECT COUNT(*) ` + baseQuery
+	var total int64
+	err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&total)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Data query with pagination, by keyset when a cursor is given
+	dataQuery := `SELECT ` + parameterColumns + ` ` + baseQuery
+	if filter.After != nil {
+		keyset, keysetArgs := parameterKeyset(filter, argIndex)
+		dataQuery += keyset + parameterOrderBy(filter) + fmt.Sprintf(` LIMIT $%d`, argIndex+len(keysetArgs))
+		args = append(append(args, keysetArgs...), filter.Limit())
+	} else {
+		dataQuery += parameterOrderBy(filter) + fmt.Sprintf(` LIMIT $%d OFFSET $%d`, argIndex, argIndex+1)
+		args = append(args, filter.Limit(), filter.Offset())
+	}
+
+	rows, err := r.db.QueryContext(ctx, dataQuery, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var result []*parameter.Parameter
+	for rows.Next() {
+		entity, err := scanParameter(rows)
 		if err != nil {
-			return fmt.Errorf("failed to marshal allowed_values: %w", err)
+			return nil, 0, err
 		}
+		result = append(result, entity)
 	}
 
+	return result, total, rows.Err()
+}
+
+// ForEach calls fn for every Parameter matching the filter, in the filter's order.
+func (r *ParameterRepository) ForEach(ctx context.Context, filter parameter.ListFilter, fn func(*parameter.Parameter) error) error {
+	baseQuery, args := parameterListQuery(filter)
+	rows, err := r.db.QueryContext(ctx, `SELECT `+parameterColumns+` `+baseQuery+parameterOrderBy(filter), args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		entity, err := scanParameter(rows)
+		if err != nil {
+			return err
+		}
+		if err := fn(entity); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// parameterListQuery builds the FROM and WHERE clauses for a list filter.
+func parameterListQuery(filter parameter.ListFilter) (string, []interface{}) {
+	baseQuery := `FROM mst_parameter WHERE 1=1`
+	args := []interface{}{}
+	argIndex := 1
+
+	if !filter.IncludeDeleted {
+		baseQuery += ` AND deleted_at IS NULL`
+	}
+	if filter.Category != nil {
+		baseQuery += fmt.Sprintf(` AND parameter_category = $%d`, argIndex)
+		args = append(args, filter.Category.String())
+		argIndex++
+	}
+	if filter.IsActive != nil {
+		baseQuery += fmt.Sprintf(` AND is_active = $%d`, argIndex)
+		args = append(args, *filter.IsActive)
+		argIndex++
+	}
+	if filter.Search != "" {
+		// Matches the expression of idx_mst_parameter_search so the trigram index is used
+		baseQuery += fmt.Sprintf(` AND `+parameterSearchExpr+` ILIKE $%d`, argIndex)
+		args = append(args, "%"+escapeLike(filter.Search)+"%")
+		argIndex++
+	}
+	if filter.DataType != nil {
+		baseQuery += fmt.Sprintf(` AND data_type = $%d`, argIndex)
+		args = append(args, filter.DataType.String())
+		argIndex++
+	}
+	if filter.UOM != nil {
+		baseQuery += fmt.Sprintf(` AND uom = $%d`, argIndex)
+		args = append(args, *filter.UOM)
+		argIndex++
+	}
+	if filter.IsMandatory != nil {
+		baseQuery += fmt.Sprintf(` AND is_mandatory = $%d`, argIndex)
+		args = append(args, *filter.IsMandatory)
+		argIndex++
+	}
+	if filter.CreatedBy != nil {
+		baseQuery += fmt.Sprintf(` AND created_by = $%d`, argIndex)
+		args = append(args, *filter.CreatedBy)
+		argIndex++
+	}
+	for _, bound := range []struct {
+		cond  string
+		value *time.Time
+	}{
+		{`created_at >= $%d`, filter.CreatedFrom},
+		{`created_at < $%d`, filter.CreatedTo},
+		{`updated_at >= $%d`, filter.UpdatedFrom},
+		{`updated_at < $%d`, filter.UpdatedTo},
+	} {
+		if bound.value != nil {
+			baseQuery += fmt.Sprintf(` AND `+bound.cond, argIndex)
+			args = append(args, *bound.value)
+			argIndex++
+		}
+	}
+	return baseQuery, args
+}
+
+// parameterSearchExpr is the text searched by ListFilter.Search.
+const parameterSearchExpr = `(parameter_code || ' ' || parameter_name || ' ' || COALESCE(description, ''))`
+
+// parameterSortColumns maps sort fields to their columns.
+var parameterSortColumns = map[parameter.SortField]string{
+	parameter.SortByCode:      "parameter_code",
+	parameter.SortByName:      "parameter_name",
+	parameter.SortByCategory:  "parameter_category",
+	parameter.SortByDataType:  "data_type",
+	parameter.SortByCreatedAt: "created_at",
+	parameter.SortByUpdatedAt: "updated_at",
+}
+
+// parameterOrderBy builds the ORDER BY clause for a list filter. Never
+// updated rows sort last either way, and the code breaks ties so pages
+// are stable.
+func parameterOrderBy(filter parameter.ListFilter) string {
+	column := parameterSortColumn(filter.SortBy)
+	direction := "ASC"
+	if filter.SortDesc {
+		direction = "DESC"
+	}
+	if column == "parameter_code" {
+		return ` ORDER BY parameter_code ` + direction
+	}
+	return ` ORDER BY ` + column + ` ` + direction + ` NULLS LAST, parameter_code ` + direction
+}
+
+// parameterKeyset builds the condition selecting the rows after
+// filter.After in the order of parameterOrderBy. argIndex is the number of
+// the first placeholder.
+func parameterKeyset(filter parameter.ListFilter, argIndex int) (string, []interface{}) {
+	after := filter.After
+	column := parameterSortColumn(filter.SortBy)
+	cmp := ">"
+	if filter.SortDesc {
+		cmp = "<"
+	}
+
+	switch {
+	case column == "parameter_code":
+		return fmt.Sprintf(` AND parameter_code %s $%d`, cmp, argIndex), []interface{}{after.Code.String()}
+	case after.SortValue == nil:
+		// NULLs sort last, so only NULL rows with a later code remain
+		return fmt.Sprintf(` AND %s IS NULL AND parameter_code %s $%d`, column, cmp, argIndex),
+			[]interface{}{after.Code.String()}
+	default:
+		return fmt.Sprintf(` AND (%[1]s %[2]s $%[3]d OR (%[1]s = $%[3]d AND parameter_code %[2]s $%[4]d) OR %[1]s IS NULL)`,
+				column, cmp, argIndex, argIndex+1),
+			[]interface{}{*after.SortValue, after.Code.String()}
+	}
+}
+
+func parameterSortColumn(field parameter.SortField) string {
+	if column, ok := parameterSortColumns[field]; ok {
+		return column
+	}
+	return "parameter_code"
+}
+
+// escapeLike escapes the LIKE wildcards in s so it matches literally.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+// Update persists changes to an existing Parameter.
+func (r *ParameterRepository) Update(ctx context.Context, entity *parameter.Parameter) error {
+	return r.db.WithTx(ctx, func(tx *sql.Tx) error {
+		return updateParameter(ctx, tx, entity)
+	})
+}
+
+// SaveBatch creates or updates Parameters in a single transaction.
+func (r *ParameterRepository) SaveBatch(ctx context.Context, entries []parameter.BatchEntry, atomic bool) ([]error, error) {
+	return r.db.saveBatch(ctx, len(entries), atomic, func(tx *sql.Tx, i int) error {
+		if entries[i].IsNew {
+			return createParameter(ctx, tx, entries[i].Parameter)
+		}
+		return updateParameter(ctx, tx, entries[i].Parameter)
+	})
+}
+
+// IsInUse checks if parameter values or machine type templates use the Parameter.
+func (r *ParameterRepository) IsInUse(ctx context.Context, code parameter.Code) (bool, error) {
 	query := `
-		INSERT INTO mst_parameter (
-			parameter_code, parameter_name, parameter_category, data_type,
-			uom, min_value, max_value, allowed_values, is_mandatory,
-			description, is_active, created_at, created_by
-		)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		SELECT EXISTS(SELECT 1 FROM mst_parameter_value WHERE parameter_code = $1)
+		    OR EXISTS(SELECT 1 FROM mst_machine_type_parameter WHERE parameter_code = $1)
 	`
 
-	_, err = r.db.ExecContext(ctx, query,
-		entity.Code().String(),
-		entity.Name(),
-		entity.Category().String(),
-		entity.DataType().String(),
-		entity.UOM(),
-		entity.MinValue(),
-		entity.MaxValue(),
-		allowedValuesJSON,
-		entity.IsMandatory(),
-		entity.Description(),
-		entity.IsActive(),
-		entity.CreatedAt(),
-		entity.CreatedBy(),
-	)
+	var inUse bool
+	err := r.db.QueryRowContext(ctx, query, code.String()).Scan(&inUse)
+	return inUse, err
+}
 
-	return err
+// parameterVersionColumns lists the mst_parameter_version columns read by
+// scanParameterVersion, in order.
+const parameterVersionColumns = `effective_from, effective_to, recorded_by, ` + parameterColumns
+
+// GetVersionAsOf retrieves the version of a Parameter in force at asOf.
+func (r *ParameterRepository) GetVersionAsOf(ctx context.Context, code parameter.Code, asOf time.Time) (*parameter.Version, error) {
+	query := `
+		SELECT ` + parameterVersionColumns + ` FROM mst_parameter_version
+		WHERE parameter_code = $1 AND effective_from <= $2 AND (effective_to IS NULL OR effective_to > $2)
+		  AND deleted_at IS NULL
+	`
+
+	version, err := scanParameterVersion(r.db.QueryRowContext(ctx, query, code.String(), asOf))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, parameter.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return version, nil
 }
 
-// GetByCode retrieves a Parameter by its code.
-func (r *ParameterRepository) GetByCode(ctx context.Context, code parameter.Code) (*parameter.Parameter, error) {
+// ListVersions retrieves the versions of a Parameter, newest first.
+func (r *ParameterRepository) ListVersions(ctx context.Context, filter parameter.VersionFilter) ([]*parameter.Version, int64, error) {
+	var total int64
+	if err := r.db.QueryRowContext(ctx,
+		`SELECT COUNT(*) FROM mst_parameter_version WHERE parameter_code = $1`,
+		filter.Code.String(),
+	).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
 	query := `
-		SELECT parameter_code, parameter_name, parameter_category, data_type,
-		       uom, min_value, max_value, allowed_values, is_mandatory,
-		       description, is_active, created_at, created_by, updated_at, updated_by
-		FROM mst_parameter
+		SELECT ` + parameterVersionColumns + ` FROM mst_parameter_version
 		WHERE parameter_code = $1
+		ORDER BY version DESC
+		LIMIT $2 OFFSET $3
 	`
 
+	rows, err := r.db.QueryContext(ctx, query, filter.Code.String(), filter.Limit(), filter.Offset())
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var result []*parameter.Version
+	for rows.Next() {
+		version, err := scanParameterVersion(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		result = append(result, version)
+	}
+
+	return result, total, rows.Err()
+}
+
+// scanParameterVersion scans a row selected with parameterVersionColumns.
+func scanParameterVersion(row rowScanner) (*parameter.Version, error) {
+	var (
+		version     parameter.Version
+		effectiveTo sql.NullTime
+	)
+	entity, err := scanParameter(prefixedScanner{rowScanner: row, prefix: []interface{}{
+		&version.EffectiveFrom, &effectiveTo, &version.RecordedBy,
+	}})
+	if err != nil {
+		return nil, err
+	}
+
+	version.Parameter = entity
+	if effectiveTo.Valid {
+		version.EffectiveTo = &effectiveTo.Time
+	}
+	return &version, nil
+}
+
+// ExistsByCode checks if a Parameter with the given code exists, deleted or not.
+func (r *ParameterRepository) ExistsByCode(ctx context.Context, code parameter.Code) (bool, error) {
+	query := `SELECT EXISTS(SELECT 1 FROM mst_parameter WHERE parameter_code = $1)`
+
+	var exists bool
+	err := r.db.QueryRowContext(ctx, query, code.String()).Scan(&exists)
+	return exists, err
+}
+
+// parameterColumns lists the mst_parameter columns read by scanParameter, in order.
+const parameterColumns = `parameter_code, parameter_name, parameter_category, data_type,
+	uom, min_value, max_value, allowed_values, is_mandatory,
+	description, is_active, created_at, created_by, updated_at, updated_by,
+	deleted_at, deleted_by, version`
+
+// scanParameter scans a row selected with parameterColumns into a Parameter.
+func scanParameter(row rowScanner) (*parameter.Parameter, error) {
 	var (
 		paramCode        string
 		paramName        string
@@ -90,9 +390,12 @@ func (r *ParameterRepository) GetByCode(ctx context.Context, code parameter.Code
 		createdBy        string
 		updatedAt        sql.NullTime
 		updatedBy        sql.NullString
+		deletedAt        sql.NullTime
+		deletedBy        sql.NullString
+		version          int
 	)
 
-	err := r.db.QueryRowContext(ctx, query, code.String()).Scan(
+	if err := row.Scan(
 		&paramCode,
 		&paramName,
 		&paramCategory,
@@ -108,12 +411,10 @@ func (r *ParameterRepository) GetByCode(ctx context.Context, code parameter.Code
 		&createdBy,
 		&updatedAt,
 		&updatedBy,
-	)
-
-	if errors.Is(err, sql.ErrNoRows) {
-		return nil, parameter.ErrNotFound
-	}
-	if err != nil {
+		&deletedAt,
+		&deletedBy,
+		&version,
+	); err != nil {
 		return nil, err
 	}
 
@@ -131,9 +432,9 @@ func (r *ParameterRepository) GetByCode(ctx context.Context, code parameter.Code
 	dataTypeVO, _ := parameter.NewDataType(dataType)
 
 	// Handle nullable fields
-	var uomPtr, descPtr, updatedByPtr *string
+	var uomPtr, descPtr, updatedByPtr, deletedByPtr *string
 	var minPtr, maxPtr *float64
-	var updatedAtPtr *time.Time
+	var updatedAtPtr, deletedAtPtr *time.Time
 
 	if uom.Valid {
 		uomPtr = &uom.String
@@ -153,6 +454,12 @@ func (r *ParameterRepository) GetByCode(ctx context.Context, code parameter.Code
 	if updatedBy.Valid {
 		updatedByPtr = &updatedBy.String
 	}
+	if deletedAt.Valid {
+		deletedAtPtr = &deletedAt.Time
+	}
+	if deletedBy.Valid {
+		deletedByPtr = &deletedBy.String
+	}
 
 	return parameter.Reconstitute(
 		codeVO,
@@ -170,146 +477,63 @@ func (r *ParameterRepository) GetByCode(ctx context.Context, code parameter.Code
 		createdBy,
 		updatedAtPtr,
 		updatedByPtr,
+		deletedAtPtr,
+		deletedByPtr,
+		version,
 	), nil
 }
 
-// List retrieves Parameters with optional filtering.
-func (r *ParameterRepository) List(ctx context.Context, filter parameter.ListFilter) ([]*parameter.Parameter, int64, error) {
-	// Base query
-	baseQuery := `FROM mst_parameter WHERE 1=1`
-	args := []interface{}{}
-	argIndex := 1
-
-	// Apply filters
-	if filter.Category != nil {
-		baseQuery += fmt.Sprintf(` AND parameter_category = $%d`, argIndex)
-		args = append(args, filter.Category.String())
-		argIndex++
-	}
-	if filter.IsActive != nil {
-		baseQuery += fmt.Sprintf(` AND is_active = $%d`, argIndex)
-		args = append(args, *filter.IsActive)
-		argIndex++
-	}
-
-	// Count query
-	countQuery := `SELECT COUNT(*) ` + baseQuery
-	var total int64
-	err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&total)
-	if err != nil {
-		return nil, 0, err
+// createParameter inserts a new Parameter and its pending events using a
+// transaction.
+func createParameter(ctx context.Context, db execer, entity *parameter.Parameter) error {
+	// Convert allowed_values to JSONB
+	var allowedValuesJSON []byte
+	var err error
+	if len(entity.AllowedValues()) > 0 {
+		allowedValuesJSON, err = json.Marshal(entity.AllowedValues())
+		if err != nil {
+			return fmt.Errorf("failed to marshal allowed_values: %w", err)
+		}
 	}
 
-	// Data query with pagination
-	dataQuery := `SELECT parameter_code, parameter_name, parameter_category, data_type,
-	              uom, min_value, max_value, allowed_values, is_mandatory,
-	              description, is_active, created_at, created_by, updated_at, updated_by ` + baseQuery +
-		fmt.Sprintf(` ORDER BY parameter_code LIMIT $%d OFFSET $%d`, argIndex, argIndex+1)
-	args = append(args, filter.Limit(), filter.Offset())
+	query := `
+		INSERT INTO mst_parameter (
+			parameter_code, parameter_name, parameter_category, data_type,
+			uom, min_value, max_value, allowed_values, is_mandatory,
+			description, is_active, created_at, created_by
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+	`
 
-	rows, err := r.db.QueryContext(ctx, dataQuery, args...)
+	_, err = db.ExecContext(ctx, query,
+		entity.Code().String(),
+		entity.Name(),
+		entity.Category().String(),
+		entity.DataType().String(),
+		entity.UOM(),
+		entity.MinValue(),
+		entity.MaxValue(),
+		allowedValuesJSON,
+		entity.IsMandatory(),
+		entity.Description(),
+		entity.IsActive(),
+		entity.CreatedAt(),
+		entity.CreatedBy(),
+	)
 	if err != nil {
-		return nil, 0, err
+		return err
 	}
-	defer rows.Close()
-
-	var result []*parameter.Parameter
-	for rows.Next() {
-		var (
-			paramCode        string
-			paramName        string
-			paramCategory    string
-			dataType         string
-			uom              sql.NullString
-			minValue         sql.NullFloat64
-			maxValue         sql.NullFloat64
-			allowedValuesRaw []byte
-			isMandatory      bool
-			description      sql.NullString
-			isActive         bool
-			createdAt        time.Time
-			createdBy        string
-			updatedAt        sql.NullTime
-			updatedBy        sql.NullString
-		)
-
-		if err := rows.Scan(
-			&paramCode,
-			&paramName,
-			&paramCategory,
-			&dataType,
-			&uom,
-			&minValue,
-			&maxValue,
-			&allowedValuesRaw,
-			&isMandatory,
-			&description,
-			&isActive,
-			&createdAt,
-			&createdBy,
-			&updatedAt,
-			&updatedBy,
-		); err != nil {
-			return nil, 0, err
-		}
-
-		var allowedValues []string
-		if len(allowedValuesRaw) > 0 {
-			json.Unmarshal(allowedValuesRaw, &allowedValues)
-		}
-
-		codeVO, _ := parameter.NewParameterCode(paramCode)
-		categoryVO, _ := parameter.NewCategory(paramCategory)
-		dataTypeVO, _ := parameter.NewDataType(dataType)
-
-		var uomPtr, descPtr, updatedByPtr *string
-		var minPtr, maxPtr *float64
-		var updatedAtPtr *time.Time
-
-		if uom.Valid {
-			uomPtr = &uom.String
-		}
-		if minValue.Valid {
-			minPtr = &minValue.Float64
-		}
-		if maxValue.Valid {
-			maxPtr = &maxValue.Float64
-		}
-		if description.Valid {
-			descPtr = &description.String
-		}
-		if updatedAt.Valid {
-			updatedAtPtr = &updatedAt.Time
-		}
-		if updatedBy.Valid {
-			updatedByPtr = &updatedBy.String
-		}
 
-		entity := parameter.Reconstitute(
-			codeVO,
-			paramName,
-			categoryVO,
-			dataTypeVO,
-			uomPtr,
-			minPtr,
-			maxPtr,
-			allowedValues,
-			isMandatory,
-			descPtr,
-			isActive,
-			createdAt,
-			createdBy,
-			updatedAtPtr,
-			updatedByPtr,
-		)
-		result = append(result, entity)
+	if err := writeParameterVersion(ctx, db, entity.Code(), entity.CreatedBy()); err != nil {
+		return err
 	}
-
-	return result, total, rows.Err()
+	return writeOutbox(ctx, db, entity.PullEvents(), "mst_parameter", "parameter_code")
 }
 
-// Update persists changes to an existing Parameter.
-func (r *ParameterRepository) Update(ctx context.Context, entity *parameter.Parameter) error {
+// updateParameter persists changes to an existing Parameter and its pending
+// events using a transaction. The row is only written if it is still at the
+// entity's version.
+func updateParameter(ctx context.Context, db queryer, entity *parameter.Parameter) error {
 	var allowedValuesJSON []byte
 	var err error
 	if len(entity.AllowedValues()) > 0 {
@@ -324,11 +548,12 @@ func (r *ParameterRepository) Update(ctx context.Context, entity *parameter.Para
 		SET parameter_name = $2, parameter_category = $3, data_type = $4,
 		    uom = $5, min_value = $6, max_value = $7, allowed_values = $8,
 		    is_mandatory = $9, description = $10, is_active = $11,
-		    updated_at = $12, updated_by = $13
-		WHERE parameter_code = $1
+		    updated_at = $12, updated_by = $13, deleted_at = $14, deleted_by = $15,
+		    version = version + 1
+		WHERE parameter_code = $1 AND version = $16
 	`
 
-	result, err := r.db.ExecContext(ctx, query,
+	result, err := db.ExecContext(ctx, query,
 		entity.Code().String(),
 		entity.Name(),
 		entity.Category().String(),
@@ -342,6 +567,9 @@ func (r *ParameterRepository) Update(ctx context.Context, entity *parameter.Para
 		entity.IsActive(),
 		entity.UpdatedAt(),
 		entity.UpdatedBy(),
+		entity.DeletedAt(),
+		entity.DeletedBy(),
+		entity.Version(),
 	)
 	if err != nil {
 		return err
@@ -352,37 +580,52 @@ func (r *ParameterRepository) Update(ctx context.Context, entity *parameter.Para
 		return err
 	}
 	if rowsAffected == 0 {
+		// Tell a missing row apart from one changed since it was read
+		var exists bool
+		if err := db.QueryRowContext(ctx,
+			`SELECT EXISTS(SELECT 1 FROM mst_parameter WHERE parameter_code = $1)`,
+			entity.Code().String(),
+		).Scan(&exists); err != nil {
+			return err
+		}
+		if exists {
+			return parameter.ErrVersionConflict
+		}
 		return parameter.ErrNotFound
 	}
 
-	return nil
-}
-
-// Delete removes a Parameter by its code.
-func (r *ParameterRepository) Delete(ctx context.Context, code parameter.Code) error {
-	query := `DELETE FROM mst_parameter WHERE parameter_code = $1`
-
-	result, err := r.db.ExecContext(ctx, query, code.String())
-	if err != nil {
+	entity.IncrementVersion()
+	if err := writeParameterVersion(ctx, db, entity.Code(), lastModifiedBy(entity)); err != nil {
 		return err
 	}
+	return writeOutbox(ctx, db, entity.PullEvents(), "mst_parameter", "parameter_code")
+}
 
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
+// writeParameterVersion closes the version of a Parameter in force and copies
+// its saved row as the next, effective from the start of the transaction.
+func writeParameterVersion(ctx context.Context, db execer, code parameter.Code, recordedBy string) error {
+	if _, err := db.ExecContext(ctx,
+		`UPDATE mst_parameter_version SET effective_to = NOW() WHERE parameter_code = $1 AND effective_to IS NULL`,
+		code.String(),
+	); err != nil {
 		return err
 	}
-	if rowsAffected == 0 {
-		return parameter.ErrNotFound
-	}
 
-	return nil
+	query := `
+		INSERT INTO mst_parameter_version (` + parameterColumns + `, effective_from, recorded_by)
+		SELECT ` + parameterColumns + `, NOW(), $2 FROM mst_parameter WHERE parameter_code = $1
+	`
+	_, err := db.ExecContext(ctx, query, code.String(), recordedBy)
+	return err
 }
 
-// ExistsByCode checks if a Parameter with the given code exists.
-func (r *ParameterRepository) ExistsByCode(ctx context.Context, code parameter.Code) (bool, error) {
-	query := `SELECT EXISTS(SELECT 1 FROM mst_parameter WHERE parameter_code = $1)`
-
-	var exists bool
-	err := r.db.QueryRowContext(ctx, query, code.String()).Scan(&exists)
-	return exists, err
+// lastModifiedBy returns who made the latest change of entity.
+func lastModifiedBy(entity *parameter.Parameter) string {
+	if entity.DeletedBy() != nil {
+		return *entity.DeletedBy()
+	}
+	if entity.UpdatedBy() != nil {
+		return *entity.UpdatedBy()
+	}
+	return entity.CreatedBy()
 }
@@ -0,0 +1,381 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/parameter"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/parametervalue"
+)
+
+// ParameterValueRepository implements parametervalue.Repository interface.
+type ParameterValueRepository struct {
+	db *DB
+}
+
+// NewParameterValueRepository creates a new ParameterValue repository.
+func NewParameterValueRepository(db *DB) *ParameterValueRepository {
+	return &ParameterValueRepository{db: db}
+}
+
+// Verify interface implementation at compile time.
+var _ parametervalue.Repository = (*ParameterValueRepository)(nil)
+
+// parameterValueEffectiveKey is the unique constraint on the start of a period.
+const parameterValueEffectiveKey = "uq_mst_parameter_value_effective"
+
+// parameterValuePeriodKey is the exclusion constraint keeping periods apart.
+const parameterValuePeriodKey = "excl_mst_parameter_value_period"
+
+// parameterValueColumns lists the columns read by scanParameterValue.
+// The data type comes from the parameter definition.
+const parameterValueColumns = `v.id, v.subject_type, v.subject_code, v.parameter_code, p.data_type,
+	v.value, v.numeric_value, v.effective_from, v.effective_to, v.remarks,
+	v.created_at, v.created_by, v.updated_at, v.updated_by`
+
+// parameterValueFrom joins values with their parameter definition.
+const parameterValueFrom = ` FROM mst_parameter_value v JOIN mst_parameter p ON p.parameter_code = v.parameter_code`
+
+// Create persists a new ParameterValue and assigns its ID.
+func (r *ParameterValueRepository) Create(ctx context.Context, entity *parametervalue.ParameterValue) error {
+	return insertParameterValue(ctx, r.db, entity)
+}
+
+// Supersede closes previous and persists next atomically.
+func (r *ParameterValueRepository) Supersede(ctx context.Context, previous, next *parametervalue.ParameterValue) error {
+	return r.db.WithTx(ctx, func(tx *sql.Tx) error {
+		if err := updateParameterValue(ctx, tx, previous); err != nil {
+			return err
+		}
+		return insertParameterValue(ctx, tx, next)
+	})
+}
+
+// GetByID retrieves a ParameterValue by its ID.
+func (r *ParameterValueRepository) GetByID(ctx context.Context, id int64) (*parametervalue.ParameterValue, error) {
+	query := `SELECT ` + parameterValueColumns + parameterValueFrom + ` WHERE v.id = $1`
+
+	entity, err := scanParameterValue(r.db.QueryRowContext(ctx, query, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, parametervalue.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+// List retrieves ParameterValues with optional filtering.
+func (r *ParameterValueRepository) List(
+	ctx context.Context,
+	filter parametervalue.ListFilter,
+) ([]*parametervalue.ParameterValue, int64, error) {
+	// Base query
+	baseQuery := parameterValueFrom + ` WHERE 1=1`
+	args := []interface{}{}
+	argIndex := 1
+
+	// Apply filters
+	if filter.SubjectType != nil {
+		baseQuery += fmt.Sprintf(` AND v.subject_type = $%d`, argIndex)
+		args = append(args, filter.SubjectType.String())
+		argIndex++
+	}
+	if filter.SubjectCode != nil {
+		baseQuery += fmt.Sprintf(` AND v.subject_code = $%d`, argIndex)
+		args = append(args, filter.SubjectCode.String())
+		argIndex++
+	}
+	if filter.ParameterCode != nil {
+		baseQuery += fmt.Sprintf(` AND v.parameter_code = $%d`, argIndex)
+		args = append(args, filter.ParameterCode.String())
+		argIndex++
+	}
+	if filter.AsOf != nil {
+		baseQuery += fmt.Sprintf(` AND v.effective_from <= $%d AND (v.effective_to IS NULL OR v.effective_to > $%d)`,
+			argIndex, argIndex)
+		args = append(args, formatDate(*filter.AsOf))
+		argIndex++
+	}
+
+	// Count query
+	countQuery := `SELECT COUNT(*) ` + baseQuery
+	var total int64
+	err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&total)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Data query with pagination
+	dataQuery := `SELECT ` + parameterValueColumns + baseQuery +
+		fmt.Sprintf(` ORDER BY v.subject_type, v.subject_code, v.parameter_code, v.effective_from LIMIT $%d OFFSET $%d`,
+			argIndex, argIndex+1)
+	args = append(args, filter.Limit(), filter.Offset())
+
+	rows, err := r.db.QueryContext(ctx, dataQuery, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var result []*parametervalue.ParameterValue
+	for rows.Next() {
+		entity, err := scanParameterValue(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		result = append(result, entity)
+	}
+
+	return result, total, rows.Err()
+}
+
+// ListBySubjectParameter retrieves every period recorded for a subject and parameter.
+func (r *ParameterValueRepository) ListBySubjectParameter(
+	ctx context.Context,
+	subjectType parametervalue.SubjectType,
+	subjectCode parametervalue.SubjectCode,
+	parameterCode parameter.Code,
+) ([]*parametervalue.ParameterValue, error) {
+	query := `SELECT ` + parameterValueColumns + parameterValueFrom + `
+		WHERE v.subject_type = $1 AND v.subject_code = $2 AND v.parameter_code = $3
+		ORDER BY v.effective_from`
+
+	rows, err := r.db.QueryContext(ctx, query, subjectType.String(), subjectCode.String(), parameterCode.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*parametervalue.ParameterValue
+	for rows.Next() {
+		entity, err := scanParameterValue(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, entity)
+	}
+
+	return result, rows.Err()
+}
+
+// LockSubjectParameter takes a transaction-scoped advisory lock on the
+// periods of a subject and parameter. Unlike SELECT ... FOR UPDATE it also
+// holds while no period exists yet.
+func (r *ParameterValueRepository) LockSubjectParameter(
+	ctx context.Context,
+	subjectType parametervalue.SubjectType,
+	subjectCode parametervalue.SubjectCode,
+	parameterCode parameter.Code,
+) error {
+	key := "mst_parameter_value:" + subjectType.String() + ":" + subjectCode.String() + ":" + parameterCode.String()
+	_, err := r.db.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtextextended($1, 0))`, key)
+	return err
+}
+
+// Update persists changes to an existing ParameterValue.
+func (r *ParameterValueRepository) Update(ctx context.Context, entity *parametervalue.ParameterValue) error {
+	return updateParameterValue(ctx, r.db, entity)
+}
+
+// Delete removes a ParameterValue by its ID.
+func (r *ParameterValueRepository) Delete(ctx context.Context, id int64) error {
+	query := `DELETE FROM mst_parameter_value WHERE id = $1`
+
+	result, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return parametervalue.ErrNotFound
+	}
+
+	return nil
+}
+
+// queryRower is implemented by both *sql.DB and *sql.Tx.
+type queryRower interface {
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// insertParameterValue inserts entity and assigns the generated ID.
+func insertParameterValue(ctx context.Context, q queryRower, entity *parametervalue.ParameterValue) error {
+	query := `
+		INSERT INTO mst_parameter_value (
+			subject_type, subject_code, parameter_code, value, numeric_value,
+			effective_from, effective_to, remarks, created_at, created_by
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING id
+	`
+
+	var id int64
+	err := q.QueryRowContext(ctx, query,
+		entity.SubjectType().String(),
+		entity.SubjectCode().String(),
+		entity.ParameterCode().String(),
+		entity.Value(),
+		entity.NumericValue(),
+		formatDate(entity.Period().From()),
+		formatDatePtr(entity.Period().To()),
+		entity.Remarks(),
+		entity.CreatedAt(),
+		entity.CreatedBy(),
+	).Scan(&id)
+	if isUniqueViolation(err, parameterValueEffectiveKey) {
+		return parametervalue.ErrAlreadyExists
+	}
+	if isExclusionViolation(err, parameterValuePeriodKey) {
+		return parametervalue.ErrPeriodOverlap
+	}
+	if err != nil {
+		return err
+	}
+
+	entity.AssignID(id)
+	return nil
+}
+
+// updateParameterValue writes the mutable columns of entity using ex.
+func updateParameterValue(ctx context.Context, ex execer, entity *parametervalue.ParameterValue) error {
+	query := `
+		UPDATE mst_parameter_value
+		SET value = $2, numeric_value = $3, effective_from = $4, effective_to = $5,
+		    remarks = $6, updated_at = $7, updated_by = $8
+		WHERE id = $1
+	`
+
+	result, err := ex.ExecContext(ctx, query,
+		entity.ID(),
+		entity.Value(),
+		entity.NumericValue(),
+		formatDate(entity.Period().From()),
+		formatDatePtr(entity.Period().To()),
+		entity.Remarks(),
+		entity.UpdatedAt(),
+		entity.UpdatedBy(),
+	)
+	if isUniqueViolation(err, parameterValueEffectiveKey) {
+		return parametervalue.ErrAlreadyExists
+	}
+	if isExclusionViolation(err, parameterValuePeriodKey) {
+		return parametervalue.ErrPeriodOverlap
+	}
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return parametervalue.ErrNotFound
+	}
+
+	return nil
+}
+
+// formatDate renders a DATE argument, avoiding time zone shifts of time.Time.
+func formatDate(t time.Time) string {
+	return t.Format(parametervalue.DateLayout)
+}
+
+// formatDatePtr renders a nullable DATE argument.
+func formatDatePtr(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	s := formatDate(*t)
+	return &s
+}
+
+// scanParameterValue reads a row selected with parameterValueColumns.
+func scanParameterValue(row rowScanner) (*parametervalue.ParameterValue, error) {
+	var (
+		id            int64
+		subjectType   string
+		subjectCode   string
+		paramCode     string
+		dataType      string
+		value         string
+		numericValue  sql.NullFloat64
+		effectiveFrom time.Time
+		effectiveTo   sql.NullTime
+		remarks       sql.NullString
+		createdAt     time.Time
+		createdBy     string
+		updatedAt     sql.NullTime
+		updatedBy     sql.NullString
+	)
+
+	if err := row.Scan(
+		&id,
+		&subjectType,
+		&subjectCode,
+		&paramCode,
+		&dataType,
+		&value,
+		&numericValue,
+		&effectiveFrom,
+		&effectiveTo,
+		&remarks,
+		&createdAt,
+		&createdBy,
+		&updatedAt,
+		&updatedBy,
+	); err != nil {
+		return nil, err
+	}
+
+	// Handle nullable fields
+	var numericPtr *float64
+	var effectiveToPtr, updatedAtPtr *time.Time
+	var remarksPtr, updatedByPtr *string
+
+	if numericValue.Valid {
+		numericPtr = &numericValue.Float64
+	}
+	if effectiveTo.Valid {
+		effectiveToPtr = &effectiveTo.Time
+	}
+	if remarks.Valid {
+		remarksPtr = &remarks.String
+	}
+	if updatedAt.Valid {
+		updatedAtPtr = &updatedAt.Time
+	}
+	if updatedBy.Valid {
+		updatedByPtr = &updatedBy.String
+	}
+
+	period, err := parametervalue.NewPeriod(effectiveFrom, effectiveToPtr)
+	if err != nil {
+		return nil, err
+	}
+
+	return parametervalue.Reconstitute(
+		id,
+		parametervalue.SubjectType(subjectType),
+		parametervalue.SubjectCode(subjectCode),
+		parameter.Code(paramCode),
+		parameter.DataType(dataType),
+		value,
+		numericPtr,
+		period,
+		remarksPtr,
+		createdAt,
+		createdBy,
+		updatedAtPtr,
+		updatedByPtr,
+	), nil
+}
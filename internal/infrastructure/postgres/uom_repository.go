@@ -24,54 +24,28 @@ var _ uom.Repository = (*UOMRepository)(nil)
 
 // Create persists a new UOM.
 func (r *UOMRepository) Create(ctx context.Context, entity *uom.UOM) error {
-	query := `
-		INSERT INTO mst_uom (uom_code, uom_name, uom_category, is_base_uom, created_at, created_by)
-		VALUES ($1, $2, $3, $4, $5, $6)
-	`
-
-	_, err := r.db.ExecContext(ctx, query,
-		entity.Code().String(),
-		entity.Name(),
-		entity.Category().String(),
-		entity.IsBaseUOM(),
-		entity.CreatedAt(),
-		entity.CreatedBy(),
-	)
-
-	return err
+	return r.db.WithTx(ctx, func(tx *sql.Tx) error {
+		return createUOM(ctx, tx, entity)
+	})
 }
 
-// GetByCode retrieves a UOM by its code.
+// GetByCode retrieves a live UOM by its code.
 func (r *UOMRepository) GetByCode(ctx context.Context, code uom.Code) (*uom.UOM, error) {
-	query := `
-		SELECT uom_code, uom_name, uom_category, is_base_uom, 
-		       created_at, created_by, updated_at, updated_by
-		FROM mst_uom
-		WHERE uom_code = $1
-	`
+	return r.getByCode(ctx, code, false)
+}
 
-	var (
-		uomCode     string
-		uomName     string
-		uomCategory string
-		isBaseUOM   bool
-		createdAt   time.Time
-		createdBy   string
-		updatedAt   sql.NullTime
-		updatedBy   sql.NullString
-	)
+// GetByCodeIncludingDeleted retrieves a UOM by its code, even if soft-deleted.
+func (r *UOMRepository) GetByCodeIncludingDeleted(ctx context.Context, code uom.Code) (*uom.UOM, error) {
+	return r.getByCode(ctx, code, true)
+}
 
-	err := r.db.QueryRowContext(ctx, query, code.String()).Scan(
-		&uomCode,
-		&uomName,
-		&uomCategory,
-		&isBaseUOM,
-		&createdAt,
-		&createdBy,
-		&updatedAt,
-		&updatedBy,
-	)
+func (r *UOMRepository) getByCode(ctx context.Context, code uom.Code, includeDeleted bool) (*uom.UOM, error) {
+	query := `SELECT ` + uomColumns + ` FROM mst_uom WHERE uom_code = $1`
+	if !includeDeleted {
+		query += ` AND deleted_at IS NULL`
+	}
 
+	entity, err := scanUOM(r.db.QueryRowContext(ctx, query, code.String()))
 	if errors.Is(err, sql.ErrNoRows) {
 		return nil, uom.ErrNotFound
 	}
@@ -79,45 +53,13 @@ func (r *UOMRepository) GetByCode(ctx context.Context, code uom.Code) (*uom.UOM,
 		return nil, err
 	}
 
-	// Create value objects
-	uomCodeVO, _ := uom.NewUOMCode(uomCode)
-	categoryVO, _ := uom.NewCategory(uomCategory)
-
-	// Handle nullable fields
-	var updatedAtPtr *time.Time
-	var updatedByPtr *string
-	if updatedAt.Valid {
-		updatedAtPtr = &updatedAt.Time
-	}
-	if updatedBy.Valid {
-		updatedByPtr = &updatedBy.String
-	}
-
-	return uom.Reconstitute(
-		uomCodeVO,
-		uomName,
-		categoryVO,
-		isBaseUOM,
-		createdAt,
-		createdBy,
-		updatedAtPtr,
-		updatedByPtr,
-	), nil
+	return entity, nil
 }
 
 // List retrieves UOMs with optional filtering.
 func (r *UOMRepository) List(ctx context.Context, filter uom.ListFilter) ([]*uom.UOM, int64, error) {
-	// Base query
-	baseQuery := `FROM mst_uom WHERE 1=1`
-	args := []interface{}{}
-	argIndex := 1
-
-	// Apply category filter
-	if filter.Category != nil {
-		baseQuery += ` AND uom_category = $` + itoa(argIndex)
-		args = append(args, filter.Category.String())
-		argIndex++
-	}
+	baseQuery, args := uomListQuery(filter)
+	argIndex := len(args) + 1
 
 	// Count query
 	countQuery := `SELECT COUNT(*) ` + baseQuery
@@ -127,11 +69,15 @@ func (r *UOMRepository) List(ctx context.Context, filter uom.ListFilter) ([]*uom
 		return nil, 0, err
 	}
 
-	// Data query with pagination
-	dataQuery := `SELECT uom_code, uom_name, uom_category, is_base_uom, 
-	              created_at, created_by, updated_at, updated_by ` + baseQuery +
-		` ORDER BY uom_code LIMIT $` + itoa(argIndex) + ` OFFSET $` + itoa(argIndex+1)
-	args = append(args, filter.Limit(), filter.Offset())
+	// Data query with pagination, by keyset when a cursor is given
+	dataQuery := `SELECT ` + uomColumns + ` ` + baseQuery
+	if filter.After != nil {
+		dataQuery += ` AND uom_code > $` + itoa(argIndex) + ` ORDER BY uom_code LIMIT $` + itoa(argIndex+1)
+		args = append(args, filter.After.String(), filter.Limit())
+	} else {
+		dataQuery += ` ORDER BY uom_code LIMIT $` + itoa(argIndex) + ` OFFSET $` + itoa(argIndex+1)
+		args = append(args, filter.Limit(), filter.Offset())
+	}
 
 	rows, err := r.db.QueryContext(ctx, dataQuery, args...)
 	if err != nil {
@@ -140,76 +86,377 @@ func (r *UOMRepository) List(ctx context.Context, filter uom.ListFilter) ([]*uom
 	defer rows.Close()
 
 	var result []*uom.UOM
+	for rows.Next() {
+		entity, err := scanUOM(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		result = append(result, entity)
+	}
+
+	return result, total, rows.Err()
+}
+
+// ForEach calls fn for every UOM matching the filter, in code order.
+func (r *UOMRepository) ForEach(ctx context.Context, filter uom.ListFilter, fn func(*uom.UOM) error) error {
+	baseQuery, args := uomListQuery(filter)
+	rows, err := r.db.QueryContext(ctx, `SELECT `+uomColumns+` `+baseQuery+` ORDER BY uom_code`, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		entity, err := scanUOM(rows)
+		if err != nil {
+			return err
+		}
+		if err := fn(entity); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// uomListQuery builds the FROM and WHERE clauses for a list filter.
+func uomListQuery(filter uom.ListFilter) (string, []interface{}) {
+	baseQuery := `FROM mst_uom WHERE 1=1`
+	args := []interface{}{}
+
+	if !filter.IncludeDeleted {
+		baseQuery += ` AND deleted_at IS NULL`
+	}
+	if filter.Category != nil {
+		baseQuery += ` AND uom_category = $` + itoa(len(args)+1)
+		args = append(args, filter.Category.String())
+	}
+	return baseQuery, args
+}
+
+// Update persists changes to an existing UOM.
+func (r *UOMRepository) Update(ctx context.Context, entity *uom.UOM) error {
+	return r.db.WithTx(ctx, func(tx *sql.Tx) error {
+		return updateUOM(ctx, tx, entity)
+	})
+}
+
+// ExistsByCode checks if a UOM with the given code exists, deleted or not.
+func (r *UOMRepository) ExistsByCode(ctx context.Context, code uom.Code) (bool, error) {
+	query := `SELECT EXISTS(SELECT 1 FROM mst_uom WHERE uom_code = $1)`
+
+	var exists bool
+	err := r.db.QueryRowContext(ctx, query, code.String()).Scan(&exists)
+	return exists, err
+}
+
+// HasActiveParameters checks if active, non-deleted parameters use the UOM.
+func (r *UOMRepository) HasActiveParameters(ctx context.Context, code uom.Code) (bool, error) {
+	query := `
+		SELECT EXISTS(
+			SELECT 1 FROM mst_parameter
+			WHERE uom = $1 AND is_active AND deleted_at IS NULL
+		)
+	`
+
+	var exists bool
+	err := r.db.QueryRowContext(ctx, query, code.String()).Scan(&exists)
+	return exists, err
+}
+
+// ListByCategory retrieves every UOM of a category, including soft-deleted ones.
+func (r *UOMRepository) ListByCategory(ctx context.Context, category uom.Category) ([]*uom.UOM, error) {
+	query := `SELECT ` + uomColumns + ` FROM mst_uom WHERE uom_category = $1 ORDER BY uom_code`
+
+	rows, err := r.db.QueryContext(ctx, query, category.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*uom.UOM
+	for rows.Next() {
+		entity, err := scanUOM(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, entity)
+	}
+
+	return result, rows.Err()
+}
+
+// GetBaseByCategory retrieves the base UOM of a category.
+func (r *UOMRepository) GetBaseByCategory(ctx context.Context, category uom.Category) (*uom.UOM, error) {
+	query := `SELECT ` + uomColumns + ` FROM mst_uom WHERE uom_category = $1 AND is_base_uom AND deleted_at IS NULL`
+
+	entity, err := scanUOM(r.db.QueryRowContext(ctx, query, category.String()))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, uom.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+// UpdateBase persists a base UOM change atomically. The other units are
+// saved first so the previous base is demoted before the new one is promoted,
+// keeping the one-base-per-category index satisfied at every statement.
+func (r *UOMRepository) UpdateBase(ctx context.Context, base *uom.UOM, others []*uom.UOM) error {
+	return r.db.WithTx(ctx, func(tx *sql.Tx) error {
+		// Lock the category so concurrent promotions serialize
+		if _, err := tx.ExecContext(ctx,
+			`SELECT 1 FROM mst_uom WHERE uom_category = $1 FOR UPDATE`,
+			base.Category().String(),
+		); err != nil {
+			return err
+		}
+
+		for _, entity := range others {
+			if err := updateUOM(ctx, tx, entity); err != nil {
+				return err
+			}
+		}
+		return updateUOM(ctx, tx, base)
+	})
+}
+
+// SaveBatch creates or updates UOMs in a single transaction.
+func (r *UOMRepository) SaveBatch(ctx context.Context, entries []uom.BatchEntry, atomic bool) ([]error, error) {
+	return r.db.saveBatch(ctx, len(entries), atomic, func(tx *sql.Tx, i int) error {
+		if entries[i].IsNew {
+			return createUOM(ctx, tx, entries[i].UOM)
+		}
+		return updateUOM(ctx, tx, entries[i].UOM)
+	})
+}
+
+// CreateConversion persists a new explicit conversion.
+func (r *UOMRepository) CreateConversion(ctx context.Context, conversion *uom.Conversion) error {
+	query := `
+		INSERT INTO mst_uom_conversion (from_uom_code, to_uom_code, factor, description, created_at, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		conversion.FromUOM().String(),
+		conversion.ToUOM().String(),
+		conversion.Factor(),
+		conversion.Description(),
+		conversion.CreatedAt(),
+		conversion.CreatedBy(),
+	)
+
+	return err
+}
+
+// ListConversions retrieves explicit conversions with optional filtering.
+func (r *UOMRepository) ListConversions(ctx context.Context, filter uom.ConversionFilter) ([]*uom.Conversion, error) {
+	query := `
+		SELECT from_uom_code, to_uom_code, factor, description, created_at, created_by
+		FROM mst_uom_conversion
+		WHERE 1=1`
+	args := []interface{}{}
+
+	if filter.UOMCode != nil {
+		query += ` AND (from_uom_code = $1 OR to_uom_code = $1)`
+		args = append(args, filter.UOMCode.String())
+	}
+	query += ` ORDER BY from_uom_code, to_uom_code`
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*uom.Conversion
 	for rows.Next() {
 		var (
-			uomCode     string
-			uomName     string
-			uomCategory string
-			isBaseUOM   bool
+			fromCode    string
+			toCode      string
+			factor      float64
+			description sql.NullString
 			createdAt   time.Time
 			createdBy   string
-			updatedAt   sql.NullTime
-			updatedBy   sql.NullString
 		)
 
 		if err := rows.Scan(
-			&uomCode,
-			&uomName,
-			&uomCategory,
-			&isBaseUOM,
+			&fromCode,
+			&toCode,
+			&factor,
+			&description,
 			&createdAt,
 			&createdBy,
-			&updatedAt,
-			&updatedBy,
 		); err != nil {
-			return nil, 0, err
+			return nil, err
 		}
 
-		uomCodeVO, _ := uom.NewUOMCode(uomCode)
-		categoryVO, _ := uom.NewCategory(uomCategory)
+		fromVO, _ := uom.NewUOMCode(fromCode)
+		toVO, _ := uom.NewUOMCode(toCode)
 
-		var updatedAtPtr *time.Time
-		var updatedByPtr *string
-		if updatedAt.Valid {
-			updatedAtPtr = &updatedAt.Time
-		}
-		if updatedBy.Valid {
-			updatedByPtr = &updatedBy.String
+		var descPtr *string
+		if description.Valid {
+			descPtr = &description.String
 		}
 
-		entity := uom.Reconstitute(
-			uomCodeVO,
-			uomName,
-			categoryVO,
-			isBaseUOM,
+		result = append(result, uom.ReconstituteConversion(
+			fromVO,
+			toVO,
+			factor,
+			descPtr,
 			createdAt,
 			createdBy,
-			updatedAtPtr,
-			updatedByPtr,
+		))
+	}
+
+	return result, rows.Err()
+}
+
+// DeleteConversion removes the conversion between two UOMs.
+func (r *UOMRepository) DeleteConversion(ctx context.Context, from, to uom.Code) error {
+	query := `DELETE FROM mst_uom_conversion WHERE from_uom_code = $1 AND to_uom_code = $2`
+
+	result, err := r.db.ExecContext(ctx, query, from.String(), to.String())
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return uom.ErrConversionNotFound
+	}
+
+	return nil
+}
+
+// ExistsConversion checks if a conversion between two UOMs exists in either direction.
+func (r *UOMRepository) ExistsConversion(ctx context.Context, from, to uom.Code) (bool, error) {
+	query := `
+		SELECT EXISTS(
+			SELECT 1 FROM mst_uom_conversion
+			WHERE (from_uom_code = $1 AND to_uom_code = $2)
+			   OR (from_uom_code = $2 AND to_uom_code = $1)
 		)
-		result = append(result, entity)
+	`
+
+	var exists bool
+	err := r.db.QueryRowContext(ctx, query, from.String(), to.String()).Scan(&exists)
+	return exists, err
+}
+
+// uomBasePerCategoryIndex is the partial unique index allowing one base UOM per category.
+const uomBasePerCategoryIndex = "uq_mst_uom_base_per_category"
+
+// uomColumns lists the mst_uom columns read by scanUOM, in order.
+const uomColumns = `uom_code, uom_name, uom_category, is_base_uom, conversion_factor,
+	created_at, created_by, updated_at, updated_by, deleted_at, deleted_by, version`
+
+// rowScanner is implemented by *sql.Row and *sql.Rows.
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanUOM scans a row selected with uomColumns into a UOM.
+func scanUOM(row rowScanner) (*uom.UOM, error) {
+	var (
+		uomCode          string
+		uomName          string
+		uomCategory      string
+		isBaseUOM        bool
+		conversionFactor float64
+		createdAt        time.Time
+		createdBy        string
+		updatedAt        sql.NullTime
+		updatedBy        sql.NullString
+		deletedAt        sql.NullTime
+		deletedBy        sql.NullString
+		version          int
+	)
+
+	if err := row.Scan(
+		&uomCode,
+		&uomName,
+		&uomCategory,
+		&isBaseUOM,
+		&conversionFactor,
+		&createdAt,
+		&createdBy,
+		&updatedAt,
+		&updatedBy,
+		&deletedAt,
+		&deletedBy,
+		&version,
+	); err != nil {
+		return nil, err
 	}
 
-	return result, total, rows.Err()
+	// Create value objects
+	uomCodeVO, _ := uom.NewUOMCode(uomCode)
+	categoryVO, _ := uom.NewCategory(uomCategory)
+
+	// Handle nullable fields
+	var updatedAtPtr, deletedAtPtr *time.Time
+	var updatedByPtr, deletedByPtr *string
+	if updatedAt.Valid {
+		updatedAtPtr = &updatedAt.Time
+	}
+	if updatedBy.Valid {
+		updatedByPtr = &updatedBy.String
+	}
+	if deletedAt.Valid {
+		deletedAtPtr = &deletedAt.Time
+	}
+	if deletedBy.Valid {
+		deletedByPtr = &deletedBy.String
+	}
+
+	return uom.Reconstitute(
+		uomCodeVO,
+		uomName,
+		categoryVO,
+		isBaseUOM,
+		conversionFactor,
+		createdAt,
+		createdBy,
+		updatedAtPtr,
+		updatedByPtr,
+		deletedAtPtr,
+		deletedByPtr,
+		version,
+	), nil
 }
 
-// Update persists changes to an existing UOM.
-func (r *UOMRepository) Update(ctx context.Context, entity *uom.UOM) error {
+// updateUOM persists changes to an existing UOM and its pending events using
+// a transaction. The row is only written if it is still at the entity's version.
+func updateUOM(ctx context.Context, db queryer, entity *uom.UOM) error {
 	query := `
 		UPDATE mst_uom
-		SET uom_name = $2, uom_category = $3, is_base_uom = $4, 
-		    updated_at = $5, updated_by = $6
-		WHERE uom_code = $1
+		SET uom_name = $2, uom_category = $3, is_base_uom = $4, conversion_factor = $5,
+		    updated_at = $6, updated_by = $7, deleted_at = $8, deleted_by = $9,
+		    version = version + 1
+		WHERE uom_code = $1 AND version = $10
 	`
 
-	result, err := r.db.ExecContext(ctx, query,
+	result, err := db.ExecContext(ctx, query,
 		entity.Code().String(),
 		entity.Name(),
 		entity.Category().String(),
 		entity.IsBaseUOM(),
+		entity.ConversionFactor(),
 		entity.UpdatedAt(),
 		entity.UpdatedBy(),
+		entity.DeletedAt(),
+		entity.DeletedBy(),
+		entity.Version(),
 	)
+	if isUniqueViolation(err, uomBasePerCategoryIndex) {
+		return uom.ErrBaseUOMExists
+	}
 	if err != nil {
 		return err
 	}
@@ -219,39 +466,48 @@ func (r *UOMRepository) Update(ctx context.Context, entity *uom.UOM) error {
 		return err
 	}
 	if rowsAffected == 0 {
+		// Tell a missing row apart from one changed since it was read
+		var exists bool
+		if err := db.QueryRowContext(ctx,
+			`SELECT EXISTS(SELECT 1 FROM mst_uom WHERE uom_code = $1)`,
+			entity.Code().String(),
+		).Scan(&exists); err != nil {
+			return err
+		}
+		if exists {
+			return uom.ErrVersionConflict
+		}
 		return uom.ErrNotFound
 	}
 
-	return nil
+	entity.IncrementVersion()
+	return writeOutbox(ctx, db, entity.PullEvents(), "mst_uom", "uom_code")
 }
 
-// Delete removes a UOM by its code.
-func (r *UOMRepository) Delete(ctx context.Context, code uom.Code) error {
-	query := `DELETE FROM mst_uom WHERE uom_code = $1`
+// createUOM inserts a new UOM and its pending events using a transaction.
+func createUOM(ctx context.Context, db execer, entity *uom.UOM) error {
+	query := `
+		INSERT INTO mst_uom (uom_code, uom_name, uom_category, is_base_uom, conversion_factor, created_at, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
 
-	result, err := r.db.ExecContext(ctx, query, code.String())
-	if err != nil {
-		return err
+	_, err := db.ExecContext(ctx, query,
+		entity.Code().String(),
+		entity.Name(),
+		entity.Category().String(),
+		entity.IsBaseUOM(),
+		entity.ConversionFactor(),
+		entity.CreatedAt(),
+		entity.CreatedBy(),
+	)
+	if isUniqueViolation(err, uomBasePerCategoryIndex) {
+		return uom.ErrBaseUOMExists
 	}
-
-	rowsAffected, err := result.RowsAffected()
 	if err != nil {
 		return err
 	}
-	if rowsAffected == 0 {
-		return uom.ErrNotFound
-	}
-
-	return nil
-}
-
-// ExistsByCode checks if a UOM with the given code exists.
-func (r *UOMRepository) ExistsByCode(ctx context.Context, code uom.Code) (bool, error) {
-	query := `SELECT EXISTS(SELECT 1 FROM mst_uom WHERE uom_code = $1)`
 
-	var exists bool
-	err := r.db.QueryRowContext(ctx, query, code.String()).Scan(&exists)
-	return exists, err
+	return writeOutbox(ctx, db, entity.PullEvents(), "mst_uom", "uom_code")
 }
 
 // Helper function.
@@ -0,0 +1,413 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/event"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/webhook"
+)
+
+// WebhookRepository implements webhook.Repository interface.
+type WebhookRepository struct {
+	db *DB
+}
+
+// NewWebhookRepository creates a new webhook repository.
+func NewWebhookRepository(db *DB) *WebhookRepository {
+	return &WebhookRepository{db: db}
+}
+
+// Verify interface implementation at compile time.
+var _ webhook.Repository = (*WebhookRepository)(nil)
+
+// webhookSubscriptionColumns lists the columns read by scanWebhookSubscription.
+const webhookSubscriptionColumns = `id, url, event_types, secret, description, created_at, created_by`
+
+// webhookDeliveryColumns lists the columns read by scanWebhookDelivery.
+const webhookDeliveryColumns = `id, subscription_id, event_id, event_type, body, status, attempts,
+	next_attempt_at, last_error, last_status_code, created_at, delivered_at`
+
+// CreateSubscription persists a new Subscription and assigns its ID.
+func (r *WebhookRepository) CreateSubscription(ctx context.Context, sub *webhook.Subscription) error {
+	eventTypes, err := json.Marshal(sub.EventTypes())
+	if err != nil {
+		return fmt.Errorf("failed to marshal event_types: %w", err)
+	}
+
+	query := `
+		INSERT INTO webhook_subscription (url, event_types, secret, description, created_at, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id
+	`
+
+	var id int64
+	err = r.db.QueryRowContext(ctx, query,
+		sub.URL().String(),
+		eventTypes,
+		sub.Secret(),
+		sub.Description(),
+		sub.CreatedAt(),
+		sub.CreatedBy(),
+	).Scan(&id)
+	if err != nil {
+		return err
+	}
+
+	sub.AssignID(id)
+	return nil
+}
+
+// GetSubscription retrieves a Subscription by its ID.
+func (r *WebhookRepository) GetSubscription(ctx context.Context, id int64) (*webhook.Subscription, error) {
+	query := `SELECT ` + webhookSubscriptionColumns + ` FROM webhook_subscription WHERE id = $1`
+
+	sub, err := scanWebhookSubscription(r.db.QueryRowContext(ctx, query, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, webhook.ErrSubscriptionNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return sub, nil
+}
+
+// ListSubscriptions retrieves every Subscription, oldest first.
+func (r *WebhookRepository) ListSubscriptions(ctx context.Context) ([]*webhook.Subscription, error) {
+	query := `SELECT ` + webhookSubscriptionColumns + ` FROM webhook_subscription ORDER BY id`
+	return r.listSubscriptions(ctx, query)
+}
+
+// SubscriptionsFor retrieves the Subscriptions to events of eventType.
+func (r *WebhookRepository) SubscriptionsFor(ctx context.Context, eventType event.Type) ([]*webhook.Subscription, error) {
+	query := `SELECT ` + webhookSubscriptionColumns + ` FROM webhook_subscription WHERE event_types ? $1 ORDER BY id`
+	return r.listSubscriptions(ctx, query, eventType.String())
+}
+
+func (r *WebhookRepository) listSubscriptions(ctx context.Context, query string, args ...interface{}) ([]*webhook.Subscription, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*webhook.Subscription
+	for rows.Next() {
+		sub, err := scanWebhookSubscription(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, sub)
+	}
+
+	return result, rows.Err()
+}
+
+// DeleteSubscription removes a Subscription and its deliveries.
+func (r *WebhookRepository) DeleteSubscription(ctx context.Context, id int64) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM webhook_subscription WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return webhook.ErrSubscriptionNotFound
+	}
+
+	return nil
+}
+
+// CreateDeliveries persists new Deliveries, skipping events already recorded
+// for their subscription.
+func (r *WebhookRepository) CreateDeliveries(ctx context.Context, deliveries ...*webhook.Delivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	query := `
+		INSERT INTO webhook_delivery (
+			subscription_id, event_id, event_type, body, status, attempts, next_attempt_at, created_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT ON CONSTRAINT uq_webhook_delivery_event DO NOTHING
+		RETURNING id
+	`
+
+	return r.db.WithTx(ctx, func(tx *sql.Tx) error {
+		for _, d := range deliveries {
+			var id int64
+			err := tx.QueryRowContext(ctx, query,
+				d.SubscriptionID(),
+				d.EventID(),
+				d.EventType().String(),
+				[]byte(d.Body()),
+				d.Status().String(),
+				d.Attempts(),
+				d.NextAttemptAt(),
+				d.CreatedAt(),
+			).Scan(&id)
+			if errors.Is(err, sql.ErrNoRows) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			d.AssignID(id)
+		}
+		return nil
+	})
+}
+
+// ClaimDueDeliveries leases up to limit pending Deliveries that are due,
+// oldest first.
+func (r *WebhookRepository) ClaimDueDeliveries(ctx context.Context, limit int, lease time.Duration) ([]*webhook.Delivery, error) {
+	query := `
+		UPDATE webhook_delivery SET locked_until = NOW() + $2::bigint * INTERVAL '1 millisecond'
+		WHERE id IN (
+			SELECT id FROM webhook_delivery
+			WHERE status = 'PENDING' AND next_attempt_at <= NOW()
+			  AND (locked_until IS NULL OR locked_until < NOW())
+			ORDER BY next_attempt_at, id
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + webhookDeliveryColumns
+
+	rows, err := r.db.QueryContext(ctx, query, limit, lease.Milliseconds())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*webhook.Delivery
+	for rows.Next() {
+		d, err := scanWebhookDelivery(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// RETURNING does not keep the order of the subquery
+	sort.Slice(result, func(i, j int) bool { return result[i].ID() < result[j].ID() })
+	return result, nil
+}
+
+// GetDelivery retrieves a Delivery by its ID.
+func (r *WebhookRepository) GetDelivery(ctx context.Context, id int64) (*webhook.Delivery, error) {
+	query := `SELECT ` + webhookDeliveryColumns + ` FROM webhook_delivery WHERE id = $1`
+
+	d, err := scanWebhookDelivery(r.db.QueryRowContext(ctx, query, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, webhook.ErrDeliveryNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// ListDeliveries retrieves Deliveries with optional filtering, newest first.
+func (r *WebhookRepository) ListDeliveries(ctx context.Context, filter webhook.DeliveryFilter) ([]*webhook.Delivery, int64, error) {
+	// Base query
+	baseQuery := ` FROM webhook_delivery WHERE 1=1`
+	args := []interface{}{}
+	argIndex := 1
+
+	// Apply filters
+	if filter.SubscriptionID != nil {
+		baseQuery += fmt.Sprintf(` AND subscription_id = $%d`, argIndex)
+		args = append(args, *filter.SubscriptionID)
+		argIndex++
+	}
+	if filter.Status != nil {
+		baseQuery += fmt.Sprintf(` AND status = $%d`, argIndex)
+		args = append(args, filter.Status.String())
+		argIndex++
+	}
+	if filter.EventID != nil {
+		baseQuery += fmt.Sprintf(` AND event_id::text = $%d`, argIndex)
+		args = append(args, *filter.EventID)
+		argIndex++
+	}
+
+	// Count query
+	countQuery := `SELECT COUNT(*)` + baseQuery
+	var total int64
+	if err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	// Data query with pagination
+	dataQuery := `SELECT ` + webhookDeliveryColumns + baseQuery +
+		fmt.Sprintf(` ORDER BY id DESC LIMIT $%d OFFSET $%d`, argIndex, argIndex+1)
+	args = append(args, filter.Limit(), filter.Offset())
+
+	rows, err := r.db.QueryContext(ctx, dataQuery, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var result []*webhook.Delivery
+	for rows.Next() {
+		d, err := scanWebhookDelivery(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		result = append(result, d)
+	}
+
+	return result, total, rows.Err()
+}
+
+// UpdateDelivery persists the outcome of an attempt or a replay and
+// releases the lease.
+func (r *WebhookRepository) UpdateDelivery(ctx context.Context, d *webhook.Delivery) error {
+	query := `
+		UPDATE webhook_delivery
+		SET status = $2, attempts = $3, next_attempt_at = $4, last_error = $5,
+		    last_status_code = $6, delivered_at = $7, locked_until = NULL
+		WHERE id = $1
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		d.ID(),
+		d.Status().String(),
+		d.Attempts(),
+		d.NextAttemptAt(),
+		d.LastError(),
+		d.LastStatusCode(),
+		d.DeliveredAt(),
+	)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return webhook.ErrDeliveryNotFound
+	}
+
+	return nil
+}
+
+// scanWebhookSubscription reads a row selected with webhookSubscriptionColumns.
+func scanWebhookSubscription(row rowScanner) (*webhook.Subscription, error) {
+	var (
+		id            int64
+		url           string
+		eventTypesRaw []byte
+		secret        string
+		description   sql.NullString
+		createdAt     time.Time
+		createdBy     string
+	)
+
+	if err := row.Scan(&id, &url, &eventTypesRaw, &secret, &description, &createdAt, &createdBy); err != nil {
+		return nil, err
+	}
+
+	var eventTypes []event.Type
+	if err := json.Unmarshal(eventTypesRaw, &eventTypes); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal event_types: %w", err)
+	}
+
+	var descPtr *string
+	if description.Valid {
+		descPtr = &description.String
+	}
+
+	return webhook.ReconstituteSubscription(
+		id,
+		webhook.EndpointURL(url),
+		eventTypes,
+		secret,
+		descPtr,
+		createdAt,
+		createdBy,
+	), nil
+}
+
+// scanWebhookDelivery reads a row selected with webhookDeliveryColumns.
+func scanWebhookDelivery(row rowScanner) (*webhook.Delivery, error) {
+	var (
+		id             int64
+		subscriptionID int64
+		eventID        string
+		eventType      string
+		body           []byte
+		status         string
+		attempts       int
+		nextAttemptAt  time.Time
+		lastError      sql.NullString
+		lastStatusCode sql.NullInt64
+		createdAt      time.Time
+		deliveredAt    sql.NullTime
+	)
+
+	if err := row.Scan(
+		&id,
+		&subscriptionID,
+		&eventID,
+		&eventType,
+		&body,
+		&status,
+		&attempts,
+		&nextAttemptAt,
+		&lastError,
+		&lastStatusCode,
+		&createdAt,
+		&deliveredAt,
+	); err != nil {
+		return nil, err
+	}
+
+	// Handle nullable fields
+	var lastErrorPtr *string
+	var lastStatusCodePtr *int
+	var deliveredAtPtr *time.Time
+
+	if lastError.Valid {
+		lastErrorPtr = &lastError.String
+	}
+	if lastStatusCode.Valid {
+		code := int(lastStatusCode.Int64)
+		lastStatusCodePtr = &code
+	}
+	if deliveredAt.Valid {
+		deliveredAtPtr = &deliveredAt.Time
+	}
+
+	return webhook.ReconstituteDelivery(
+		id,
+		subscriptionID,
+		eventID,
+		event.Type(eventType),
+		json.RawMessage(body),
+		webhook.Status(status),
+		attempts,
+		nextAttemptAt,
+		lastErrorPtr,
+		lastStatusCodePtr,
+		createdAt,
+		deliveredAtPtr,
+	), nil
+}
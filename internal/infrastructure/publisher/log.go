@@ -0,0 +1,29 @@
+// Package publisher provides outbox.Publisher adapters.
+package publisher
+
+import (
+	"context"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/homindolenern/goapps-costing-v1/internal/application/outbox"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/event"
+)
+
+// Log writes each message to the application log.
+type Log struct{}
+
+// Verify interface implementation at compile time.
+var _ outbox.Publisher = Log{}
+
+// Publish logs msg.
+func (Log) Publish(_ context.Context, msg *event.Message) error {
+	log.Info().
+		Int64("sequence", msg.Sequence).
+		Str("event_id", msg.EventID).
+		Str("event_type", msg.Type.String()).
+		Str("aggregate_code", msg.AggregateCode).
+		RawJSON("payload", msg.Payload).
+		Msg("Domain event")
+	return nil
+}
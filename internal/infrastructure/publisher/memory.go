@@ -0,0 +1,48 @@
+package publisher
+
+import (
+	"context"
+	"sync"
+
+	"github.com/homindolenern/goapps-costing-v1/internal/application/outbox"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/event"
+)
+
+// Memory keeps published messages in memory, for tests and local runs.
+type Memory struct {
+	mu       sync.Mutex
+	messages []*event.Message
+	// Fail, when set, is called before each publish; a non-nil result is
+	// returned instead of keeping the message.
+	Fail func(msg *event.Message) error
+}
+
+// Verify interface implementation at compile time.
+var _ outbox.Publisher = (*Memory)(nil)
+
+// NewMemory creates an empty in-memory publisher.
+func NewMemory() *Memory {
+	return &Memory{}
+}
+
+// Publish keeps msg.
+func (m *Memory) Publish(_ context.Context, msg *event.Message) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.Fail != nil {
+		if err := m.Fail(msg); err != nil {
+			return err
+		}
+	}
+	m.messages = append(m.messages, msg)
+	return nil
+}
+
+// Messages returns the messages published so far, in order.
+func (m *Memory) Messages() []*event.Message {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]*event.Message(nil), m.messages...)
+}
@@ -0,0 +1,75 @@
+package publisher
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/nats-io/nats.go"
+
+	"github.com/homindolenern/goapps-costing-v1/internal/application/outbox"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/event"
+)
+
+// NATS publishes each message as JSON to "<prefix>.<event type>", e.g.
+// "costing.parameter.updated". The event ID is sent in the Nats-Msg-Id
+// header so a JetStream stream on the subjects drops redelivered events.
+type NATS struct {
+	conn    *nats.Conn
+	prefix  string
+	timeout time.Duration
+}
+
+// Verify interface implementation at compile time.
+var _ outbox.Publisher = (*NATS)(nil)
+
+// NewNATS creates a NATS publisher for a comma-separated list of server
+// URLs. The connection is retried in the background, so the service starts
+// while NATS is down; publishing fails until it is reachable rather than
+// buffering, and the relay retries the messages.
+func NewNATS(url, prefix string, timeout time.Duration) (*NATS, error) {
+	if timeout <= 0 {
+		timeout = 5 * time.Second
+	}
+	conn, err := nats.Connect(url,
+		nats.Name("master-service"),
+		nats.Timeout(timeout),
+		nats.RetryOnFailedConnect(true),
+		nats.MaxReconnects(-1),
+		nats.ReconnectBufSize(-1),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &NATS{conn: conn, prefix: strings.TrimSuffix(prefix, "."), timeout: timeout}, nil
+}
+
+// Publish sends msg and waits until the server has processed it.
+func (n *NATS) Publish(ctx context.Context, msg *event.Message) error {
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	subject := msg.Type.String()
+	if n.prefix != "" {
+		subject = n.prefix + "." + subject
+	}
+
+	out := nats.NewMsg(subject)
+	out.Header.Set(nats.MsgIdHdr, msg.EventID)
+	out.Header.Set("Event-Type", msg.Type.String())
+	out.Data = body
+	if err := n.conn.PublishMsg(out); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, n.timeout)
+	defer cancel()
+	return n.conn.FlushWithContext(ctx)
+}
+
+// Close flushes pending messages and closes the connection.
+func (n *NATS) Close() error {
+	return n.conn.Drain()
+}
@@ -0,0 +1,59 @@
+package publisher
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	appwebhook "github.com/homindolenern/goapps-costing-v1/internal/application/webhook"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/webhook"
+	pkgwebhook "github.com/homindolenern/goapps-costing-v1/pkg/webhook"
+)
+
+// SubscriptionSender posts webhook deliveries to their subscription's
+// endpoint, signed with the subscription secret. Any 2xx response
+// acknowledges the delivery.
+type SubscriptionSender struct {
+	client *http.Client
+}
+
+// Verify interface implementation at compile time.
+var _ appwebhook.Sender = (*SubscriptionSender)(nil)
+
+// NewSubscriptionSender creates a sender using client. Redirects are not
+// followed, as the signature is for the registered URL only.
+func NewSubscriptionSender(client *http.Client) *SubscriptionSender {
+	c := *client
+	c.CheckRedirect = func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }
+	return &SubscriptionSender{client: &c}
+}
+
+// Send posts delivery to sub's URL.
+func (s *SubscriptionSender) Send(ctx context.Context, sub *webhook.Subscription, delivery *webhook.Delivery) (int, error) {
+	body := []byte(delivery.Body())
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sub.URL().String(), bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Event-Id", delivery.EventID())
+	req.Header.Set("X-Event-Type", delivery.EventType().String())
+	req.Header.Set(pkgwebhook.DeliveryHeader, strconv.FormatInt(delivery.ID(), 10))
+	pkgwebhook.SetHeaders(req.Header, sub.Secret(), time.Now(), body)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("webhook responded %s", resp.Status)
+	}
+	return resp.StatusCode, nil
+}
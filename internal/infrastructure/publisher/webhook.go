@@ -0,0 +1,57 @@
+package publisher
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/homindolenern/goapps-costing-v1/internal/application/outbox"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/event"
+)
+
+// Webhook POSTs each message as JSON to a fixed URL. Any 2xx response
+// acknowledges the message.
+type Webhook struct {
+	url    string
+	client *http.Client
+}
+
+// Verify interface implementation at compile time.
+var _ outbox.Publisher = (*Webhook)(nil)
+
+// NewWebhook creates a webhook publisher for url.
+func NewWebhook(url string, timeout time.Duration) *Webhook {
+	return &Webhook{url: url, client: &http.Client{Timeout: timeout}}
+}
+
+// Publish posts msg to the webhook URL.
+func (w *Webhook) Publish(ctx context.Context, msg *event.Message) error {
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Event-Id", msg.EventID)
+	req.Header.Set("X-Event-Type", msg.Type.String())
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook responded %s", resp.Status)
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package redis
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
@@ -84,19 +85,54 @@ func (c *Client) Exists(ctx context.Context, key string) (bool, error) {
 	return result > 0, nil
 }
 
-// Keys returns keys matching a pattern.
+// scanBatchSize is the COUNT hint for SCAN and the UNLINK batch size.
+const scanBatchSize = 500
+
+// Incr increments an integer key and returns the new value.
+func (c *Client) Incr(ctx context.Context, key string) (int64, error) {
+	return c.rdb.Incr(ctx, key).Result()
+}
+
+// GetInt64 retrieves an integer key, returning 0 when it does not exist.
+func (c *Client) GetInt64(ctx context.Context, key string) (int64, error) {
+	value, err := c.rdb.Get(ctx, key).Int64()
+	if errors.Is(err, redis.Nil) {
+		return 0, nil
+	}
+	return value, err
+}
+
+// Keys returns keys matching a pattern. It iterates with SCAN so it never
+// blocks Redis the way KEYS does.
 func (c *Client) Keys(ctx context.Context, pattern string) ([]string, error) {
-	return c.rdb.Keys(ctx, pattern).Result()
+	var keys []string
+	iter := c.rdb.Scan(ctx, 0, pattern, scanBatchSize).Iterator()
+	for iter.Next(ctx) {
+		keys = append(keys, iter.Val())
+	}
+	return keys, iter.Err()
 }
 
-// DeleteByPattern deletes all keys matching a pattern.
+// DeleteByPattern deletes all keys matching a pattern, scanning and
+// unlinking in batches. Prefer generation-based invalidation for hot paths;
+// this is O(keyspace).
 func (c *Client) DeleteByPattern(ctx context.Context, pattern string) error {
-	keys, err := c.Keys(ctx, pattern)
-	if err != nil {
+	batch := make([]string, 0, scanBatchSize)
+	iter := c.rdb.Scan(ctx, 0, pattern, scanBatchSize).Iterator()
+	for iter.Next(ctx) {
+		batch = append(batch, iter.Val())
+		if len(batch) == scanBatchSize {
+			if err := c.rdb.Unlink(ctx, batch...).Err(); err != nil {
+				return err
+			}
+			batch = batch[:0]
+		}
+	}
+	if err := iter.Err(); err != nil {
 		return err
 	}
-	if len(keys) > 0 {
-		return c.Delete(ctx, keys...)
+	if len(batch) > 0 {
+		return c.rdb.Unlink(ctx, batch...).Err()
 	}
 	return nil
 }
@@ -107,13 +143,25 @@ const (
 	ParameterKeyPrefix = "param:"
 )
 
+// Cache tags. Every list page key embeds its tag's generation, so bumping
+// the generation invalidates all pages at once without touching them.
+const (
+	UOMListTag       = UOMKeyPrefix + "list"
+	ParameterListTag = ParameterKeyPrefix + "list"
+)
+
+// GenerationKey returns the key holding a tag's generation counter.
+func GenerationKey(tag string) string {
+	return tag + ":gen"
+}
+
 // UOM cache keys.
 func UOMCacheKey(code string) string {
 	return UOMKeyPrefix + code
 }
 
-func UOMListCacheKey(page, pageSize int, category string) string {
-	return fmt.Sprintf("%slist:%d:%d:%s", UOMKeyPrefix, page, pageSize, category)
+func UOMListCacheKey(generation int64, page, pageSize int, category, after string) string {
+	return fmt.Sprintf("%s:v%d:%d:%d:%s:%s", UOMListTag, generation, page, pageSize, category, after)
 }
 
 // Parameter cache keys.
@@ -121,7 +169,7 @@ func ParameterCacheKey(code string) string {
 	return ParameterKeyPrefix + code
 }
 
-func ParameterListCacheKey(page, pageSize int, category string, isActive *bool) string {
+func ParameterListCacheKey(generation int64, page, pageSize int, category string, isActive *bool, sort, after string) string {
 	activeStr := "all"
 	if isActive != nil {
 		if *isActive {
@@ -130,5 +178,5 @@ func ParameterListCacheKey(page, pageSize int, category string, isActive *bool)
 			activeStr = "inactive"
 		}
 	}
-	return fmt.Sprintf("%slist:%d:%d:%s:%s", ParameterKeyPrefix, page, pageSize, category, activeStr)
+	return fmt.Sprintf("%s:v%d:%d:%d:%s:%s:%s:%s", ParameterListTag, generation, page, pageSize, category, activeStr, sort, after)
 }
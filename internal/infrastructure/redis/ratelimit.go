@@ -0,0 +1,85 @@
+package redis
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/homindolenern/goapps-costing-v1/pkg/ratelimit"
+)
+
+// RateLimitKeyPrefix prefixes the token bucket keys.
+const RateLimitKeyPrefix = "ratelimit:"
+
+// tokenBucketScript refills and takes from a bucket atomically using the
+// Redis server clock, so every replica shares one bucket per key.
+// Returns {allowed, retry_after_ms}.
+var tokenBucketScript = redis.NewScript(`
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local t = redis.call('TIME')
+local now = tonumber(t[1]) * 1000 + math.floor(tonumber(t[2]) / 1000)
+
+local state = redis.call('HMGET', KEYS[1], 'tokens', 'ts')
+local tokens = tonumber(state[1])
+local ts = tonumber(state[2])
+if tokens == nil then
+  tokens = burst
+  ts = now
+end
+
+tokens = math.min(burst, tokens + math.max(0, now - ts) * rate / 1000)
+
+local allowed = 0
+local retry_after = 0
+if tokens >= 1 then
+  tokens = tokens - 1
+  allowed = 1
+elseif rate > 0 then
+  retry_after = math.ceil((1 - tokens) * 1000 / rate)
+else
+  retry_after = -1
+end
+
+redis.call('HSET', KEYS[1], 'tokens', tokens, 'ts', now)
+local ttl = 60000
+if rate > 0 then
+  ttl = math.ceil(burst * 1000 / rate) + 1000
+end
+redis.call('PEXPIRE', KEYS[1], ttl)
+
+return {allowed, retry_after}
+`)
+
+// RateLimiter is a distributed ratelimit.Limiter backed by Redis.
+type RateLimiter struct {
+	client *Client
+}
+
+// NewRateLimiter creates a Redis-backed rate limiter.
+func NewRateLimiter(client *Client) *RateLimiter {
+	return &RateLimiter{client: client}
+}
+
+// Take implements ratelimit.Limiter.
+func (l *RateLimiter) Take(ctx context.Context, key string, limit ratelimit.Limit) (ratelimit.Decision, error) {
+	result, err := tokenBucketScript.Run(ctx, l.client.rdb,
+		[]string{RateLimitKeyPrefix + key},
+		strconv.FormatFloat(limit.Rate, 'f', -1, 64),
+		strconv.FormatFloat(limit.Burst, 'f', -1, 64),
+	).Int64Slice()
+	if err != nil {
+		return ratelimit.Decision{}, err
+	}
+
+	if result[0] == 1 {
+		return ratelimit.Decision{Allowed: true}, nil
+	}
+	retryAfter := time.Duration(result[1]) * time.Millisecond
+	if result[1] < 0 {
+		retryAfter = time.Hour
+	}
+	return ratelimit.Decision{RetryAfter: retryAfter}, nil
+}
@@ -0,0 +1,5 @@
+-- Rollback: Drop mst_uom_conversion table
+
+DROP TABLE IF EXISTS mst_uom_conversion;
+
+ALTER TABLE mst_uom DROP COLUMN IF EXISTS conversion_factor;
@@ -0,0 +1,32 @@
+-- Migration: Create mst_uom_conversion table
+-- Conversion factors between Units of Measure
+
+-- Factor of each UOM relative to the base UOM of its category (base = 1)
+ALTER TABLE mst_uom
+    ADD COLUMN IF NOT EXISTS conversion_factor DECIMAL(24,12) NOT NULL DEFAULT 1
+    CONSTRAINT chk_mst_uom_conversion_factor CHECK (conversion_factor > 0);
+
+-- Explicit conversions between UOMs of different categories
+CREATE TABLE IF NOT EXISTS mst_uom_conversion (
+    from_uom_code VARCHAR(20) NOT NULL,
+    to_uom_code VARCHAR(20) NOT NULL,
+    factor DECIMAL(24,12) NOT NULL CHECK (factor > 0),
+    description TEXT,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    created_by VARCHAR(100) NOT NULL,
+    updated_at TIMESTAMPTZ,
+    updated_by VARCHAR(100),
+
+    CONSTRAINT pk_mst_uom_conversion PRIMARY KEY (from_uom_code, to_uom_code),
+    CONSTRAINT chk_mst_uom_conversion_distinct CHECK (from_uom_code <> to_uom_code),
+    CONSTRAINT fk_mst_uom_conversion_from FOREIGN KEY (from_uom_code) REFERENCES mst_uom(uom_code) ON DELETE CASCADE,
+    CONSTRAINT fk_mst_uom_conversion_to FOREIGN KEY (to_uom_code) REFERENCES mst_uom(uom_code) ON DELETE CASCADE
+);
+
+-- Indexes
+CREATE INDEX IF NOT EXISTS idx_mst_uom_conversion_to ON mst_uom_conversion(to_uom_code);
+
+-- Comments
+COMMENT ON COLUMN mst_uom.conversion_factor IS 'Number of base units of the category in one unit, e.g., TON = 1000 when KG is base';
+COMMENT ON TABLE mst_uom_conversion IS 'Explicit conversions between UOMs of different categories';
+COMMENT ON COLUMN mst_uom_conversion.factor IS 'One from_uom equals factor to_uom, e.g., 1 CONE = 2.5 KG';
@@ -0,0 +1,3 @@
+-- Rollback: Drop single base UOM per category index
+
+DROP INDEX IF EXISTS uq_mst_uom_base_per_category;
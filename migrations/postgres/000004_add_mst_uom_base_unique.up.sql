@@ -0,0 +1,19 @@
+-- Migration: Enforce a single base UOM per category
+
+-- Keep the oldest base UOM of each category and demote the others
+UPDATE mst_uom u
+SET is_base_uom = FALSE,
+    updated_at = NOW(),
+    updated_by = 'migration'
+WHERE u.is_base_uom
+  AND EXISTS (
+      SELECT 1 FROM mst_uom o
+      WHERE o.uom_category = u.uom_category
+        AND o.is_base_uom
+        AND (o.created_at, o.uom_code) < (u.created_at, u.uom_code)
+  );
+
+-- At most one base UOM per category
+CREATE UNIQUE INDEX IF NOT EXISTS uq_mst_uom_base_per_category
+    ON mst_uom(uom_category)
+    WHERE is_base_uom;
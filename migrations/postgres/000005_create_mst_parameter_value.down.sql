@@ -0,0 +1,3 @@
+-- Rollback: Drop mst_parameter_value table
+
+DROP TABLE IF EXISTS mst_parameter_value;
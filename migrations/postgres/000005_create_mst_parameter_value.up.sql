@@ -0,0 +1,33 @@
+-- Migration: Create mst_parameter_value table
+-- Values of parameters recorded per machine, material or product
+
+CREATE TABLE IF NOT EXISTS mst_parameter_value (
+    id BIGSERIAL PRIMARY KEY,
+    subject_type VARCHAR(20) NOT NULL CHECK (subject_type IN ('MACHINE', 'MATERIAL', 'PRODUCT')),
+    subject_code VARCHAR(50) NOT NULL,
+    parameter_code VARCHAR(50) NOT NULL,
+    value TEXT NOT NULL,
+    numeric_value DECIMAL(24,6), -- Parsed value for NUMERIC parameters
+    effective_from DATE NOT NULL,
+    effective_to DATE,
+    remarks TEXT,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    created_by VARCHAR(100) NOT NULL,
+    updated_at TIMESTAMPTZ,
+    updated_by VARCHAR(100),
+
+    CONSTRAINT uq_mst_parameter_value_effective UNIQUE (subject_type, subject_code, parameter_code, effective_from),
+    CONSTRAINT chk_mst_parameter_value_period CHECK (effective_to IS NULL OR effective_to > effective_from),
+    CONSTRAINT fk_mst_parameter_value_parameter FOREIGN KEY (parameter_code) REFERENCES mst_parameter(parameter_code) ON DELETE RESTRICT
+);
+
+-- Indexes
+CREATE INDEX IF NOT EXISTS idx_mst_parameter_value_parameter ON mst_parameter_value(parameter_code);
+CREATE INDEX IF NOT EXISTS idx_mst_parameter_value_subject ON mst_parameter_value(subject_type, subject_code);
+
+-- Comments
+COMMENT ON TABLE mst_parameter_value IS 'Parameter values per subject with effective periods';
+COMMENT ON COLUMN mst_parameter_value.subject_type IS 'Subject type: MACHINE, MATERIAL, PRODUCT';
+COMMENT ON COLUMN mst_parameter_value.value IS 'Normalized value, validated against the parameter data type';
+COMMENT ON COLUMN mst_parameter_value.effective_from IS 'First date the value is effective';
+COMMENT ON COLUMN mst_parameter_value.effective_to IS 'First date the value is no longer effective, NULL until superseded';
@@ -0,0 +1,3 @@
+-- Rollback: Drop mst_material table
+
+DROP TABLE IF EXISTS mst_material;
@@ -0,0 +1,32 @@
+-- Migration: Create mst_material table
+-- Material master data: fibres, yarns, chemicals and packaging
+
+CREATE TABLE IF NOT EXISTS mst_material (
+    material_code VARCHAR(50) PRIMARY KEY,
+    material_name VARCHAR(200) NOT NULL,
+    material_type VARCHAR(20) NOT NULL CHECK (material_type IN ('FIBRE', 'YARN', 'CHEMICAL', 'PACKAGING')),
+    purchase_uom_code VARCHAR(20) NOT NULL,
+    standard_price DECIMAL(18,6) NOT NULL DEFAULT 0 CHECK (standard_price >= 0),
+    currency CHAR(3) NOT NULL,
+    description TEXT,
+    is_active BOOLEAN DEFAULT TRUE,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    created_by VARCHAR(100) NOT NULL,
+    updated_at TIMESTAMPTZ,
+    updated_by VARCHAR(100),
+
+    -- Default purchase UOM must exist in the UOM master
+    CONSTRAINT fk_mst_material_purchase_uom FOREIGN KEY (purchase_uom_code) REFERENCES mst_uom(uom_code) ON DELETE RESTRICT
+);
+
+-- Indexes
+CREATE INDEX IF NOT EXISTS idx_mst_material_type ON mst_material(material_type);
+CREATE INDEX IF NOT EXISTS idx_mst_material_active ON mst_material(is_active);
+CREATE INDEX IF NOT EXISTS idx_mst_material_purchase_uom ON mst_material(purchase_uom_code);
+
+-- Comments
+COMMENT ON TABLE mst_material IS 'Master table for materials';
+COMMENT ON COLUMN mst_material.material_type IS 'Type: FIBRE, YARN, CHEMICAL, PACKAGING';
+COMMENT ON COLUMN mst_material.purchase_uom_code IS 'Default UOM materials are purchased and priced in';
+COMMENT ON COLUMN mst_material.standard_price IS 'Standard price of one purchase UOM';
+COMMENT ON COLUMN mst_material.currency IS 'ISO 4217 currency of the standard price';
@@ -0,0 +1,6 @@
+-- Rollback: Drop machine master tables
+
+DROP TABLE IF EXISTS mst_machine_parameter;
+DROP TABLE IF EXISTS mst_machine;
+DROP TABLE IF EXISTS mst_machine_type_parameter;
+DROP TABLE IF EXISTS mst_machine_type;
@@ -0,0 +1,67 @@
+-- Migration: Create machine master tables
+-- Machine types with their MACHINE-category parameter templates, and machines with their values
+
+CREATE TABLE IF NOT EXISTS mst_machine_type (
+    machine_type_code VARCHAR(30) PRIMARY KEY,
+    machine_type_name VARCHAR(200) NOT NULL,
+    description TEXT,
+    is_active BOOLEAN DEFAULT TRUE,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    created_by VARCHAR(100) NOT NULL,
+    updated_at TIMESTAMPTZ,
+    updated_by VARCHAR(100)
+);
+
+CREATE TABLE IF NOT EXISTS mst_machine_type_parameter (
+    machine_type_code VARCHAR(30) NOT NULL,
+    parameter_code VARCHAR(50) NOT NULL,
+    is_mandatory BOOLEAN NOT NULL DEFAULT FALSE,
+    sort_order INT NOT NULL DEFAULT 0,
+
+    CONSTRAINT pk_mst_machine_type_parameter PRIMARY KEY (machine_type_code, parameter_code),
+    CONSTRAINT fk_mst_machine_type_parameter_type FOREIGN KEY (machine_type_code) REFERENCES mst_machine_type(machine_type_code) ON DELETE CASCADE,
+    CONSTRAINT fk_mst_machine_type_parameter_parameter FOREIGN KEY (parameter_code) REFERENCES mst_parameter(parameter_code) ON DELETE RESTRICT
+);
+
+CREATE TABLE IF NOT EXISTS mst_machine (
+    machine_code VARCHAR(50) PRIMARY KEY,
+    machine_name VARCHAR(200) NOT NULL,
+    machine_type_code VARCHAR(30) NOT NULL,
+    description TEXT,
+    is_active BOOLEAN DEFAULT TRUE,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    created_by VARCHAR(100) NOT NULL,
+    updated_at TIMESTAMPTZ,
+    updated_by VARCHAR(100),
+
+    CONSTRAINT fk_mst_machine_type FOREIGN KEY (machine_type_code) REFERENCES mst_machine_type(machine_type_code) ON DELETE RESTRICT
+);
+
+CREATE TABLE IF NOT EXISTS mst_machine_parameter (
+    machine_code VARCHAR(50) NOT NULL,
+    machine_type_code VARCHAR(30) NOT NULL,
+    parameter_code VARCHAR(50) NOT NULL,
+    value TEXT NOT NULL,
+
+    CONSTRAINT pk_mst_machine_parameter PRIMARY KEY (machine_code, parameter_code),
+    CONSTRAINT fk_mst_machine_parameter_machine FOREIGN KEY (machine_code) REFERENCES mst_machine(machine_code) ON DELETE CASCADE,
+    -- Values can only exist for parameters declared by the machine type template
+    CONSTRAINT fk_mst_machine_parameter_template FOREIGN KEY (machine_type_code, parameter_code)
+        REFERENCES mst_machine_type_parameter(machine_type_code, parameter_code) ON DELETE RESTRICT
+);
+
+-- Indexes
+CREATE INDEX IF NOT EXISTS idx_mst_machine_type_active ON mst_machine_type(is_active);
+CREATE INDEX IF NOT EXISTS idx_mst_machine_type_parameter_parameter ON mst_machine_type_parameter(parameter_code);
+CREATE INDEX IF NOT EXISTS idx_mst_machine_type ON mst_machine(machine_type_code);
+CREATE INDEX IF NOT EXISTS idx_mst_machine_active ON mst_machine(is_active);
+CREATE INDEX IF NOT EXISTS idx_mst_machine_parameter_template ON mst_machine_parameter(machine_type_code, parameter_code);
+
+-- Comments
+COMMENT ON TABLE mst_machine_type IS 'Master table for machine types (ring frame, carding, draw frame, winding...)';
+COMMENT ON TABLE mst_machine_type_parameter IS 'MACHINE-category parameters declared by a machine type';
+COMMENT ON COLUMN mst_machine_type_parameter.is_mandatory IS 'Every machine of the type must have a value';
+COMMENT ON COLUMN mst_machine_type_parameter.sort_order IS 'Position of the parameter in the template';
+COMMENT ON TABLE mst_machine IS 'Master table for machines';
+COMMENT ON TABLE mst_machine_parameter IS 'Parameter values of a machine';
+COMMENT ON COLUMN mst_machine_parameter.value IS 'Normalized value, validated against the parameter data type';
@@ -0,0 +1,40 @@
+-- Migration: Create audit_log table
+-- Append-only trail of every master-data change with before/after snapshots
+
+CREATE TABLE IF NOT EXISTS audit_log (
+    id BIGSERIAL PRIMARY KEY,
+    entity_type VARCHAR(30) NOT NULL CHECK (entity_type IN (
+        'UOM', 'UOM_CONVERSION', 'PARAMETER', 'PARAMETER_VALUE', 'MATERIAL', 'MACHINE_TYPE', 'MACHINE'
+    )),
+    entity_code VARCHAR(100) NOT NULL,
+    action VARCHAR(10) NOT NULL CHECK (action IN ('CREATE', 'UPDATE', 'DELETE')),
+    before_data JSONB,
+    after_data JSONB,
+    changes JSONB NOT NULL DEFAULT '[]',
+    changed_by VARCHAR(100) NOT NULL,
+    occurred_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Rows are never changed once written
+CREATE OR REPLACE FUNCTION audit_log_append_only() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_log is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_audit_log_append_only ON audit_log;
+CREATE TRIGGER trg_audit_log_append_only
+    BEFORE UPDATE OR DELETE ON audit_log
+    FOR EACH ROW EXECUTE FUNCTION audit_log_append_only();
+
+-- Indexes
+CREATE INDEX IF NOT EXISTS idx_audit_log_entity ON audit_log(entity_type, entity_code, occurred_at DESC);
+CREATE INDEX IF NOT EXISTS idx_audit_log_changed_by ON audit_log(changed_by, occurred_at DESC);
+CREATE INDEX IF NOT EXISTS idx_audit_log_occurred_at ON audit_log(occurred_at DESC);
+
+-- Comments
+COMMENT ON TABLE audit_log IS 'Append-only audit trail of master-data changes';
+COMMENT ON COLUMN audit_log.entity_code IS 'Code of the changed entity; ID for parameter values, FROM:TO for conversions';
+COMMENT ON COLUMN audit_log.before_data IS 'Snapshot before the change, NULL for CREATE';
+COMMENT ON COLUMN audit_log.after_data IS 'Snapshot after the change, NULL for DELETE';
+COMMENT ON COLUMN audit_log.changes IS 'Changed fields as [{field, before, after}]';
@@ -0,0 +1,5 @@
+-- Rollback: Drop optimistic concurrency versions
+
+ALTER TABLE mst_parameter DROP COLUMN IF EXISTS version;
+
+ALTER TABLE mst_uom DROP COLUMN IF EXISTS version;
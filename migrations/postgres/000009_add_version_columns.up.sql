@@ -0,0 +1,7 @@
+-- Migration: Add optimistic concurrency versions to UOM and Parameter
+
+ALTER TABLE mst_uom
+    ADD COLUMN IF NOT EXISTS version INT NOT NULL DEFAULT 1;
+
+ALTER TABLE mst_parameter
+    ADD COLUMN IF NOT EXISTS version INT NOT NULL DEFAULT 1;
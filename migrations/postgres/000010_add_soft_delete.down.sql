@@ -0,0 +1,25 @@
+-- Rollback: Soft delete for UOM and Parameter
+-- Soft-deleted rows are removed physically before the columns are dropped
+
+DROP INDEX IF EXISTS idx_mst_parameter_live;
+DROP INDEX IF EXISTS idx_mst_uom_live;
+
+ALTER TABLE mst_parameter DROP CONSTRAINT IF EXISTS fk_mst_parameter_uom;
+ALTER TABLE mst_parameter
+    ADD CONSTRAINT fk_mst_parameter_uom FOREIGN KEY (uom) REFERENCES mst_uom(uom_code) ON DELETE SET NULL;
+
+DELETE FROM mst_parameter WHERE deleted_at IS NOT NULL;
+DELETE FROM mst_uom WHERE deleted_at IS NOT NULL;
+
+DROP INDEX IF EXISTS uq_mst_uom_base_per_category;
+CREATE UNIQUE INDEX IF NOT EXISTS uq_mst_uom_base_per_category
+    ON mst_uom(uom_category)
+    WHERE is_base_uom;
+
+ALTER TABLE mst_parameter
+    DROP COLUMN IF EXISTS deleted_by,
+    DROP COLUMN IF EXISTS deleted_at;
+
+ALTER TABLE mst_uom
+    DROP COLUMN IF EXISTS deleted_by,
+    DROP COLUMN IF EXISTS deleted_at;
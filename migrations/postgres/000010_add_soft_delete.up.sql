@@ -0,0 +1,25 @@
+-- Migration: Soft delete for UOM and Parameter
+-- Deleted rows are kept and hidden by default so references stay intact
+
+ALTER TABLE mst_uom
+    ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS deleted_by VARCHAR(100);
+
+ALTER TABLE mst_parameter
+    ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS deleted_by VARCHAR(100);
+
+-- A deleted base UOM no longer blocks a new base in its category
+DROP INDEX IF EXISTS uq_mst_uom_base_per_category;
+CREATE UNIQUE INDEX IF NOT EXISTS uq_mst_uom_base_per_category
+    ON mst_uom(uom_category)
+    WHERE is_base_uom AND deleted_at IS NULL;
+
+-- Never strip a UOM from parameters; rows are no longer physically deleted
+ALTER TABLE mst_parameter DROP CONSTRAINT IF EXISTS fk_mst_parameter_uom;
+ALTER TABLE mst_parameter
+    ADD CONSTRAINT fk_mst_parameter_uom FOREIGN KEY (uom) REFERENCES mst_uom(uom_code) ON DELETE RESTRICT;
+
+-- Most reads only see live rows
+CREATE INDEX IF NOT EXISTS idx_mst_uom_live ON mst_uom(uom_code) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_mst_parameter_live ON mst_parameter(parameter_code) WHERE deleted_at IS NULL;
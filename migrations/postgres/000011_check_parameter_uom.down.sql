@@ -0,0 +1,3 @@
+-- Rollback: Drop the NUMERIC-only UOM check
+
+ALTER TABLE mst_parameter DROP CONSTRAINT IF EXISTS chk_mst_parameter_uom_numeric;
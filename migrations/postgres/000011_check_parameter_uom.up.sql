@@ -0,0 +1,6 @@
+-- Migration: Only NUMERIC parameters carry a UOM
+-- NOT VALID keeps existing rows untouched; new and updated rows are checked.
+
+ALTER TABLE mst_parameter
+    ADD CONSTRAINT chk_mst_parameter_uom_numeric
+    CHECK (uom IS NULL OR data_type = 'NUMERIC') NOT VALID;
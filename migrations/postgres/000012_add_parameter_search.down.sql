@@ -0,0 +1,5 @@
+-- Rollback: Drop the parameter search indexes
+-- pg_trgm is kept as other objects may depend on it.
+
+DROP INDEX IF EXISTS idx_mst_parameter_created_at;
+DROP INDEX IF EXISTS idx_mst_parameter_search;
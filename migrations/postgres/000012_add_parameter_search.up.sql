@@ -0,0 +1,10 @@
+-- Migration: Substring search over parameter code, name and description
+-- The indexed expression must match the one the repository searches with ILIKE.
+
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX IF NOT EXISTS idx_mst_parameter_search
+    ON mst_parameter USING GIN ((parameter_code || ' ' || parameter_name || ' ' || COALESCE(description, '')) gin_trgm_ops);
+
+-- Date range filters and sorts
+CREATE INDEX IF NOT EXISTS idx_mst_parameter_created_at ON mst_parameter(created_at);
@@ -0,0 +1,3 @@
+-- Rollback: Drop outbox_event table
+
+DROP TABLE IF EXISTS outbox_event;
@@ -0,0 +1,29 @@
+-- Migration: Create outbox_event table
+-- Domain events written in the same transaction as the change that raised
+-- them, published afterwards by the outbox relay
+
+CREATE TABLE IF NOT EXISTS outbox_event (
+    id BIGSERIAL PRIMARY KEY,
+    event_id UUID NOT NULL DEFAULT gen_random_uuid(),
+    event_type VARCHAR(50) NOT NULL,
+    aggregate_type VARCHAR(20) NOT NULL CHECK (aggregate_type IN ('uom', 'parameter')),
+    aggregate_code VARCHAR(50) NOT NULL,
+    payload JSONB NOT NULL,
+    occurred_at TIMESTAMPTZ NOT NULL,
+    published_at TIMESTAMPTZ,
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT,
+    locked_until TIMESTAMPTZ,
+
+    CONSTRAINT uq_outbox_event_event_id UNIQUE (event_id)
+);
+
+-- Indexes
+CREATE INDEX IF NOT EXISTS idx_outbox_event_pending ON outbox_event(id) WHERE published_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_outbox_event_aggregate ON outbox_event(aggregate_type, aggregate_code, id);
+
+-- Comments
+COMMENT ON TABLE outbox_event IS 'Transactional outbox of master-data domain events';
+COMMENT ON COLUMN outbox_event.id IS 'Publish order of the events';
+COMMENT ON COLUMN outbox_event.payload IS 'Aggregate row as saved by the change';
+COMMENT ON COLUMN outbox_event.locked_until IS 'Lease of the relay currently publishing the event';
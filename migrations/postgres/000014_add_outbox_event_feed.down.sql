@@ -0,0 +1,7 @@
+-- Rollback: Drop the outbox_event change feed support
+
+DROP TRIGGER IF EXISTS trg_outbox_event_notify ON outbox_event;
+DROP FUNCTION IF EXISTS outbox_event_notify();
+DROP INDEX IF EXISTS idx_outbox_event_feed;
+DROP INDEX IF EXISTS idx_outbox_event_tx;
+ALTER TABLE outbox_event DROP COLUMN IF EXISTS tx_id;
@@ -0,0 +1,24 @@
+-- Migration: Read outbox_event as an ordered change feed
+-- Ids are taken before commit, so a reader following ids alone could pass an
+-- id whose transaction commits later. Readers instead follow (tx_id, id) and
+-- only read transactions older than every running one.
+
+ALTER TABLE outbox_event ADD COLUMN IF NOT EXISTS tx_id XID8 NOT NULL DEFAULT pg_current_xact_id();
+
+CREATE INDEX IF NOT EXISTS idx_outbox_event_feed ON outbox_event(aggregate_type, tx_id, id);
+CREATE INDEX IF NOT EXISTS idx_outbox_event_tx ON outbox_event(tx_id, id);
+
+-- Wake feed readers once per committing statement
+CREATE OR REPLACE FUNCTION outbox_event_notify() RETURNS TRIGGER AS $$
+BEGIN
+    PERFORM pg_notify('outbox_event', '');
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_outbox_event_notify ON outbox_event;
+CREATE TRIGGER trg_outbox_event_notify
+    AFTER INSERT ON outbox_event
+    FOR EACH STATEMENT EXECUTE FUNCTION outbox_event_notify();
+
+COMMENT ON COLUMN outbox_event.tx_id IS 'Transaction that wrote the event; orders the change feed';
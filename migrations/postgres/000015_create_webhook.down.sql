@@ -0,0 +1,4 @@
+-- Rollback: Drop webhook tables
+
+DROP TABLE IF EXISTS webhook_delivery;
+DROP TABLE IF EXISTS webhook_subscription;
@@ -0,0 +1,43 @@
+-- Migration: Create webhook_subscription and webhook_delivery tables
+-- Subscriptions register HTTPS endpoints for event types; every outbox event
+-- is fanned out to one delivery per matching subscription
+
+CREATE TABLE IF NOT EXISTS webhook_subscription (
+    id BIGSERIAL PRIMARY KEY,
+    url VARCHAR(500) NOT NULL,
+    event_types JSONB NOT NULL,
+    secret VARCHAR(100) NOT NULL,
+    description TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_by VARCHAR(100) NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS webhook_delivery (
+    id BIGSERIAL PRIMARY KEY,
+    subscription_id BIGINT NOT NULL REFERENCES webhook_subscription(id) ON DELETE CASCADE,
+    event_id UUID NOT NULL,
+    event_type VARCHAR(50) NOT NULL,
+    body JSONB NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'PENDING' CHECK (status IN ('PENDING', 'SUCCEEDED', 'DEAD')),
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL,
+    last_error TEXT,
+    last_status_code INT,
+    locked_until TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    delivered_at TIMESTAMPTZ,
+
+    CONSTRAINT uq_webhook_delivery_event UNIQUE (subscription_id, event_id)
+);
+
+-- Indexes
+CREATE INDEX IF NOT EXISTS idx_webhook_subscription_event_types ON webhook_subscription USING GIN (event_types);
+CREATE INDEX IF NOT EXISTS idx_webhook_delivery_due ON webhook_delivery(next_attempt_at) WHERE status = 'PENDING';
+CREATE INDEX IF NOT EXISTS idx_webhook_delivery_status ON webhook_delivery(status, id);
+
+-- Comments
+COMMENT ON TABLE webhook_subscription IS 'HTTPS endpoints notified of master-data events';
+COMMENT ON COLUMN webhook_subscription.secret IS 'HMAC-SHA256 key signing the deliveries';
+COMMENT ON TABLE webhook_delivery IS 'Deliveries of events to webhook subscriptions; DEAD rows are the dead letters';
+COMMENT ON COLUMN webhook_delivery.body IS 'Outbox message posted to the endpoint';
+COMMENT ON COLUMN webhook_delivery.locked_until IS 'Lease of the dispatcher currently sending the delivery';
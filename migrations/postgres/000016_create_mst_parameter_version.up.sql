@@ -0,0 +1,58 @@
+-- Migration: Create mst_parameter_version table
+-- Effective-dated history of parameter definitions. Every save of a parameter
+-- closes the version in force and copies the saved row as the next, so values
+-- and costs can be checked against the definition in force at their time
+
+CREATE TABLE IF NOT EXISTS mst_parameter_version (
+    parameter_code VARCHAR(50) NOT NULL REFERENCES mst_parameter(parameter_code) ON DELETE CASCADE,
+    version INT NOT NULL,
+    parameter_name VARCHAR(200) NOT NULL,
+    parameter_category VARCHAR(20) NOT NULL,
+    data_type VARCHAR(20) NOT NULL,
+    uom VARCHAR(20),
+    min_value DECIMAL(18,6),
+    max_value DECIMAL(18,6),
+    allowed_values JSONB,
+    is_mandatory BOOLEAN,
+    description TEXT,
+    is_active BOOLEAN,
+    created_at TIMESTAMPTZ,
+    created_by VARCHAR(100) NOT NULL,
+    updated_at TIMESTAMPTZ,
+    updated_by VARCHAR(100),
+    deleted_at TIMESTAMPTZ,
+    deleted_by VARCHAR(100),
+    effective_from TIMESTAMPTZ NOT NULL,
+    effective_to TIMESTAMPTZ,
+    recorded_by VARCHAR(100) NOT NULL,
+
+    PRIMARY KEY (parameter_code, version),
+    CONSTRAINT chk_mst_parameter_version_period CHECK (effective_to IS NULL OR effective_to >= effective_from)
+);
+
+-- At most one version of a parameter is open-ended
+CREATE UNIQUE INDEX IF NOT EXISTS uq_mst_parameter_version_current
+    ON mst_parameter_version(parameter_code) WHERE effective_to IS NULL;
+CREATE INDEX IF NOT EXISTS idx_mst_parameter_version_effective
+    ON mst_parameter_version(parameter_code, effective_from);
+
+-- Earlier revisions were overwritten; the current one stands since creation
+INSERT INTO mst_parameter_version (
+    parameter_code, version, parameter_name, parameter_category, data_type,
+    uom, min_value, max_value, allowed_values, is_mandatory,
+    description, is_active, created_at, created_by, updated_at, updated_by,
+    deleted_at, deleted_by, effective_from, recorded_by
+)
+SELECT
+    parameter_code, version, parameter_name, parameter_category, data_type,
+    uom, min_value, max_value, allowed_values, is_mandatory,
+    description, is_active, created_at, created_by, updated_at, updated_by,
+    deleted_at, deleted_by, COALESCE(created_at, NOW()), COALESCE(deleted_by, updated_by, created_by)
+FROM mst_parameter
+ON CONFLICT DO NOTHING;
+
+-- Comments
+COMMENT ON TABLE mst_parameter_version IS 'Effective-dated history of parameter definitions';
+COMMENT ON COLUMN mst_parameter_version.version IS 'mst_parameter.version of the saved row';
+COMMENT ON COLUMN mst_parameter_version.effective_from IS 'Time of the save, inclusive';
+COMMENT ON COLUMN mst_parameter_version.effective_to IS 'Time of the next save, exclusive; NULL while in force';
@@ -0,0 +1,3 @@
+-- Rollback: Drop mst_parameter_change_request table
+
+DROP TABLE IF EXISTS mst_parameter_change_request;
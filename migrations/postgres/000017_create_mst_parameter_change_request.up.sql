@@ -0,0 +1,37 @@
+-- Migration: Create mst_parameter_change_request table
+-- Edits, deletes and restores of parameters under the approval policy are held
+-- here until a second person approves or rejects them; only approved ones
+-- reach mst_parameter
+
+CREATE TABLE IF NOT EXISTS mst_parameter_change_request (
+    id BIGSERIAL PRIMARY KEY,
+    parameter_code VARCHAR(50) NOT NULL REFERENCES mst_parameter(parameter_code) ON DELETE CASCADE,
+    base_version INT NOT NULL,
+    action VARCHAR(20) NOT NULL DEFAULT 'UPDATE' CHECK (action IN ('UPDATE', 'DELETE', 'RESTORE')),
+    proposal JSONB NOT NULL,
+    changes JSONB NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'PENDING' CHECK (status IN ('PENDING', 'APPROVED', 'REJECTED')),
+    requested_by VARCHAR(100) NOT NULL,
+    requested_by_subject VARCHAR(255) NOT NULL,
+    requested_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    reviewed_by VARCHAR(100),
+    reviewed_by_subject VARCHAR(255),
+    reviewed_at TIMESTAMPTZ,
+    review_comment TEXT,
+
+    CONSTRAINT chk_mst_parameter_change_request_four_eyes CHECK (status <> 'APPROVED' OR reviewed_by_subject <> requested_by_subject)
+);
+
+-- At most one pending change request per parameter
+CREATE UNIQUE INDEX IF NOT EXISTS uq_mst_parameter_change_request_pending
+    ON mst_parameter_change_request(parameter_code) WHERE status = 'PENDING';
+CREATE INDEX IF NOT EXISTS idx_mst_parameter_change_request_status
+    ON mst_parameter_change_request(status, id);
+
+-- Comments
+COMMENT ON TABLE mst_parameter_change_request IS 'Parameter changes awaiting or after four-eyes review';
+COMMENT ON COLUMN mst_parameter_change_request.base_version IS 'mst_parameter.version the edit was made against';
+COMMENT ON COLUMN mst_parameter_change_request.action IS 'UPDATE applies the proposal, DELETE and RESTORE soft-delete and restore the parameter';
+COMMENT ON COLUMN mst_parameter_change_request.proposal IS 'Fields of the UpdateParameter command applied on approval, empty for DELETE and RESTORE';
+COMMENT ON COLUMN mst_parameter_change_request.changes IS 'Field changes against the base version, as in audit_event.changes';
+COMMENT ON COLUMN mst_parameter_change_request.requested_by_subject IS 'Token subject of the author; four eyes compare subjects, not the display names in requested_by and reviewed_by';
@@ -0,0 +1,3 @@
+-- Rollback: Drop the period exclusion constraint of mst_parameter_value
+
+ALTER TABLE mst_parameter_value DROP CONSTRAINT IF EXISTS excl_mst_parameter_value_period;
@@ -0,0 +1,13 @@
+-- Migration: Keep the effective periods of mst_parameter_value apart
+-- The application checks for overlaps under an advisory lock; the exclusion
+-- constraint makes the database enforce it for every writer
+
+CREATE EXTENSION IF NOT EXISTS btree_gist;
+
+ALTER TABLE mst_parameter_value DROP CONSTRAINT IF EXISTS excl_mst_parameter_value_period;
+ALTER TABLE mst_parameter_value ADD CONSTRAINT excl_mst_parameter_value_period EXCLUDE USING gist (
+    subject_type WITH =,
+    subject_code WITH =,
+    parameter_code WITH =,
+    daterange(effective_from, effective_to) WITH &&
+);
@@ -0,0 +1,108 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+)
+
+// Policy errors.
+var (
+	ErrUnknownRole   = errors.New("unknown role")
+	ErrRoleCycle     = errors.New("role inheritance cycle")
+	ErrInvalidPolicy = errors.New("invalid permission pattern")
+
+	ErrPermissionDenied = errors.New("permission denied")
+)
+
+// Role grants permissions, given as full gRPC method names or path.Match
+// patterns such as "/costing.v1.*/Get*", plus those of the inherited roles.
+type Role struct {
+	Name        string
+	Inherits    []string
+	Permissions []string
+}
+
+// Policy maps roles to the permissions they grant.
+type Policy struct {
+	permissions map[string][]string
+}
+
+// NewPolicy resolves role inheritance and validates every pattern.
+func NewPolicy(roles []Role) (*Policy, error) {
+	byName := make(map[string]Role, len(roles))
+	for _, role := range roles {
+		byName[role.Name] = role
+	}
+
+	policy := &Policy{permissions: make(map[string][]string, len(roles))}
+	for _, role := range roles {
+		permissions, err := resolve(byName, role.Name, map[string]bool{})
+		if err != nil {
+			return nil, fmt.Errorf("role %s: %w", role.Name, err)
+		}
+		policy.permissions[role.Name] = permissions
+	}
+
+	return policy, nil
+}
+
+func resolve(roles map[string]Role, name string, visiting map[string]bool) ([]string, error) {
+	role, ok := roles[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownRole, name)
+	}
+	if visiting[name] {
+		return nil, ErrRoleCycle
+	}
+	visiting[name] = true
+	defer delete(visiting, name)
+
+	permissions := make([]string, 0, len(role.Permissions))
+	for _, pattern := range role.Permissions {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidPolicy, pattern)
+		}
+		permissions = append(permissions, pattern)
+	}
+	for _, parent := range role.Inherits {
+		inherited, err := resolve(roles, parent, visiting)
+		if err != nil {
+			return nil, err
+		}
+		permissions = append(permissions, inherited...)
+	}
+
+	return permissions, nil
+}
+
+// Allows reports whether any of the roles grants the permission.
+// Roles unknown to the policy grant nothing.
+func (p *Policy) Allows(roles []string, permission string) bool {
+	for _, role := range roles {
+		for _, pattern := range p.permissions[role] {
+			if matched, _ := path.Match(pattern, permission); matched {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Authorize checks that the principal in ctx holds the permission. It serves
+// permissions that depend on the data a request changes, which only the
+// application knows. A nil Policy, i.e. authorization disabled, allows all.
+func (p *Policy) Authorize(ctx context.Context, permission string) error {
+	if p == nil {
+		return nil
+	}
+	principal, ok := PrincipalFromContext(ctx)
+	if !ok {
+		return ErrMissingToken
+	}
+	if !p.Allows(principal.Roles, permission) {
+		return fmt.Errorf("%w: %s", ErrPermissionDenied, permission)
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package auth
+
+import (
+	"context"
+	"errors"
+)
+
+// Standard authentication errors.
+var (
+	ErrMissingToken = errors.New("missing bearer token")
+	ErrInvalidToken = errors.New("invalid bearer token")
+)
+
+// Principal is the authenticated caller of a request.
+type Principal struct {
+	Subject  string
+	Username string
+	Email    string
+	Roles    []string
+}
+
+// Name returns the identity recorded in audit fields: the username when the
+// token carries one, otherwise the subject.
+func (p *Principal) Name() string {
+	if p.Username != "" {
+		return p.Username
+	}
+	return p.Subject
+}
+
+// HasRole reports whether the principal has the given role.
+func (p *Principal) HasRole(role string) bool {
+	for _, r := range p.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// Verifier verifies a raw bearer token and returns its principal.
+type Verifier interface {
+	Verify(ctx context.Context, token string) (*Principal, error)
+}
+
+type principalKey struct{}
+
+// WithPrincipal returns a copy of ctx carrying the principal.
+func WithPrincipal(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// PrincipalFromContext returns the principal carried by ctx, if any.
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(*Principal)
+	return p, ok && p != nil
+}
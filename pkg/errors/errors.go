@@ -83,6 +83,16 @@ func NewValidationError(validation *ValidationErrors) *AppError {
 	}
 }
 
+// NewFieldError creates a validation error for a single field that still matches err.
+func NewFieldError(field string, err error) *AppError {
+	validation := NewValidationErrors()
+	validation.Add(field, err.Error())
+
+	appErr := NewValidationError(validation)
+	appErr.Err = err
+	return appErr
+}
+
 // Error implements the error interface.
 func (e *AppError) Error() string {
 	if e.Err != nil {
@@ -0,0 +1,199 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/homindolenern/goapps-costing-v1/pkg/auth"
+)
+
+// RetryAfterKey is the response header metadata carrying the seconds to wait
+// after a ResourceExhausted error. The gateway maps it to Retry-After.
+const RetryAfterKey = "retry-after"
+
+// Policy configures the interceptor.
+type Policy struct {
+	// Default applies to every method without an override, shared across
+	// those methods per identity.
+	Default Limit
+	// Methods overrides the limit per full gRPC method name, with a
+	// separate bucket per identity and method.
+	Methods map[string]Limit
+	// TrustedProxies is the number of proxies in front of the gateway whose
+	// X-Forwarded-For entries are trusted.
+	TrustedProxies int
+	// Gateways are the networks, besides loopback, of gateways calling the
+	// gRPC server. X-Forwarded-For is only read from calls they make, since
+	// any other client could set it to pick a fresh bucket.
+	Gateways []*net.IPNet
+}
+
+// UnaryInterceptor returns a gRPC unary interceptor that rate limits each
+// caller, identified by authenticated principal, then X-Forwarded-For from
+// a gateway, then peer address. Methods matching exemptPrefixes are not limited.
+// It fails open when the limiter errors (e.g. Redis unavailable).
+func UnaryInterceptor(limiter Limiter, policy Policy, exemptPrefixes ...string) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		for _, prefix := range exemptPrefixes {
+			if strings.HasPrefix(info.FullMethod, prefix) {
+				return handler(ctx, req)
+			}
+		}
+
+		if err := take(ctx, limiter, policy, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamInterceptor is the streaming counterpart of UnaryInterceptor. A
+// stream takes a single token when it is opened.
+func StreamInterceptor(limiter Limiter, policy Policy, exemptPrefixes ...string) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		for _, prefix := range exemptPrefixes {
+			if strings.HasPrefix(info.FullMethod, prefix) {
+				return handler(srv, ss)
+			}
+		}
+
+		if err := take(ss.Context(), limiter, policy, info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+// take spends a token of the caller's bucket for method and returns a
+// ResourceExhausted error with a Retry-After header when it is empty.
+func take(ctx context.Context, limiter Limiter, policy Policy, method string) error {
+	limit, scope := policy.Default, "*"
+	if override, ok := policy.Methods[method]; ok {
+		limit, scope = override, method
+	}
+
+	key := Identity(ctx, policy) + "|" + scope
+	decision, err := limiter.Take(ctx, key, limit)
+	if err != nil {
+		log.Warn().Err(err).Str("method", method).Msg("Rate limiter unavailable - allowing request")
+		return nil
+	}
+
+	if !decision.Allowed {
+		seconds := int64(math.Ceil(decision.RetryAfter.Seconds()))
+		if seconds < 1 {
+			seconds = 1
+		}
+		_ = grpc.SetHeader(ctx, metadata.Pairs(RetryAfterKey, strconv.FormatInt(seconds, 10)))
+		return status.Errorf(codes.ResourceExhausted, "rate limit exceeded, retry after %ds", seconds)
+	}
+
+	return nil
+}
+
+// Identity returns the rate limit identity of the caller.
+func Identity(ctx context.Context, policy Policy) string {
+	if principal, ok := auth.PrincipalFromContext(ctx); ok {
+		return "user:" + principal.Subject
+	}
+
+	host := peerHost(ctx)
+	if md, ok := metadata.FromIncomingContext(ctx); ok && isGateway(host, policy.Gateways) {
+		if ip := forwardedFor(md.Get("x-forwarded-for"), policy.TrustedProxies); ip != "" {
+			return "ip:" + ip
+		}
+	}
+
+	if host != "" {
+		return "ip:" + host
+	}
+	return "unknown"
+}
+
+// ParseNetworks parses CIDR blocks, such as the gateway networks of a Policy.
+func ParseNetworks(cidrs []string) ([]*net.IPNet, error) {
+	networks := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, err
+		}
+		networks = append(networks, network)
+	}
+	return networks, nil
+}
+
+// peerHost returns the address of the connection the call came in on.
+func peerHost(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		host = p.Addr.String()
+	}
+	return host
+}
+
+// isGateway reports whether host is loopback, where the in-process gateway
+// dials from, or in one of the gateway networks.
+func isGateway(host string, gateways []*net.IPNet) bool {
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return false
+	}
+	if ip.IsLoopback() {
+		return true
+	}
+	for _, network := range gateways {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// forwardedFor picks the client address from X-Forwarded-For. The gateway
+// appends the address it received the request from, so the rightmost entry
+// is trustworthy and each trusted proxy moves one entry to the left.
+// Entries further left are client-supplied and could be spoofed.
+func forwardedFor(values []string, trustedProxies int) string {
+	var hops []string
+	for _, value := range values {
+		for _, hop := range strings.Split(value, ",") {
+			if hop = strings.TrimSpace(hop); hop != "" {
+				hops = append(hops, hop)
+			}
+		}
+	}
+	if len(hops) == 0 {
+		return ""
+	}
+
+	i := len(hops) - 1 - trustedProxies
+	if i < 0 {
+		i = 0
+	}
+	return hops[i]
+}
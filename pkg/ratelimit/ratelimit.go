@@ -4,13 +4,25 @@ import (
 	"context"
 	"sync"
 	"time"
-
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/peer"
-	"google.golang.org/grpc/status"
 )
 
+// Limit is a token bucket configuration.
+type Limit struct {
+	Rate  float64 // tokens per second
+	Burst float64 // bucket size
+}
+
+// Decision is the outcome of taking a token.
+type Decision struct {
+	Allowed    bool
+	RetryAfter time.Duration // time until a token is available when denied
+}
+
+// Limiter takes a token from the bucket identified by key.
+type Limiter interface {
+	Take(ctx context.Context, key string, limit Limit) (Decision, error)
+}
+
 // TokenBucket implements a token bucket rate limiter.
 type TokenBucket struct {
 	tokens     float64
@@ -32,6 +44,12 @@ func NewTokenBucket(maxTokens, refillRate float64) *TokenBucket {
 
 // Allow checks if a request is allowed and consumes a token.
 func (tb *TokenBucket) Allow() bool {
+	return tb.Take().Allowed
+}
+
+// Take consumes a token if one is available, otherwise reports how long
+// until the next token.
+func (tb *TokenBucket) Take() Decision {
 	tb.mu.Lock()
 	defer tb.mu.Unlock()
 
@@ -42,12 +60,12 @@ func (tb *TokenBucket) Allow() bool {
 
 	if tb.tokens >= 1 {
 		tb.tokens--
-		return true
+		return Decision{Allowed: true}
 	}
-	return false
+	return Decision{RetryAfter: retryAfter(1-tb.tokens, tb.refillRate)}
 }
 
-// RateLimiter manages per-client rate limiting.
+// RateLimiter manages per-client rate limiting in process memory.
 type RateLimiter struct {
 	buckets    map[string]*TokenBucket
 	maxTokens  float64
@@ -64,8 +82,18 @@ func NewRateLimiter(maxTokens, refillRate float64) *RateLimiter {
 	}
 }
 
-// Allow checks if a request from the given key is allowed.
+// Allow checks if a request from the given key is allowed under the default limit.
 func (rl *RateLimiter) Allow(key string) bool {
+	return rl.bucket(key, Limit{Rate: rl.refillRate, Burst: rl.maxTokens}).Allow()
+}
+
+// Take implements Limiter. The bucket for a key keeps the limit it was
+// created with, so callers should include the limit's scope in the key.
+func (rl *RateLimiter) Take(_ context.Context, key string, limit Limit) (Decision, error) {
+	return rl.bucket(key, limit).Take(), nil
+}
+
+func (rl *RateLimiter) bucket(key string, limit Limit) *TokenBucket {
 	rl.mu.RLock()
 	bucket, exists := rl.buckets[key]
 	rl.mu.RUnlock()
@@ -75,13 +103,13 @@ func (rl *RateLimiter) Allow(key string) bool {
 		// Double check after acquiring write lock
 		bucket, exists = rl.buckets[key]
 		if !exists {
-			bucket = NewTokenBucket(rl.maxTokens, rl.refillRate)
+			bucket = NewTokenBucket(limit.Burst, limit.Rate)
 			rl.buckets[key] = bucket
 		}
 		rl.mu.Unlock()
 	}
 
-	return bucket.Allow()
+	return bucket
 }
 
 // Cleanup removes old buckets that haven't been used.
@@ -116,26 +144,12 @@ func (rl *RateLimiter) StartCleanup(ctx context.Context, interval, maxAge time.D
 	}()
 }
 
-// UnaryInterceptor returns a gRPC unary interceptor for rate limiting.
-func UnaryInterceptor(rl *RateLimiter) grpc.UnaryServerInterceptor {
-	return func(
-		ctx context.Context,
-		req interface{},
-		info *grpc.UnaryServerInfo,
-		handler grpc.UnaryHandler,
-	) (interface{}, error) {
-		// Get client identifier (IP address)
-		clientIP := "unknown"
-		if p, ok := peer.FromContext(ctx); ok {
-			clientIP = p.Addr.String()
-		}
-
-		if !rl.Allow(clientIP) {
-			return nil, status.Error(codes.ResourceExhausted, "rate limit exceeded")
-		}
-
-		return handler(ctx, req)
+// retryAfter returns the time needed to refill the missing tokens.
+func retryAfter(missing, refillRate float64) time.Duration {
+	if refillRate <= 0 {
+		return time.Hour
 	}
+	return time.Duration(missing / refillRate * float64(time.Second))
 }
 
 // minFloat returns the smaller of two float64 values.
@@ -0,0 +1,140 @@
+// Package spreadsheet reads and writes the CSV and XLSX files used to
+// exchange master data.
+package spreadsheet
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+)
+
+// Format is a tabular file format.
+type Format string
+
+// Supported formats.
+const (
+	FormatCSV  Format = "csv"
+	FormatXLSX Format = "xlsx"
+)
+
+// Errors returned by the readers.
+var (
+	ErrUnsupportedFormat = errors.New("unsupported file format, use csv or xlsx")
+	ErrInvalidFile       = errors.New("file cannot be read")
+	ErrTooManyRows       = errors.New("file has too many rows")
+)
+
+// ParseFormat parses a format name or file extension such as "CSV" or ".xlsx".
+func ParseFormat(s string) (Format, error) {
+	switch f := Format(strings.ToLower(strings.TrimPrefix(s, "."))); f {
+	case FormatCSV, FormatXLSX:
+		return f, nil
+	default:
+		return "", ErrUnsupportedFormat
+	}
+}
+
+// FormatFromFilename returns the format matching a file name's extension.
+func FormatFromFilename(name string) (Format, error) {
+	return ParseFormat(path.Ext(name))
+}
+
+// ReadRows returns the rows of a CSV file or of the first sheet of an XLSX
+// workbook. Row i of the result is line i+1 of the file, so blank lines are
+// kept as empty rows and row numbers can be reported to the user.
+// Reading stops with ErrTooManyRows at the first row holding data past
+// maxRows such rows; zero means no limit.
+func ReadRows(format Format, data []byte, maxRows int) ([][]string, error) {
+	switch format {
+	case FormatCSV:
+		return readCSV(data, maxRows)
+	case FormatXLSX:
+		return readXLSX(data, maxRows)
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+}
+
+// rowCounter counts the rows holding data against a ReadRows limit.
+type rowCounter struct {
+	max, count int
+}
+
+// add counts row and reports ErrTooManyRows once past the limit.
+func (c *rowCounter) add(row []string) error {
+	for _, cell := range row {
+		if strings.TrimSpace(cell) != "" {
+			if c.count++; c.max > 0 && c.count > c.max {
+				return ErrTooManyRows
+			}
+			return nil
+		}
+	}
+	return nil
+}
+
+func readCSV(data []byte, maxRows int) ([][]string, error) {
+	// Excel saves UTF-8 CSV with a byte order mark
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+
+	r := csv.NewReader(bytes.NewReader(data))
+	r.FieldsPerRecord = -1
+
+	var rows [][]string
+	counter := rowCounter{max: maxRows}
+	for {
+		record, err := r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return rows, nil
+			}
+			return nil, fmt.Errorf("%w: %v", ErrInvalidFile, err)
+		}
+		if err := counter.add(record); err != nil {
+			return nil, err
+		}
+		// The csv reader skips blank lines; pad so row numbers stay true
+		line, _ := r.FieldPos(0)
+		for len(rows) < line-1 {
+			rows = append(rows, nil)
+		}
+		rows = append(rows, record)
+	}
+}
+
+// Writer writes rows to a CSV file or to the single sheet of an XLSX
+// workbook. Rows are streamed to the underlying writer; Close must be called
+// to complete the file.
+type Writer interface {
+	Write(row []string) error
+	Close() error
+}
+
+// NewWriter returns a Writer for the format.
+func NewWriter(format Format, w io.Writer) (Writer, error) {
+	switch format {
+	case FormatCSV:
+		return &csvWriter{w: csv.NewWriter(w)}, nil
+	case FormatXLSX:
+		return newXLSXWriter(w)
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+}
+
+type csvWriter struct {
+	w *csv.Writer
+}
+
+func (c *csvWriter) Write(row []string) error {
+	return c.w.Write(row)
+}
+
+func (c *csvWriter) Close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
@@ -0,0 +1,308 @@
+package spreadsheet
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+)
+
+const (
+	// maxPartSize caps the uncompressed size of a workbook part to guard
+	// against zip bombs.
+	maxPartSize = 64 << 20
+	// maxSheetRows and maxSheetColumns are the sheet bounds of Excel (XFD1048576).
+	maxSheetRows    = 1 << 20
+	maxSheetColumns = 1 << 14
+	// maxCells caps the cells read from a sheet, counting the empty ones
+	// padding out rows, so sparse references cannot blow up memory.
+	maxCells = 1 << 20
+)
+
+type xlsxWorkbook struct {
+	Sheets []struct {
+		RelID string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+	} `xml:"sheets>sheet"`
+}
+
+type xlsxRelationships struct {
+	Relationships []struct {
+		ID     string `xml:"Id,attr"`
+		Target string `xml:"Target,attr"`
+	} `xml:"Relationship"`
+}
+
+type xlsxSharedStrings struct {
+	Items []xlsxText `xml:"si"`
+}
+
+// xlsxText is plain or rich text; rich text is split into runs.
+type xlsxText struct {
+	T    string `xml:"t"`
+	Runs []struct {
+		T string `xml:"t"`
+	} `xml:"r"`
+}
+
+func (t xlsxText) String() string {
+	if len(t.Runs) == 0 {
+		return t.T
+	}
+	var b strings.Builder
+	for _, r := range t.Runs {
+		b.WriteString(r.T)
+	}
+	return b.String()
+}
+
+type xlsxWorksheet struct {
+	Rows []struct {
+		R     int `xml:"r,attr"`
+		Cells []struct {
+			R      string   `xml:"r,attr"`
+			T      string   `xml:"t,attr"`
+			V      string   `xml:"v"`
+			Inline xlsxText `xml:"is"`
+		} `xml:"c"`
+	} `xml:"sheetData>row"`
+}
+
+func readXLSX(data []byte, maxRows int) ([][]string, error) {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidFile, err)
+	}
+
+	sheetPath, err := firstSheetPath(zr)
+	if err != nil {
+		return nil, err
+	}
+
+	var shared xlsxSharedStrings
+	if err := decodePart(zr, "xl/sharedStrings.xml", &shared, true); err != nil {
+		return nil, err
+	}
+
+	var sheet xlsxWorksheet
+	if err := decodePart(zr, sheetPath, &sheet, false); err != nil {
+		return nil, err
+	}
+
+	var rows [][]string
+	counter := rowCounter{max: maxRows}
+	cellCount := 0
+	for _, row := range sheet.Rows {
+		// Rows without cells are left out of the file, and r is optional
+		index := len(rows)
+		if row.R > 0 {
+			index = row.R - 1
+		}
+		if row.R < 0 || index >= maxSheetRows {
+			return nil, fmt.Errorf("%w: bad row number %d", ErrInvalidFile, row.R)
+		}
+		for len(rows) <= index {
+			rows = append(rows, nil)
+		}
+
+		var cells []string
+		for _, c := range row.Cells {
+			col := len(cells)
+			if c.R != "" {
+				if col, err = columnIndex(c.R); err != nil {
+					return nil, err
+				}
+			}
+			value, err := cellValue(c.T, c.V, c.Inline, shared.Items)
+			if err != nil {
+				return nil, err
+			}
+			if col >= len(cells) {
+				if cellCount += col + 1 - len(cells); cellCount > maxCells {
+					return nil, fmt.Errorf("%w: sheet has too many cells", ErrInvalidFile)
+				}
+			}
+			for len(cells) <= col {
+				cells = append(cells, "")
+			}
+			cells[col] = value
+		}
+		if err := counter.add(cells); err != nil {
+			return nil, err
+		}
+		rows[index] = cells
+	}
+	return rows, nil
+}
+
+// firstSheetPath resolves the part name of the workbook's first sheet.
+func firstSheetPath(zr *zip.Reader) (string, error) {
+	var wb xlsxWorkbook
+	if err := decodePart(zr, "xl/workbook.xml", &wb, false); err != nil {
+		return "", err
+	}
+	if len(wb.Sheets) == 0 {
+		return "", fmt.Errorf("%w: workbook has no sheets", ErrInvalidFile)
+	}
+
+	var rels xlsxRelationships
+	if err := decodePart(zr, "xl/_rels/workbook.xml.rels", &rels, false); err != nil {
+		return "", err
+	}
+	for _, rel := range rels.Relationships {
+		if rel.ID != wb.Sheets[0].RelID {
+			continue
+		}
+		if strings.HasPrefix(rel.Target, "/") {
+			return strings.TrimPrefix(rel.Target, "/"), nil
+		}
+		return path.Join("xl", rel.Target), nil
+	}
+	return "", fmt.Errorf("%w: first sheet not found", ErrInvalidFile)
+}
+
+// decodePart unmarshals the XML part with the given name. A missing optional
+// part leaves v unchanged.
+func decodePart(zr *zip.Reader, name string, v any, optional bool) error {
+	f, err := zr.Open(name)
+	if err != nil {
+		if optional {
+			return nil
+		}
+		return fmt.Errorf("%w: missing %s", ErrInvalidFile, name)
+	}
+	defer f.Close()
+
+	if err := xml.NewDecoder(io.LimitReader(f, maxPartSize)).Decode(v); err != nil {
+		return fmt.Errorf("%w: %s: %v", ErrInvalidFile, name, err)
+	}
+	return nil
+}
+
+// cellValue returns the text of a cell as Excel would display it unformatted.
+func cellValue(typ, v string, inline xlsxText, shared []xlsxText) (string, error) {
+	switch typ {
+	case "s":
+		i, err := strconv.Atoi(v)
+		if err != nil || i < 0 || i >= len(shared) {
+			return "", fmt.Errorf("%w: bad shared string index %q", ErrInvalidFile, v)
+		}
+		return shared[i].String(), nil
+	case "inlineStr":
+		return inline.String(), nil
+	case "b":
+		if v == "1" {
+			return "TRUE", nil
+		}
+		return "FALSE", nil
+	default:
+		// Numbers, formula strings, ISO dates and errors are stored as is
+		return v, nil
+	}
+}
+
+// columnIndex returns the zero-based column of a cell reference such as "AB12".
+func columnIndex(ref string) (int, error) {
+	col := 0
+	for i, r := range ref {
+		if r >= 'A' && r <= 'Z' {
+			if col = col*26 + int(r-'A'+1); col > maxSheetColumns {
+				break
+			}
+			continue
+		}
+		if i == 0 {
+			break
+		}
+		return col - 1, nil
+	}
+	return 0, fmt.Errorf("%w: bad cell reference %q", ErrInvalidFile, ref)
+}
+
+// Fixed parts of a written workbook with a single sheet.
+var xlsxParts = []struct{ name, content string }{
+	{"[Content_Types].xml", xml.Header + `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+		`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+		`<Default Extension="xml" ContentType="application/xml"/>` +
+		`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+		`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+		`</Types>`},
+	{"_rels/.rels", xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+		`</Relationships>`},
+	{"xl/workbook.xml", xml.Header + `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" ` +
+		`xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+		`<sheets><sheet name="Sheet1" sheetId="1" r:id="rId1"/></sheets></workbook>`},
+	{"xl/_rels/workbook.xml.rels", xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+		`</Relationships>`},
+}
+
+// xlsxWriter streams rows into the sheet part, which is written last so the
+// workbook never has to be held in memory. Cells are written as inline text.
+type xlsxWriter struct {
+	zw    *zip.Writer
+	sheet *bufio.Writer
+	row   int
+}
+
+func newXLSXWriter(w io.Writer) (*xlsxWriter, error) {
+	zw := zip.NewWriter(w)
+	for _, part := range xlsxParts {
+		f, err := zw.Create(part.name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := io.WriteString(f, part.content); err != nil {
+			return nil, err
+		}
+	}
+
+	f, err := zw.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return nil, err
+	}
+	sheet := bufio.NewWriter(f)
+	_, err = sheet.WriteString(xml.Header + `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`)
+	return &xlsxWriter{zw: zw, sheet: sheet}, err
+}
+
+func (x *xlsxWriter) Write(row []string) error {
+	x.row++
+	fmt.Fprintf(x.sheet, `<row r="%d">`, x.row)
+	for i, cell := range row {
+		if cell == "" {
+			continue
+		}
+		fmt.Fprintf(x.sheet, `<c r="%s%d" t="inlineStr"><is><t xml:space="preserve">`, columnName(i), x.row)
+		if err := xml.EscapeText(x.sheet, []byte(cell)); err != nil {
+			return err
+		}
+		x.sheet.WriteString(`</t></is></c>`)
+	}
+	_, err := x.sheet.WriteString(`</row>`)
+	return err
+}
+
+func (x *xlsxWriter) Close() error {
+	if _, err := x.sheet.WriteString(`</sheetData></worksheet>`); err != nil {
+		return err
+	}
+	if err := x.sheet.Flush(); err != nil {
+		return err
+	}
+	return x.zw.Close()
+}
+
+// columnName returns the letters of a zero-based column, e.g. 27 is "AB".
+func columnName(col int) string {
+	name := ""
+	for col++; col > 0; col = (col - 1) / 26 {
+		name = string(rune('A'+(col-1)%26)) + name
+	}
+	return name
+}
@@ -0,0 +1,121 @@
+package integration_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	appaudit "github.com/homindolenern/goapps-costing-v1/internal/application/audit"
+	appparam "github.com/homindolenern/goapps-costing-v1/internal/application/parameter"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/audit"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/changerequest"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/parameter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditDomain_Diff(t *testing.T) {
+	before := audit.Snapshot{"name": "Kilogram", "factor": 1.0, "is_active": true}
+	after := audit.Snapshot{"name": "Kilogram (kg)", "factor": 1.0, "is_active": false}
+
+	changes := audit.Diff(before, after)
+	require.Len(t, changes, 2)
+	assert.Equal(t, "is_active", changes[0].Field)
+	assert.Equal(t, true, changes[0].Before)
+	assert.Equal(t, false, changes[0].After)
+	assert.Equal(t, "name", changes[1].Field)
+
+	assert.Empty(t, audit.Diff(before, before))
+	assert.NotNil(t, audit.Diff(before, before))
+}
+
+func TestAuditDomain_NewEvent(t *testing.T) {
+	t.Run("create has only after", func(t *testing.T) {
+		event, err := audit.NewEvent(audit.EntityUOM, "KG", audit.ActionCreate, nil, audit.Snapshot{"name": "Kilogram"}, "admin")
+		require.NoError(t, err)
+		assert.Nil(t, event.Before())
+		require.Len(t, event.Changes(), 1)
+		assert.Nil(t, event.Changes()[0].Before)
+		assert.Equal(t, "Kilogram", event.Changes()[0].After)
+	})
+
+	t.Run("update normalizes pointers", func(t *testing.T) {
+		minValue := 10.0
+		before := audit.Snapshot{"min_value": &minValue}
+		after := audit.Snapshot{"min_value": 10.0}
+
+		event, err := audit.NewEvent(audit.EntityParameter, "SPEED", audit.ActionUpdate, before, after, "admin")
+		require.NoError(t, err)
+		assert.Empty(t, event.Changes())
+	})
+
+	t.Run("snapshot mismatch", func(t *testing.T) {
+		_, err := audit.NewEvent(audit.EntityUOM, "KG", audit.ActionDelete, nil, nil, "admin")
+		assert.ErrorIs(t, err, audit.ErrSnapshotMismatch)
+		_, err = audit.NewEvent(audit.EntityUOM, "KG", audit.ActionCreate, audit.Snapshot{}, audit.Snapshot{}, "admin")
+		assert.ErrorIs(t, err, audit.ErrSnapshotMismatch)
+	})
+
+	t.Run("required fields", func(t *testing.T) {
+		_, err := audit.NewEvent(audit.EntityUOM, "KG", audit.ActionCreate, nil, audit.Snapshot{}, "")
+		assert.ErrorIs(t, err, audit.ErrEmptyChangedBy)
+		_, err = audit.NewEvent(audit.EntityUOM, "", audit.ActionCreate, nil, audit.Snapshot{}, "admin")
+		assert.ErrorIs(t, err, audit.ErrEmptyEntityCode)
+	})
+}
+
+// failingAuditRepo refuses every event.
+type failingAuditRepo struct {
+	audit.Repository
+}
+
+func (failingAuditRepo) Append(context.Context, *audit.Event) error {
+	return errors.New("audit log unavailable")
+}
+
+// rollbackTx restores the rows of a versionedParameterRepo, and of the
+// change requests if set, when the transaction fails, like Postgres would.
+type rollbackTx struct {
+	repo    *versionedParameterRepo
+	changes *memoryChangeRequests
+}
+
+func (tx rollbackTx) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	saved := make(map[parameter.Code]*parameter.Parameter, len(tx.repo.rows))
+	for code, row := range tx.repo.rows {
+		saved[code] = copyParameter(row)
+	}
+	var savedChanges []*changerequest.ChangeRequest
+	if tx.changes != nil {
+		savedChanges = append(savedChanges, tx.changes.rows...)
+	}
+	if err := fn(ctx); err != nil {
+		tx.repo.rows = saved
+		if tx.changes != nil {
+			tx.changes.rows = savedChanges
+		}
+		return err
+	}
+	return nil
+}
+
+func TestRecorder_FailureRollsBackChange(t *testing.T) {
+	rpm, err := parameter.NewParameter(
+		parameter.Code("RPM"), "Rotation Per Minute", parameter.CategoryMachine, parameter.DataTypeNumeric, "admin")
+	require.NoError(t, err)
+	repo := &versionedParameterRepo{rows: map[parameter.Code]*parameter.Parameter{rpm.Code(): rpm}}
+	handler := appparam.NewUpdateHandler(repo, &softDeleteUOMRepo{}, appaudit.NewRecorder(failingAuditRepo{}, rollbackTx{repo: repo}))
+
+	_, err = handler.Handle(context.Background(), appparam.UpdateCommand{
+		ParameterCode: "RPM",
+		ParameterName: "Spindle Speed",
+		Category:      "MACHINE",
+		DataType:      "NUMERIC",
+		IsActive:      true,
+		Version:       1,
+		UpdatedBy:     "alice",
+	})
+	require.Error(t, err)
+	assert.Equal(t, "Rotation Per Minute", repo.rows["RPM"].Name())
+	assert.Equal(t, 1, repo.rows["RPM"].Version())
+}
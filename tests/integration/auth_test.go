@@ -0,0 +1,168 @@
+package integration_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/homindolenern/goapps-costing-v1/internal/config"
+	"github.com/homindolenern/goapps-costing-v1/internal/delivery/grpc/interceptors"
+	infraauth "github.com/homindolenern/goapps-costing-v1/internal/infrastructure/auth"
+	"github.com/homindolenern/goapps-costing-v1/pkg/auth"
+)
+
+func signToken(t *testing.T, method jwt.SigningMethod, key interface{}, kid string, claims jwt.MapClaims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(method, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+	return signed
+}
+
+func validClaims() jwt.MapClaims {
+	return jwt.MapClaims{
+		"sub":                "user-42",
+		"preferred_username": "budi",
+		"iss":                "https://idp.test",
+		"aud":                "costing",
+		"exp":                time.Now().Add(time.Hour).Unix(),
+		"roles":              []string{"costing.editor"},
+	}
+}
+
+func TestJWTVerifier_StaticRSAKey(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	require.NoError(t, err)
+	keyFile := filepath.Join(t.TempDir(), "public.pem")
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0o600))
+
+	verifier, err := infraauth.NewJWTVerifier(config.AuthConfig{
+		PublicKeyFile: keyFile,
+		Issuer:        "https://idp.test",
+		Audience:      "costing",
+	})
+	require.NoError(t, err)
+
+	t.Run("valid token", func(t *testing.T) {
+		principal, err := verifier.Verify(context.Background(), signToken(t, jwt.SigningMethodRS256, privateKey, "", validClaims()))
+		require.NoError(t, err)
+		assert.Equal(t, "user-42", principal.Subject)
+		assert.Equal(t, "budi", principal.Name())
+		assert.True(t, principal.HasRole("costing.editor"))
+	})
+
+	t.Run("expired token", func(t *testing.T) {
+		claims := validClaims()
+		claims["exp"] = time.Now().Add(-time.Hour).Unix()
+		_, err := verifier.Verify(context.Background(), signToken(t, jwt.SigningMethodRS256, privateKey, "", claims))
+		assert.ErrorIs(t, err, auth.ErrInvalidToken)
+	})
+
+	t.Run("wrong audience", func(t *testing.T) {
+		claims := validClaims()
+		claims["aud"] = "billing"
+		_, err := verifier.Verify(context.Background(), signToken(t, jwt.SigningMethodRS256, privateKey, "", claims))
+		assert.ErrorIs(t, err, auth.ErrInvalidToken)
+	})
+
+	t.Run("other key", func(t *testing.T) {
+		otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+		_, err = verifier.Verify(context.Background(), signToken(t, jwt.SigningMethodRS256, otherKey, "", validClaims()))
+		assert.ErrorIs(t, err, auth.ErrInvalidToken)
+	})
+
+	t.Run("hmac with public key rejected", func(t *testing.T) {
+		_, err := verifier.Verify(context.Background(), signToken(t, jwt.SigningMethodHS256, []byte("secret"), "", validClaims()))
+		assert.ErrorIs(t, err, auth.ErrInvalidToken)
+	})
+}
+
+func TestJWTVerifier_JWKS(t *testing.T) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	encode := func(i *big.Int) string { return base64.RawURLEncoding.EncodeToString(i.FillBytes(make([]byte, 32))) }
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"keys": []map[string]string{{
+				"kty": "EC", "kid": "key-1", "use": "sig", "crv": "P-256",
+				"x": encode(privateKey.X), "y": encode(privateKey.Y),
+			}},
+		})
+	}))
+	defer server.Close()
+
+	verifier, err := infraauth.NewJWTVerifier(config.AuthConfig{
+		JWKSURL:             server.URL,
+		JWKSRefreshInterval: time.Minute,
+	})
+	require.NoError(t, err)
+
+	principal, err := verifier.Verify(context.Background(), signToken(t, jwt.SigningMethodES256, privateKey, "key-1", validClaims()))
+	require.NoError(t, err)
+	assert.Equal(t, "budi", principal.Name())
+
+	_, err = verifier.Verify(context.Background(), signToken(t, jwt.SigningMethodES256, privateKey, "key-2", validClaims()))
+	assert.ErrorIs(t, err, auth.ErrInvalidToken)
+}
+
+func TestAuthInterceptor(t *testing.T) {
+	verifier, err := infraauth.NewJWTVerifier(config.AuthConfig{HMACSecret: "test-secret"})
+	require.NoError(t, err)
+	interceptor := interceptors.Auth(verifier, "/costing.v1.HealthService/")
+
+	var seen *auth.Principal
+	handler := func(ctx context.Context, _ interface{}) (interface{}, error) {
+		seen, _ = auth.PrincipalFromContext(ctx)
+		return "ok", nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/costing.v1.UOMService/CreateUOM"}
+
+	t.Run("missing token", func(t *testing.T) {
+		_, err := interceptor(context.Background(), nil, info, handler)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("bearer token", func(t *testing.T) {
+		token := signToken(t, jwt.SigningMethodHS256, []byte("test-secret"), "", validClaims())
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+		_, err := interceptor(ctx, nil, info, handler)
+		require.NoError(t, err)
+		require.NotNil(t, seen)
+		assert.Equal(t, "budi", seen.Name())
+	})
+
+	t.Run("public method", func(t *testing.T) {
+		seen = nil
+		_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/costing.v1.HealthService/Liveness"}, handler)
+		require.NoError(t, err)
+		assert.Nil(t, seen)
+	})
+}
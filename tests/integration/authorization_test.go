@@ -0,0 +1,148 @@
+package integration_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/homindolenern/goapps-costing-v1/gen/go/costing/v1"
+	appaudit "github.com/homindolenern/goapps-costing-v1/internal/application/audit"
+	appuom "github.com/homindolenern/goapps-costing-v1/internal/application/uom"
+	"github.com/homindolenern/goapps-costing-v1/internal/delivery/grpc/interceptors"
+	httpdelivery "github.com/homindolenern/goapps-costing-v1/internal/delivery/http"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/uom"
+	"github.com/homindolenern/goapps-costing-v1/pkg/auth"
+)
+
+func newTestPolicy(t *testing.T) *auth.Policy {
+	t.Helper()
+	policy, err := auth.NewPolicy([]auth.Role{
+		{Name: "viewer", Permissions: []string{"/costing.v1.*/Get*", "/costing.v1.*/List*"}},
+		{Name: "costing_engineer", Inherits: []string{"viewer"}, Permissions: []string{
+			"/costing.v1.ParameterService/*",
+			"/costing.v1.UOMService/UpdateUOM",
+		}},
+		{Name: "admin", Permissions: []string{"/*/*"}},
+	})
+	require.NoError(t, err)
+	return policy
+}
+
+func TestPolicy_Allows(t *testing.T) {
+	policy := newTestPolicy(t)
+
+	assert.True(t, policy.Allows([]string{"viewer"}, "/costing.v1.UOMService/GetUOM"))
+	assert.True(t, policy.Allows([]string{"viewer"}, "/costing.v1.ParameterService/ListParameters"))
+	assert.False(t, policy.Allows([]string{"viewer"}, "/costing.v1.ParameterService/UpdateParameter"))
+
+	assert.True(t, policy.Allows([]string{"costing_engineer"}, "/costing.v1.ParameterService/UpdateParameter"))
+	assert.True(t, policy.Allows([]string{"costing_engineer"}, "/costing.v1.UOMService/ListUOMs"))
+	assert.False(t, policy.Allows([]string{"costing_engineer"}, "/costing.v1.UOMService/DeleteUOM"))
+
+	assert.True(t, policy.Allows([]string{"admin"}, "/costing.v1.UOMService/DeleteUOM"))
+	assert.True(t, policy.Allows([]string{"admin"}, appuom.PermissionSetBaseUOM))
+	assert.False(t, policy.Allows([]string{"unknown"}, "/costing.v1.UOMService/GetUOM"))
+}
+
+func TestPolicy_InvalidConfig(t *testing.T) {
+	_, err := auth.NewPolicy([]auth.Role{{Name: "a", Inherits: []string{"missing"}}})
+	assert.ErrorIs(t, err, auth.ErrUnknownRole)
+
+	_, err = auth.NewPolicy([]auth.Role{
+		{Name: "a", Inherits: []string{"b"}},
+		{Name: "b", Inherits: []string{"a"}},
+	})
+	assert.ErrorIs(t, err, auth.ErrRoleCycle)
+
+	_, err = auth.NewPolicy([]auth.Role{{Name: "a", Permissions: []string{"/costing.v1.[/Get"}}})
+	assert.ErrorIs(t, err, auth.ErrInvalidPolicy)
+}
+
+func TestAuthorizationInterceptor(t *testing.T) {
+	interceptor := interceptors.Authorization(newTestPolicy(t))
+	handler := func(context.Context, interface{}) (interface{}, error) { return "ok", nil }
+	call := func(roles []string, method string, req interface{}) error {
+		ctx := auth.WithPrincipal(context.Background(), &auth.Principal{Subject: "u1", Roles: roles})
+		_, err := interceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return err
+	}
+
+	assert.NoError(t, call([]string{"viewer"}, "/costing.v1.UOMService/GetUOM", &pb.GetUOMRequest{}))
+	assert.Equal(t, codes.PermissionDenied, status.Code(call([]string{"viewer"}, "/costing.v1.UOMService/DeleteUOM", &pb.DeleteUOMRequest{})))
+	assert.NoError(t, call([]string{"costing_engineer"}, "/costing.v1.UOMService/UpdateUOM", &pb.UpdateUOMRequest{IsBaseUom: true}))
+
+	t.Run("no principal", func(t *testing.T) {
+		_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/costing.v1.UOMService/GetUOM"}, handler)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+}
+
+func TestSetBaseUOMPermission(t *testing.T) {
+	policy := newTestPolicy(t)
+	kg := newTestUOM(t, "KG", "WEIGHT", 1)
+	g := newTestUOM(t, "G", "WEIGHT", 0.001)
+	repo := &softDeleteUOMRepo{uoms: map[uom.Code]*uom.UOM{kg.Code(): kg, g.Code(): g}}
+	update := appuom.NewUpdateHandler(repo, appaudit.NewRecorder(&memoryAuditRepo{}, inlineTx{}), policy)
+	engineer := auth.WithPrincipal(context.Background(), &auth.Principal{Subject: "u1", Roles: []string{"costing_engineer"}})
+
+	t.Run("editing the base unit needs no extra permission", func(t *testing.T) {
+		_, err := update.Handle(engineer, appuom.UpdateCommand{
+			UOMCode: "KG", UOMName: "Kilogramme", Category: "WEIGHT", IsBaseUOM: true, Version: 1, UpdatedBy: "u1",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "Kilogramme", repo.uoms["KG"].Name())
+	})
+
+	t.Run("promoting a unit needs SetBaseUOM", func(t *testing.T) {
+		_, err := update.Handle(engineer, appuom.UpdateCommand{
+			UOMCode: "G", UOMName: "Gram", Category: "WEIGHT", IsBaseUOM: true, Version: 1, UpdatedBy: "u1",
+		})
+		assert.ErrorIs(t, err, auth.ErrPermissionDenied)
+		assert.True(t, repo.uoms["KG"].IsBaseUOM())
+
+		admin := auth.WithPrincipal(context.Background(), &auth.Principal{Subject: "u2", Roles: []string{"admin"}})
+		assert.NoError(t, policy.Authorize(admin, appuom.PermissionSetBaseUOM))
+		assert.NoError(t, (*auth.Policy)(nil).Authorize(engineer, appuom.PermissionSetBaseUOM), "authorization disabled")
+	})
+
+	t.Run("creating a base unit in a batch needs SetBaseUOM", func(t *testing.T) {
+		handler := appuom.NewBatchUpsertHandler(repo, appaudit.NewRecorder(&memoryAuditRepo{}, inlineTx{}), policy)
+		results, err := handler.Handle(engineer, appuom.BatchUpsertCommand{
+			Items:      []appuom.BatchUpsertItem{{UOMCode: "M", UOMName: "Metre", Category: "LENGTH", IsBaseUOM: true}},
+			Atomic:     true,
+			UpsertedBy: "u1",
+		})
+		require.NoError(t, err)
+		assert.ErrorIs(t, results[0].Err, auth.ErrPermissionDenied)
+	})
+}
+
+func TestCustomErrorHandler_PermissionDenied(t *testing.T) {
+	mux := httpdelivery.NewServeMux()
+	recorder := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodDelete, "/v1/uoms/KG", nil)
+
+	_, marshaler := runtime.MarshalerForRequest(mux, req)
+	httpdelivery.CustomErrorHandler(context.Background(), mux, marshaler, recorder, req,
+		status.Error(codes.PermissionDenied, "permission denied: /costing.v1.UOMService/DeleteUOM"))
+
+	assert.Equal(t, http.StatusForbidden, recorder.Code)
+
+	var body struct {
+		Base map[string]interface{} `json:"base"`
+	}
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &body))
+	// Error bodies keep the proto field names clients already depend on
+	assert.Equal(t, "403", body.Base["status_code"])
+	assert.Equal(t, "permission denied: /costing.v1.UOMService/DeleteUOM", body.Base["message"])
+	assert.NotContains(t, body.Base, "statusCode")
+}
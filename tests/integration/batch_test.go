@@ -0,0 +1,169 @@
+package integration_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	appaudit "github.com/homindolenern/goapps-costing-v1/internal/application/audit"
+	"github.com/homindolenern/goapps-costing-v1/internal/application/batch"
+	appparam "github.com/homindolenern/goapps-costing-v1/internal/application/parameter"
+	appuom "github.com/homindolenern/goapps-costing-v1/internal/application/uom"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/changerequest"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/parameter"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/uom"
+	"github.com/homindolenern/goapps-costing-v1/pkg/auth"
+	pkgerrors "github.com/homindolenern/goapps-costing-v1/pkg/errors"
+)
+
+// batchParameterRepo keeps Parameters in memory and saves batches like the
+// Postgres repository: failed entries are skipped, atomic batches roll back.
+type batchParameterRepo struct {
+	parameter.Repository
+	rows      map[parameter.Code]*parameter.Parameter
+	saveErrs  map[parameter.Code]error
+	saveCalls int
+}
+
+func (r *batchParameterRepo) GetByCodeIncludingDeleted(_ context.Context, code parameter.Code) (*parameter.Parameter, error) {
+	row, ok := r.rows[code]
+	if !ok {
+		return nil, parameter.ErrNotFound
+	}
+	return copyParameter(row), nil
+}
+
+func (r *batchParameterRepo) SaveBatch(_ context.Context, entries []parameter.BatchEntry, atomic bool) ([]error, error) {
+	r.saveCalls++
+	errs := make([]error, len(entries))
+	failed := false
+	for i, entry := range entries {
+		if err := r.saveErrs[entry.Parameter.Code()]; err != nil {
+			errs[i] = err
+			failed = true
+		}
+	}
+	if atomic && failed {
+		return errs, nil
+	}
+	for i, entry := range entries {
+		if errs[i] == nil {
+			if !entry.IsNew {
+				entry.Parameter.IncrementVersion()
+			}
+			r.rows[entry.Parameter.Code()] = copyParameter(entry.Parameter)
+		}
+	}
+	return errs, nil
+}
+
+func newBatchParameterRepo(t *testing.T) *batchParameterRepo {
+	t.Helper()
+	rpm, err := parameter.NewParameter(
+		parameter.Code("RPM"), "Rotation Per Minute", parameter.CategoryMachine, parameter.DataTypeNumeric, "admin")
+	require.NoError(t, err)
+	twist, err := parameter.NewParameter(
+		parameter.Code("TWIST"), "Twist Direction", parameter.CategoryProcess, parameter.DataTypeText, "admin")
+	require.NoError(t, err)
+	twist.SoftDelete("admin")
+
+	return &batchParameterRepo{
+		rows:     map[parameter.Code]*parameter.Parameter{rpm.Code(): rpm, twist.Code(): twist},
+		saveErrs: map[parameter.Code]error{},
+	}
+}
+
+func batchItems() []appparam.BatchUpsertItem {
+	return []appparam.BatchUpsertItem{
+		{ParameterCode: "RPM", ParameterName: "Spindle Speed", Category: "MACHINE", DataType: "NUMERIC", Version: 1},
+		{ParameterCode: "TPI", ParameterName: "Twists Per Inch", Category: "PROCESS", DataType: "NUMERIC"},
+		{ParameterCode: "TPI", ParameterName: "Twists Per Inch", Category: "PROCESS", DataType: "NUMERIC"},
+		{ParameterCode: "TWIST", ParameterName: "Twist Direction", Category: "PROCESS", DataType: "TEXT"},
+	}
+}
+
+func TestBatchUpsertParameters_BestEffort(t *testing.T) {
+	ctx := context.Background()
+	repo := newBatchParameterRepo(t)
+	auditRepo := &memoryAuditRepo{}
+	handler := appparam.NewBatchUpsertHandler(repo, &softDeleteUOMRepo{}, appaudit.NewRecorder(auditRepo, inlineTx{}), changerequest.Policy{})
+
+	results, err := handler.Handle(ctx, appparam.BatchUpsertCommand{Items: batchItems(), UpsertedBy: "alice"})
+	require.NoError(t, err)
+	require.Len(t, results, 4)
+
+	assert.Equal(t, batch.StatusUpdated, results[0].Status)
+	assert.Equal(t, 2, results[0].Entity.Version())
+	assert.Equal(t, batch.StatusCreated, results[1].Status)
+	assert.True(t, results[1].Entity.IsActive())
+
+	// Duplicates are reported on the code field
+	assert.Equal(t, batch.StatusFailed, results[2].Status)
+	assert.ErrorIs(t, results[2].Err, batch.ErrDuplicateCode)
+	assert.True(t, pkgerrors.IsValidation(results[2].Err))
+
+	// Deleted codes cannot be reused
+	assert.Equal(t, 3, results[3].Index)
+	assert.ErrorIs(t, results[3].Err, parameter.ErrDeleted)
+
+	assert.Equal(t, 2, batch.CountFailed(results))
+	assert.Equal(t, "Spindle Speed", repo.rows["RPM"].Name())
+	assert.Contains(t, repo.rows, parameter.Code("TPI"))
+	assert.Len(t, auditRepo.events, 2)
+}
+
+func TestBatchUpsertParameters_Atomic(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("domain failure skips the database", func(t *testing.T) {
+		repo := newBatchParameterRepo(t)
+		handler := appparam.NewBatchUpsertHandler(repo, &softDeleteUOMRepo{}, appaudit.NewRecorder(&memoryAuditRepo{}, inlineTx{}), changerequest.Policy{})
+
+		results, err := handler.Handle(ctx, appparam.BatchUpsertCommand{Items: batchItems(), Atomic: true, UpsertedBy: "alice"})
+		require.NoError(t, err)
+
+		assert.Equal(t, batch.StatusSkipped, results[0].Status)
+		assert.Equal(t, batch.StatusSkipped, results[1].Status)
+		assert.Nil(t, results[1].Entity)
+		assert.Equal(t, 0, repo.saveCalls)
+		assert.NotContains(t, repo.rows, parameter.Code("TPI"))
+	})
+
+	t.Run("save failure rolls back the batch", func(t *testing.T) {
+		repo := newBatchParameterRepo(t)
+		repo.saveErrs["TPI"] = parameter.ErrAlreadyExists
+		auditRepo := &memoryAuditRepo{}
+		handler := appparam.NewBatchUpsertHandler(repo, &softDeleteUOMRepo{}, appaudit.NewRecorder(auditRepo, inlineTx{}), changerequest.Policy{})
+
+		results, err := handler.Handle(ctx, appparam.BatchUpsertCommand{Items: batchItems()[:2], Atomic: true, UpsertedBy: "alice"})
+		require.NoError(t, err)
+
+		assert.Equal(t, batch.StatusSkipped, results[0].Status)
+		assert.Equal(t, batch.StatusFailed, results[1].Status)
+		assert.ErrorIs(t, results[1].Err, parameter.ErrAlreadyExists)
+		assert.Equal(t, "Rotation Per Minute", repo.rows["RPM"].Name())
+		assert.Empty(t, auditRepo.events)
+	})
+}
+
+func TestBatchUpsertUOMs_RejectsBaseChange(t *testing.T) {
+	ctx := context.Background()
+	kg, err := uom.NewUOM(uom.Code("KG"), "Kilogram", uom.CategoryWeight, "admin")
+	require.NoError(t, err)
+	kg.SetAsBaseUOM()
+
+	repo := &softDeleteUOMRepo{uoms: map[uom.Code]*uom.UOM{kg.Code(): kg}}
+	handler := appuom.NewBatchUpsertHandler(repo, appaudit.NewRecorder(&memoryAuditRepo{}, inlineTx{}), (*auth.Policy)(nil))
+
+	results, err := handler.Handle(ctx, appuom.BatchUpsertCommand{
+		Items:      []appuom.BatchUpsertItem{{UOMCode: "KG", UOMName: "Kilogram", Category: "WEIGHT"}},
+		Atomic:     true,
+		UpsertedBy: "alice",
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.ErrorIs(t, results[0].Err, appuom.ErrBaseChangeNotBatched)
+	assert.True(t, kg.IsBaseUOM())
+}
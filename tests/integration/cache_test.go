@@ -0,0 +1,215 @@
+package integration_test
+
+import (
+	"context"
+	"encoding/json"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/parameter"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/uom"
+	"github.com/homindolenern/goapps-costing-v1/internal/infrastructure/cache"
+	"github.com/homindolenern/goapps-costing-v1/internal/infrastructure/redis"
+)
+
+// memoryCache is an in-process cache.Cache used instead of Redis.
+type memoryCache struct {
+	entries     map[string][]byte
+	generations map[string]int64
+	patternDels int
+}
+
+func newMemoryCache() *memoryCache {
+	return &memoryCache{entries: make(map[string][]byte), generations: make(map[string]int64)}
+}
+
+func (c *memoryCache) Get(_ context.Context, key string, dest interface{}) (bool, error) {
+	data, ok := c.entries[key]
+	if !ok {
+		return false, nil
+	}
+	return true, json.Unmarshal(data, dest)
+}
+
+func (c *memoryCache) Set(_ context.Context, key string, value interface{}, _ time.Duration) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	c.entries[key] = data
+	return nil
+}
+
+func (c *memoryCache) Delete(_ context.Context, keys ...string) error {
+	for _, key := range keys {
+		delete(c.entries, key)
+	}
+	return nil
+}
+
+func (c *memoryCache) DeleteByPattern(_ context.Context, pattern string) error {
+	c.patternDels++
+	for key := range c.entries {
+		if matched, _ := path.Match(pattern, key); matched {
+			delete(c.entries, key)
+		}
+	}
+	return nil
+}
+
+func (c *memoryCache) Generation(_ context.Context, tag string) (int64, error) {
+	return c.generations[tag], nil
+}
+
+func (c *memoryCache) BumpGeneration(_ context.Context, tag string) error {
+	c.generations[tag]++
+	return nil
+}
+
+// countingUOMRepo serves UOMs from memory and counts reads.
+type countingUOMRepo struct {
+	uom.Repository
+	uoms  map[uom.Code]*uom.UOM
+	reads int
+}
+
+func (r *countingUOMRepo) GetByCode(_ context.Context, code uom.Code) (*uom.UOM, error) {
+	r.reads++
+	entity, ok := r.uoms[code]
+	if !ok {
+		return nil, uom.ErrNotFound
+	}
+	return entity, nil
+}
+
+func (r *countingUOMRepo) List(context.Context, uom.ListFilter) ([]*uom.UOM, int64, error) {
+	r.reads++
+	list := make([]*uom.UOM, 0, len(r.uoms))
+	for _, entity := range r.uoms {
+		list = append(list, entity)
+	}
+	return list, int64(len(list)), nil
+}
+
+func (r *countingUOMRepo) Create(_ context.Context, entity *uom.UOM) error {
+	r.uoms[entity.Code()] = entity
+	return nil
+}
+
+func (r *countingUOMRepo) Update(_ context.Context, entity *uom.UOM) error {
+	r.uoms[entity.Code()] = entity
+	return nil
+}
+
+func TestCachedUOMRepository(t *testing.T) {
+	ctx := context.Background()
+	kg, err := uom.NewUOM(uom.Code("KG"), "Kilogram", uom.CategoryWeight, "admin")
+	require.NoError(t, err)
+
+	inner := &countingUOMRepo{uoms: map[uom.Code]*uom.UOM{kg.Code(): kg}}
+	memory := newMemoryCache()
+	repo := cache.NewUOMRepository(inner, memory, time.Minute)
+
+	t.Run("get is served from cache", func(t *testing.T) {
+		first, err := repo.GetByCode(ctx, kg.Code())
+		require.NoError(t, err)
+		second, err := repo.GetByCode(ctx, kg.Code())
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, inner.reads)
+		assert.Equal(t, "Kilogram", second.Name())
+		assert.Equal(t, first.CreatedAt().Unix(), second.CreatedAt().Unix())
+	})
+
+	t.Run("not found is not cached", func(t *testing.T) {
+		inner.reads = 0
+		_, err := repo.GetByCode(ctx, uom.Code("LB"))
+		assert.ErrorIs(t, err, uom.ErrNotFound)
+		_, err = repo.GetByCode(ctx, uom.Code("LB"))
+		assert.ErrorIs(t, err, uom.ErrNotFound)
+		assert.Equal(t, 2, inner.reads)
+	})
+
+	t.Run("update invalidates entry and lists", func(t *testing.T) {
+		_, _, err := repo.List(ctx, uom.ListFilter{Page: 1, PageSize: 10})
+		require.NoError(t, err)
+
+		require.NoError(t, kg.Update("Kilogram (kg)", uom.CategoryWeight, true, "editor"))
+		require.NoError(t, repo.Update(ctx, kg))
+
+		inner.reads = 0
+		entity, err := repo.GetByCode(ctx, kg.Code())
+		require.NoError(t, err)
+		assert.Equal(t, "Kilogram (kg)", entity.Name())
+		_, _, err = repo.List(ctx, uom.ListFilter{Page: 1, PageSize: 10})
+		require.NoError(t, err)
+		assert.Equal(t, 2, inner.reads)
+	})
+
+	t.Run("list invalidation bumps the generation without scanning", func(t *testing.T) {
+		assert.Equal(t, int64(1), memory.generations[redis.UOMListTag])
+		assert.Zero(t, memory.patternDels)
+		_, stale := memory.entries[redis.UOMListCacheKey(0, 1, 10, "", "")]
+		assert.True(t, stale, "old pages are orphaned, not deleted")
+		_, fresh := memory.entries[redis.UOMListCacheKey(1, 1, 10, "", "")]
+		assert.True(t, fresh)
+	})
+
+	t.Run("create invalidates lists", func(t *testing.T) {
+		gr, err := uom.NewUOM(uom.Code("GR"), "Gram", uom.CategoryWeight, "admin")
+		require.NoError(t, err)
+		require.NoError(t, repo.Create(ctx, gr))
+
+		uoms, total, err := repo.List(ctx, uom.ListFilter{Page: 1, PageSize: 10})
+		require.NoError(t, err)
+		assert.Len(t, uoms, 2)
+		assert.Equal(t, int64(2), total)
+	})
+}
+
+// countingParameterRepo serves a single Parameter and counts reads.
+type countingParameterRepo struct {
+	parameter.Repository
+	param *parameter.Parameter
+	reads int
+}
+
+func (r *countingParameterRepo) GetByCode(context.Context, parameter.Code) (*parameter.Parameter, error) {
+	r.reads++
+	return r.param, nil
+}
+
+func TestCachedParameterRepository(t *testing.T) {
+	param, err := parameter.NewParameter(parameter.Code("SPINDLE_SPEED"), "Spindle speed", parameter.CategoryMachine, parameter.DataTypeNumeric, "admin")
+	require.NoError(t, err)
+	minValue, maxValue := 1000.0, 25000.0
+	require.NoError(t, param.SetNumericConstraints(&minValue, &maxValue))
+
+	t.Run("round trip keeps constraints", func(t *testing.T) {
+		inner := &countingParameterRepo{param: param}
+		repo := cache.NewParameterRepository(inner, newMemoryCache(), time.Minute)
+		_, err := repo.GetByCode(context.Background(), param.Code())
+		require.NoError(t, err)
+		cached, err := repo.GetByCode(context.Background(), param.Code())
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, inner.reads)
+		require.NotNil(t, cached.MaxValue())
+		assert.Equal(t, 25000.0, *cached.MaxValue())
+		assert.Equal(t, parameter.CategoryMachine, cached.Category())
+	})
+
+	t.Run("no-op cache always reads through", func(t *testing.T) {
+		inner := &countingParameterRepo{param: param}
+		repo := cache.NewParameterRepository(inner, cache.NewNoOpCache(), time.Minute)
+		for i := 0; i < 3; i++ {
+			_, err := repo.GetByCode(context.Background(), param.Code())
+			require.NoError(t, err)
+		}
+		assert.Equal(t, 3, inner.reads)
+	})
+}
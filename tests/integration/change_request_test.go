@@ -0,0 +1,334 @@
+package integration_test
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	appaudit "github.com/homindolenern/goapps-costing-v1/internal/application/audit"
+	"github.com/homindolenern/goapps-costing-v1/internal/application/batch"
+	appparam "github.com/homindolenern/goapps-costing-v1/internal/application/parameter"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/changerequest"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/parameter"
+)
+
+// memoryChangeRequests stores copies of change requests like the Postgres
+// repository, so a review is only kept once saved.
+type memoryChangeRequests struct {
+	rows []*changerequest.ChangeRequest
+}
+
+func copyChangeRequest(c *changerequest.ChangeRequest) *changerequest.ChangeRequest {
+	return changerequest.Reconstitute(
+		c.ID(), c.ParameterCode(), c.BaseVersion(), c.Action(), c.Proposal(), c.Changes(), c.Status(),
+		c.RequestedBy(), c.RequestedBySubject(), c.RequestedAt(),
+		c.ReviewedBy(), c.ReviewedBySubject(), c.ReviewedAt(), c.ReviewComment(),
+	)
+}
+
+func (m *memoryChangeRequests) Create(_ context.Context, request *changerequest.ChangeRequest) error {
+	for _, row := range m.rows {
+		if row.ParameterCode() == request.ParameterCode() && row.Status() == changerequest.StatusPending {
+			return changerequest.ErrPendingExists
+		}
+	}
+	request.AssignID(int64(len(m.rows) + 1))
+	m.rows = append(m.rows, copyChangeRequest(request))
+	return nil
+}
+
+func (m *memoryChangeRequests) GetByID(_ context.Context, id int64) (*changerequest.ChangeRequest, error) {
+	if id < 1 || int(id) > len(m.rows) {
+		return nil, changerequest.ErrNotFound
+	}
+	return copyChangeRequest(m.rows[id-1]), nil
+}
+
+func (m *memoryChangeRequests) List(_ context.Context, filter changerequest.ListFilter) ([]*changerequest.ChangeRequest, int64, error) {
+	var result []*changerequest.ChangeRequest
+	for _, row := range m.rows {
+		if filter.Status == nil || row.Status() == *filter.Status {
+			result = append(result, copyChangeRequest(row))
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].ID() > result[j].ID() })
+	return result, int64(len(result)), nil
+}
+
+func (m *memoryChangeRequests) Review(_ context.Context, request *changerequest.ChangeRequest) error {
+	if m.rows[request.ID()-1].Status() != changerequest.StatusPending {
+		return changerequest.ErrNotPending
+	}
+	m.rows[request.ID()-1] = copyChangeRequest(request)
+	return nil
+}
+
+func TestParameterChangeRequests(t *testing.T) {
+	ctx := context.Background()
+	rpm, err := parameter.NewParameter(
+		parameter.Code("RPM"), "Rotation Per Minute", parameter.CategoryMachine, parameter.DataTypeNumeric, "admin")
+	require.NoError(t, err)
+	grade, err := parameter.NewParameter(
+		parameter.Code("GRADE"), "Cotton Grade", parameter.CategoryMaterial, parameter.DataTypeText, "admin")
+	require.NoError(t, err)
+
+	repo := &versionedParameterRepo{rows: map[parameter.Code]*parameter.Parameter{rpm.Code(): rpm, grade.Code(): grade}}
+	changes := &memoryChangeRequests{}
+	auditRepo := &memoryAuditRepo{}
+	policy, err := changerequest.NewPolicy([]string{"MACHINE"}, true)
+	require.NoError(t, err)
+
+	tx := rollbackTx{repo: repo, changes: changes}
+	recorder := appaudit.NewRecorder(auditRepo, tx)
+	update := appparam.NewUpdateHandler(repo, &softDeleteUOMRepo{}, recorder)
+	softDelete := appparam.NewDeleteHandler(repo, recorder)
+	restore := appparam.NewRestoreHandler(repo, recorder)
+	edit := appparam.NewEditHandler(repo, changes, update, softDelete, restore, policy)
+	approve := appparam.NewApproveChangeHandler(changes, update, softDelete, restore)
+	reject := appparam.NewRejectChangeHandler(changes)
+
+	maxRPM := 18000.0
+	editRPM := appparam.UpdateCommand{
+		ParameterCode:    "RPM",
+		ParameterName:    "Rotation Per Minute",
+		Category:         "MACHINE",
+		DataType:         "NUMERIC",
+		MaxValue:         &maxRPM,
+		IsActive:         true,
+		Version:          1,
+		UpdatedBy:        "alice",
+		UpdatedBySubject: "sub-alice",
+	}
+
+	t.Run("edits outside the policy are applied at once", func(t *testing.T) {
+		result, err := edit.Handle(ctx, appparam.UpdateCommand{
+			ParameterCode:    "GRADE",
+			ParameterName:    "Cotton Staple Grade",
+			Category:         "MATERIAL",
+			DataType:         "TEXT",
+			IsActive:         true,
+			Version:          1,
+			UpdatedBy:        "alice",
+			UpdatedBySubject: "sub-alice",
+		})
+		require.NoError(t, err)
+		assert.Nil(t, result.ChangeRequest)
+		assert.Equal(t, 2, result.Parameter.Version())
+		assert.Equal(t, "Cotton Staple Grade", repo.rows["GRADE"].Name())
+	})
+
+	t.Run("making a parameter mandatory needs approval", func(t *testing.T) {
+		result, err := edit.Handle(ctx, appparam.UpdateCommand{
+			ParameterCode:    "GRADE",
+			ParameterName:    "Cotton Staple Grade",
+			Category:         "MATERIAL",
+			DataType:         "TEXT",
+			IsMandatory:      true,
+			IsActive:         true,
+			Version:          2,
+			UpdatedBy:        "alice",
+			UpdatedBySubject: "sub-alice",
+		})
+		require.NoError(t, err)
+		require.NotNil(t, result.ChangeRequest)
+		assert.False(t, repo.rows["GRADE"].IsMandatory())
+
+		_, err = reject.Handle(ctx, appparam.ReviewChangeCommand{ChangeRequestID: result.ChangeRequest.ID(), ReviewedBy: "alice", ReviewedBySubject: "sub-alice"})
+		require.NoError(t, err, "authors may withdraw their own requests")
+	})
+
+	var requestID int64
+	t.Run("edits of reviewed parameters are held with their diff", func(t *testing.T) {
+		result, err := edit.Handle(ctx, editRPM)
+		require.NoError(t, err)
+		assert.Nil(t, result.Parameter)
+		request := result.ChangeRequest
+		require.NotNil(t, request)
+		requestID = request.ID()
+
+		assert.Equal(t, changerequest.StatusPending, request.Status())
+		assert.Equal(t, 1, request.BaseVersion())
+		require.Len(t, request.Changes(), 1)
+		assert.Equal(t, "max_value", request.Changes()[0].Field)
+		assert.Nil(t, request.Changes()[0].Before)
+		assert.Equal(t, 18000.0, request.Changes()[0].After)
+
+		assert.Equal(t, 1, repo.rows["RPM"].Version())
+		assert.Nil(t, repo.rows["RPM"].MaxValue())
+
+		_, err = edit.Handle(ctx, editRPM)
+		assert.ErrorIs(t, err, changerequest.ErrPendingExists)
+	})
+
+	t.Run("the author cannot approve", func(t *testing.T) {
+		_, err := approve.Handle(ctx, appparam.ReviewChangeCommand{ChangeRequestID: requestID, ReviewedBy: "alice", ReviewedBySubject: "sub-alice"})
+		assert.ErrorIs(t, err, changerequest.ErrSelfApproval)
+		assert.Nil(t, repo.rows["RPM"].MaxValue())
+
+		// Display names may change; the token subject does not
+		_, err = approve.Handle(ctx, appparam.ReviewChangeCommand{ChangeRequestID: requestID, ReviewedBy: "alice.renamed", ReviewedBySubject: "sub-alice"})
+		assert.ErrorIs(t, err, changerequest.ErrSelfApproval)
+		assert.Nil(t, repo.rows["RPM"].MaxValue())
+	})
+
+	t.Run("approval applies the change", func(t *testing.T) {
+		events := len(auditRepo.events)
+		comment := "Matches the new spindle spec"
+		result, err := approve.Handle(ctx, appparam.ReviewChangeCommand{ChangeRequestID: requestID, Comment: &comment, ReviewedBy: "bob", ReviewedBySubject: "sub-bob"})
+		require.NoError(t, err)
+
+		assert.Equal(t, 2, result.Parameter.Version())
+		assert.Equal(t, 18000.0, *repo.rows["RPM"].MaxValue())
+		assert.Equal(t, "alice", *repo.rows["RPM"].UpdatedBy())
+		assert.Len(t, auditRepo.events, events+1)
+
+		stored, err := changes.GetByID(ctx, requestID)
+		require.NoError(t, err)
+		assert.Equal(t, changerequest.StatusApproved, stored.Status())
+		assert.Equal(t, "bob", *stored.ReviewedBy())
+		assert.Equal(t, "sub-bob", *stored.ReviewedBySubject())
+		assert.Equal(t, "sub-alice", stored.RequestedBySubject())
+		assert.Equal(t, comment, *stored.ReviewComment())
+
+		_, err = reject.Handle(ctx, appparam.ReviewChangeCommand{ChangeRequestID: requestID, ReviewedBy: "carol", ReviewedBySubject: "sub-carol"})
+		assert.ErrorIs(t, err, changerequest.ErrNotPending)
+	})
+
+	t.Run("a stale request is not applied and stays pending", func(t *testing.T) {
+		stale := editRPM
+		stale.ParameterName = "Spindle Speed"
+		stale.Version = 2
+		result, err := edit.Handle(ctx, stale)
+		require.NoError(t, err)
+
+		// The parameter moves on before the review
+		repo.rows["RPM"].IncrementVersion()
+
+		_, err = approve.Handle(ctx, appparam.ReviewChangeCommand{ChangeRequestID: result.ChangeRequest.ID(), ReviewedBy: "bob", ReviewedBySubject: "sub-bob"})
+		assert.ErrorIs(t, err, parameter.ErrVersionConflict)
+		stored, err := changes.GetByID(ctx, result.ChangeRequest.ID())
+		require.NoError(t, err)
+		assert.Equal(t, changerequest.StatusPending, stored.Status())
+	})
+
+	t.Run("an edit that changes nothing is refused", func(t *testing.T) {
+		_, err := edit.Handle(ctx, appparam.UpdateCommand{
+			ParameterCode:    "RPM",
+			ParameterName:    "Rotation Per Minute",
+			Category:         "MACHINE",
+			DataType:         "NUMERIC",
+			MaxValue:         &maxRPM,
+			IsActive:         true,
+			Version:          repo.rows["RPM"].Version(),
+			UpdatedBy:        "alice",
+			UpdatedBySubject: "sub-alice",
+		})
+		assert.ErrorIs(t, err, changerequest.ErrNoChanges)
+	})
+
+	t.Run("deletes and restores of reviewed parameters are held", func(t *testing.T) {
+		tpi, err := parameter.NewParameter(
+			parameter.Code("TPI"), "Twists Per Inch", parameter.CategoryMachine, parameter.DataTypeNumeric, "admin")
+		require.NoError(t, err)
+		repo.rows[tpi.Code()] = tpi
+
+		result, err := edit.Delete(ctx, appparam.DeleteCommand{ParameterCode: "TPI", DeletedBy: "alice", DeletedBySubject: "sub-alice"})
+		require.NoError(t, err)
+		require.NotNil(t, result.ChangeRequest)
+		assert.Equal(t, changerequest.ActionDelete, result.ChangeRequest.Action())
+		require.Len(t, result.ChangeRequest.Changes(), 1)
+		assert.Equal(t, "is_deleted", result.ChangeRequest.Changes()[0].Field)
+		assert.False(t, repo.rows["TPI"].IsDeleted())
+
+		_, err = approve.Handle(ctx, appparam.ReviewChangeCommand{ChangeRequestID: result.ChangeRequest.ID(), ReviewedBy: "alice", ReviewedBySubject: "sub-alice"})
+		assert.ErrorIs(t, err, changerequest.ErrSelfApproval)
+		_, err = approve.Handle(ctx, appparam.ReviewChangeCommand{ChangeRequestID: result.ChangeRequest.ID(), ReviewedBy: "bob", ReviewedBySubject: "sub-bob"})
+		require.NoError(t, err)
+		assert.True(t, repo.rows["TPI"].IsDeleted())
+		assert.Equal(t, "alice", *repo.rows["TPI"].DeletedBy())
+
+		result, err = edit.Restore(ctx, appparam.RestoreCommand{ParameterCode: "TPI", RestoredBy: "alice", RestoredBySubject: "sub-alice"})
+		require.NoError(t, err)
+		require.NotNil(t, result.ChangeRequest)
+		assert.Equal(t, changerequest.ActionRestore, result.ChangeRequest.Action())
+		assert.Equal(t, 2, result.ChangeRequest.BaseVersion())
+		assert.True(t, repo.rows["TPI"].IsDeleted())
+
+		_, err = approve.Handle(ctx, appparam.ReviewChangeCommand{ChangeRequestID: result.ChangeRequest.ID(), ReviewedBy: "bob", ReviewedBySubject: "sub-bob"})
+		require.NoError(t, err)
+		assert.False(t, repo.rows["TPI"].IsDeleted())
+		assert.Equal(t, 3, repo.rows["TPI"].Version())
+	})
+
+	t.Run("deletes outside the policy are applied at once", func(t *testing.T) {
+		result, err := edit.Delete(ctx, appparam.DeleteCommand{ParameterCode: "GRADE", DeletedBy: "alice", DeletedBySubject: "sub-alice"})
+		require.NoError(t, err)
+		assert.Nil(t, result.ChangeRequest)
+		assert.True(t, repo.rows["GRADE"].IsDeleted())
+	})
+}
+
+func TestChangePolicy(t *testing.T) {
+	_, err := changerequest.NewPolicy([]string{"COSTING"}, false)
+	assert.ErrorIs(t, err, parameter.ErrInvalidCategory)
+
+	policy, err := changerequest.NewPolicy([]string{"MACHINE", "PROCESS"}, false)
+	require.NoError(t, err)
+	assert.True(t, policy.Requires(parameter.CategoryProcess, false))
+	assert.False(t, policy.Requires(parameter.CategoryMaterial, true))
+	assert.False(t, changerequest.Policy{}.Requires(parameter.CategoryMachine, true))
+
+	t.Run("batch upserts cannot bypass review", func(t *testing.T) {
+		repo := newBatchParameterRepo(t)
+		handler := appparam.NewBatchUpsertHandler(repo, &softDeleteUOMRepo{}, appaudit.NewRecorder(&memoryAuditRepo{}, inlineTx{}), policy)
+
+		results, err := handler.Handle(context.Background(), appparam.BatchUpsertCommand{Items: batchItems()[:2], UpsertedBy: "alice"})
+		require.NoError(t, err)
+		assert.ErrorIs(t, results[0].Err, changerequest.ErrApprovalRequired)
+		assert.Equal(t, batch.StatusCreated, results[1].Status, "new parameters are not changes")
+		assert.Equal(t, "Rotation Per Minute", repo.rows["RPM"].Name())
+	})
+}
+
+// Under the default config authentication is off, so every caller is the
+// system actor and edits must not be held for a review nobody can give.
+func TestChangePolicy_DefaultConfig(t *testing.T) {
+	cfg := loadConfig(t, t.TempDir())
+	require.False(t, cfg.Auth.Enabled)
+	require.NotEmpty(t, cfg.Approval.Categories)
+
+	approval := cfg.EffectiveApproval()
+	policy, err := changerequest.NewPolicy(approval.Categories, approval.Mandatory)
+	require.NoError(t, err)
+
+	rpm, err := parameter.NewParameter(
+		parameter.Code("RPM"), "Rotation Per Minute", parameter.CategoryMachine, parameter.DataTypeNumeric, "system")
+	require.NoError(t, err)
+	repo := &versionedParameterRepo{rows: map[parameter.Code]*parameter.Parameter{rpm.Code(): rpm}}
+	recorder := appaudit.NewRecorder(&memoryAuditRepo{}, inlineTx{})
+	edit := appparam.NewEditHandler(repo, &memoryChangeRequests{},
+		appparam.NewUpdateHandler(repo, &softDeleteUOMRepo{}, recorder),
+		appparam.NewDeleteHandler(repo, recorder),
+		appparam.NewRestoreHandler(repo, recorder),
+		policy)
+
+	result, err := edit.Handle(context.Background(), appparam.UpdateCommand{
+		ParameterCode: "RPM",
+		ParameterName: "Spindle Speed",
+		Category:      "MACHINE",
+		DataType:      "NUMERIC",
+		IsMandatory:   true,
+		IsActive:      true,
+		Version:       1,
+		UpdatedBy:     "system",
+	})
+	require.NoError(t, err)
+	assert.Nil(t, result.ChangeRequest)
+	assert.Equal(t, "Spindle Speed", repo.rows["RPM"].Name())
+
+	cfg.Auth.Enabled = true
+	assert.Equal(t, cfg.Approval, cfg.EffectiveApproval())
+}
@@ -0,0 +1,158 @@
+package integration_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	appaudit "github.com/homindolenern/goapps-costing-v1/internal/application/audit"
+	appparam "github.com/homindolenern/goapps-costing-v1/internal/application/parameter"
+	httpdelivery "github.com/homindolenern/goapps-costing-v1/internal/delivery/http"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/audit"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/parameter"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/uom"
+)
+
+// memoryAuditRepo keeps appended audit events in memory.
+type memoryAuditRepo struct {
+	audit.Repository
+	events []*audit.Event
+}
+
+func (r *memoryAuditRepo) Append(_ context.Context, event *audit.Event) error {
+	r.events = append(r.events, event)
+	return nil
+}
+
+// inlineTx runs the function it is given as is; the in-memory repositories
+// have no transactions to join.
+type inlineTx struct{}
+
+func (inlineTx) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// versionedParameterRepo stores copies of Parameters and enforces versions
+// on update like the Postgres repository.
+type versionedParameterRepo struct {
+	parameter.Repository
+	rows map[parameter.Code]*parameter.Parameter
+}
+
+func (r *versionedParameterRepo) GetByCode(_ context.Context, code parameter.Code) (*parameter.Parameter, error) {
+	row, ok := r.rows[code]
+	if !ok || row.IsDeleted() {
+		return nil, parameter.ErrNotFound
+	}
+	return copyParameter(row), nil
+}
+
+func (r *versionedParameterRepo) GetByCodeIncludingDeleted(_ context.Context, code parameter.Code) (*parameter.Parameter, error) {
+	row, ok := r.rows[code]
+	if !ok {
+		return nil, parameter.ErrNotFound
+	}
+	return copyParameter(row), nil
+}
+
+func (r *versionedParameterRepo) IsInUse(context.Context, parameter.Code) (bool, error) {
+	return false, nil
+}
+
+func (r *versionedParameterRepo) Update(_ context.Context, entity *parameter.Parameter) error {
+	row, ok := r.rows[entity.Code()]
+	if !ok {
+		return parameter.ErrNotFound
+	}
+	if row.Version() != entity.Version() {
+		return parameter.ErrVersionConflict
+	}
+	entity.IncrementVersion()
+	r.rows[entity.Code()] = copyParameter(entity)
+	return nil
+}
+
+func copyParameter(p *parameter.Parameter) *parameter.Parameter {
+	return parameter.Reconstitute(
+		p.Code(), p.Name(), p.Category(), p.DataType(), p.UOM(), p.MinValue(), p.MaxValue(),
+		p.AllowedValues(), p.IsMandatory(), p.Description(), p.IsActive(),
+		p.CreatedAt(), p.CreatedBy(), p.UpdatedAt(), p.UpdatedBy(), p.DeletedAt(), p.DeletedBy(), p.Version(),
+	)
+}
+
+func TestVersionDomain(t *testing.T) {
+	kg, err := uom.NewUOM(uom.Code("KG"), "Kilogram", uom.CategoryWeight, "admin")
+	require.NoError(t, err)
+	assert.Equal(t, 1, kg.Version())
+	assert.NoError(t, kg.CheckVersion(1))
+	assert.ErrorIs(t, kg.CheckVersion(2), uom.ErrVersionConflict)
+
+	kg.IncrementVersion()
+	assert.Equal(t, 2, kg.Version())
+	assert.ErrorIs(t, kg.CheckVersion(1), uom.ErrVersionConflict)
+}
+
+func TestUpdateParameter_VersionConflict(t *testing.T) {
+	ctx := context.Background()
+	entity, err := parameter.NewParameter(
+		parameter.Code("RPM"), "Rotation Per Minute", parameter.CategoryMachine, parameter.DataTypeNumeric, "admin")
+	require.NoError(t, err)
+
+	repo := &versionedParameterRepo{rows: map[parameter.Code]*parameter.Parameter{entity.Code(): entity}}
+	auditRepo := &memoryAuditRepo{}
+	handler := appparam.NewUpdateHandler(repo, &softDeleteUOMRepo{}, appaudit.NewRecorder(auditRepo, inlineTx{}))
+
+	cmd := appparam.UpdateCommand{
+		ParameterCode: "RPM",
+		ParameterName: "Spindle Speed",
+		Category:      "MACHINE",
+		DataType:      "NUMERIC",
+		IsActive:      true,
+		Version:       1,
+		UpdatedBy:     "alice",
+	}
+
+	// First writer wins and bumps the version
+	updated, err := handler.Handle(ctx, cmd)
+	require.NoError(t, err)
+	assert.Equal(t, 2, updated.Version())
+	assert.Len(t, auditRepo.events, 1)
+
+	// Second writer based on the same read is rejected
+	cmd.ParameterName = "Rotor Speed"
+	cmd.UpdatedBy = "bob"
+	_, err = handler.Handle(ctx, cmd)
+	assert.ErrorIs(t, err, parameter.ErrVersionConflict)
+	assert.Len(t, auditRepo.events, 1)
+
+	stored, err := repo.GetByCode(ctx, parameter.Code("RPM"))
+	require.NoError(t, err)
+	assert.Equal(t, "Spindle Speed", stored.Name())
+
+	// Retrying with the current version succeeds
+	cmd.Version = 2
+	updated, err = handler.Handle(ctx, cmd)
+	require.NoError(t, err)
+	assert.Equal(t, "Rotor Speed", updated.Name())
+	assert.Equal(t, 3, updated.Version())
+}
+
+func TestGatewayVersionHeaders(t *testing.T) {
+	key, ok := httpdelivery.IncomingHeaderMatcher("if-match")
+	assert.True(t, ok)
+	assert.Equal(t, "if-match", key)
+
+	key, ok = httpdelivery.IncomingHeaderMatcher("Authorization")
+	assert.True(t, ok)
+	assert.Equal(t, "grpcgateway-Authorization", key)
+
+	key, ok = httpdelivery.OutgoingHeaderMatcher("etag")
+	assert.True(t, ok)
+	assert.Equal(t, "ETag", key)
+
+	key, ok = httpdelivery.OutgoingHeaderMatcher("retry-after")
+	assert.True(t, ok)
+	assert.Equal(t, "Grpc-Metadata-retry-after", key)
+}
@@ -0,0 +1,31 @@
+package integration_test
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/homindolenern/goapps-costing-v1/internal/config"
+)
+
+// loadConfig loads the configuration as the service would from dir.
+func loadConfig(t *testing.T, dir string) *config.Config {
+	t.Helper()
+	t.Chdir(dir)
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	cfg, err := config.Load()
+	require.NoError(t, err)
+	return cfg
+}
+
+// The image does not ship config.yaml, so its defaults must match the file.
+func TestConfigDefaultsMatchConfigFile(t *testing.T) {
+	fromFile := loadConfig(t, "../..")
+	fromDefaults := loadConfig(t, t.TempDir())
+
+	assert.Equal(t, fromFile, fromDefaults)
+}
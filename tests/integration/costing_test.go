@@ -0,0 +1,152 @@
+package integration_test
+
+import (
+	"testing"
+
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/costing"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/uom"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestCostingConverter(t *testing.T) *uom.Converter {
+	t.Helper()
+	units := []*uom.UOM{
+		newTestUOM(t, "KG", "WEIGHT", 1),
+		newTestUOM(t, "G", "WEIGHT", 0.001),
+		newTestUOM(t, "TON", "WEIGHT", 1000),
+		newTestUOM(t, "M", "LENGTH", 1),
+		newTestUOM(t, "KM", "LENGTH", 1000),
+	}
+	// Ne 30 yarn: roughly 50 km per kg
+	conv, err := uom.NewConversion("KG", "KM", 50, "admin")
+	require.NoError(t, err)
+	return uom.NewConverter(units, []*uom.Conversion{conv})
+}
+
+// Hand-computed sheet for 100 KG of YARN-30S at 0.15 per kWh:
+//
+//	COTTON    100 KG net, 20% waste -> 125 KG x 2.50/KG         = 312.5000
+//	POLY      500 G net             -> 0.0005 TON x 1200/TON    =   0.6000
+//	CARDING   50 KG/h x 80%  = 40 KG/h, 100 KG -> 2.5 h
+//	          energy 2.5 h x 10 kWh = 25 kWh x 0.15             =   3.7500
+//	          labor 2.5 h x 8                                   =  20.0000
+//	          overhead 2.5 h x 4                                =  10.0000
+//	SPINNING  15000 RPM x 60 x 0.0001 KM x 90% = 81 KM/h
+//	          100 KG = 5000 KM -> 61.728395 h
+//	          energy 61.728395 h x 5 kWh = 308.641975 kWh x 0.15 =  46.2963
+//	          labor 61.728395 h x 8                             = 493.8272
+//	material 313.1000, process 573.8735, total 886.9735, unit 8.8697 per KG
+func TestCosting_CalculateHandComputedSheet(t *testing.T) {
+	recipe := costing.Recipe{
+		ProductCode:     "YARN-30S",
+		OutputQuantity:  100,
+		OutputUOM:       "KG",
+		ElectricityRate: 0.15,
+		Materials: []costing.MaterialLine{
+			{MaterialCode: "COTTON", Quantity: 100, UOM: "KG", UnitPrice: 2.5, PriceUOM: "KG", WastePercent: 20},
+			{MaterialCode: "POLY", Quantity: 500, UOM: "G", UnitPrice: 1200, PriceUOM: "TON"},
+		},
+		Steps: []costing.ProcessStep{
+			{
+				StepCode:    "CARDING",
+				MachineCode: "CRD-01",
+				OutputUOM:   "KG",
+				Parameters: map[string]float64{
+					costing.ParamProductionRate: 50,
+					costing.ParamEfficiency:     80,
+					costing.ParamPowerKWh:       10,
+				},
+				LaborRatePerHour:    8,
+				OverheadRatePerHour: 4,
+			},
+			{
+				StepCode:    "SPINNING",
+				MachineCode: "RNG-01",
+				OutputUOM:   "KM",
+				Parameters: map[string]float64{
+					costing.ParamRPM:          15000,
+					costing.ParamOutputPerRev: 0.0001,
+					costing.ParamEfficiency:   90,
+					costing.ParamPowerKWh:     5,
+				},
+				LaborRatePerHour: 8,
+			},
+		},
+	}
+
+	breakdown, err := costing.Calculate(recipe, newTestCostingConverter(t))
+	require.NoError(t, err)
+
+	amounts := make([]float64, len(breakdown.Components))
+	for i, c := range breakdown.Components {
+		amounts[i] = c.Amount
+	}
+	assert.Equal(t, []float64{312.5, 0.6, 3.75, 20, 10, 46.2963, 493.8272}, amounts)
+	assert.Equal(t, costing.ComponentTypeMaterial, breakdown.Components[0].Type)
+	assert.Equal(t, costing.ComponentTypeEnergy, breakdown.Components[2].Type)
+	assert.Equal(t, costing.ComponentTypeLabor, breakdown.Components[6].Type)
+	assert.InDelta(t, 125, breakdown.Components[0].Quantity, 1e-9)
+
+	require.Len(t, breakdown.Steps, 2)
+	assert.InDelta(t, 2.5, breakdown.Steps[0].Hours, 1e-9)
+	assert.InDelta(t, 81, breakdown.Steps[1].ProductionRate, 1e-9)
+	assert.InDelta(t, 5000, breakdown.Steps[1].Output, 1e-9)
+
+	assert.Equal(t, 313.1, breakdown.MaterialCost)
+	assert.Equal(t, 573.8735, breakdown.ProcessCost)
+	assert.Equal(t, 886.9735, breakdown.TotalCost)
+	assert.Equal(t, 8.8697, breakdown.UnitCost)
+
+	// Same input, same output
+	again, err := costing.Calculate(recipe, newTestCostingConverter(t))
+	require.NoError(t, err)
+	assert.Equal(t, breakdown, again)
+}
+
+func TestCosting_CalculateErrors(t *testing.T) {
+	converter := newTestCostingConverter(t)
+	step := func(params map[string]float64, outputUOM uom.Code) costing.Recipe {
+		return costing.Recipe{
+			ProductCode:    "YARN-30S",
+			OutputQuantity: 100,
+			OutputUOM:      "KG",
+			Steps:          []costing.ProcessStep{{StepCode: "S1", OutputUOM: outputUOM, Parameters: params}},
+		}
+	}
+
+	testCases := []struct {
+		name    string
+		recipe  costing.Recipe
+		wantErr error
+	}{
+		{"empty recipe", costing.Recipe{ProductCode: "P", OutputQuantity: 1, OutputUOM: "KG"}, costing.ErrEmptyRecipe},
+		{"zero output", costing.Recipe{ProductCode: "P", OutputUOM: "KG"}, costing.ErrInvalidOutputQuantity},
+		{"missing rate", step(map[string]float64{costing.ParamRPM: 100}, "KG"), costing.ErrMissingProductionRate},
+		{
+			"efficiency above 100",
+			step(map[string]float64{costing.ParamProductionRate: 10, costing.ParamEfficiency: 120}, "KG"),
+			costing.ErrInvalidEfficiency,
+		},
+		{"cross-category via explicit conversion", step(map[string]float64{costing.ParamProductionRate: 10}, "M"), nil},
+		{
+			"waste 100%",
+			costing.Recipe{
+				ProductCode: "P", OutputQuantity: 1, OutputUOM: "KG",
+				Materials: []costing.MaterialLine{{MaterialCode: "M", Quantity: 1, UOM: "KG", PriceUOM: "KG", WastePercent: 100}},
+			},
+			costing.ErrInvalidWaste,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := costing.Calculate(tc.recipe, converter)
+			if tc.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package integration_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/parameter"
+	"github.com/homindolenern/goapps-costing-v1/pkg/spreadsheet"
+)
+
+func (r *batchParameterRepo) ForEach(_ context.Context, filter parameter.ListFilter, fn func(*parameter.Parameter) error) error {
+	codes := make([]string, 0, len(r.rows))
+	for code := range r.rows {
+		codes = append(codes, code.String())
+	}
+	sort.Strings(codes)
+
+	for _, code := range codes {
+		row := r.rows[parameter.Code(code)]
+		if row.IsDeleted() && !filter.IncludeDeleted {
+			continue
+		}
+		if filter.IsActive != nil && row.IsActive() != *filter.IsActive {
+			continue
+		}
+		if err := fn(copyParameter(row)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func downloadExport(t *testing.T, handler http.Handler, query string) *httptest.ResponseRecorder {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/parameters:export"+query, nil))
+	return rec
+}
+
+func TestExportParameters_HTTP(t *testing.T) {
+	repo := newBatchParameterRepo(t)
+	gateway := newParameterFileGateway(t, repo)
+
+	rec := downloadExport(t, gateway, "?format=csv")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Equal(t, "text/csv; charset=utf-8", rec.Header().Get("Content-Type"))
+	assert.Contains(t, rec.Header().Get("Content-Disposition"), `filename="parameters.csv"`)
+
+	csvRows, err := spreadsheet.ReadRows(spreadsheet.FormatCSV, rec.Body.Bytes(), 0)
+	require.NoError(t, err)
+	require.Len(t, csvRows, 2, "deleted parameters are left out")
+	assert.Equal(t, []string{"parameter_code", "parameter_name", "parameter_category", "data_type"}, csvRows[0][:4])
+	assert.Equal(t, []string{"RPM", "Rotation Per Minute", "MACHINE", "NUMERIC"}, csvRows[1][:4])
+
+	// The same rows come back from a workbook
+	rec = downloadExport(t, gateway, "?format=xlsx")
+	require.Equal(t, http.StatusOK, rec.Code)
+	xlsxRows, err := spreadsheet.ReadRows(spreadsheet.FormatXLSX, rec.Body.Bytes(), 0)
+	require.NoError(t, err)
+	assert.Equal(t, csvRows[1][:4], xlsxRows[1][:4])
+
+	rec = downloadExport(t, gateway, "?format=json&include_deleted=true")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var records []map[string]any
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &records))
+	require.Len(t, records, 2)
+	assert.Equal(t, "TWIST", records[1]["parameterCode"])
+
+	rec = downloadExport(t, gateway, "?format=pdf")
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	// An export can be imported again as is
+	rec = downloadExport(t, gateway, "?format=csv")
+	resp := uploadImport(t, gateway, "?update_existing=true", "parameters.csv", rec.Body.String())
+	assert.Equal(t, "200", resp["base"].(map[string]any)["statusCode"], resp)
+	assert.EqualValues(t, 1, resp["summary"].(map[string]any)["updated"])
+}
@@ -0,0 +1,230 @@
+package integration_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"mime/multipart"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"buf.build/go/protovalidate"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	pb "github.com/homindolenern/goapps-costing-v1/gen/go/costing/v1"
+	appaudit "github.com/homindolenern/goapps-costing-v1/internal/application/audit"
+	"github.com/homindolenern/goapps-costing-v1/internal/application/batch"
+	appparam "github.com/homindolenern/goapps-costing-v1/internal/application/parameter"
+	grpcdelivery "github.com/homindolenern/goapps-costing-v1/internal/delivery/grpc"
+	httpdelivery "github.com/homindolenern/goapps-costing-v1/internal/delivery/http"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/changerequest"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/parameter"
+	"github.com/homindolenern/goapps-costing-v1/pkg/spreadsheet"
+)
+
+// newXLSX builds a minimal workbook. Its first row uses shared strings, the
+// second an inline string with a skipped column, and row 3 is left out.
+func newXLSX(t *testing.T) []byte {
+	t.Helper()
+	return newXLSXSheet(t, `
+		<row r="1"><c r="A1" t="s"><v>0</v></c><c r="B1" t="s"><v>1</v></c><c r="C1" t="b"><v>1</v></c></row>
+		<row r="2"><c r="A2" t="inlineStr"><is><t>KG</t></is></c><c r="C2"><v>2.5</v></c></row>
+		<row r="4"><c r="B4" t="str"><v>Gram</v></c></row>`)
+}
+
+// newXLSXSheet builds a minimal workbook whose sheet holds sheetData.
+func newXLSXSheet(t *testing.T, sheetData string) []byte {
+	t.Helper()
+	parts := map[string]string{
+		"xl/workbook.xml": `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"
+			xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">
+			<sheets><sheet name="UOMs" sheetId="1" r:id="rId1"/></sheets></workbook>`,
+		"xl/_rels/workbook.xml.rels": `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+			<Relationship Id="rId1" Target="worksheets/sheet1.xml"/></Relationships>`,
+		"xl/sharedStrings.xml":     `<sst><si><t>Code</t></si><si><r><t>Na</t></r><r><t>me</t></r></si></sst>`,
+		"xl/worksheets/sheet1.xml": `<worksheet><sheetData>` + sheetData + `</sheetData></worksheet>`,
+	}
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range parts {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+func TestReadRows(t *testing.T) {
+	rows, err := spreadsheet.ReadRows(spreadsheet.FormatXLSX, newXLSX(t), 0)
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{
+		{"Code", "Name", "TRUE"},
+		{"KG", "", "2.5"},
+		nil,
+		{"", "Gram"},
+	}, rows)
+
+	rows, err = spreadsheet.ReadRows(spreadsheet.FormatCSV, []byte("\xef\xbb\xbfCode,Name\nKG,\"Kilo, gram\"\n\nG,Gram\n"), 0)
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{{"Code", "Name"}, {"KG", "Kilo, gram"}, nil, {"G", "Gram"}}, rows)
+
+	_, err = spreadsheet.ReadRows(spreadsheet.FormatXLSX, []byte("not a zip"), 0)
+	assert.ErrorIs(t, err, spreadsheet.ErrInvalidFile)
+
+	_, err = spreadsheet.FormatFromFilename("params.ods")
+	assert.ErrorIs(t, err, spreadsheet.ErrUnsupportedFormat)
+}
+
+// A few hundred bytes must not make the reader allocate a sheet's worth of
+// memory or index out of range.
+func TestReadRows_HostileSheet(t *testing.T) {
+	for name, sheetData := range map[string]string{
+		"row past the sheet":    `<row r="2000000000"><c><v>1</v></c></row>`,
+		"column past the sheet": `<row r="1"><c r="XFE1"><v>1</v></c></row>`,
+		"overflowing column":    `<row r="1"><c r="ZZZZZZZZZZZZZZZ1"><v>1</v></c></row>`,
+		"sparse cells":          strings.Repeat(`<row><c r="XFD1"><v>1</v></c></row>`, 100),
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := spreadsheet.ReadRows(spreadsheet.FormatXLSX, newXLSXSheet(t, sheetData), 0)
+			assert.ErrorIs(t, err, spreadsheet.ErrInvalidFile)
+		})
+	}
+
+	t.Run("reading stops past the limit", func(t *testing.T) {
+		sheetData := `<row><c><v>Code</v></c></row>` +
+			strings.Repeat(`<row><c t="inlineStr"><is><t> </t></is></c></row><row><c><v>KG</v></c></row>`, 3)
+		rows, err := spreadsheet.ReadRows(spreadsheet.FormatXLSX, newXLSXSheet(t, sheetData), 4)
+		require.NoError(t, err)
+		assert.Len(t, rows, 7, "blank rows do not count")
+
+		_, err = spreadsheet.ReadRows(spreadsheet.FormatXLSX, newXLSXSheet(t, sheetData), 3)
+		assert.ErrorIs(t, err, spreadsheet.ErrTooManyRows)
+		_, err = spreadsheet.ReadRows(spreadsheet.FormatCSV, []byte("Code\nKG\n \nG\nM\n"), 3)
+		assert.ErrorIs(t, err, spreadsheet.ErrTooManyRows)
+	})
+}
+
+func TestBatchUpsertParameters_DryRunAndCreateOnly(t *testing.T) {
+	ctx := context.Background()
+	repo := newBatchParameterRepo(t)
+	auditRepo := &memoryAuditRepo{}
+	handler := appparam.NewBatchUpsertHandler(repo, &softDeleteUOMRepo{}, appaudit.NewRecorder(auditRepo, inlineTx{}), changerequest.Policy{})
+
+	results, err := handler.Handle(ctx, appparam.BatchUpsertCommand{Items: batchItems()[:2], DryRun: true, UpsertedBy: "alice"})
+	require.NoError(t, err)
+	assert.Equal(t, batch.StatusUpdated, results[0].Status)
+	assert.Equal(t, batch.StatusCreated, results[1].Status)
+	assert.Equal(t, 0, repo.saveCalls)
+	assert.Empty(t, auditRepo.events)
+
+	results, err = handler.Handle(ctx, appparam.BatchUpsertCommand{Items: batchItems()[:2], CreateOnly: true, UpsertedBy: "alice"})
+	require.NoError(t, err)
+	assert.ErrorIs(t, results[0].Err, parameter.ErrAlreadyExists)
+	assert.Equal(t, batch.StatusCreated, results[1].Status)
+}
+
+// newParameterFileGateway serves ImportParameters and ExportParameters over
+// an in-memory gRPC connection behind the HTTP upload and download endpoints.
+func newParameterFileGateway(t *testing.T, repo *batchParameterRepo) http.Handler {
+	t.Helper()
+	validator, err := protovalidate.New()
+	require.NoError(t, err)
+
+	lis := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	pb.RegisterParameterServiceServer(server, grpcdelivery.NewParameterHandler(
+		nil, nil,
+		appparam.NewBatchUpsertHandler(repo, &softDeleteUOMRepo{}, appaudit.NewRecorder(&memoryAuditRepo{}, inlineTx{}), changerequest.Policy{}),
+		nil, nil, nil,
+		appparam.NewExportHandler(repo),
+		nil,
+		grpcdelivery.NewValidationHelper(validator),
+	))
+	go func() { _ = server.Serve(lis) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	mux := httpdelivery.NewServeMux()
+	require.NoError(t, httpdelivery.RegisterImportHandlers(mux, conn))
+	require.NoError(t, httpdelivery.RegisterExportHandlers(mux, conn))
+	return mux
+}
+
+func uploadImport(t *testing.T, handler http.Handler, query, filename, content string) map[string]any {
+	t.Helper()
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	fw, err := mw.CreateFormFile("file", filename)
+	require.NoError(t, err)
+	_, err = fw.Write([]byte(content))
+	require.NoError(t, err)
+	require.NoError(t, mw.Close())
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/parameters:import"+query, &body)
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	var resp map[string]any
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp), rec.Body.String())
+	return resp
+}
+
+func TestImportParameters_HTTP(t *testing.T) {
+	const file = "Parameter Code,Parameter Name,Parameter Category,Data Type,Allowed Values\n" +
+		"TPI,Twists Per Inch,PROCESS,numeric,\n" +
+		"TWIST_DIR,Twist Direction,process,TEXT,S|Z\n"
+
+	t.Run("dry run saves nothing", func(t *testing.T) {
+		repo := newBatchParameterRepo(t)
+		resp := uploadImport(t, newParameterFileGateway(t, repo), "?dry_run=true", "params.csv", file)
+
+		assert.Equal(t, "200", resp["base"].(map[string]any)["statusCode"])
+		assert.Equal(t, true, resp["dryRun"])
+		assert.EqualValues(t, 2, resp["summary"].(map[string]any)["created"])
+		assert.Equal(t, 0, repo.saveCalls)
+	})
+
+	t.Run("rows are saved together", func(t *testing.T) {
+		repo := newBatchParameterRepo(t)
+		resp := uploadImport(t, newParameterFileGateway(t, repo), "", "params.csv", file)
+
+		assert.Equal(t, "200", resp["base"].(map[string]any)["statusCode"])
+		require.Contains(t, repo.rows, parameter.Code("TWIST_DIR"))
+		assert.Equal(t, []string{"S", "Z"}, repo.rows["TWIST_DIR"].AllowedValues())
+	})
+
+	t.Run("row errors reject the file", func(t *testing.T) {
+		repo := newBatchParameterRepo(t)
+		resp := uploadImport(t, newParameterFileGateway(t, repo), "", "params.csv",
+			file+"RPM,Rotation Per Minute,MACHINE,NUMERIC,\nlower,Bad,MACHINE,DATE,\n")
+
+		assert.Equal(t, "400", resp["base"].(map[string]any)["statusCode"])
+		summary := resp["summary"].(map[string]any)
+		assert.EqualValues(t, 4, summary["total"])
+		assert.EqualValues(t, 2, summary["failed"])
+		assert.EqualValues(t, 2, summary["skipped"])
+
+		errs := resp["errors"].([]any)
+		require.Len(t, errs, 2)
+		assert.EqualValues(t, 4, errs[0].(map[string]any)["row"])
+		assert.Equal(t, "Data Type", errs[1].(map[string]any)["column"])
+		assert.Equal(t, 0, repo.saveCalls)
+	})
+}
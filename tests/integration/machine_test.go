@@ -0,0 +1,109 @@
+package integration_test
+
+import (
+	"testing"
+
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/machine"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/parameter"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/parametervalue"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestMachineType(t *testing.T, code string, params ...machine.TemplateParameter) *machine.MachineType {
+	t.Helper()
+	c, err := machine.NewTypeCode(code)
+	require.NoError(t, err)
+	entity, err := machine.NewMachineType(c, code, "admin")
+	require.NoError(t, err)
+	require.NoError(t, entity.SetParameters(params))
+	return entity
+}
+
+func TestMachineDomain_Codes(t *testing.T) {
+	_, err := machine.NewTypeCode("RING_FRAME")
+	assert.NoError(t, err)
+	_, err = machine.NewTypeCode("ring-frame")
+	assert.ErrorIs(t, err, machine.ErrInvalidTypeCode)
+
+	_, err = machine.NewMachineCode("RF-01")
+	assert.NoError(t, err)
+	_, err = machine.NewMachineCode("rf 01")
+	assert.ErrorIs(t, err, machine.ErrInvalidCode)
+}
+
+func TestMachineDomain_Template(t *testing.T) {
+	machineType := newTestMachineType(t, "RING_FRAME")
+
+	err := machineType.SetParameters([]machine.TemplateParameter{
+		machine.NewTemplateParameter("SPINDLES", true),
+		machine.NewTemplateParameter("SPINDLES", false),
+	})
+	assert.ErrorIs(t, err, machine.ErrDuplicateParameter)
+
+	assert.NoError(t, machine.CheckTemplateParameter(newTestParameter(t, "SPINDLES", parameter.DataTypeNumeric)))
+
+	code, err := parameter.NewParameterCode("FIBRE_LENGTH")
+	require.NoError(t, err)
+	materialParam, err := parameter.NewParameter(code, "Fibre length", parameter.CategoryMaterial, parameter.DataTypeNumeric, "admin")
+	require.NoError(t, err)
+	assert.ErrorIs(t, machine.CheckTemplateParameter(materialParam), machine.ErrParameterNotMachine)
+}
+
+func TestMachineDomain_ResolveValues(t *testing.T) {
+	spindles := newTestParameter(t, "SPINDLES", parameter.DataTypeNumeric)
+	minVal, maxVal := 1.0, 2000.0
+	require.NoError(t, spindles.SetNumericConstraints(&minVal, &maxVal))
+	automation := newTestParameter(t, "AUTO_DOFFER", parameter.DataTypeBoolean)
+	maker := newTestParameter(t, "MAKER", parameter.DataTypeText)
+
+	definitions := map[parameter.Code]*parameter.Parameter{
+		spindles.Code():   spindles,
+		automation.Code(): automation,
+		maker.Code():      maker,
+	}
+	machineType := newTestMachineType(t, "RING_FRAME",
+		machine.NewTemplateParameter(spindles.Code(), true),
+		machine.NewTemplateParameter(automation.Code(), false),
+		machine.NewTemplateParameter(maker.Code(), false),
+	)
+
+	t.Run("normalized in template order, empty optional omitted", func(t *testing.T) {
+		values, err := machine.ResolveValues(machineType, definitions, map[parameter.Code]string{
+			"MAKER":       "Rieter",
+			"SPINDLES":    "1632",
+			"AUTO_DOFFER": "1",
+		})
+		require.NoError(t, err)
+		require.Len(t, values, 3)
+		assert.Equal(t, parameter.Code("SPINDLES"), values[0].ParameterCode())
+		assert.Equal(t, "true", values[1].Value())
+		assert.Equal(t, parameter.Code("MAKER"), values[2].ParameterCode())
+
+		values, err = machine.ResolveValues(machineType, definitions, map[parameter.Code]string{
+			"SPINDLES": "1200",
+			"MAKER":    "",
+		})
+		require.NoError(t, err)
+		assert.Len(t, values, 1)
+	})
+
+	t.Run("mandatory parameter missing", func(t *testing.T) {
+		_, err := machine.ResolveValues(machineType, definitions, map[parameter.Code]string{"MAKER": "Toyota"})
+		assert.ErrorIs(t, err, machine.ErrMandatoryParameterMissing)
+		assert.Contains(t, err.Error(), "SPINDLES")
+	})
+
+	t.Run("parameter outside the template", func(t *testing.T) {
+		_, err := machine.ResolveValues(machineType, definitions, map[parameter.Code]string{
+			"SPINDLES": "1200",
+			"TPI":      "18",
+		})
+		assert.ErrorIs(t, err, machine.ErrParameterNotInTemplate)
+	})
+
+	t.Run("value checked against the definition", func(t *testing.T) {
+		_, err := machine.ResolveValues(machineType, definitions, map[parameter.Code]string{"SPINDLES": "5000"})
+		assert.ErrorIs(t, err, parametervalue.ErrValueAboveMax)
+	})
+}
@@ -0,0 +1,75 @@
+package integration_test
+
+import (
+	"testing"
+
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/material"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/uom"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaterialDomain_ValueObjects(t *testing.T) {
+	t.Run("code", func(t *testing.T) {
+		_, err := material.NewMaterialCode("COTTON-30S")
+		assert.NoError(t, err)
+		_, err = material.NewMaterialCode("cotton")
+		assert.ErrorIs(t, err, material.ErrInvalidCode)
+		_, err = material.NewMaterialCode("")
+		assert.ErrorIs(t, err, material.ErrInvalidCode)
+	})
+
+	t.Run("type", func(t *testing.T) {
+		materialType, err := material.NewType("YARN")
+		assert.NoError(t, err)
+		assert.Equal(t, material.TypeYarn, materialType)
+		_, err = material.NewType("METAL")
+		assert.ErrorIs(t, err, material.ErrInvalidType)
+	})
+
+	t.Run("currency", func(t *testing.T) {
+		_, err := material.NewCurrency("IDR")
+		assert.NoError(t, err)
+		_, err = material.NewCurrency("usd")
+		assert.ErrorIs(t, err, material.ErrInvalidCurrency)
+		_, err = material.NewCurrency("USDT")
+		assert.ErrorIs(t, err, material.ErrInvalidCurrency)
+	})
+
+	t.Run("price", func(t *testing.T) {
+		price, err := material.NewPrice(0, "USD")
+		assert.NoError(t, err)
+		assert.Equal(t, 0.0, price.Amount())
+		_, err = material.NewPrice(-0.01, "USD")
+		assert.ErrorIs(t, err, material.ErrNegativePrice)
+	})
+}
+
+func TestMaterialDomain_NewAndUpdate(t *testing.T) {
+	code, err := material.NewMaterialCode("PES-14D")
+	require.NoError(t, err)
+	price, err := material.NewPrice(1.85, "USD")
+	require.NoError(t, err)
+
+	entity, err := material.NewMaterial(code, "Polyester staple 1.4D", material.TypeFibre, uom.Code("KG"), price, "admin")
+	require.NoError(t, err)
+	assert.True(t, entity.IsActive())
+	assert.Equal(t, uom.Code("KG"), entity.PurchaseUOM())
+	assert.Equal(t, 1.85, entity.StandardPrice().Amount())
+	assert.Nil(t, entity.UpdatedAt())
+
+	_, err = material.NewMaterial(code, "", material.TypeFibre, uom.Code("KG"), price, "admin")
+	assert.ErrorIs(t, err, material.ErrEmptyName)
+	_, err = material.NewMaterial(code, "Polyester", material.TypeFibre, uom.Code("KG"), price, "")
+	assert.ErrorIs(t, err, material.ErrEmptyCreatedBy)
+
+	newPrice, err := material.NewPrice(27500, "IDR")
+	require.NoError(t, err)
+	require.NoError(t, entity.Update("Polyester staple 1.4D x 38mm", material.TypeFibre, uom.Code("BALE"), newPrice, "editor"))
+	assert.Equal(t, uom.Code("BALE"), entity.PurchaseUOM())
+	assert.Equal(t, material.Currency("IDR"), entity.StandardPrice().Currency())
+	require.NotNil(t, entity.UpdatedBy())
+	assert.Equal(t, "editor", *entity.UpdatedBy())
+
+	assert.ErrorIs(t, entity.Update("", material.TypeFibre, uom.Code("KG"), price, "editor"), material.ErrEmptyName)
+}
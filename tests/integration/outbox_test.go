@@ -0,0 +1,281 @@
+package integration_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/homindolenern/goapps-costing-v1/internal/application/outbox"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/event"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/parameter"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/uom"
+	"github.com/homindolenern/goapps-costing-v1/internal/infrastructure/publisher"
+)
+
+func eventTypes(events []event.Event) []event.Type {
+	types := make([]event.Type, 0, len(events))
+	for _, e := range events {
+		types = append(types, e.Type)
+	}
+	return types
+}
+
+func TestAggregateEvents(t *testing.T) {
+	t.Run("parameter", func(t *testing.T) {
+		param, err := parameter.NewParameter("TPI", "Twists Per Inch", parameter.CategoryProcess, parameter.DataTypeNumeric, "alice")
+		require.NoError(t, err)
+
+		// Changes before the first save fold into the creation
+		require.NoError(t, param.Update("Twists", parameter.CategoryProcess, parameter.DataTypeNumeric, "alice"))
+		assert.Equal(t, []event.Type{event.ParameterCreated}, eventTypes(param.PullEvents()))
+		assert.Empty(t, param.PullEvents(), "pulled events are cleared")
+
+		require.NoError(t, param.Update("Twists Per Inch", parameter.CategoryProcess, parameter.DataTypeNumeric, "bob"))
+		param.Deactivate()
+		param.Deactivate()
+		events := param.PullEvents()
+		assert.Equal(t, []event.Type{event.ParameterUpdated, event.ParameterDeactivated}, eventTypes(events))
+		assert.Equal(t, "TPI", events[0].AggregateCode)
+
+		param.Deactivate()
+		assert.Empty(t, param.PullEvents(), "no event without a state change")
+	})
+
+	t.Run("uom", func(t *testing.T) {
+		unit, err := uom.NewUOM("KG", "Kilogram", uom.CategoryWeight, "alice")
+		require.NoError(t, err)
+		assert.Equal(t, []event.Type{event.UOMCreated}, eventTypes(unit.PullEvents()))
+
+		unit.SoftDelete("bob")
+		require.NoError(t, unit.Restore("bob"))
+		assert.Equal(t, []event.Type{event.UOMDeleted, event.UOMRestored}, eventTypes(unit.PullEvents()))
+	})
+}
+
+func TestEventType(t *testing.T) {
+	assert.Equal(t, "parameter", event.ParameterDeactivated.Aggregate())
+	assert.Equal(t, "deactivated", event.ParameterDeactivated.Action())
+	assert.Equal(t, "uom", event.UOMCreated.Aggregate())
+}
+
+// memoryOutbox is an in-memory event.Outbox.
+type memoryOutbox struct {
+	messages  []*event.Message
+	published map[int64]bool
+	failures  map[int64]int
+}
+
+func newMemoryOutbox(types ...event.Type) *memoryOutbox {
+	o := &memoryOutbox{published: map[int64]bool{}, failures: map[int64]int{}}
+	for i, eventType := range types {
+		o.messages = append(o.messages, &event.Message{
+			Sequence:      int64(i + 1),
+			Type:          eventType,
+			AggregateType: eventType.Aggregate(),
+			AggregateCode: "TPI",
+			OccurredAt:    time.Now(),
+			Payload:       json.RawMessage(`{"parameter_code":"TPI"}`),
+		})
+	}
+	return o
+}
+
+func (o *memoryOutbox) Claim(_ context.Context, limit int, _ time.Duration) ([]*event.Message, error) {
+	var claimed []*event.Message
+	for _, msg := range o.messages {
+		if !o.published[msg.Sequence] && len(claimed) < limit {
+			claimed = append(claimed, msg)
+		}
+	}
+	return claimed, nil
+}
+
+func (o *memoryOutbox) MarkPublished(_ context.Context, sequences ...int64) error {
+	for _, seq := range sequences {
+		o.published[seq] = true
+	}
+	return nil
+}
+
+func (o *memoryOutbox) MarkFailed(_ context.Context, sequence int64, _ error) error {
+	o.failures[sequence]++
+	for _, msg := range o.messages {
+		if msg.Sequence == sequence {
+			msg.Attempts++
+		}
+	}
+	return nil
+}
+
+func TestRelay(t *testing.T) {
+	ctx := context.Background()
+	store := newMemoryOutbox(event.ParameterCreated, event.ParameterUpdated, event.ParameterDeactivated)
+	pub := publisher.NewMemory()
+	pub.Fail = func(msg *event.Message) error {
+		if msg.Sequence == 2 && msg.Attempts == 0 {
+			return errors.New("broker down")
+		}
+		return nil
+	}
+	relay := outbox.NewRelay(store, pub, outbox.RelayConfig{BatchSize: 10})
+
+	n, err := relay.RelayOnce(ctx)
+	assert.EqualError(t, err, "broker down")
+	assert.Equal(t, 1, n, "publishing stops at the failure to keep order")
+	assert.Equal(t, 1, store.failures[2])
+
+	n, err = relay.RelayOnce(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+
+	var types []event.Type
+	for _, msg := range pub.Messages() {
+		types = append(types, msg.Type)
+	}
+	assert.Equal(t, []event.Type{event.ParameterCreated, event.ParameterUpdated, event.ParameterDeactivated}, types)
+
+	n, err = relay.RelayOnce(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, n, "drained")
+}
+
+func TestRelay_RunStopsWithContext(t *testing.T) {
+	store := newMemoryOutbox(event.UOMCreated)
+	pub := publisher.NewMemory()
+	relay := outbox.NewRelay(store, pub, outbox.RelayConfig{Interval: 10 * time.Millisecond})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- relay.Run(ctx) }()
+
+	require.Eventually(t, func() bool { return len(pub.Messages()) == 1 }, time.Second, 5*time.Millisecond)
+	cancel()
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("relay did not stop")
+	}
+}
+
+func TestWebhookPublisher(t *testing.T) {
+	received := make(chan event.Message, 2)
+	var status atomic.Int32
+	status.Store(http.StatusNoContent)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.Equal(t, "parameter.updated", r.Header.Get("X-Event-Type"))
+		var msg event.Message
+		assert.NoError(t, json.Unmarshal(body, &msg))
+		received <- msg
+		w.WriteHeader(int(status.Load()))
+	}))
+	defer server.Close()
+
+	webhook := publisher.NewWebhook(server.URL, time.Second)
+	msg := newMemoryOutbox(event.ParameterUpdated).messages[0]
+	msg.EventID = "5f0c6f1e-0000-4000-8000-000000000001"
+
+	require.NoError(t, webhook.Publish(context.Background(), msg))
+	got := <-received
+	assert.Equal(t, msg.EventID, got.EventID)
+	assert.Equal(t, event.ParameterUpdated, got.Type)
+	assert.JSONEq(t, `{"parameter_code":"TPI"}`, string(got.Payload))
+
+	status.Store(http.StatusServiceUnavailable)
+	assert.Error(t, webhook.Publish(context.Background(), msg))
+}
+
+// natsPublish is a message received by fakeNATSServer.
+type natsPublish struct {
+	subject string
+	header  string
+	body    []byte
+}
+
+// fakeNATSServer accepts a single client and speaks just enough of the NATS
+// protocol to receive its publishes. Closing conns drops the client.
+func fakeNATSServer(t *testing.T) (url string, published <-chan natsPublish, conns chan net.Conn) {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	received := make(chan natsPublish, 10)
+	conns = make(chan net.Conn, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		listener.Close()
+		conns <- conn
+		fmt.Fprint(conn, `INFO {"server_id":"fake","version":"2.10.0","proto":1,"headers":true,"max_payload":1048576}`+"\r\n")
+		r := bufio.NewReader(conn)
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+			fields := strings.Fields(line)
+			if len(fields) == 0 {
+				continue
+			}
+			switch fields[0] {
+			case "PING":
+				fmt.Fprint(conn, "PONG\r\n")
+			case "HPUB":
+				var headerLen, totalLen int
+				fmt.Sscan(fields[len(fields)-2], &headerLen)
+				fmt.Sscan(fields[len(fields)-1], &totalLen)
+				payload := make([]byte, totalLen+2)
+				if _, err := io.ReadFull(r, payload); err != nil {
+					return
+				}
+				received <- natsPublish{subject: fields[1], header: string(payload[:headerLen]), body: payload[headerLen:totalLen]}
+			}
+		}
+	}()
+	return "nats://" + listener.Addr().String(), received, conns
+}
+
+func TestNATSPublisher(t *testing.T) {
+	url, published, conns := fakeNATSServer(t)
+	nats, err := publisher.NewNATS(url, "costing.", time.Second)
+	require.NoError(t, err)
+	defer nats.Close()
+
+	msg := newMemoryOutbox(event.ParameterUpdated).messages[0]
+	msg.EventID = "5f0c6f1e-0000-4000-8000-000000000001"
+	require.Eventually(t, func() bool {
+		return nats.Publish(context.Background(), msg) == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	got := <-published
+	assert.Equal(t, "costing.parameter.updated", got.subject)
+	assert.Contains(t, got.header, "Nats-Msg-Id: "+msg.EventID)
+	var body event.Message
+	require.NoError(t, json.Unmarshal(got.body, &body))
+	assert.Equal(t, msg.EventID, body.EventID)
+	assert.JSONEq(t, `{"parameter_code":"TPI"}`, string(body.Payload))
+
+	// Publishing while disconnected fails instead of buffering
+	(<-conns).Close()
+	assert.Eventually(t, func() bool {
+		return nats.Publish(context.Background(), msg) != nil
+	}, 5*time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,134 @@
+package integration_test
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/homindolenern/goapps-costing-v1/gen/go/costing/v1"
+	appparam "github.com/homindolenern/goapps-costing-v1/internal/application/parameter"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/parameter"
+	"github.com/homindolenern/goapps-costing-v1/pkg/pagetoken"
+)
+
+func TestPageTokenCodec(t *testing.T) {
+	codec := pagetoken.NewCodec([]byte("secret"))
+	filter := appparam.Filter{Search: "twist"}
+	value := "Twist"
+	cursor := pagetoken.Cursor{SortValue: &value, Key: "TPI", Page: 3}
+
+	token, err := codec.Encode(cursor, filter)
+	require.NoError(t, err)
+	decoded, err := codec.Decode(token, filter)
+	require.NoError(t, err)
+	assert.Equal(t, cursor, decoded)
+
+	_, err = codec.Decode(token, appparam.Filter{Search: "twist", SortDesc: true})
+	assert.ErrorIs(t, err, pagetoken.ErrFilterMismatch)
+
+	_, err = pagetoken.NewCodec([]byte("other")).Decode(token, filter)
+	assert.ErrorIs(t, err, pagetoken.ErrInvalid)
+
+	body, sig, _ := strings.Cut(token, ".")
+	tampered := []byte(body)
+	tampered[len(tampered)/2] ^= 1
+	_, err = codec.Decode(string(tampered)+"."+sig, filter)
+	assert.ErrorIs(t, err, pagetoken.ErrInvalid)
+
+	_, err = codec.Decode("not-a-token", filter)
+	assert.ErrorIs(t, err, pagetoken.ErrInvalid)
+}
+
+// keysetParameterRepo lists Parameters in code order by offset or keyset.
+type keysetParameterRepo struct {
+	parameter.Repository
+	rows map[parameter.Code]*parameter.Parameter
+}
+
+func (r *keysetParameterRepo) add(t *testing.T, codes ...string) {
+	t.Helper()
+	for _, code := range codes {
+		param, err := parameter.NewParameter(parameter.Code(code), code, parameter.CategoryMachine, parameter.DataTypeText, "alice")
+		require.NoError(t, err)
+		r.rows[param.Code()] = param
+	}
+}
+
+func (r *keysetParameterRepo) List(_ context.Context, filter parameter.ListFilter) ([]*parameter.Parameter, int64, error) {
+	codes := make([]string, 0, len(r.rows))
+	for code := range r.rows {
+		codes = append(codes, code.String())
+	}
+	sort.Strings(codes)
+
+	start := filter.Offset()
+	if filter.After != nil {
+		start = sort.SearchStrings(codes, filter.After.Code.String()+"\x00")
+	}
+	var page []*parameter.Parameter
+	for i := start; i < len(codes) && len(page) < filter.Limit(); i++ {
+		page = append(page, r.rows[parameter.Code(codes[i])])
+	}
+	return page, int64(len(codes)), nil
+}
+
+func TestListParameters_PageTokens(t *testing.T) {
+	ctx := context.Background()
+	repo := &keysetParameterRepo{rows: map[parameter.Code]*parameter.Parameter{}}
+	repo.add(t, "A1", "A2", "A3", "A4", "A5")
+	handler := newParameterListHandler(t, repo)
+
+	var seen []string
+	req := &pb.ListParametersRequest{Page: 1, PageSize: 2}
+	for pages := 1; ; pages++ {
+		resp, err := handler.ListParameters(ctx, req)
+		require.NoError(t, err)
+		require.Equal(t, "200", resp.Base.StatusCode, resp.Base.Message)
+		assert.EqualValues(t, pages, resp.Pagination.CurrentPage)
+		for _, p := range resp.Data {
+			seen = append(seen, p.ParameterCode)
+		}
+		if pages == 1 {
+			// A row added before the cursor neither repeats nor hides a row
+			repo.add(t, "A0")
+		}
+		if resp.NextPageToken == "" {
+			break
+		}
+		req.PageToken = resp.NextPageToken
+	}
+	assert.Equal(t, []string{"A1", "A2", "A3", "A4", "A5"}, seen)
+
+	t.Run("last full page has no token", func(t *testing.T) {
+		resp, err := handler.ListParameters(ctx, &pb.ListParametersRequest{Page: 2, PageSize: 3})
+		require.NoError(t, err)
+		assert.Len(t, resp.Data, 3)
+		assert.Empty(t, resp.NextPageToken)
+	})
+
+	t.Run("token is bound to the filters", func(t *testing.T) {
+		first, err := handler.ListParameters(ctx, &pb.ListParametersRequest{Page: 1, PageSize: 2})
+		require.NoError(t, err)
+		require.NotEmpty(t, first.NextPageToken)
+
+		resp, err := handler.ListParameters(ctx, &pb.ListParametersRequest{
+			Page: 1, PageSize: 2, Search: "A", PageToken: first.NextPageToken,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "400", resp.Base.StatusCode)
+		assert.Contains(t, resp.Base.Message, parameter.ErrInvalidPageToken.Error())
+	})
+}
+
+func TestSortFieldValue(t *testing.T) {
+	param, err := parameter.NewParameter("TPI", "Twists Per Inch", parameter.CategoryProcess, parameter.DataTypeNumeric, "alice")
+	require.NoError(t, err)
+
+	assert.Equal(t, "Twists Per Inch", *parameter.SortByName.Value(param))
+	assert.Equal(t, "TPI", *parameter.SortByCode.Value(param))
+	assert.Nil(t, parameter.SortByUpdatedAt.Value(param), "never updated sorts as NULL")
+}
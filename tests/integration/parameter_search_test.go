@@ -0,0 +1,130 @@
+package integration_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"buf.build/go/protovalidate"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/homindolenern/goapps-costing-v1/gen/go/costing/v1"
+	appparam "github.com/homindolenern/goapps-costing-v1/internal/application/parameter"
+	grpcdelivery "github.com/homindolenern/goapps-costing-v1/internal/delivery/grpc"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/parameter"
+	"github.com/homindolenern/goapps-costing-v1/internal/infrastructure/cache"
+	"github.com/homindolenern/goapps-costing-v1/pkg/pagetoken"
+)
+
+// filterRecordingRepo records the filters it is listed with and returns no rows.
+type filterRecordingRepo struct {
+	parameter.Repository
+	filters []parameter.ListFilter
+}
+
+func (r *filterRecordingRepo) List(_ context.Context, filter parameter.ListFilter) ([]*parameter.Parameter, int64, error) {
+	r.filters = append(r.filters, filter)
+	return nil, 0, nil
+}
+
+func newParameterListHandler(t *testing.T, repo parameter.Repository) *grpcdelivery.ParameterHandler {
+	t.Helper()
+	validator, err := protovalidate.New()
+	require.NoError(t, err)
+	return grpcdelivery.NewParameterHandler(
+		nil, nil, nil, nil,
+		appparam.NewListHandler(repo, pagetoken.NewCodec([]byte("test-secret"))),
+		nil, nil, nil,
+		grpcdelivery.NewValidationHelper(validator),
+	)
+}
+
+func TestListParameters_SearchFilterSort(t *testing.T) {
+	ctx := context.Background()
+	str := func(s string) *string { return &s }
+
+	t.Run("filters reach the repository", func(t *testing.T) {
+		repo := &filterRecordingRepo{}
+		numeric := pb.ParameterDataType_PARAMETER_DATA_TYPE_NUMERIC
+		mandatory := true
+		resp, err := newParameterListHandler(t, repo).ListParameters(ctx, &pb.ListParametersRequest{
+			Page:          1,
+			PageSize:      20,
+			Search:        "  twist ",
+			DataType:      &numeric,
+			Uom:           str("TPI"),
+			IsMandatory:   &mandatory,
+			CreatedBy:     str("alice"),
+			CreatedFrom:   str("2026-01-01T00:00:00Z"),
+			CreatedTo:     str("2026-02-01T00:00:00Z"),
+			SortBy:        pb.ParameterSortField_PARAMETER_SORT_FIELD_UPDATED_AT,
+			SortDirection: pb.SortDirection_SORT_DIRECTION_DESC,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "200", resp.Base.StatusCode)
+
+		require.Len(t, repo.filters, 1)
+		filter := repo.filters[0]
+		assert.Equal(t, "twist", filter.Search)
+		assert.Equal(t, parameter.DataTypeNumeric, *filter.DataType)
+		assert.Equal(t, "TPI", *filter.UOM)
+		assert.True(t, *filter.IsMandatory)
+		assert.Equal(t, "alice", *filter.CreatedBy)
+		assert.Equal(t, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), filter.CreatedFrom.UTC())
+		assert.Nil(t, filter.UpdatedFrom)
+		assert.Equal(t, parameter.SortByUpdatedAt, filter.SortBy)
+		assert.True(t, filter.SortDesc)
+	})
+
+	t.Run("default sort is by code", func(t *testing.T) {
+		repo := &filterRecordingRepo{}
+		_, err := newParameterListHandler(t, repo).ListParameters(ctx, &pb.ListParametersRequest{Page: 1, PageSize: 10})
+		require.NoError(t, err)
+		require.Len(t, repo.filters, 1)
+		assert.Equal(t, parameter.SortByCode, repo.filters[0].SortBy)
+		assert.False(t, repo.filters[0].SortDesc)
+	})
+
+	t.Run("invalid time range is rejected", func(t *testing.T) {
+		repo := &filterRecordingRepo{}
+		handler := newParameterListHandler(t, repo)
+		for _, req := range []*pb.ListParametersRequest{
+			{Page: 1, PageSize: 10, UpdatedFrom: str("yesterday")},
+			{Page: 1, PageSize: 10, CreatedFrom: str("2026-02-01T00:00:00Z"), CreatedTo: str("2026-01-01T00:00:00Z")},
+		} {
+			resp, err := handler.ListParameters(ctx, req)
+			require.NoError(t, err)
+			assert.Equal(t, "400", resp.Base.StatusCode)
+			assert.Equal(t, parameter.ErrInvalidTimeRange.Error(), resp.Base.Message)
+		}
+		assert.Empty(t, repo.filters)
+	})
+
+	t.Run("undefined sort field is rejected", func(t *testing.T) {
+		resp, err := newParameterListHandler(t, &filterRecordingRepo{}).ListParameters(ctx, &pb.ListParametersRequest{
+			Page: 1, PageSize: 10, SortBy: pb.ParameterSortField(99),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "400", resp.Base.StatusCode)
+	})
+}
+
+func TestCachedParameterRepository_SearchBypassesCache(t *testing.T) {
+	ctx := context.Background()
+	inner := &filterRecordingRepo{}
+	repo := cache.NewParameterRepository(inner, newMemoryCache(), time.Minute)
+
+	for i := 0; i < 2; i++ {
+		_, _, err := repo.List(ctx, parameter.ListFilter{Page: 1, PageSize: 10, SortBy: parameter.SortByName})
+		require.NoError(t, err)
+		_, _, err = repo.List(ctx, parameter.ListFilter{Page: 1, PageSize: 10, Search: "twist"})
+		require.NoError(t, err)
+	}
+	// The plain list is read once; the search reads through every time
+	assert.Len(t, inner.filters, 3)
+
+	_, _, err := repo.List(ctx, parameter.ListFilter{Page: 1, PageSize: 10, SortBy: parameter.SortByName, SortDesc: true})
+	require.NoError(t, err)
+	assert.Len(t, inner.filters, 4, "the sort direction is part of the cache key")
+}
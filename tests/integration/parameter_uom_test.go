@@ -0,0 +1,92 @@
+package integration_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	appaudit "github.com/homindolenern/goapps-costing-v1/internal/application/audit"
+	appparam "github.com/homindolenern/goapps-costing-v1/internal/application/parameter"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/parameter"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/uom"
+	pkgerrors "github.com/homindolenern/goapps-costing-v1/pkg/errors"
+)
+
+// createOnlyParameterRepo accepts every new Parameter.
+type createOnlyParameterRepo struct {
+	parameter.Repository
+	created []*parameter.Parameter
+}
+
+func (r *createOnlyParameterRepo) ExistsByCode(_ context.Context, _ parameter.Code) (bool, error) {
+	return false, nil
+}
+
+func (r *createOnlyParameterRepo) Create(_ context.Context, entity *parameter.Parameter) error {
+	r.created = append(r.created, entity)
+	return nil
+}
+
+func strPtr(s string) *string { return &s }
+
+func TestCreateParameter_UOMReference(t *testing.T) {
+	ctx := context.Background()
+	rpm, err := uom.NewUOM(uom.Code("RPM"), "Revolutions Per Minute", uom.CategoryQuantity, "admin")
+	require.NoError(t, err)
+	old, err := uom.NewUOM(uom.Code("OLD"), "Retired Unit", uom.CategoryQuantity, "admin")
+	require.NoError(t, err)
+	old.SoftDelete("admin")
+
+	uomRepo := &softDeleteUOMRepo{uoms: map[uom.Code]*uom.UOM{rpm.Code(): rpm, old.Code(): old}}
+	paramRepo := &createOnlyParameterRepo{}
+	handler := appparam.NewCreateHandler(paramRepo, uomRepo, appaudit.NewRecorder(&memoryAuditRepo{}, inlineTx{}))
+
+	cmd := appparam.CreateCommand{
+		ParameterCode: "SPINDLE_SPEED",
+		ParameterName: "Spindle Speed",
+		Category:      "MACHINE",
+		DataType:      "NUMERIC",
+		CreatedBy:     "alice",
+	}
+
+	tests := []struct {
+		name     string
+		dataType string
+		uom      *string
+		wantErr  error
+	}{
+		{"numeric with live uom", "NUMERIC", strPtr("RPM"), nil},
+		{"numeric without uom", "NUMERIC", nil, nil},
+		{"numeric with unknown uom", "NUMERIC", strPtr("FURLONG"), parameter.ErrUOMNotFound},
+		{"numeric with deleted uom", "NUMERIC", strPtr("OLD"), parameter.ErrUOMNotFound},
+		{"numeric with malformed uom", "NUMERIC", strPtr("rpm!"), uom.ErrInvalidUOMCode},
+		{"text with uom", "TEXT", strPtr("RPM"), parameter.ErrUOMNotAllowed},
+		{"boolean with uom", "BOOLEAN", strPtr("RPM"), parameter.ErrUOMNotAllowed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd.DataType = tt.dataType
+			cmd.UOM = tt.uom
+
+			_, err := handler.Handle(ctx, cmd)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.ErrorIs(t, err, tt.wantErr)
+			require.True(t, pkgerrors.IsValidation(err))
+
+			var appErr *pkgerrors.AppError
+			require.ErrorAs(t, err, &appErr)
+			require.Len(t, appErr.Validation.Errors, 1)
+			assert.Equal(t, "uom", appErr.Validation.Errors[0].Field)
+			assert.Equal(t, tt.wantErr.Error(), appErr.Validation.Errors[0].Message)
+		})
+	}
+
+	assert.Len(t, paramRepo.created, 2)
+}
@@ -0,0 +1,134 @@
+package integration_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/parameter"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/parametervalue"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestParameter(t *testing.T, code string, dataType parameter.DataType) *parameter.Parameter {
+	t.Helper()
+	c, err := parameter.NewParameterCode(code)
+	require.NoError(t, err)
+	entity, err := parameter.NewParameter(c, code, parameter.CategoryMachine, dataType, "admin")
+	require.NoError(t, err)
+	return entity
+}
+
+func newTestPeriod(t *testing.T, from string, to string) parametervalue.Period {
+	t.Helper()
+	start, err := parametervalue.NewDate(from)
+	require.NoError(t, err)
+	var end *time.Time
+	if to != "" {
+		e, err := parametervalue.NewDate(to)
+		require.NoError(t, err)
+		end = &e
+	}
+	period, err := parametervalue.NewPeriod(start, end)
+	require.NoError(t, err)
+	return period
+}
+
+func TestParameterValueDomain_ParseValue(t *testing.T) {
+	numeric := newTestParameter(t, "SPEED", parameter.DataTypeNumeric)
+	minVal, maxVal := 10.0, 100.0
+	require.NoError(t, numeric.SetNumericConstraints(&minVal, &maxVal))
+
+	dropdown := newTestParameter(t, "YARN_TYPE", parameter.DataTypeDropdown)
+	require.NoError(t, dropdown.SetAllowedValues([]string{"COTTON", "POLY"}))
+
+	boolean := newTestParameter(t, "HAS_HEATER", parameter.DataTypeBoolean)
+	text := newTestParameter(t, "NOTES", parameter.DataTypeText)
+
+	testCases := []struct {
+		name       string
+		definition *parameter.Parameter
+		raw        string
+		want       string
+		wantErr    error
+	}{
+		{"numeric normalized", numeric, " 12.50 ", "12.5", nil},
+		{"numeric not a number", numeric, "fast", "", parametervalue.ErrValueNotNumeric},
+		{"numeric below min", numeric, "9.99", "", parametervalue.ErrValueBelowMin},
+		{"numeric above max", numeric, "100.01", "", parametervalue.ErrValueAboveMax},
+		{"dropdown allowed", dropdown, "COTTON", "COTTON", nil},
+		{"dropdown not allowed", dropdown, "SILK", "", parametervalue.ErrValueNotAllowed},
+		{"boolean normalized", boolean, "TRUE", "true", nil},
+		{"boolean invalid", boolean, "yes", "", parametervalue.ErrValueNotBoolean},
+		{"text", text, "any text", "any text", nil},
+		{"empty", text, "  ", "", parametervalue.ErrEmptyValue},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, _, err := parametervalue.ParseValue(tc.definition, tc.raw)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+
+	text.Deactivate()
+	_, _, err := parametervalue.ParseValue(text, "any text")
+	assert.ErrorIs(t, err, parametervalue.ErrParameterInactive)
+}
+
+func TestParameterValueDomain_InvalidPeriod(t *testing.T) {
+	from, err := parametervalue.NewDate("2026-03-01")
+	require.NoError(t, err)
+
+	_, err = parametervalue.NewPeriod(from, &from)
+	assert.ErrorIs(t, err, parametervalue.ErrInvalidPeriod)
+
+	_, err = parametervalue.NewDate("01/03/2026")
+	assert.ErrorIs(t, err, parametervalue.ErrInvalidDate)
+}
+
+func TestParameterValueDomain_Schedule(t *testing.T) {
+	definition := newTestParameter(t, "SPEED", parameter.DataTypeNumeric)
+
+	newValue := func(id int64, raw, from, to string) *parametervalue.ParameterValue {
+		v, err := parametervalue.NewParameterValue(
+			definition, parametervalue.SubjectTypeMachine, "MC-01", raw, newTestPeriod(t, from, to), "admin",
+		)
+		require.NoError(t, err)
+		v.AssignID(id)
+		return v
+	}
+
+	current := newValue(1, "100", "2026-01-01", "")
+
+	// An open-ended value starting later supersedes the current one
+	next := newValue(0, "120", "2026-03-01", "")
+	superseded, err := parametervalue.Schedule(next, []*parametervalue.ParameterValue{current}, "admin")
+	require.NoError(t, err)
+	require.Same(t, current, superseded)
+	require.NotNil(t, current.Period().To())
+	assert.Equal(t, "2026-03-01", current.Period().To().Format(parametervalue.DateLayout))
+	assert.True(t, current.Period().Contains(time.Date(2026, 2, 28, 15, 0, 0, 0, time.UTC)))
+	assert.False(t, current.Period().Contains(time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)))
+
+	// Same start date is a duplicate
+	dup := newValue(0, "130", "2026-01-01", "")
+	_, err = parametervalue.Schedule(dup, []*parametervalue.ParameterValue{current}, "admin")
+	assert.ErrorIs(t, err, parametervalue.ErrAlreadyExists)
+
+	// A bounded value inside a closed period overlaps
+	inside := newValue(0, "110", "2026-02-01", "2026-02-15")
+	_, err = parametervalue.Schedule(inside, []*parametervalue.ParameterValue{current}, "admin")
+	assert.ErrorIs(t, err, parametervalue.ErrPeriodOverlap)
+
+	// A value before the first one fits when it ends in time
+	before := newValue(0, "90", "2025-06-01", "2026-01-01")
+	superseded, err = parametervalue.Schedule(before, []*parametervalue.ParameterValue{current}, "admin")
+	require.NoError(t, err)
+	assert.Nil(t, superseded)
+}
@@ -0,0 +1,168 @@
+package integration_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	appaudit "github.com/homindolenern/goapps-costing-v1/internal/application/audit"
+	appcosting "github.com/homindolenern/goapps-costing-v1/internal/application/costing"
+	appparam "github.com/homindolenern/goapps-costing-v1/internal/application/parameter"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/costing"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/parameter"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/parametervalue"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/uom"
+)
+
+// historyParameterRepo records a version on every update, closing the one
+// in force like the Postgres repository.
+type historyParameterRepo struct {
+	versionedParameterRepo
+	now     time.Time
+	history []*parameter.Version
+}
+
+func newHistoryParameterRepo(entity *parameter.Parameter, at time.Time) *historyParameterRepo {
+	return &historyParameterRepo{
+		versionedParameterRepo: versionedParameterRepo{rows: map[parameter.Code]*parameter.Parameter{entity.Code(): entity}},
+		now:                    at,
+		history:                []*parameter.Version{{Parameter: copyParameter(entity), EffectiveFrom: at, RecordedBy: entity.CreatedBy()}},
+	}
+}
+
+func (r *historyParameterRepo) Update(ctx context.Context, entity *parameter.Parameter) error {
+	if err := r.versionedParameterRepo.Update(ctx, entity); err != nil {
+		return err
+	}
+	for _, v := range r.history {
+		if v.Parameter.Code() == entity.Code() && v.EffectiveTo == nil {
+			closedAt := r.now
+			v.EffectiveTo = &closedAt
+		}
+	}
+	r.history = append(r.history, &parameter.Version{
+		Parameter:     copyParameter(entity),
+		EffectiveFrom: r.now,
+		RecordedBy:    *entity.UpdatedBy(),
+	})
+	return nil
+}
+
+func (r *historyParameterRepo) GetVersionAsOf(_ context.Context, code parameter.Code, asOf time.Time) (*parameter.Version, error) {
+	for _, v := range r.history {
+		if v.Parameter.Code() == code && !v.EffectiveFrom.After(asOf) && (v.EffectiveTo == nil || v.EffectiveTo.After(asOf)) {
+			return v, nil
+		}
+	}
+	return nil, parameter.ErrNotFound
+}
+
+func (r *historyParameterRepo) ListVersions(_ context.Context, filter parameter.VersionFilter) ([]*parameter.Version, int64, error) {
+	var versions []*parameter.Version
+	for i := len(r.history) - 1; i >= 0; i-- {
+		if r.history[i].Parameter.Code() == filter.Code {
+			versions = append(versions, r.history[i])
+		}
+	}
+	return versions, int64(len(versions)), nil
+}
+
+func TestParameterVersions(t *testing.T) {
+	ctx := context.Background()
+	created := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	changed := created.Add(30 * 24 * time.Hour)
+
+	entity, err := parameter.NewParameter(
+		parameter.Code(costing.ParamProductionRate), "Production Rate", parameter.CategoryMachine, parameter.DataTypeNumeric, "admin")
+	require.NoError(t, err)
+	maxRate := 60.0
+	require.NoError(t, entity.SetNumericConstraints(nil, &maxRate))
+	repo := newHistoryParameterRepo(entity, created)
+
+	// Lower the maximum a month later
+	repo.now = changed
+	lowered := 40.0
+	_, err = appparam.NewUpdateHandler(repo, &softDeleteUOMRepo{}, appaudit.NewRecorder(&memoryAuditRepo{}, inlineTx{})).Handle(ctx, appparam.UpdateCommand{
+		ParameterCode: costing.ParamProductionRate,
+		ParameterName: "Production Rate",
+		Category:      "MACHINE",
+		DataType:      "NUMERIC",
+		MaxValue:      &lowered,
+		IsActive:      true,
+		Version:       1,
+		UpdatedBy:     "alice",
+	})
+	require.NoError(t, err)
+
+	t.Run("the update closes the previous version", func(t *testing.T) {
+		result, err := appparam.NewListVersionsHandler(repo).Handle(ctx, appparam.ListVersionsQuery{
+			ParameterCode: costing.ParamProductionRate,
+		})
+		require.NoError(t, err)
+		require.EqualValues(t, 2, result.Total)
+
+		current, previous := result.Versions[0], result.Versions[1]
+		assert.Equal(t, 2, current.Parameter.Version())
+		assert.Nil(t, current.EffectiveTo)
+		assert.Equal(t, "alice", current.RecordedBy)
+		assert.Equal(t, 1, previous.Parameter.Version())
+		require.NotNil(t, previous.EffectiveTo)
+		assert.Equal(t, changed, *previous.EffectiveTo)
+		assert.Equal(t, 60.0, *previous.Parameter.MaxValue())
+
+		_, err = appparam.NewListVersionsHandler(repo).Handle(ctx, appparam.ListVersionsQuery{ParameterCode: "UNKNOWN"})
+		assert.ErrorIs(t, err, parameter.ErrNotFound)
+	})
+
+	t.Run("get as of a time", func(t *testing.T) {
+		get := appparam.NewGetHandler(repo)
+		cases := map[string]struct {
+			asOf    *string
+			wantMax float64
+			wantErr error
+		}{
+			"current":           {nil, 40, nil},
+			"before the change": {strPtr("2026-01-15T00:00:00Z"), 60, nil},
+			"at the change":     {strPtr("2026-01-31T00:00:00Z"), 40, nil},
+			"before creation":   {strPtr("2025-12-31T00:00:00Z"), 0, parameter.ErrNotFound},
+			"not a timestamp":   {strPtr("2026-01-15"), 0, parameter.ErrInvalidAsOf},
+		}
+		for name, tc := range cases {
+			t.Run(name, func(t *testing.T) {
+				got, err := get.Handle(ctx, appparam.GetQuery{ParameterCode: costing.ParamProductionRate, AsOf: tc.asOf})
+				if tc.wantErr != nil {
+					assert.ErrorIs(t, err, tc.wantErr)
+					return
+				}
+				require.NoError(t, err)
+				assert.Equal(t, tc.wantMax, *got.MaxValue())
+			})
+		}
+	})
+
+	t.Run("costing validates against the definition in force", func(t *testing.T) {
+		kg := newTestUOM(t, "KG", "WEIGHT", 1)
+		calculate := appcosting.NewCalculateHandler(&softDeleteUOMRepo{uoms: map[uom.Code]*uom.UOM{kg.Code(): kg}}, repo)
+		query := appcosting.CalculateQuery{
+			ProductCode:    "YARN-30S",
+			OutputQuantity: 100,
+			OutputUOMCode:  "KG",
+			Steps: []appcosting.StepInput{{
+				StepCode:      "CARDING",
+				OutputUOMCode: "KG",
+				Parameters:    []appcosting.StepParameterInput{{ParameterCode: costing.ParamProductionRate, Value: 50}},
+			}},
+		}
+
+		_, err := calculate.Handle(ctx, query)
+		assert.ErrorIs(t, err, parametervalue.ErrValueAboveMax)
+
+		query.AsOf = strPtr("2026-01-15T00:00:00Z")
+		breakdown, err := calculate.Handle(ctx, query)
+		require.NoError(t, err)
+		assert.InDelta(t, 2.0, breakdown.Steps[0].Hours, 1e-9)
+	})
+}
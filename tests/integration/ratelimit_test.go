@@ -0,0 +1,146 @@
+package integration_test
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	httpdelivery "github.com/homindolenern/goapps-costing-v1/internal/delivery/http"
+	"github.com/homindolenern/goapps-costing-v1/pkg/auth"
+	"github.com/homindolenern/goapps-costing-v1/pkg/ratelimit"
+)
+
+// headerStream captures headers set by interceptors.
+type headerStream struct {
+	grpc.ServerTransportStream
+	header metadata.MD
+}
+
+func (s *headerStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func TestRateLimiter_Take(t *testing.T) {
+	limiter := ratelimit.NewRateLimiter(10, 10)
+	limit := ratelimit.Limit{Rate: 0.5, Burst: 2}
+
+	for i := 0; i < 2; i++ {
+		decision, err := limiter.Take(context.Background(), "user:a", limit)
+		require.NoError(t, err)
+		assert.True(t, decision.Allowed)
+	}
+
+	decision, err := limiter.Take(context.Background(), "user:a", limit)
+	require.NoError(t, err)
+	assert.False(t, decision.Allowed)
+	assert.InDelta(t, 2.0, decision.RetryAfter.Seconds(), 0.1)
+
+	decision, err = limiter.Take(context.Background(), "user:b", limit)
+	require.NoError(t, err)
+	assert.True(t, decision.Allowed, "buckets are per key")
+}
+
+func TestRateLimitInterceptor(t *testing.T) {
+	policy := ratelimit.Policy{
+		Default: ratelimit.Limit{Rate: 0.01, Burst: 2},
+		Methods: map[string]ratelimit.Limit{
+			"/costing.v1.CostingService/CalculateCost": {Rate: 0.01, Burst: 1},
+		},
+	}
+	interceptor := ratelimit.UnaryInterceptor(ratelimit.NewRateLimiter(1, 1), policy, "/costing.v1.HealthService/")
+	handler := func(context.Context, interface{}) (interface{}, error) { return "ok", nil }
+	call := func(ctx context.Context, method string) (*headerStream, error) {
+		stream := &headerStream{}
+		_, err := interceptor(grpc.NewContextWithServerTransportStream(ctx, stream), nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return stream, err
+	}
+
+	alice := auth.WithPrincipal(context.Background(), &auth.Principal{Subject: "alice"})
+
+	t.Run("method override has its own bucket", func(t *testing.T) {
+		_, err := call(alice, "/costing.v1.CostingService/CalculateCost")
+		require.NoError(t, err)
+		stream, err := call(alice, "/costing.v1.CostingService/CalculateCost")
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		assert.Equal(t, []string{"100"}, stream.header.Get(ratelimit.RetryAfterKey))
+
+		_, err = call(alice, "/costing.v1.UOMService/GetUOM")
+		assert.NoError(t, err)
+	})
+
+	t.Run("default bucket is shared across methods", func(t *testing.T) {
+		_, err := call(alice, "/costing.v1.ParameterService/ListParameters")
+		require.NoError(t, err)
+		_, err = call(alice, "/costing.v1.MaterialService/GetMaterial")
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	})
+
+	t.Run("identities are independent", func(t *testing.T) {
+		bob := auth.WithPrincipal(context.Background(), &auth.Principal{Subject: "bob"})
+		_, err := call(bob, "/costing.v1.UOMService/GetUOM")
+		assert.NoError(t, err)
+	})
+
+	t.Run("health is exempt", func(t *testing.T) {
+		for i := 0; i < 5; i++ {
+			_, err := call(alice, "/costing.v1.HealthService/Liveness")
+			require.NoError(t, err)
+		}
+	})
+}
+
+func TestRateLimitIdentity(t *testing.T) {
+	forwarded := metadata.Pairs("x-forwarded-for", "6.6.6.6, 203.0.113.7, 10.0.0.5")
+	fromPeer := func(addr string) context.Context {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(addr), Port: 50000}})
+		return metadata.NewIncomingContext(ctx, forwarded)
+	}
+
+	gateway := fromPeer("127.0.0.1")
+	assert.Equal(t, "ip:10.0.0.5", ratelimit.Identity(gateway, ratelimit.Policy{}))
+	assert.Equal(t, "ip:203.0.113.7", ratelimit.Identity(gateway, ratelimit.Policy{TrustedProxies: 1}))
+	assert.Equal(t, "ip:6.6.6.6", ratelimit.Identity(gateway, ratelimit.Policy{TrustedProxies: 5}))
+
+	t.Run("a direct client cannot choose its bucket", func(t *testing.T) {
+		spoofed := fromPeer("198.51.100.9")
+		assert.Equal(t, "ip:198.51.100.9", ratelimit.Identity(spoofed, ratelimit.Policy{TrustedProxies: 1}))
+
+		networks, err := ratelimit.ParseNetworks([]string{"198.51.100.0/24"})
+		require.NoError(t, err)
+		assert.Equal(t, "ip:10.0.0.5", ratelimit.Identity(spoofed, ratelimit.Policy{Gateways: networks}))
+
+		_, err = ratelimit.ParseNetworks([]string{"198.51.100.9"})
+		assert.Error(t, err)
+	})
+
+	withPrincipal := auth.WithPrincipal(gateway, &auth.Principal{Subject: "alice"})
+	assert.Equal(t, "user:alice", ratelimit.Identity(withPrincipal, ratelimit.Policy{}))
+}
+
+func TestCustomErrorHandler_RetryAfter(t *testing.T) {
+	mux := httpdelivery.NewServeMux()
+	recorder := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/costing:calculate", nil)
+	ctx := runtime.NewServerMetadataContext(context.Background(), runtime.ServerMetadata{
+		HeaderMD: metadata.Pairs(ratelimit.RetryAfterKey, "3"),
+	})
+
+	_, marshaler := runtime.MarshalerForRequest(mux, req)
+	httpdelivery.CustomErrorHandler(ctx, mux, marshaler, recorder, req,
+		status.Error(codes.ResourceExhausted, "rate limit exceeded, retry after 3s"))
+
+	assert.Equal(t, http.StatusTooManyRequests, recorder.Code)
+	assert.Equal(t, "3", recorder.Header().Get("Retry-After"))
+}
@@ -0,0 +1,136 @@
+package integration_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	appaudit "github.com/homindolenern/goapps-costing-v1/internal/application/audit"
+	appuom "github.com/homindolenern/goapps-costing-v1/internal/application/uom"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/audit"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/uom"
+)
+
+// softDeleteUOMRepo keeps UOMs in memory, hiding soft-deleted ones from GetByCode.
+type softDeleteUOMRepo struct {
+	uom.Repository
+	uoms         map[uom.Code]*uom.UOM
+	activeParams map[uom.Code]bool
+	conversions  []*uom.Conversion
+}
+
+func (r *softDeleteUOMRepo) GetByCode(_ context.Context, code uom.Code) (*uom.UOM, error) {
+	entity, ok := r.uoms[code]
+	if !ok || entity.IsDeleted() {
+		return nil, uom.ErrNotFound
+	}
+	return entity, nil
+}
+
+func (r *softDeleteUOMRepo) GetByCodeIncludingDeleted(_ context.Context, code uom.Code) (*uom.UOM, error) {
+	entity, ok := r.uoms[code]
+	if !ok {
+		return nil, uom.ErrNotFound
+	}
+	return entity, nil
+}
+
+func (r *softDeleteUOMRepo) ListByCategory(_ context.Context, category uom.Category) ([]*uom.UOM, error) {
+	var list []*uom.UOM
+	for _, entity := range r.uoms {
+		if entity.Category() == category {
+			list = append(list, entity)
+		}
+	}
+	return list, nil
+}
+
+func (r *softDeleteUOMRepo) HasActiveParameters(_ context.Context, code uom.Code) (bool, error) {
+	return r.activeParams[code], nil
+}
+
+func (r *softDeleteUOMRepo) ListConversions(_ context.Context, filter uom.ConversionFilter) ([]*uom.Conversion, error) {
+	var list []*uom.Conversion
+	for _, conversion := range r.conversions {
+		if filter.UOMCode == nil || conversion.FromUOM() == *filter.UOMCode || conversion.ToUOM() == *filter.UOMCode {
+			list = append(list, conversion)
+		}
+	}
+	return list, nil
+}
+
+func (r *softDeleteUOMRepo) Update(_ context.Context, entity *uom.UOM) error {
+	entity.IncrementVersion()
+	r.uoms[entity.Code()] = entity
+	return nil
+}
+
+func TestUOMDomain_SoftDeleteRestore(t *testing.T) {
+	kg, err := uom.NewUOM(uom.Code("KG"), "Kilogram", uom.CategoryWeight, "admin")
+	require.NoError(t, err)
+	assert.ErrorIs(t, kg.Restore("admin"), uom.ErrNotDeleted)
+
+	kg.SoftDelete("alice")
+	assert.True(t, kg.IsDeleted())
+	require.NotNil(t, kg.DeletedBy())
+	assert.Equal(t, "alice", *kg.DeletedBy())
+
+	require.NoError(t, kg.Restore("bob"))
+	assert.False(t, kg.IsDeleted())
+	assert.Nil(t, kg.DeletedBy())
+	require.NotNil(t, kg.UpdatedBy())
+	assert.Equal(t, "bob", *kg.UpdatedBy())
+}
+
+func TestEnsureBaseRetained_IgnoresDeletedUnits(t *testing.T) {
+	kg, _ := uom.NewUOM(uom.Code("KG"), "Kilogram", uom.CategoryWeight, "admin")
+	kg.SetAsBaseUOM()
+	ton, _ := uom.NewUOM(uom.Code("TON"), "Ton", uom.CategoryWeight, "admin")
+
+	assert.ErrorIs(t, uom.EnsureBaseRetained(kg, []*uom.UOM{kg, ton}), uom.ErrBaseUOMRequired)
+
+	ton.SoftDelete("admin")
+	assert.NoError(t, uom.EnsureBaseRetained(kg, []*uom.UOM{kg, ton}))
+}
+
+func TestDeleteUOM_InUseGuard(t *testing.T) {
+	ctx := context.Background()
+	mtr, err := uom.NewUOM(uom.Code("MTR"), "Meter", uom.CategoryLength, "admin")
+	require.NoError(t, err)
+
+	repo := &softDeleteUOMRepo{
+		uoms:         map[uom.Code]*uom.UOM{mtr.Code(): mtr},
+		activeParams: map[uom.Code]bool{mtr.Code(): true},
+	}
+	auditRepo := &memoryAuditRepo{}
+	recorder := appaudit.NewRecorder(auditRepo, inlineTx{})
+	deleteHandler := appuom.NewDeleteHandler(repo, recorder)
+
+	// Refused while active parameters use it
+	err = deleteHandler.Handle(ctx, appuom.DeleteCommand{UOMCode: "MTR", DeletedBy: "alice"})
+	assert.ErrorIs(t, err, uom.ErrInUse)
+	assert.False(t, mtr.IsDeleted())
+
+	// Forced delete keeps the row but hides it
+	err = deleteHandler.Handle(ctx, appuom.DeleteCommand{UOMCode: "MTR", Force: true, DeletedBy: "alice"})
+	require.NoError(t, err)
+	_, err = repo.GetByCode(ctx, uom.Code("MTR"))
+	assert.ErrorIs(t, err, uom.ErrNotFound)
+	require.Len(t, auditRepo.events, 1)
+	assert.Equal(t, audit.ActionDelete, auditRepo.events[0].Action())
+
+	// Deleting again finds nothing
+	err = deleteHandler.Handle(ctx, appuom.DeleteCommand{UOMCode: "MTR", Force: true, DeletedBy: "alice"})
+	assert.ErrorIs(t, err, uom.ErrNotFound)
+
+	// Restore brings it back
+	restored, err := appuom.NewRestoreHandler(repo, recorder).Handle(ctx, appuom.RestoreCommand{UOMCode: "MTR", RestoredBy: "bob"})
+	require.NoError(t, err)
+	assert.False(t, restored.IsDeleted())
+	assert.Equal(t, 3, restored.Version())
+
+	_, err = repo.GetByCode(ctx, uom.Code("MTR"))
+	assert.NoError(t, err)
+}
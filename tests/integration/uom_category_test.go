@@ -0,0 +1,66 @@
+package integration_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	appaudit "github.com/homindolenern/goapps-costing-v1/internal/application/audit"
+	appuom "github.com/homindolenern/goapps-costing-v1/internal/application/uom"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/uom"
+)
+
+func TestUpdateUOM_CategoryChange(t *testing.T) {
+	ctx := context.Background()
+	kg := newTestUOM(t, "KG", "WEIGHT", 1)
+	bag := newTestUOM(t, "BAG", "WEIGHT", 25)
+	pcs := newTestUOM(t, "PCS", "QUANTITY", 1)
+	m := newTestUOM(t, "M", "LENGTH", 1)
+	perPiece, err := uom.NewConversion(bag.Code(), pcs.Code(), 1, "admin")
+	require.NoError(t, err)
+	repo := &softDeleteUOMRepo{
+		uoms:        map[uom.Code]*uom.UOM{kg.Code(): kg, bag.Code(): bag, pcs.Code(): pcs, m.Code(): m},
+		conversions: []*uom.Conversion{perPiece},
+	}
+	update := appuom.NewUpdateHandler(repo, appaudit.NewRecorder(&memoryAuditRepo{}, inlineTx{}), nil)
+	factor := func(f float64) *float64 { return &f }
+
+	t.Run("a new factor must come with the move", func(t *testing.T) {
+		_, err := update.Handle(ctx, appuom.UpdateCommand{
+			UOMCode: "BAG", UOMName: "Bag", Category: "LENGTH", Version: 1, UpdatedBy: "admin",
+		})
+		assert.ErrorIs(t, err, uom.ErrCategoryChangeFactor)
+		assert.Equal(t, uom.CategoryWeight, repo.uoms["BAG"].Category())
+		assert.Equal(t, 25.0, repo.uoms["BAG"].ConversionFactor())
+	})
+
+	t.Run("explicit conversions must stay across categories", func(t *testing.T) {
+		_, err := update.Handle(ctx, appuom.UpdateCommand{
+			UOMCode: "BAG", UOMName: "Bag", Category: "QUANTITY", ConversionFactor: factor(10), Version: 1, UpdatedBy: "admin",
+		})
+		assert.ErrorIs(t, err, uom.ErrConversionSameCategory)
+		assert.Equal(t, uom.CategoryWeight, repo.uoms["BAG"].Category())
+	})
+
+	t.Run("moving with a factor", func(t *testing.T) {
+		updated, err := update.Handle(ctx, appuom.UpdateCommand{
+			UOMCode: "BAG", UOMName: "Bag", Category: "LENGTH", ConversionFactor: factor(2), Version: 1, UpdatedBy: "admin",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, uom.CategoryLength, updated.Category())
+		assert.Equal(t, 2.0, updated.ConversionFactor())
+	})
+
+	t.Run("batch items follow the same rule", func(t *testing.T) {
+		handler := appuom.NewBatchUpsertHandler(repo, appaudit.NewRecorder(&memoryAuditRepo{}, inlineTx{}), nil)
+		results, err := handler.Handle(ctx, appuom.BatchUpsertCommand{
+			Items:      []appuom.BatchUpsertItem{{UOMCode: "BAG", UOMName: "Bag", Category: "VOLUME"}},
+			Atomic:     true,
+			UpsertedBy: "admin",
+		})
+		require.NoError(t, err)
+		assert.ErrorIs(t, results[0].Err, uom.ErrCategoryChangeFactor)
+	})
+}
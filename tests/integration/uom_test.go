@@ -74,6 +74,145 @@ func TestUOMDomain_Update(t *testing.T) {
 	assert.Equal(t, "updater", *entity.UpdatedBy())
 }
 
+func TestUOMDomain_ConversionFactor(t *testing.T) {
+	code, _ := uom.NewUOMCode("TON")
+	category, _ := uom.NewCategory("WEIGHT")
+	entity, _ := uom.NewUOM(code, "Metric Ton", category, "admin")
+	assert.Equal(t, 1.0, entity.ConversionFactor()) // Default is 1
+
+	require.NoError(t, entity.SetConversionFactor(1000))
+	assert.Equal(t, 1000.0, entity.ConversionFactor())
+
+	assert.ErrorIs(t, entity.SetConversionFactor(0), uom.ErrInvalidConversionFactor)
+	assert.ErrorIs(t, entity.SetConversionFactor(-2), uom.ErrInvalidConversionFactor)
+
+	// Base UOM always has factor 1
+	entity.SetAsBaseUOM()
+	assert.Equal(t, 1.0, entity.ConversionFactor())
+	assert.ErrorIs(t, entity.SetConversionFactor(1000), uom.ErrBaseFactorNotOne)
+}
+
+func newTestUOM(t *testing.T, code, category string, factor float64) *uom.UOM {
+	t.Helper()
+	c, err := uom.NewUOMCode(code)
+	require.NoError(t, err)
+	cat, err := uom.NewCategory(category)
+	require.NoError(t, err)
+	entity, err := uom.NewUOM(c, code, cat, "admin")
+	require.NoError(t, err)
+	if factor == 1 {
+		entity.SetAsBaseUOM()
+	} else {
+		require.NoError(t, entity.SetConversionFactor(factor))
+	}
+	return entity
+}
+
+func TestUOMConverter_Convert(t *testing.T) {
+	units := []*uom.UOM{
+		newTestUOM(t, "KG", "WEIGHT", 1),
+		newTestUOM(t, "TON", "WEIGHT", 1000),
+		newTestUOM(t, "LBS", "WEIGHT", 0.45359237),
+		newTestUOM(t, "PCS", "QUANTITY", 1),
+		newTestUOM(t, "CONE", "QUANTITY", 2), // e.g. sold in pairs
+		newTestUOM(t, "M", "LENGTH", 1),
+	}
+	coneToKG, err := uom.NewConversion("CONE", "KG", 2.5, "admin")
+	require.NoError(t, err)
+	converter := uom.NewConverter(units, []*uom.Conversion{coneToKG})
+
+	testCases := []struct {
+		name     string
+		quantity float64
+		from     uom.Code
+		to       uom.Code
+		want     float64
+	}{
+		{"same unit", 3, "KG", "KG", 3},
+		{"to base", 2, "TON", "KG", 2000},
+		{"from base", 500, "KG", "TON", 0.5},
+		{"between non-base units", 1, "TON", "LBS", 1000 / 0.45359237},
+		{"explicit conversion", 4, "CONE", "KG", 10},
+		{"explicit conversion reversed", 10, "KG", "CONE", 4},
+		{"explicit conversion scaled on both sides", 1, "CONE", "TON", 0.0025},
+		{"explicit conversion via base unit", 2, "PCS", "KG", 2.5},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := converter.Convert(tc.quantity, tc.from, tc.to)
+			require.NoError(t, err)
+			assert.InDelta(t, tc.want, got, 1e-9)
+		})
+	}
+
+	_, err = converter.Convert(1, "M", "KG")
+	assert.ErrorIs(t, err, uom.ErrConversionNotFound)
+
+	_, err = converter.Convert(1, "KG", "UNKNOWN")
+	assert.ErrorIs(t, err, uom.ErrNotFound)
+}
+
+func TestUOMConverter_Ambiguous(t *testing.T) {
+	units := []*uom.UOM{
+		newTestUOM(t, "KG", "WEIGHT", 1),
+		newTestUOM(t, "PCS", "QUANTITY", 1),
+		newTestUOM(t, "CONE", "QUANTITY", 1),
+		newTestUOM(t, "BOBBIN", "QUANTITY", 1),
+	}
+	coneToKG, _ := uom.NewConversion("CONE", "KG", 2.5, "admin")
+	bobbinToKG, _ := uom.NewConversion("BOBBIN", "KG", 1.2, "admin")
+	converter := uom.NewConverter(units, []*uom.Conversion{coneToKG, bobbinToKG})
+
+	// Direct conversions win over paths through other units
+	got, err := converter.Convert(1, "CONE", "KG")
+	require.NoError(t, err)
+	assert.InDelta(t, 2.5, got, 1e-9)
+
+	// PCS has no direct conversion and two conflicting paths
+	_, err = converter.Convert(1, "PCS", "KG")
+	assert.ErrorIs(t, err, uom.ErrAmbiguousConversion)
+}
+
+func TestUOMDomain_NewConversionValidation(t *testing.T) {
+	_, err := uom.NewConversion("KG", "KG", 1, "admin")
+	assert.ErrorIs(t, err, uom.ErrConversionSameUOM)
+
+	_, err = uom.NewConversion("CONE", "KG", 0, "admin")
+	assert.ErrorIs(t, err, uom.ErrInvalidConversionFactor)
+
+	_, err = uom.NewConversion("CONE", "KG", 2.5, "")
+	assert.ErrorIs(t, err, uom.ErrEmptyCreatedBy)
+}
+
+func TestUOMDomain_PromoteToBase(t *testing.T) {
+	kg := newTestUOM(t, "KG", "WEIGHT", 1)
+	g := newTestUOM(t, "G", "WEIGHT", 0.001)
+	ton := newTestUOM(t, "TON", "WEIGHT", 1000)
+
+	changed, err := uom.PromoteToBase(g, []*uom.UOM{kg, g, ton}, "admin")
+	require.NoError(t, err)
+	assert.Len(t, changed, 2)
+
+	assert.True(t, g.IsBaseUOM())
+	assert.Equal(t, 1.0, g.ConversionFactor())
+	assert.False(t, kg.IsBaseUOM())
+	assert.InDelta(t, 1000, kg.ConversionFactor(), 1e-9)
+	assert.InDelta(t, 1e6, ton.ConversionFactor(), 1e-6)
+	require.NotNil(t, kg.UpdatedBy())
+	assert.Equal(t, "admin", *kg.UpdatedBy())
+}
+
+func TestUOMDomain_EnsureBaseRetained(t *testing.T) {
+	kg := newTestUOM(t, "KG", "WEIGHT", 1)
+	g := newTestUOM(t, "G", "WEIGHT", 0.001)
+	m := newTestUOM(t, "M", "LENGTH", 1)
+
+	assert.ErrorIs(t, uom.EnsureBaseRetained(kg, []*uom.UOM{kg, g}), uom.ErrBaseUOMRequired)
+	assert.NoError(t, uom.EnsureBaseRetained(kg, []*uom.UOM{kg, m}))
+	assert.NoError(t, uom.EnsureBaseRetained(g, []*uom.UOM{kg, g}))
+}
+
 func TestUOMRepository_Interface(t *testing.T) {
 	// This test verifies that the repository interface is properly defined
 	// The actual implementation tests would require a database connection
@@ -0,0 +1,225 @@
+package integration_test
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"buf.build/go/protovalidate"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	pb "github.com/homindolenern/goapps-costing-v1/gen/go/costing/v1"
+	appparam "github.com/homindolenern/goapps-costing-v1/internal/application/parameter"
+	"github.com/homindolenern/goapps-costing-v1/internal/application/watch"
+	grpcdelivery "github.com/homindolenern/goapps-costing-v1/internal/delivery/grpc"
+	httpdelivery "github.com/homindolenern/goapps-costing-v1/internal/delivery/http"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/event"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/parameter"
+)
+
+// memoryFeed is an in-memory parameter.ChangeFeed and event.Notifier.
+type memoryFeed struct {
+	mu      sync.Mutex
+	changes []*parameter.Change
+	signals []chan struct{}
+}
+
+func (f *memoryFeed) Head(context.Context) (event.Position, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if len(f.changes) == 0 {
+		return event.Position{}, nil
+	}
+	return f.changes[len(f.changes)-1].Position, nil
+}
+
+func (f *memoryFeed) ParameterChangesAfter(_ context.Context, after event.Position, limit int) ([]*parameter.Change, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var result []*parameter.Change
+	for _, change := range f.changes {
+		p := change.Position
+		if (p.Tx > after.Tx || p.Tx == after.Tx && p.Sequence > after.Sequence) && len(result) < limit {
+			result = append(result, change)
+		}
+	}
+	return result, nil
+}
+
+func (f *memoryFeed) Subscribe() (<-chan struct{}, func()) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	ch := make(chan struct{}, 1)
+	f.signals = append(f.signals, ch)
+	return ch, func() {}
+}
+
+// commit appends a change of code and wakes the watchers.
+func (f *memoryFeed) commit(t *testing.T, eventType event.Type, code string) {
+	t.Helper()
+	param, err := parameter.NewParameter(parameter.Code(code), code, parameter.CategoryMachine, parameter.DataTypeText, "alice")
+	require.NoError(t, err)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	n := int64(len(f.changes) + 1)
+	f.changes = append(f.changes, &parameter.Change{
+		Position:   event.Position{Tx: 100 + n, Sequence: n},
+		Type:       eventType,
+		OccurredAt: time.Now(),
+		Parameter:  param,
+	})
+	for _, ch := range f.signals {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// newWatchClient serves WatchParameters over an in-memory gRPC connection.
+func newWatchClient(t *testing.T, feed *memoryFeed, follower *watch.Follower) *grpc.ClientConn {
+	t.Helper()
+	validator, err := protovalidate.New()
+	require.NoError(t, err)
+
+	lis := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	pb.RegisterParameterServiceServer(server, grpcdelivery.NewParameterHandler(
+		nil, nil, nil, nil, nil, nil, nil,
+		appparam.NewWatchHandler(feed, follower),
+		grpcdelivery.NewValidationHelper(validator),
+	))
+	go func() { _ = server.Serve(lis) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn
+}
+
+func TestWatchParameters(t *testing.T) {
+	feed := &memoryFeed{}
+	feed.commit(t, event.ParameterCreated, "OLD")
+	follower := watch.NewFollower(feed, time.Minute)
+	client := pb.NewParameterServiceClient(newWatchClient(t, feed, follower))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	stream, err := client.WatchParameters(ctx, &pb.WatchParametersRequest{})
+	require.NoError(t, err)
+	header, err := stream.Header()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"101.1"}, header.Get(grpcdelivery.WatchStartKey), "starts at the latest change")
+
+	feed.commit(t, event.ParameterDeactivated, "RPM")
+	feed.commit(t, event.ParameterDeleted, "RPM")
+
+	first, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, pb.ChangeType_CHANGE_TYPE_UPDATED, first.ChangeType)
+	assert.Equal(t, "parameter.deactivated", first.EventType)
+	assert.Equal(t, "RPM", first.Parameter.ParameterCode)
+	second, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, pb.ChangeType_CHANGE_TYPE_DELETED, second.ChangeType)
+
+	t.Run("resumes after the token", func(t *testing.T) {
+		resumed, err := client.WatchParameters(ctx, &pb.WatchParametersRequest{ResumeToken: first.ResumeToken})
+		require.NoError(t, err)
+		change, err := resumed.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, second.ResumeToken, change.ResumeToken)
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		bad, err := client.WatchParameters(ctx, &pb.WatchParametersRequest{ResumeToken: "nope"})
+		require.NoError(t, err)
+		_, err = bad.Recv()
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Contains(t, status.Convert(err).Message(), parameter.ErrInvalidResumeToken.Error())
+	})
+
+	t.Run("stop ends the stream as unavailable", func(t *testing.T) {
+		follower.Stop()
+		_, err := stream.Recv()
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+	})
+}
+
+func TestWatchParameters_ServerSentEvents(t *testing.T) {
+	feed := &memoryFeed{}
+	feed.commit(t, event.ParameterCreated, "TPI")
+	feed.commit(t, event.ParameterUpdated, "TPI")
+	conn := newWatchClient(t, feed, watch.NewFollower(feed, time.Minute))
+	mux := httpdelivery.NewServeMux()
+	require.NoError(t, httpdelivery.RegisterWatchHandlers(mux, conn))
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/v1/parameters:watch?resume_token=0.0", nil)
+	require.NoError(t, err)
+	// A reconnecting EventSource sends the id of the last event it received
+	req.Header.Set("Last-Event-ID", "101.1")
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+	assert.Equal(t, "101.1", resp.Header.Get("X-Watch-Start"))
+
+	var lines []string
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() && scanner.Text() != "" {
+		lines = append(lines, scanner.Text())
+	}
+	require.NotEmpty(t, lines)
+	assert.Equal(t, "id: 102.2", lines[0])
+	data := strings.Join(lines[1:], "\n")
+	assert.Contains(t, data, `"changeType":"CHANGE_TYPE_UPDATED"`)
+	assert.Contains(t, data, `"parameterCode":"TPI"`)
+
+	t.Run("invalid token is a 400", func(t *testing.T) {
+		resp, err := http.Get(server.URL + "/v1/parameters:watch?resume_token=nope")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
+
+func TestEventPosition(t *testing.T) {
+	pos, err := event.ParsePosition("42.7")
+	require.NoError(t, err)
+	assert.Equal(t, event.Position{Tx: 42, Sequence: 7}, pos)
+	assert.Equal(t, "42.7", pos.String())
+
+	pos, err = event.ParsePosition("")
+	require.NoError(t, err)
+	assert.Zero(t, pos)
+
+	for _, bad := range []string{"42", "a.1", "1.-2", "1.2.3"} {
+		_, err := event.ParsePosition(bad)
+		assert.ErrorIs(t, err, event.ErrInvalidPosition, bad)
+	}
+}
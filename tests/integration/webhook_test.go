@@ -0,0 +1,295 @@
+package integration_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	appwebhook "github.com/homindolenern/goapps-costing-v1/internal/application/webhook"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/event"
+	"github.com/homindolenern/goapps-costing-v1/internal/domain/webhook"
+	"github.com/homindolenern/goapps-costing-v1/internal/infrastructure/publisher"
+	pkgwebhook "github.com/homindolenern/goapps-costing-v1/pkg/webhook"
+)
+
+// memoryWebhooks is an in-memory webhook.Repository.
+type memoryWebhooks struct {
+	mu         sync.Mutex
+	subs       []*webhook.Subscription
+	deliveries []*webhook.Delivery
+}
+
+func (m *memoryWebhooks) CreateSubscription(_ context.Context, sub *webhook.Subscription) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	sub.AssignID(int64(len(m.subs) + 1))
+	m.subs = append(m.subs, sub)
+	return nil
+}
+
+func (m *memoryWebhooks) GetSubscription(_ context.Context, id int64) (*webhook.Subscription, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, sub := range m.subs {
+		if sub.ID() == id {
+			return sub, nil
+		}
+	}
+	return nil, webhook.ErrSubscriptionNotFound
+}
+
+func (m *memoryWebhooks) ListSubscriptions(context.Context) ([]*webhook.Subscription, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.subs, nil
+}
+
+func (m *memoryWebhooks) SubscriptionsFor(_ context.Context, eventType event.Type) ([]*webhook.Subscription, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var result []*webhook.Subscription
+	for _, sub := range m.subs {
+		if sub.Subscribes(eventType) {
+			result = append(result, sub)
+		}
+	}
+	return result, nil
+}
+
+func (m *memoryWebhooks) DeleteSubscription(context.Context, int64) error {
+	return nil
+}
+
+func (m *memoryWebhooks) CreateDeliveries(_ context.Context, deliveries ...*webhook.Delivery) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, d := range deliveries {
+		duplicate := false
+		for _, existing := range m.deliveries {
+			duplicate = duplicate || existing.SubscriptionID() == d.SubscriptionID() && existing.EventID() == d.EventID()
+		}
+		if !duplicate {
+			d.AssignID(int64(len(m.deliveries) + 1))
+			m.deliveries = append(m.deliveries, d)
+		}
+	}
+	return nil
+}
+
+func (m *memoryWebhooks) ClaimDueDeliveries(_ context.Context, limit int, _ time.Duration) ([]*webhook.Delivery, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var result []*webhook.Delivery
+	for _, d := range m.deliveries {
+		if d.Status() == webhook.StatusPending && !d.NextAttemptAt().After(time.Now()) && len(result) < limit {
+			result = append(result, d)
+		}
+	}
+	return result, nil
+}
+
+func (m *memoryWebhooks) GetDelivery(_ context.Context, id int64) (*webhook.Delivery, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, d := range m.deliveries {
+		if d.ID() == id {
+			return d, nil
+		}
+	}
+	return nil, webhook.ErrDeliveryNotFound
+}
+
+func (m *memoryWebhooks) ListDeliveries(_ context.Context, filter webhook.DeliveryFilter) ([]*webhook.Delivery, int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var result []*webhook.Delivery
+	for _, d := range m.deliveries {
+		if filter.Status == nil || d.Status() == *filter.Status {
+			result = append(result, d)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].ID() > result[j].ID() })
+	return result, int64(len(result)), nil
+}
+
+func (m *memoryWebhooks) UpdateDelivery(context.Context, *webhook.Delivery) error {
+	return nil
+}
+
+// receiver is an HTTPS webhook endpoint verifying signatures.
+type receiver struct {
+	*httptest.Server
+	secret   atomic.Value
+	status   atomic.Int32
+	received chan event.Message
+}
+
+func newReceiver(t *testing.T) *receiver {
+	t.Helper()
+	r := &receiver{received: make(chan event.Message, 10)}
+	r.status.Store(http.StatusNoContent)
+	r.Server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		secret, _ := r.secret.Load().(string)
+		if err := pkgwebhook.Verify(req.Header, secret, body, 5*time.Minute, time.Now()); err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		assert.NotEmpty(t, req.Header.Get(pkgwebhook.DeliveryHeader))
+		var msg event.Message
+		assert.NoError(t, json.Unmarshal(body, &msg))
+		r.received <- msg
+		w.WriteHeader(int(r.status.Load()))
+	}))
+	t.Cleanup(r.Close)
+	return r
+}
+
+func TestWebhookDispatcher(t *testing.T) {
+	ctx := context.Background()
+	endpoint := newReceiver(t)
+	repo := &memoryWebhooks{}
+
+	create := appwebhook.NewCreateHandler(repo)
+	sub, err := create.Handle(ctx, appwebhook.CreateCommand{
+		URL:        endpoint.URL + "/hooks",
+		EventTypes: []string{"parameter.updated", "parameter.deleted"},
+		CreatedBy:  "alice",
+	})
+	require.NoError(t, err)
+	endpoint.secret.Store(sub.Secret())
+	_, err = create.Handle(ctx, appwebhook.CreateCommand{
+		URL:        endpoint.URL + "/uoms",
+		EventTypes: []string{"uom.created"},
+		CreatedBy:  "alice",
+	})
+	require.NoError(t, err)
+
+	// The relay hands a message over twice; it is recorded once
+	fanout := appwebhook.NewFanout(repo)
+	msg := newMemoryOutbox(event.ParameterUpdated).messages[0]
+	msg.EventID = "5f0c6f1e-0000-4000-8000-000000000002"
+	require.NoError(t, fanout.Publish(ctx, msg))
+	require.NoError(t, fanout.Publish(ctx, msg))
+	require.Len(t, repo.deliveries, 1, "only the matching subscription gets a delivery")
+	delivery := repo.deliveries[0]
+
+	endpoint.status.Store(http.StatusServiceUnavailable)
+	dispatcher := appwebhook.NewDispatcher(repo, publisher.NewSubscriptionSender(endpoint.Client()), appwebhook.DispatcherConfig{
+		Retry: webhook.RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond},
+	})
+
+	t.Run("failures are retried, then dead", func(t *testing.T) {
+		n, err := dispatcher.DispatchOnce(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+		got := <-endpoint.received
+		assert.Equal(t, msg.EventID, got.EventID)
+		assert.JSONEq(t, `{"parameter_code":"TPI"}`, string(got.Payload))
+		assert.Equal(t, webhook.StatusPending, delivery.Status())
+		assert.Equal(t, 1, delivery.Attempts())
+		require.NotNil(t, delivery.LastStatusCode())
+		assert.Equal(t, http.StatusServiceUnavailable, *delivery.LastStatusCode())
+
+		time.Sleep(5 * time.Millisecond)
+		_, err = dispatcher.DispatchOnce(ctx)
+		require.NoError(t, err)
+		<-endpoint.received
+		assert.Equal(t, webhook.StatusDead, delivery.Status())
+
+		dead := webhook.StatusDead
+		letters, total, err := repo.ListDeliveries(ctx, webhook.DeliveryFilter{Status: &dead})
+		require.NoError(t, err)
+		assert.EqualValues(t, 1, total)
+		assert.Equal(t, delivery, letters[0])
+	})
+
+	t.Run("replay delivers a dead letter", func(t *testing.T) {
+		replay := appwebhook.NewReplayHandler(repo)
+		_, err := replay.Handle(ctx, appwebhook.ReplayCommand{ID: delivery.ID()})
+		require.NoError(t, err)
+		assert.Equal(t, webhook.StatusPending, delivery.Status())
+		assert.Zero(t, delivery.Attempts())
+
+		_, err = replay.Handle(ctx, appwebhook.ReplayCommand{ID: delivery.ID()})
+		assert.ErrorIs(t, err, webhook.ErrDeliveryPending)
+
+		endpoint.status.Store(http.StatusOK)
+		_, err = dispatcher.DispatchOnce(ctx)
+		require.NoError(t, err)
+		<-endpoint.received
+		assert.Equal(t, webhook.StatusSucceeded, delivery.Status())
+		assert.NotNil(t, delivery.DeliveredAt())
+		assert.Nil(t, delivery.LastError())
+	})
+
+	t.Run("a wrong secret is rejected by the receiver", func(t *testing.T) {
+		endpoint.secret.Store("whsec_other")
+		sender := publisher.NewSubscriptionSender(endpoint.Client())
+		status, err := sender.Send(ctx, sub, delivery)
+		assert.Error(t, err)
+		assert.Equal(t, http.StatusUnauthorized, status)
+	})
+}
+
+func TestWebhookSubscriptionValidation(t *testing.T) {
+	create := appwebhook.NewCreateHandler(&memoryWebhooks{})
+	cases := map[string]struct {
+		cmd  appwebhook.CreateCommand
+		want error
+	}{
+		"plain http": {
+			appwebhook.CreateCommand{URL: "http://example.com/hook", EventTypes: []string{"uom.created"}, CreatedBy: "alice"},
+			webhook.ErrInvalidURL,
+		},
+		"relative url": {
+			appwebhook.CreateCommand{URL: "/hook", EventTypes: []string{"uom.created"}, CreatedBy: "alice"},
+			webhook.ErrInvalidURL,
+		},
+		"unknown event type": {
+			appwebhook.CreateCommand{URL: "https://example.com/hook", EventTypes: []string{"uom.exploded"}, CreatedBy: "alice"},
+			event.ErrInvalidType,
+		},
+		"no event type": {
+			appwebhook.CreateCommand{URL: "https://example.com/hook", CreatedBy: "alice"},
+			webhook.ErrNoEventTypes,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := create.Handle(context.Background(), tc.cmd)
+			assert.ErrorIs(t, err, tc.want)
+		})
+	}
+}
+
+func TestWebhookSignature(t *testing.T) {
+	body := []byte(`{"event_type":"uom.created"}`)
+	signedAt := time.Unix(1_700_000_000, 0)
+	header := http.Header{}
+	pkgwebhook.SetHeaders(header, "whsec_test", signedAt, body)
+
+	assert.NoError(t, pkgwebhook.Verify(header, "whsec_test", body, time.Minute, signedAt.Add(30*time.Second)))
+	assert.ErrorIs(t, pkgwebhook.Verify(header, "whsec_test", []byte(`{}`), time.Minute, signedAt), pkgwebhook.ErrInvalidSignature)
+	assert.ErrorIs(t, pkgwebhook.Verify(header, "whsec_other", body, time.Minute, signedAt), pkgwebhook.ErrInvalidSignature)
+	assert.ErrorIs(t, pkgwebhook.Verify(header, "whsec_test", body, time.Minute, signedAt.Add(2*time.Minute)), pkgwebhook.ErrExpired)
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := webhook.RetryPolicy{MaxAttempts: 8, InitialBackoff: time.Minute, MaxBackoff: 10 * time.Minute}
+	assert.Equal(t, time.Minute, policy.Backoff(1))
+	assert.Equal(t, 2*time.Minute, policy.Backoff(2))
+	assert.Equal(t, 8*time.Minute, policy.Backoff(4))
+	assert.Equal(t, 10*time.Minute, policy.Backoff(5))
+	assert.Equal(t, 10*time.Minute, policy.Backoff(50))
+}